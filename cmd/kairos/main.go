@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/alexanderramin/kairos/internal/cli"
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/intelligence"
 	"github.com/alexanderramin/kairos/internal/llm"
@@ -63,6 +64,9 @@ func run() error {
 	depRepo := repository.NewSQLiteDependencyRepo(database)
 	sessionRepo := repository.NewSQLiteSessionRepo(database)
 	profileRepo := repository.NewSQLiteUserProfileRepo(database)
+	snapshotRepo := repository.NewSQLiteRiskSnapshotRepo(database)
+	focusRepo := repository.NewSQLiteFocusRepo(database)
+	auditRepo := repository.NewSQLiteAuditRepo(database)
 
 	// Wire unit of work for transactional operations
 	uow := db.NewSQLiteUnitOfWork(database)
@@ -71,22 +75,46 @@ func run() error {
 	if envEnabled("KAIROS_LOG_USECASES") {
 		useCaseObserver = service.NewLogUseCaseObserver(os.Stderr)
 	}
+	// In-memory latency stats are cheap; always collect them for `debug timings`.
+	timings := service.NewTimingUseCaseObserver()
+	useCaseObserver = service.NewMultiUseCaseObserver(timings, useCaseObserver)
+	// Mutations are always recorded in the audit log (see `history`).
+	useCaseObserver = service.NewAuditUseCaseObserver(auditRepo, useCaseObserver)
 
 	// Wire services
-	sessionSvc := service.NewSessionService(sessionRepo, uow, useCaseObserver)
+	replanSvc := service.NewReplanService(projectRepo, workItemRepo, sessionRepo, profileRepo, uow, useCaseObserver)
+	// Opt-in (profile set auto-replan=true): replan the affected project
+	// after each logged session.
+	sessionSvc := service.NewAutoReplanSessionService(
+		service.NewSessionService(sessionRepo, uow, useCaseObserver),
+		replanSvc, profileRepo, workItemRepo, nodeRepo,
+	)
 	templateSvc := service.NewTemplateService(templateDir, uow, useCaseObserver)
 	importSvc := service.NewImportService(uow, useCaseObserver)
+	statusSvc := service.NewStatusService(projectRepo, nodeRepo, workItemRepo, sessionRepo, profileRepo, snapshotRepo)
+	weeklySvc := service.NewWeeklyPlanService(workItemRepo, sessionRepo, depRepo, profileRepo)
 
 	app := &cli.App{
-		Projects:  service.NewProjectService(projectRepo),
+		Projects:  service.NewProjectService(projectRepo, uow),
 		Nodes:     service.NewNodeService(nodeRepo, uow),
-		WorkItems: service.NewWorkItemService(workItemRepo, nodeRepo, uow),
+		WorkItems: service.NewWorkItemService(workItemRepo, nodeRepo, uow, useCaseObserver),
 		Sessions:  sessionSvc,
 		WhatNow:   service.NewWhatNowService(workItemRepo, sessionRepo, depRepo, profileRepo, useCaseObserver),
-		Status:    service.NewStatusService(projectRepo, workItemRepo, sessionRepo, profileRepo),
-		Replan:    service.NewReplanService(projectRepo, workItemRepo, sessionRepo, profileRepo, uow, useCaseObserver),
+		Status:    statusSvc,
+		Replan:    replanSvc,
 		Templates: templateSvc,
 		Import:    importSvc,
+		Export:    service.NewExportService(uow, useCaseObserver),
+		Stats:     service.NewStatsService(workItemRepo),
+		Focus:     service.NewFocusService(focusRepo, workItemRepo),
+		Audit:     service.NewAuditService(auditRepo),
+		Presets:   service.NewWorkPresetService(repository.NewSQLiteWorkPresetRepo(database)),
+		Plans:     service.NewDayPlanService(repository.NewSQLiteDayPlanRepo(database), sessionRepo, workItemRepo, uow),
+		Weekly:    weeklySvc,
+		Review:    service.NewWeeklyReviewService(statusSvc, weeklySvc, workItemRepo, sessionRepo),
+		Profile:   service.NewProfileService(profileRepo),
+		Archive:   service.NewArchiveService(repository.NewSQLiteArchiveRepo(database), uow),
+		Timings:   timings,
 
 		LogSession:    sessionSvc,
 		InitProject:   templateSvc,
@@ -100,6 +128,7 @@ func run() error {
 
 	// Wire v2 intelligence services (only when LLM is enabled)
 	llmCfg := llm.LoadConfig()
+	app.LLMConfig = llmCfg
 	if llmCfg.Enabled {
 		var observer llm.Observer = llm.NoopObserver{}
 		if llmCfg.LogCalls {
@@ -115,6 +144,45 @@ func run() error {
 		app.Help = intelligence.NewHelpService(llmClient, observer)
 	}
 
+	// Load TUI key bindings: env var or default ~/.kairos/keys.toml. A
+	// missing file keeps the built-in keys.
+	keysPath := os.Getenv("KAIROS_KEYS")
+	if keysPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("finding home directory: %w", err)
+		}
+		keysPath = filepath.Join(home, ".kairos", "keys.toml")
+	}
+	keys, err := cli.LoadKeymap(keysPath)
+	if err != nil {
+		return err
+	}
+	app.Keys = keys
+	app.DBPath, app.TemplateDir, app.KeysPath = dbPath, templateDir, keysPath
+	// An unfinished project draft is kept next to the database, so each
+	// KAIROS_DB has its own.
+	app.DraftPath = filepath.Join(filepath.Dir(dbPath), "draft.json")
+
+	// Default output verbosity: KAIROS_VERBOSITY, overridden by a leading
+	// --quiet or --verbose.
+	verbosity, ok := formatter.ParseVerbosity(os.Getenv("KAIROS_VERBOSITY"))
+	if !ok {
+		return fmt.Errorf("KAIROS_VERBOSITY must be quiet, normal or verbose, got %q", os.Getenv("KAIROS_VERBOSITY"))
+	}
+	args := os.Args[1:]
+	for len(args) > 0 && (args[0] == "--quiet" || args[0] == "--verbose") {
+		verbosity, _ = formatter.ParseVerbosity(strings.TrimPrefix(args[0], "--"))
+		args = args[1:]
+	}
+	app.Verbosity = verbosity
+
+	// `kairos what-now --oneline` is the one command that runs outside the
+	// shell, so prompts and status bars can show the next action.
+	if len(args) > 0 && args[0] == "what-now" {
+		return cli.RunWhatNowOneline(app, args[1:], os.Stdout)
+	}
+
 	// Launch interactive shell (only entry point).
 	if !app.IsInteractive() {
 		return fmt.Errorf("kairos requires an interactive terminal")
@@ -16,6 +16,11 @@ const (
 	ReasonOnTrackSafeMix    RecommendationReasonCode = "ON_TRACK_SAFE_MIX"
 	ReasonCriticalFocus     RecommendationReasonCode = "CRITICAL_FOCUS"
 	ReasonMomentum          RecommendationReasonCode = "MOMENTUM"
+	ReasonContinuePinned    RecommendationReasonCode = "CONTINUE_PINNED"
+	ReasonFocusList         RecommendationReasonCode = "FOCUS_LIST"
+	ReasonWarmup            RecommendationReasonCode = "WARMUP"
+	ReasonProjectImportance RecommendationReasonCode = "PROJECT_IMPORTANCE"
+	ReasonManualPriority    RecommendationReasonCode = "MANUAL_PRIORITY"
 )
 
 type RecommendationReason struct {
@@ -54,6 +59,10 @@ type RiskSummary struct {
 	RecentDailyMin    float64
 	SlackMinPerDay    float64
 	ProgressTimePct   float64
+	// Infeasible marks a deadline the remaining work cannot meet even at the
+	// profile's max daily minutes; ShortfallMin is the work that won't fit.
+	Infeasible   bool
+	ShortfallMin int
 }
 
 type ConstraintBlockerCode string
@@ -65,6 +74,12 @@ const (
 	BlockerNotInCriticalScope     ConstraintBlockerCode = "NOT_IN_CRITICAL_SCOPE"
 	BlockerSessionMinExceedsAvail ConstraintBlockerCode = "SESSION_MIN_EXCEEDS_AVAILABLE"
 	BlockerWorkComplete           ConstraintBlockerCode = "WORK_COMPLETE"
+	BlockerUserExcluded           ConstraintBlockerCode = "USER_EXCLUDED"
+	BlockerNeedsLongerBlock       ConstraintBlockerCode = "NEEDS_LONGER_BLOCK"
+	BlockerInsufficientTime       ConstraintBlockerCode = "INSUFFICIENT_TIME"
+	BlockerWrongContext           ConstraintBlockerCode = "WRONG_CONTEXT"
+	BlockerCriticalSnoozed        ConstraintBlockerCode = "CRITICAL_SNOOZED"
+	BlockerNotStarted             ConstraintBlockerCode = "NOT_STARTED"
 )
 
 type ConstraintBlocker struct {
@@ -0,0 +1,32 @@
+package app
+
+import "github.com/alexanderramin/kairos/internal/domain"
+
+// DayPlanProgress is one planned slice with the time actually logged on its
+// work item during the plan's day.
+type DayPlanProgress struct {
+	domain.DayPlanItem
+	LoggedMin int
+	// Done reports whether the work item is finished now.
+	Done bool
+}
+
+// DayPlanAdherence compares a saved day plan with what was logged that day.
+type DayPlanAdherence struct {
+	Plan  *domain.DayPlan
+	Items []DayPlanProgress
+	// PlannedMin is the total allocated in the plan; CoveredMin counts
+	// logged time toward it, capped per item at the item's allocation.
+	PlannedMin int
+	CoveredMin int
+	// UnplannedMin is time logged that day on items outside the plan.
+	UnplannedMin int
+}
+
+// AdherencePct is CoveredMin as a percentage of PlannedMin.
+func (a *DayPlanAdherence) AdherencePct() float64 {
+	if a.PlannedMin == 0 {
+		return 0
+	}
+	return float64(a.CoveredMin) / float64(a.PlannedMin) * 100
+}
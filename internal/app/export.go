@@ -0,0 +1,111 @@
+package app
+
+import "time"
+
+// ExportFormatVersion is bumped whenever the export envelope changes shape.
+const ExportFormatVersion = 1
+
+// ExportRequest selects which entities to include in an incremental export.
+// A zero Since exports everything.
+type ExportRequest struct {
+	Since time.Time
+	Now   *time.Time
+}
+
+// ExportEnvelope is the JSON document produced by `export`. Timestamps are
+// RFC3339 UTC; calendar dates use YYYY-MM-DD.
+type ExportEnvelope struct {
+	FormatVersion int               `json:"format_version"`
+	Since         string            `json:"since,omitempty"`
+	GeneratedAt   string            `json:"generated_at"`
+	Projects      []ExportProject   `json:"projects"`
+	Nodes         []ExportNode      `json:"nodes"`
+	WorkItems     []ExportWorkItem  `json:"work_items"`
+	Sessions      []ExportSession   `json:"sessions"`
+	Tombstones    []ExportTombstone `json:"tombstones"`
+}
+
+type ExportProject struct {
+	ID         string  `json:"id"`
+	ShortID    string  `json:"short_id"`
+	Name       string  `json:"name"`
+	Domain     string  `json:"domain"`
+	StartDate  string  `json:"start_date"`
+	TargetDate *string `json:"target_date,omitempty"`
+	Status     string  `json:"status"`
+	ArchivedAt *string `json:"archived_at,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+type ExportNode struct {
+	ID               string  `json:"id"`
+	ProjectID        string  `json:"project_id"`
+	ParentID         *string `json:"parent_id,omitempty"`
+	Seq              int     `json:"seq"`
+	Title            string  `json:"title"`
+	Kind             string  `json:"kind"`
+	IsDefault        bool    `json:"is_default,omitempty"`
+	OrderIndex       int     `json:"order_index"`
+	DueDate          *string `json:"due_date,omitempty"`
+	NotBefore        *string `json:"not_before,omitempty"`
+	NotAfter         *string `json:"not_after,omitempty"`
+	PlannedMinBudget *int    `json:"planned_min_budget,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type ExportWorkItem struct {
+	ID                 string  `json:"id"`
+	NodeID             string  `json:"node_id"`
+	Seq                int     `json:"seq"`
+	Title              string  `json:"title"`
+	Description        string  `json:"description,omitempty"`
+	Type               string  `json:"type"`
+	Status             string  `json:"status"`
+	DurationMode       string  `json:"duration_mode"`
+	PlannedMin         int     `json:"planned_min"`
+	LoggedMin          int     `json:"logged_min"`
+	DurationSource     string  `json:"duration_source"`
+	EstimateConfidence float64 `json:"estimate_confidence"`
+	MinSessionMin      int     `json:"min_session_min"`
+	MaxSessionMin      int     `json:"max_session_min"`
+	DefaultSessionMin  int     `json:"default_session_min"`
+	Splittable         bool    `json:"splittable"`
+	UnitsKind          string  `json:"units_kind,omitempty"`
+	UnitsTotal         int     `json:"units_total,omitempty"`
+	UnitsDone          int     `json:"units_done,omitempty"`
+	DueDate            *string `json:"due_date,omitempty"`
+	NotBefore          *string `json:"not_before,omitempty"`
+	ArchivedAt         *string `json:"archived_at,omitempty"`
+	CompletedAt        *string `json:"completed_at,omitempty"`
+	CreatedAt          string  `json:"created_at"`
+	UpdatedAt          string  `json:"updated_at"`
+
+	Checklist []ExportChecklistItem `json:"checklist,omitempty"`
+}
+
+type ExportChecklistItem struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+type ExportSession struct {
+	ID             string   `json:"id"`
+	WorkItemID     string   `json:"work_item_id"`
+	StartedAt      string   `json:"started_at"`
+	Minutes        int      `json:"minutes"`
+	UnitsDoneDelta int      `json:"units_done_delta,omitempty"`
+	Note           string   `json:"note,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+// ExportTombstone tells the consumer that an entity was deleted or archived
+// after the cutoff and should be removed or hidden on their side.
+type ExportTombstone struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	Reason     string `json:"reason"`
+	At         string `json:"at"`
+}
@@ -21,12 +21,26 @@ type ReplanUseCase interface {
 
 type LogSessionUseCase interface {
 	LogSession(ctx context.Context, s *domain.WorkSessionLog) error
+	LogSessionAndFinish(ctx context.Context, s *domain.WorkSessionLog) error
 }
 
 type InitProjectUseCase interface {
 	InitProject(ctx context.Context, templateName string, projectName string, shortID string, startDate string, dueDate *string, vars map[string]string) (*domain.Project, error)
 }
 
+// TemplateValidation reports the problems found in a template file. Errors
+// would break `project init`; warnings flag unusual but accepted values.
+type TemplateValidation struct {
+	Path     string
+	ID       string
+	Name     string
+	Errors   []string
+	Warnings []string
+}
+
+// Valid reports whether the template has no errors.
+func (v *TemplateValidation) Valid() bool { return len(v.Errors) == 0 }
+
 type ImportResult struct {
 	Project         *domain.Project
 	NodeCount       int
@@ -34,7 +48,50 @@ type ImportResult struct {
 	DependencyCount int
 }
 
+// MergeImportOptions controls how a merge-import reconciles an existing project.
+type MergeImportOptions struct {
+	// Prune archives work items whose ref no longer appears in the import file.
+	Prune bool
+}
+
+// MergeImportResult summarizes a merge-import. When no project matched the
+// schema's short_id, Created is true and the plan was imported as new.
+type MergeImportResult struct {
+	Project           *domain.Project
+	Created           bool
+	NodesAdded        int
+	NodesUpdated      int
+	WorkItemsAdded    int
+	WorkItemsUpdated  int
+	WorkItemsArchived int
+	DependenciesAdded int
+}
+
+// ImportPreview reports what importing a file would create, without writing.
+// Problems holds validation errors and conflicts with existing data; the
+// counts are only filled in when Problems is empty.
+type ImportPreview struct {
+	ShortID         string
+	Name            string
+	NodeCount       int
+	WorkItemCount   int
+	DependencyCount int
+	Problems        []string
+}
+
 type ImportProjectUseCase interface {
 	ImportProject(ctx context.Context, filePath string) (*ImportResult, error)
+	PreviewImport(ctx context.Context, filePath string) (*ImportPreview, error)
 	ImportProjectFromSchema(ctx context.Context, schema *importer.ImportSchema) (*ImportResult, error)
+	MergeProject(ctx context.Context, filePath string, opts MergeImportOptions) (*MergeImportResult, error)
+	MergeProjectFromSchema(ctx context.Context, schema *importer.ImportSchema, opts MergeImportOptions) (*MergeImportResult, error)
+}
+
+type StatsUseCase interface {
+	EstimateAccuracy(ctx context.Context) (*EstimateAccuracyResponse, error)
+	SuggestEstimate(ctx context.Context, req EstimateSuggestionRequest) (*EstimateSuggestion, error)
+}
+
+type ExportUseCase interface {
+	Export(ctx context.Context, req ExportRequest) (*ExportEnvelope, error)
 }
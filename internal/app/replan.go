@@ -13,8 +13,9 @@ type ReplanRequest struct {
 	Strategy                    string // "rebalance" or "deadline_first"
 	PreserveExistingAssignments bool
 	IncludeArchived             bool
-	IncludeRecentSessionDays    int // lookback window for pace calculation; 0 defaults to 7
+	IncludeRecentSessionDays    int // lookback window for pace calculation; 0 uses the profile's pace window
 	Explain                     bool
+	DryRun                      bool // compute deltas, then roll back every write
 }
 
 func NewReplanRequest(trigger domain.ReplanTrigger) ReplanRequest {
@@ -22,7 +23,6 @@ func NewReplanRequest(trigger domain.ReplanTrigger) ReplanRequest {
 		Trigger:                     trigger,
 		Strategy:                    "rebalance",
 		PreserveExistingAssignments: true,
-		IncludeRecentSessionDays:    7,
 		Explain:                     true,
 	}
 }
@@ -37,9 +37,28 @@ type ProjectReplanDelta struct {
 	RemainingMinBefore     int
 	RemainingMinAfter      int
 	ChangedItemsCount      int
+	ItemChanges            []ReplanItemChange
 	Notes                  []string
 }
 
+// ReplanItemChange is a single work item whose estimate replan adjusted.
+type ReplanItemChange struct {
+	WorkItemID       string
+	Title            string
+	PlannedMinBefore int
+	PlannedMinAfter  int
+
+	// The unit progress that drove the re-estimate: MinPerUnit is the
+	// observed pace (logged minutes per unit done) and ImpliedTotalMin that
+	// pace over all units, which the new estimate is smoothed towards.
+	LoggedMin       int
+	UnitsDone       int
+	UnitsTotal      int
+	UnitsKind       string
+	MinPerUnit      float64
+	ImpliedTotalMin int
+}
+
 type ReplanResponse struct {
 	GeneratedAt        time.Time
 	Trigger            domain.ReplanTrigger
@@ -49,6 +68,7 @@ type ReplanResponse struct {
 	GlobalModeAfter    domain.PlanMode
 	Warnings           []string
 	Explanation        *ReplanExplanation
+	DryRun             bool // true when nothing was persisted
 }
 
 type ReplanExplanation struct {
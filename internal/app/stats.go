@@ -0,0 +1,54 @@
+package app
+
+// EstimateAccuracy summarizes how logged time compared to the original
+// estimate for a group of completed work items. Ratio is LoggedMin divided by
+// InitialPlannedMin, so values above 1 mean the work took longer than planned.
+type EstimateAccuracy struct {
+	Type        string
+	Count       int
+	MeanRatio   float64
+	StdDevRatio float64
+	MinRatio    float64
+	MaxRatio    float64
+}
+
+type EstimateAccuracyResponse struct {
+	ByType  []EstimateAccuracy
+	Overall EstimateAccuracy
+	// SkippedNoEstimate counts done items excluded because they had no
+	// original estimate to compare against.
+	SkippedNoEstimate int
+}
+
+// EstimateSuggestionRequest describes a work item about to be added. Units
+// and UnitLabel are optional; with Units set, history is read as minutes per
+// unit.
+type EstimateSuggestionRequest struct {
+	Type      string
+	Units     int
+	UnitLabel string
+}
+
+// EstimateBasis says what an estimate suggestion was computed from.
+type EstimateBasis string
+
+const (
+	// EstimateBasisPerUnit scales the observed minutes per unit by Units.
+	EstimateBasisPerUnit EstimateBasis = "per_unit"
+	// EstimateBasisAverage is the mean logged time of past items of the type.
+	EstimateBasisAverage EstimateBasis = "average"
+	// EstimateBasisDefault means there was no history to go on.
+	EstimateBasisDefault EstimateBasis = "default"
+)
+
+// EstimateSuggestion is a proposed PlannedMin and the history behind it.
+type EstimateSuggestion struct {
+	Type         string
+	Units        int
+	UnitLabel    string
+	SuggestedMin int
+	Basis        EstimateBasis
+	SampleCount  int     // completed items the suggestion is based on
+	MinPerUnit   float64 // set for EstimateBasisPerUnit
+	MeanMin      float64 // mean logged minutes of the samples
+}
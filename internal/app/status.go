@@ -12,13 +12,15 @@ type StatusRequest struct {
 	IncludeArchived          bool
 	Recalc                   bool
 	IncludeBlockers          bool
-	IncludeRecentSessionDays int
+	IncludeRecentSessionDays int // lookback window for pace calculation; 0 uses the profile's pace window
+	// CompareTo, when set, attaches a Delta to each project view describing
+	// how progress and risk changed since that date.
+	CompareTo *time.Time
 }
 
 func NewStatusRequest() StatusRequest {
 	return StatusRequest{
-		Recalc:                   true,
-		IncludeRecentSessionDays: 7,
+		Recalc: true,
 	}
 }
 
@@ -31,6 +33,8 @@ type ProjectStatusView struct {
 	DaysLeft              *int
 	ProgressTimePct       float64
 	ProgressStructuralPct float64
+	TimeElapsedPct        float64 // share of the start-to-target timeline passed, 0-100; 0 without a target
+	WorkDonePct           float64 // share of planned minutes in finished items
 	PlannedMinTotal       int
 	LoggedMinTotal        int
 	RemainingMinTotal     int
@@ -38,17 +42,55 @@ type ProjectStatusView struct {
 	RecentDailyMin        float64
 	SlackMinPerDay        float64
 	SafeForSecondaryWork  bool
-	Notes                 []string
+	// Infeasible marks a deadline the remaining work cannot meet even at the
+	// profile's max daily minutes; ShortfallMin is the work that won't fit.
+	Infeasible   bool
+	ShortfallMin int
+	// Upcoming marks a project whose start date is still in the future
+	// (StartsOn). It counts as on track and carries no pace figures, since
+	// there is nothing it should have done yet.
+	Upcoming bool
+	StartsOn *string
+	// NextMilestone is the soonest assessment node that still has work
+	// open, so a looming exam or deliverable is not lost behind weekly
+	// tasks; nil when the project has none.
+	NextMilestone *ProjectMilestone
+	Notes         []string
+	Delta         *ProjectStatusDelta
+}
+
+// ProjectMilestone is an assessment node with a due date, as surfaced by
+// status and the dashboard.
+type ProjectMilestone struct {
+	NodeID   string
+	Title    string
+	DueDate  string
+	DaysLeft int  // negative once the due date has passed
+	Started  bool // some of its work has been logged or finished
+}
+
+// ProjectStatusDelta describes a project's change between a past date and now.
+type ProjectStatusDelta struct {
+	// IsNew is set when the project did not exist at the comparison date.
+	IsNew bool
+	// HasSnapshot is false when no history was recorded on or before the
+	// comparison date; the Before/Delta fields are then zero.
+	HasSnapshot           bool
+	SnapshotDate          time.Time
+	RiskBefore            domain.RiskLevel
+	ProgressTimePctBefore float64
+	ProgressTimePctDelta  float64
 }
 
 type GlobalStatusSummary struct {
-	GeneratedAt      time.Time
-	CountsTotal      int
-	CountsOnTrack    int
-	CountsAtRisk     int
-	CountsCritical   int
-	GlobalModeIfNow  domain.PlanMode
-	PolicyMessage    string
+	GeneratedAt     time.Time
+	CountsTotal     int
+	CountsOnTrack   int
+	CountsAtRisk    int
+	CountsCritical  int
+	CountsUpcoming  int // not started yet; not included in CountsOnTrack
+	GlobalModeIfNow domain.PlanMode
+	PolicyMessage   string
 }
 
 type StatusResponse struct {
@@ -0,0 +1,65 @@
+package app
+
+import "time"
+
+// WeeklyPlanDay is one simulated day of a weekly plan.
+type WeeklyPlanDay struct {
+	Date time.Time
+	// AvailableMin is the profile's availability for the day's weekday.
+	AvailableMin int
+	Slices       []WorkSlice
+}
+
+// AllocatedMin sums the day's slices.
+func (d WeeklyPlanDay) AllocatedMin() int {
+	total := 0
+	for _, sl := range d.Slices {
+		total += sl.AllocatedMin
+	}
+	return total
+}
+
+// InfeasibleProject is a project whose remaining work does not fit in the
+// days up to its deadline.
+type InfeasibleProject struct {
+	ProjectID   string
+	ProjectName string
+	DueDate     time.Time
+	// RemainingMin is the project's open work at the start of the plan;
+	// ScheduledMin is what the plan fits in on or before DueDate.
+	RemainingMin int
+	ScheduledMin int
+}
+
+// ShortfallMin is the work left over at the deadline.
+func (p InfeasibleProject) ShortfallMin() int {
+	return p.RemainingMin - p.ScheduledMin
+}
+
+// WeeklyPlan spreads remaining work over the coming days, one what-now
+// allocation per day. Infeasible lists projects due within the plan (or
+// already overdue) that it cannot finish in time, soonest deadline first.
+type WeeklyPlan struct {
+	Days       []WeeklyPlanDay
+	Infeasible []InfeasibleProject
+	// ProjectNames maps the project IDs in Days to their names.
+	ProjectNames map[string]string
+}
+
+// AllocatedMin sums every day's slices.
+func (p *WeeklyPlan) AllocatedMin() int {
+	total := 0
+	for _, d := range p.Days {
+		total += d.AllocatedMin()
+	}
+	return total
+}
+
+// AvailableMin sums every day's availability.
+func (p *WeeklyPlan) AvailableMin() int {
+	total := 0
+	for _, d := range p.Days {
+		total += d.AvailableMin
+	}
+	return total
+}
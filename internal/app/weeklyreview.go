@@ -0,0 +1,71 @@
+package app
+
+import (
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// WeeklyReviewRequest asks for a review of the seven days up to Now (the
+// current time when nil) and a plan for the seven days from Now.
+type WeeklyReviewRequest struct {
+	Now *time.Time
+}
+
+// WeeklyReviewProject is one project's time over the review period.
+type WeeklyReviewProject struct {
+	ProjectID   string
+	ProjectName string
+	RiskLevel   domain.RiskLevel
+	PlannedMin  int
+	// LoggedMin and Sessions count only sessions started in the period.
+	LoggedMin int
+	Sessions  int
+}
+
+// WeeklyReviewItem is a work item finished during the review period.
+type WeeklyReviewItem struct {
+	ProjectName string
+	WorkItemSeq int
+	Title       string
+	CompletedAt time.Time
+}
+
+// WeeklyReviewRisk is a project whose risk rose since the start of the
+// period, or whose deadline can no longer be met at the max daily minutes.
+type WeeklyReviewRisk struct {
+	ProjectID   string
+	ProjectName string
+	DueDate     *string
+	// RiskBefore is the level at the start of the period; it is empty when
+	// no snapshot was recorded then.
+	RiskBefore   domain.RiskLevel
+	RiskLevel    domain.RiskLevel
+	Infeasible   bool
+	ShortfallMin int
+}
+
+// WeeklyReviewAction is one item of the next-week plan: the days the weekly
+// planner puts it on and the minutes it gets over the week.
+type WeeklyReviewAction struct {
+	WorkItemID  string
+	WorkItemSeq int
+	Title       string
+	ProjectName string
+	Days        []time.Time
+	Minutes     int
+}
+
+// WeeklyReview looks back over [From, To) and ahead one week. NextWeek is in
+// the order the weekly planner first schedules each item, most urgent first.
+type WeeklyReview struct {
+	From         time.Time
+	To           time.Time
+	LoggedMin    int
+	SessionCount int
+	// Projects lists every active project, most time logged first.
+	Projects  []WeeklyReviewProject
+	Completed []WeeklyReviewItem
+	Worsened  []WeeklyReviewRisk
+	NextWeek  []WeeklyReviewAction
+}
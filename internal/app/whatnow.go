@@ -15,8 +15,62 @@ type WhatNowRequest struct {
 	MaxSlices        int
 	EnforceVariation bool
 	Explain          bool
+	// MaxProjects, when set, caps how many distinct projects the slices come
+	// from: the top-ranked projects up to the cap share the time, with
+	// variation still applied among them. 0 means no cap.
+	MaxProjects int
+	// ProjectBudgetMin, when set, caps the minutes any one project's slices
+	// may take, so the time is shared out instead of going mostly to the
+	// top-scored project. EvenProjectBudget sets the cap to AvailableMin
+	// divided by the projects with schedulable work (at most MaxProjects).
+	// A project's first slice may still reach its minimum session, and time
+	// no project can take is left unallocated.
+	ProjectBudgetMin  int
+	EvenProjectBudget bool
+	// Continue pins ContinueItemID (or, when empty, the most recently worked
+	// in-progress item) as the first recommendation and exempts it from the
+	// spacing penalty. Critical-mode scoping still applies to the pin.
+	Continue       bool
+	ContinueItemID string
+	// AvoidProjects excludes these project IDs from candidates for this
+	// request only, reporting each as a USER_EXCLUDED blocker. Unlike pausing
+	// a project, nothing is persisted.
+	AvoidProjects []string
+	// Context, when set, limits candidates to items tagged with this
+	// situational context (e.g. "office"; a leading "@" is ignored) and
+	// reports the rest as WRONG_CONTEXT blockers. With no tagged candidates
+	// the filter is dropped and a warning says so.
+	Context string
+	// MinBlockMin, when set, is the shortest slice worth suggesting. Items
+	// that can't use that much time in one session are skipped with an
+	// INSUFFICIENT_TIME blocker; the rest are allocated at least this much.
+	MinBlockMin int
+	// Strategy orders the slices: "priority" (the default) strictly by
+	// score, or "warmup", which puts one short item ahead of the top one.
+	Strategy string
+	// IncludeRanking fills WhatNowResponse.Ranking with every scored
+	// candidate, not just the allocated slices.
+	IncludeRanking bool
+	// RespectActiveHours refuses to recommend outside the profile's active
+	// hours (as the profile's RespectActiveHours does for every request),
+	// failing with ErrOutsideActiveHours. IgnoreActiveHours overrides both.
+	RespectActiveHours bool
+	IgnoreActiveHours  bool
+	// ForceEarly keeps work from projects whose start date is still in the
+	// future. Without it they are left out with one NOT_STARTED blocker each.
+	ForceEarly bool
+	// Until, when set, replaces AvailableMin with the whole minutes from Now
+	// to Until (e.g. "until my 3pm meeting"). It must be at least a minute
+	// after Now, else the request fails with ErrInvalidAvailableMin.
+	Until *time.Time
 }
 
+// What-now ordering strategies.
+const (
+	WhatNowStrategyPriority = "priority"
+	WhatNowStrategyWarmup   = "warmup"
+)
+
 func NewWhatNowRequest(availableMin int) WhatNowRequest {
 	return WhatNowRequest{
 		AvailableMin:     availableMin,
@@ -37,13 +91,33 @@ type WhatNowResponse struct {
 	TopRiskProjects []RiskSummary
 	PolicyMessages  []string
 	Warnings        []string
+	// Ranking is set only when the request asks for it (IncludeRanking).
+	Ranking []RankedCandidate
 }
 
-type WhatNowErrorCode string
+// RankedCandidate is one scored candidate in a what-now ranking, sorted by
+// final score. LostReason says why an unselected candidate got no slice.
+type RankedCandidate struct {
+	WorkItemID  string
+	ProjectID   string
+	ProjectName string
+	Title       string
+	RiskLevel   domain.RiskLevel
+	Score       float64
+	Reasons     []RecommendationReason
+	Selected    bool
+	LostReason  string
+}
+
+// WhatNowErrorCode is a domain.ErrorCode, so what-now failures share the
+// code space of every other error.
+type WhatNowErrorCode = domain.ErrorCode
 
 const (
 	ErrInvalidAvailableMin WhatNowErrorCode = "INVALID_AVAILABLE_MIN"
 	ErrNoCandidates        WhatNowErrorCode = "NO_CANDIDATES"
+	ErrInvalidStrategy     WhatNowErrorCode = "INVALID_STRATEGY"
+	ErrOutsideActiveHours  WhatNowErrorCode = "OUTSIDE_ACTIVE_HOURS"
 	ErrDataIntegrity       WhatNowErrorCode = "DATA_INTEGRITY"
 	ErrInternalError       WhatNowErrorCode = "INTERNAL_ERROR"
 )
@@ -56,3 +130,6 @@ type WhatNowError struct {
 func (e *WhatNowError) Error() string {
 	return string(e.Code) + ": " + e.Message
 }
+
+// ErrorCode reports e's code for domain.CodeOf.
+func (e *WhatNowError) ErrorCode() domain.ErrorCode { return e.Code }
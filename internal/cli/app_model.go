@@ -29,8 +29,10 @@ type appModel struct {
 
 func newAppModel(app *App) appModel {
 	state := &SharedState{
-		App:   app,
-		Cache: newShellProjectCache(),
+		App:       app,
+		Cache:     newShellProjectCache(),
+		Inspect:   newShellInspectCache(),
+		Verbosity: app.Verbosity,
 	}
 	cb := newCommandBar(state)
 
@@ -74,6 +76,10 @@ func (m appModel) Init() tea.Cmd {
 	if v := m.activeView(); v != nil {
 		cmds = append(cmds, v.Init())
 	}
+	if warnings := m.state.App.keymap().Warnings; len(warnings) > 0 {
+		cmds = append(cmds, outputCmd(formatter.StyleYellow.Render(
+			"keys.toml: "+strings.Join(warnings, "\nkeys.toml: "))))
+	}
 	return tea.Batch(cmds...)
 }
 
@@ -100,6 +106,12 @@ func (m appModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKey(msg)
 
+	case statusWatchLoadedMsg:
+		return m.updateWatchView(msg.view, msg)
+
+	case statusWatchTickMsg:
+		return m.updateWatchView(msg.view, msg)
+
 	case tea.MouseMsg:
 		if m.outputActive {
 			var cmd tea.Cmd
@@ -171,24 +183,48 @@ func (m appModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Batch the follow-up command with a refresh so the underlying view reloads.
 		return m, tea.Batch(msg.nextCmd, func() tea.Msg { return refreshViewMsg{} })
 
+	case paletteRunMsg:
+		// Close the palette, then run its action as a typed command.
+		if v := m.activeView(); v != nil && v.ID() == ViewPalette && len(m.viewStack) > 1 {
+			m.viewStack = m.viewStack[:len(m.viewStack)-1]
+		}
+		m.clearOutput()
+		return m, m.cmdBar.executeCommand(msg.input)
+
 	case quitMsg:
 		m.quitting = true
 		return m, tea.Quit
 	}
 
-	// Forward other messages to command bar (e.g., cursor blink)
+	// Forward other messages to command bar (e.g., cursor blink). The active
+	// view still receives them so data loads triggered by a command-bar
+	// mutation (refreshViewMsg) land while the bar keeps focus.
+	var barCmd tea.Cmd
 	if m.cmdBar.Focused() {
-		cmd := m.cmdBar.UpdateNonKey(msg)
-		return m, cmd
+		barCmd = m.cmdBar.UpdateNonKey(msg)
 	}
 
 	// Forward to active view
 	if v := m.activeView(); v != nil {
 		updated, cmd := v.Update(msg)
 		m.setActiveView(updated.(View))
-		return m, cmd
+		return m, tea.Batch(barCmd, cmd)
 	}
 
+	return m, barCmd
+}
+
+// updateWatchView delivers a status --watch message to its view wherever it
+// sits in the stack, so refreshes continue under views pushed on top. Once
+// the view is popped the message is dropped, which stops its ticker.
+func (m appModel) updateWatchView(target *statusWatchView, msg tea.Msg) (tea.Model, tea.Cmd) {
+	for i, v := range m.viewStack {
+		if v == View(target) {
+			updated, cmd := v.Update(msg)
+			m.viewStack[i] = updated.(View)
+			return m, cmd
+		}
+	}
 	return m, nil
 }
 
@@ -198,6 +234,9 @@ func (m appModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.quitting = true
 		return m, tea.Quit
 	}
+	if v := m.activeView(); v != nil {
+		m.state.CurrentView = v.ID()
+	}
 
 	// If command bar is focused, route keys there
 	if m.cmdBar.Focused() {
@@ -229,17 +268,31 @@ func (m appModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	// Global keys when command bar is NOT focused
+	keys := m.state.App.keymap()
 	switch {
-	case msg.String() == ":":
+	case keys.Matches(msg, KeyCommand):
 		// Focus the command bar
 		m.cmdBar.Focus()
 		return m, nil
 
-	case msg.String() == "q":
+	case keys.Matches(msg, KeyQuit):
+		if v := m.activeView(); v != nil && v.ID() == ViewStatusWatch && len(m.viewStack) > 1 {
+			// q stops status --watch rather than leaving the shell.
+			m.viewStack = m.viewStack[:len(m.viewStack)-1]
+			return m, nil
+		}
 		m.quitting = true
 		return m, tea.Quit
 
-	case msg.String() == "?":
+	case keys.Matches(msg, KeyPalette):
+		// Quick-jump palette over whatever view is showing.
+		m.cmdBar.Blur()
+		m.clearOutput()
+		v := newPaletteView(m.state)
+		m.viewStack = append(m.viewStack, v)
+		return m, v.Init()
+
+	case keys.Matches(msg, KeyWhatNow):
 		// Global what-now: push recommendation view from any view.
 		if v := m.activeView(); v != nil && v.ID() == ViewRecommendation {
 			break // already on recommendation view, let it handle
@@ -253,8 +306,14 @@ func (m appModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case msg.Type == tea.KeyEsc:
 		// Pop view stack (go back)
 		if len(m.viewStack) > 1 {
+			popped := m.activeView()
 			m.viewStack = m.viewStack[:len(m.viewStack)-1]
 			m.clearOutput()
+			if popped.ID() == ViewOnboarding {
+				// Onboarding starts on top of the dashboard, which has not
+				// loaded yet.
+				return m, func() tea.Msg { return refreshViewMsg{} }
+			}
 			return m, nil
 		}
 		return m, nil
@@ -359,7 +418,7 @@ func (m *appModel) renderStatusBar() string {
 		if len(m.viewStack) > 1 {
 			hints = append(hints, formatter.Dim("esc: back"))
 		}
-		hints = append(hints, formatter.Dim(": command"))
+		hints = append(hints, formatter.Dim(m.state.App.keymap().Key(KeyCommand)+": command"))
 	}
 
 	bar := strings.Join(hints, "  ")
@@ -418,7 +477,7 @@ func viewCapturesInput(v View) bool {
 		return false
 	}
 	switch v.ID() {
-	case ViewDraft, ViewHelpChat, ViewForm:
+	case ViewDraft, ViewHelpChat, ViewForm, ViewPalette:
 		return true
 	}
 	return false
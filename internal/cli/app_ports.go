@@ -22,3 +22,12 @@ func (a *App) importProjectUseCase() app.ImportProjectUseCase {
 	}
 	return a.Import
 }
+
+var defaultKeymap = DefaultKeymap()
+
+func (a *App) keymap() *Keymap {
+	if a.Keys != nil {
+		return a.Keys
+	}
+	return defaultKeymap
+}
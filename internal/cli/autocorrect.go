@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Typo handling for the command bar. A word that is one edit (insert,
+// delete, substitute or swap of adjacent letters) from exactly one known
+// command is corrected and run with an "(assuming: ...)" note when the
+// profile's autocorrect is on; anything less certain only suggests.
+const (
+	// autocorrectMinLen keeps very short words from being corrected; "lg"
+	// is as close to "log" as a mistyped "ls" would be.
+	autocorrectMinLen = 3
+	// suggestMaxDistance is how far off a command can be and still be
+	// offered as "did you mean".
+	suggestMaxDistance = 2
+	maxSuggestions     = 3
+)
+
+// neverAutocorrect lists commands that are only ever suggested: running
+// them by accident can't be undone, and quitting would hide the note.
+var neverAutocorrect = map[string]bool{"exit": true, "quit": true, "clear": true}
+
+// typoFix is the outcome of checking a command line for typos.
+type typoFix struct {
+	parts   []string // the command line to run (corrected when assumed != "")
+	assumed string   // the corrected command, e.g. "project inspect"
+	unknown string   // error for an unknown word with suggestions; parts is not run
+}
+
+// correctTypo checks the command word and, for commands with a fixed set of
+// subcommands, the subcommand word. Lines that are already valid, or that are
+// too far from anything known, come back unchanged for the normal handling.
+func (c *commandBar) correctTypo(parts []string) typoFix {
+	fix := typoFix{parts: parts}
+	cmd := strings.ToLower(parts[0])
+	if !slices.Contains(allCommandNames(), cmd) {
+		match, suggestions := closestNames(cmd, allCommandNames())
+		if match == "" || !c.autocorrectEnabled() {
+			if len(suggestions) > 0 {
+				fix.unknown = fmt.Sprintf("Unknown command: %s. Did you mean: %s?", parts[0], strings.Join(suggestions, ", "))
+			}
+			return fix
+		}
+		fix.parts = append([]string{match}, parts[1:]...)
+		fix.assumed = match
+		cmd = match
+	}
+
+	subs, ok := subcommandNames()[cmd]
+	if !ok || cmd == "help" || len(fix.parts) < 2 || strings.HasPrefix(fix.parts[1], "-") {
+		return fix
+	}
+	sub := strings.ToLower(fix.parts[1])
+	if slices.Contains(subs, sub) {
+		return fix
+	}
+	match, suggestions := closestNames(sub, subs)
+	if match == "" || !c.autocorrectEnabled() {
+		if len(suggestions) > 0 {
+			fix.unknown = fmt.Sprintf("Unknown %s subcommand: %s. Did you mean: %s?", cmd, fix.parts[1], strings.Join(suggestions, ", "))
+		}
+		return fix
+	}
+	fix.parts = append([]string{cmd, match}, fix.parts[2:]...)
+	fix.assumed = cmd + " " + match
+	return fix
+}
+
+// autocorrectEnabled reads the profile toggle; it defaults to on when the
+// profile can't be read.
+func (c *commandBar) autocorrectEnabled() bool {
+	if c.state.App.Profile == nil {
+		return true
+	}
+	p, err := c.state.App.Profile.Get(context.Background())
+	if err != nil {
+		return true
+	}
+	return p.Autocorrect
+}
+
+// closestNames returns the single name one edit from word, if exactly one
+// is (and word is long enough to trust and spelled like a command name),
+// plus up to maxSuggestions names within suggestMaxDistance, closest first.
+func closestNames(word string, names []string) (match string, suggestions []string) {
+	type candidate struct {
+		name string
+		dist int
+	}
+	var near []candidate
+	for _, n := range names {
+		if d := typoDistance(word, n); d <= suggestMaxDistance {
+			near = append(near, candidate{n, d})
+		}
+	}
+	sort.SliceStable(near, func(i, j int) bool { return near[i].dist < near[j].dist })
+
+	oneEdit := 0
+	for _, c := range near {
+		if c.dist == 1 {
+			oneEdit++
+			match = c.name
+		}
+		if len(suggestions) < maxSuggestions {
+			suggestions = append(suggestions, c.name)
+		}
+	}
+	if oneEdit != 1 || len([]rune(word)) < autocorrectMinLen || !isNameLike(word) || neverAutocorrect[match] {
+		match = ""
+	}
+	return match, suggestions
+}
+
+// isNameLike reports whether word uses only letters and hyphens, as command
+// names do. Stray punctuation such as ":use" (a second ':' typed into the
+// bar) is suggested rather than run.
+func isNameLike(word string) bool {
+	for _, r := range word {
+		if !unicode.IsLetter(r) && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// typoDistance is the optimal string alignment distance between a and b:
+// Levenshtein edits plus swaps of adjacent characters, each costing one.
+func typoDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypoDistance(t *testing.T) {
+	assert.Equal(t, 0, typoDistance("status", "status"))
+	assert.Equal(t, 1, typoDistance("stauts", "status"), "adjacent swap is one edit")
+	assert.Equal(t, 1, typoDistance("proejct", "project"))
+	assert.Equal(t, 1, typoDistance("inpect", "inspect"))
+	assert.Equal(t, 2, typoDistance("stat", "status"))
+}
+
+func TestClosestNames(t *testing.T) {
+	names := allCommandNames()
+
+	match, _ := closestNames("sttaus", names)
+	assert.Equal(t, "status", match)
+
+	match, suggestions := closestNames("stauts", names)
+	assert.Empty(t, match, "one edit from both status and stats")
+	assert.Equal(t, []string{"status", "stats", "start"}, suggestions)
+
+	match, suggestions = closestNames("lg", names)
+	assert.Empty(t, match, "too short to correct")
+	assert.Contains(t, suggestions, "log")
+
+	match, suggestions = closestNames(":use", names)
+	assert.Empty(t, match, "punctuation is not a letter typo")
+	assert.Equal(t, []string{"use"}, suggestions)
+
+	match, suggestions = closestNames("exot", names)
+	assert.Empty(t, match, "exit is only ever suggested")
+	assert.Contains(t, suggestions, "exit")
+
+	match, suggestions = closestNames("projectz", names)
+	assert.Empty(t, match, "one edit from both projects and project")
+	assert.ElementsMatch(t, []string{"projects", "project"}, suggestions)
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const archiveUsage = "Usage: archive [list] | archive purge --older-than 90d [--dry-run] [--yes]"
+
+// cmdArchive handles "archive [list]" and "archive purge". A purge shows what
+// it would delete and asks for confirmation unless --yes is given; --dry-run
+// only shows it.
+func (c *commandBar) cmdArchive(args []string) tea.Cmd {
+	app := c.state.App
+	if app.Archive == nil {
+		return outputCmd(shellError(fmt.Errorf("archive is not configured")))
+	}
+	ctx := context.Background()
+	positional, flags := parseShellFlags(args)
+	now := time.Now()
+
+	sub := "list"
+	if len(positional) > 0 {
+		sub = strings.ToLower(positional[0])
+	}
+	switch sub {
+	case "list":
+		archived, err := app.Archive.List(ctx)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(formatter.FormatArchived("Archived", archived,
+			"Nothing is archived.", "Delete old entries with: archive purge --older-than 90d", now, c.state.Width))
+
+	case "purge":
+		cutoff, err := purgeCutoff(flags, now)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		preview, err := app.Archive.Purge(ctx, cutoff, true)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		before := cutoff.Local().Format("2006-01-02")
+		if len(preview) == 0 || flags["dry-run"] == "true" {
+			return outputCmd(formatter.FormatArchived("Purge preview", preview,
+				"Nothing archived before "+before+".",
+				"Dry run: nothing was deleted. Run without --dry-run to purge.", now, c.state.Width))
+		}
+		purge := func() tea.Cmd {
+			return tea.Batch(
+				asyncOutputCmd(func() string {
+					out, err := execArchivePurge(context.Background(), app, cutoff)
+					if err != nil {
+						return shellError(err)
+					}
+					return out
+				}),
+				func() tea.Msg { return refreshViewMsg{} },
+			)
+		}
+		if flags["yes"] == "true" {
+			return purge()
+		}
+		var confirmed bool
+		form := wizardConfirmPreview(
+			fmt.Sprintf("Permanently delete %d archived entries from before %s?", len(preview), before),
+			formatter.FormatArchived("Purge preview", preview, "", "Their nodes and logged sessions are deleted too.", now, c.state.Width),
+			&confirmed,
+		)
+		return startWizardCmd(c.state, "Confirm", form, func() tea.Cmd {
+			if !confirmed {
+				return outputCmd(formatter.Dim("Cancelled."))
+			}
+			return purge()
+		})
+	}
+	return outputCmd(formatter.StyleYellow.Render(archiveUsage))
+}
+
+// purgeCutoff reads --older-than (days or weeks, e.g. 90d or 12w) as the
+// archive time before which entries are purged.
+func purgeCutoff(flags map[string]string, now time.Time) (time.Time, error) {
+	v, ok := flags["older-than"]
+	if !ok {
+		return time.Time{}, fmt.Errorf("archive purge needs --older-than (e.g. 90d or 12w)")
+	}
+	days, ok := parseDayOffset(v)
+	if !ok || days <= 0 {
+		return time.Time{}, fmt.Errorf("invalid --older-than %q (use e.g. 90d or 12w)", v)
+	}
+	return now.AddDate(0, 0, -days), nil
+}
+
+// execArchivePurge deletes everything archived before cutoff and reports
+// what went.
+func execArchivePurge(ctx context.Context, app *App, cutoff time.Time) (string, error) {
+	purged, err := app.Archive.Purge(ctx, cutoff, false)
+	if err != nil {
+		return "", err
+	}
+	before := cutoff.Local().Format("2006-01-02")
+	if len(purged) == 0 {
+		return formatter.Dim("Nothing archived before " + before + "."), nil
+	}
+	purgedProjects := make(map[string]bool)
+	for _, e := range purged {
+		if e.EntityType == domain.TombstoneEntityProject {
+			purgedProjects[e.ID] = true
+		}
+	}
+	items, sessions := 0, 0
+	for _, e := range purged {
+		switch {
+		case e.EntityType == domain.TombstoneEntityProject:
+			sessions += e.SessionCount
+		case !purgedProjects[e.ProjectID]:
+			// An item's sessions are already in its purged project's count.
+			sessions += e.SessionCount
+			items++
+		default:
+			items++
+		}
+	}
+	projects := len(purgedProjects)
+	return fmt.Sprintf("%s Purged %d project(s) and %d work item(s) archived before %s %s",
+		formatter.StyleGreen.Render("✔"), projects, items, before,
+		formatter.Dim(fmt.Sprintf("(%d logged session(s) removed)", sessions))), nil
+}
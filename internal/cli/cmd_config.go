@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/llm"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const configUsage = "Usage: config [list] | config get <key> | config set <key> <value> (keys: see config list)"
+
+// configSetting is one profile field that `config` can read and write.
+// Values are shown in the form set accepts, so get output can be pasted
+// back into set.
+type configSetting struct {
+	key  string
+	hint string
+	get  func(p *domain.UserProfile) string
+	set  func(p *domain.UserProfile, v string) error
+}
+
+// configSettings lists the profile settings in display order. Non-weight
+// keys share their setters with `profile set`, and ProfileService.Update
+// range-checks the result either way.
+func configSettings() []configSetting {
+	var out []configSetting
+	for _, w := range (&domain.UserProfile{}).ScoringWeights() {
+		name := w.Name
+		key := "weight." + name
+		out = append(out, configSetting{
+			key:  key,
+			hint: fmt.Sprintf("0-%g, what-now scoring weight", domain.MaxScoringWeight),
+			get: func(p *domain.UserProfile) string {
+				return strconv.FormatFloat(*scoringWeight(p, name), 'g', -1, 64)
+			},
+			set: func(p *domain.UserProfile, v string) error {
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return fmt.Errorf("%s: expected a number (e.g. 1 or 2.5), got %q", key, v)
+				}
+				*scoringWeight(p, name) = f
+				return nil
+			},
+		})
+	}
+	return append(out,
+		configSetting{
+			key:  "baseline-daily",
+			hint: "minutes a day used for pace",
+			get:  func(p *domain.UserProfile) string { return configMinutes(p.BaselineDailyMin) },
+			set:  profileSetters["baseline-daily"],
+		},
+		configSetting{
+			key:  "max-daily",
+			hint: "up to 24h, most work a day can hold",
+			get:  func(p *domain.UserProfile) string { return configMinutes(p.MaxDailyCapacity()) },
+			set:  profileSetters["max-daily"],
+		},
+		configSetting{
+			key:  "pace-window",
+			hint: "1-90 days of sessions behind the recent daily pace",
+			get:  func(p *domain.UserProfile) string { return strconv.Itoa(p.PaceWindow()) },
+			set:  profileSetters["pace-window"],
+		},
+		configSetting{
+			key:  "spacing-lookback",
+			hint: "1-90 days what-now looks back for an item's last session",
+			get:  func(p *domain.UserProfile) string { return strconv.Itoa(p.SpacingLookback()) },
+			set:  profileSetters["spacing-lookback"],
+		},
+		configSetting{
+			key:  "deadline-buffer",
+			hint: "0-100%, margin on remaining work",
+			get: func(p *domain.UserProfile) string {
+				return strconv.FormatFloat(math.Round(p.BufferPct*1e4)/100, 'g', -1, 64) + "%"
+			},
+			set: profileSetters["deadline-buffer"],
+		},
+		configSetting{
+			key:  "focus-block",
+			hint: "pomodoro focus block",
+			get:  func(p *domain.UserProfile) string { return configMinutes(p.FocusBlockMin) },
+			set:  profileSetters["focus-block"],
+		},
+		configSetting{
+			key:  "break",
+			hint: "pomodoro break",
+			get:  func(p *domain.UserProfile) string { return configMinutes(p.BreakMin) },
+			set:  profileSetters["break"],
+		},
+		configSetting{
+			key:  "availability",
+			hint: "per weekday, Monday first; empty uses baseline-daily",
+			get: func(p *domain.UserProfile) string {
+				days := make([]string, len(p.WeekdayMin))
+				for i, m := range p.WeekdayMin {
+					days[i] = configMinutes(m)
+				}
+				return strings.Join(days, ",")
+			},
+			set: profileSetters["availability"],
+		},
+		configSetting{
+			key:  "active-hours",
+			hint: "HH:MM-HH:MM or off, when what-now may suggest work",
+			get: func(p *domain.UserProfile) string {
+				if !p.HasActiveHours() {
+					return "off"
+				}
+				return domain.FormatClock(p.ActiveHoursStart) + "-" + domain.FormatClock(p.ActiveHoursEnd)
+			},
+			set: profileSetters["active-hours"],
+		},
+		configSetting{
+			key:  "respect-active-hours",
+			hint: "true/false, apply active-hours to every what-now",
+			get:  func(p *domain.UserProfile) string { return strconv.FormatBool(p.RespectActiveHours) },
+			set:  profileSetters["respect-active-hours"],
+		},
+		configSetting{
+			key:  "auto-replan",
+			hint: "true/false",
+			get:  func(p *domain.UserProfile) string { return strconv.FormatBool(p.AutoReplan) },
+			set:  profileSetters["auto-replan"],
+		},
+		configSetting{
+			key:  "autocorrect",
+			hint: "true/false",
+			get:  func(p *domain.UserProfile) string { return strconv.FormatBool(p.Autocorrect) },
+			set:  profileSetters["autocorrect"],
+		},
+		configSetting{
+			key:  "validate-session-time",
+			hint: "true/false, reject sessions longer than the time since --at",
+			get:  func(p *domain.UserProfile) string { return strconv.FormatBool(p.ValidateSessionTime) },
+			set:  profileSetters["validate-session-time"],
+		},
+	)
+}
+
+// scoringWeight returns the profile's weight with the given name.
+func scoringWeight(p *domain.UserProfile, name string) *float64 {
+	for _, w := range p.ScoringWeights() {
+		if w.Name == name {
+			return w.Value
+		}
+	}
+	panic("unknown scoring weight " + name)
+}
+
+// configMinutes formats minutes the way profile setters parse them.
+func configMinutes(m int) string {
+	if m <= 0 {
+		return "0"
+	}
+	return strings.ReplaceAll(formatter.FormatMinutes(m), " ", "")
+}
+
+// configEnv lists the settings read from the environment at startup. They
+// are read-only here since they are fixed for the life of the shell.
+func (a *App) configEnv() []formatter.ConfigEntry {
+	cfg := a.LLMConfig
+	if cfg.Endpoint == "" {
+		cfg = llm.LoadConfig()
+	}
+	return []formatter.ConfigEntry{
+		{Key: "db", Value: a.DBPath, Note: "KAIROS_DB"},
+		{Key: "templates", Value: a.TemplateDir, Note: "KAIROS_TEMPLATES"},
+		{Key: "keys", Value: a.KeysPath, Note: "KAIROS_KEYS"},
+		{Key: "verbosity", Value: a.Verbosity.String(), Note: "KAIROS_VERBOSITY"},
+		{Key: "llm.enabled", Value: strconv.FormatBool(cfg.Enabled), Note: "KAIROS_LLM_ENABLED"},
+		{Key: "llm.endpoint", Value: cfg.Endpoint, Note: "KAIROS_LLM_ENDPOINT"},
+		{Key: "llm.model", Value: cfg.Model, Note: "KAIROS_LLM_MODEL"},
+		{Key: "llm.timeout-ms", Value: strconv.Itoa(cfg.TimeoutMs), Note: "KAIROS_LLM_TIMEOUT_MS"},
+		{Key: "llm.max-retries", Value: strconv.Itoa(cfg.MaxRetries), Note: "KAIROS_LLM_MAX_RETRIES"},
+		{Key: "llm.retry-backoff-ms", Value: strconv.Itoa(cfg.RetryBackoffMs), Note: "KAIROS_LLM_RETRY_BACKOFF_MS"},
+		{Key: "llm.confidence-threshold", Value: strconv.FormatFloat(cfg.ConfidenceThreshold, 'g', -1, 64), Note: "KAIROS_LLM_CONFIDENCE_THRESHOLD"},
+	}
+}
+
+// cmdConfig handles "config [list]", "config get <key>" and
+// "config set <key> <value>" over profile settings, plus the environment
+// settings as read-only entries.
+func (c *commandBar) cmdConfig(args []string) tea.Cmd {
+	if c.state.App.Profile == nil {
+		return outputCmd(shellError(fmt.Errorf("profile is not configured")))
+	}
+	ctx := context.Background()
+	p, err := c.state.App.Profile.Get(ctx)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	settings := configSettings()
+	env := c.state.App.configEnv()
+
+	sub := "list"
+	if len(args) > 0 {
+		sub = strings.ToLower(args[0])
+	}
+	switch sub {
+	case "list":
+		entries := make([]formatter.ConfigEntry, len(settings))
+		for i, s := range settings {
+			entries[i] = formatter.ConfigEntry{Key: s.key, Value: s.get(p), Note: s.hint}
+		}
+		return outputCmd(formatter.FormatConfig(entries, env))
+
+	case "get":
+		if len(args) != 2 {
+			return outputCmd(formatter.StyleYellow.Render(configUsage))
+		}
+		key := strings.ToLower(args[1])
+		for _, s := range settings {
+			if s.key == key {
+				return outputCmd(fmt.Sprintf("%s = %s", key, s.get(p)))
+			}
+		}
+		for _, e := range env {
+			if e.Key == key {
+				return outputCmd(fmt.Sprintf("%s = %s %s", key, e.Value, formatter.Dim("("+e.Note+", read-only)")))
+			}
+		}
+		return outputCmd(shellError(fmt.Errorf("unknown config key %q (see: config list)", args[1])))
+
+	case "set":
+		var key, value string
+		switch {
+		case len(args) == 3:
+			key, value = args[1], args[2]
+		case len(args) == 2 && strings.Contains(args[1], "="):
+			key, value, _ = strings.Cut(args[1], "=")
+		default:
+			return outputCmd(formatter.StyleYellow.Render(configUsage))
+		}
+		key = strings.ToLower(key)
+		for _, e := range env {
+			if e.Key == key {
+				return outputCmd(shellError(fmt.Errorf("%s comes from the environment; set %s and restart kairos", key, e.Note)))
+			}
+		}
+		for _, s := range settings {
+			if s.key != key {
+				continue
+			}
+			if err := s.set(p, value); err != nil {
+				return outputCmd(shellError(err))
+			}
+			if err := c.state.App.Profile.Update(ctx, p); err != nil {
+				return outputCmd(shellError(err))
+			}
+			return outputCmd(fmt.Sprintf("%s %s = %s", formatter.StyleGreen.Render("✔"), key, s.get(p)))
+		}
+		return outputCmd(shellError(fmt.Errorf("unknown config key %q (see: config list)", key)))
+	}
+	return outputCmd(formatter.StyleYellow.Render(configUsage))
+}
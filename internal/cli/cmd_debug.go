@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdDebug handles "debug <sub>" diagnostics.
+func (c *commandBar) cmdDebug(args []string) tea.Cmd {
+	if len(args) == 0 || strings.ToLower(args[0]) != "timings" {
+		return outputCmd(formatter.StyleYellow.Render("Usage: debug timings"))
+	}
+	if c.state.App.Timings == nil {
+		return outputCmd(shellError(fmt.Errorf("use-case timings are not configured")))
+	}
+	return outputCmd(formatter.FormatUseCaseTimings(c.state.App.Timings.Snapshot(), c.state.Width))
+}
@@ -26,11 +26,7 @@ func (c *commandBar) cmdEntityGroup(parts []string) tea.Cmd {
 
 	// Route "project draft" to the draft view.
 	if group == "project" && sub == "draft" {
-		description := ""
-		if len(parts) > 2 {
-			description = strings.Join(parts[2:], " ")
-		}
-		return pushView(newDraftView(c.state, description))
+		return c.cmdDraft(parts[2:])
 	}
 
 	// Bare creation commands → launch wizard.
@@ -44,7 +40,7 @@ func (c *commandBar) cmdEntityGroup(parts []string) tea.Cmd {
 	}
 
 	// Commands that mutate project data need a dashboard refresh.
-	mutating := map[string]bool{"import": true, "add": true, "update": true, "init": true, "archive": true, "unarchive": true}
+	mutating := map[string]bool{"import": true, "add": true, "update": true, "init": true, "archive": true, "unarchive": true, "undo-last": true}
 	if mutating[sub] {
 		return tea.Batch(
 			c.dispatchEntityCommand(group, sub, parts[2:]),
@@ -129,11 +125,12 @@ func (c *commandBar) workAddGetDueDate(nodeID, title, wiType, minutes string) te
 	return startWizardCmd(c.state, "Due Date", dueDateForm(&dueDate), func() tea.Cmd {
 		ctx := context.Background()
 		w := &domain.WorkItem{
-			ID:        uuid.New().String(),
-			NodeID:    nodeID,
-			Title:     title,
-			Type:      wiType,
-			Status:    domain.WorkItemTodo,
+			ID:         uuid.New().String(),
+			NodeID:     nodeID,
+			Title:      title,
+			Type:       wiType,
+			Status:     domain.WorkItemTodo,
+			Splittable: true,
 		}
 		if v, err := strconv.Atoi(minutes); err == nil && v > 0 {
 			w.PlannedMin = v
@@ -201,14 +198,8 @@ func (c *commandBar) cmdDestructive(parts []string, group, sub string) tea.Cmd {
 		}
 	}
 
-	target := ""
-	if len(parts) > 2 {
-		target = parts[2]
-	}
-	desc := fmt.Sprintf("%s %s", group, sub)
-	if target != "" {
-		desc += " " + target
-	}
+	// Echo the full command so bulk flags (e.g. --with-done --all) are visible.
+	desc := strings.Join(parts, " ")
 
 	var confirmed bool
 	form := wizardConfirm(desc+"?", &confirmed)
@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/google/uuid"
 	tea "github.com/charmbracelet/bubbletea"
@@ -25,7 +27,7 @@ func parseShellFlags(args []string) (positional []string, flags map[string]strin
 			} else {
 				flags[key] = "true"
 			}
-		} else if len(args[i]) == 2 && args[i][0] == '-' {
+		} else if len(args[i]) == 2 && args[i][0] == '-' && (args[i][1] < '0' || args[i][1] > '9') {
 			flags[string(args[i][1])] = "true"
 		} else {
 			positional = append(positional, args[i])
@@ -34,14 +36,38 @@ func parseShellFlags(args []string) (positional []string, flags map[string]strin
 	return
 }
 
+// localTimestampLayouts lists the accepted user-supplied timestamp formats
+// (e.g. session log --at, export --since), most specific first. Values are
+// interpreted in the local timezone.
+var localTimestampLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseLocalTimestamp parses a user-supplied timestamp in local time.
+// A bare date means the start of that day; RFC3339 is accepted as-is.
+func parseLocalTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range localTimestampLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q (expected YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")", value)
+}
+
 // entityGroupHelp returns usage text for a bare entity group command.
 func entityGroupHelp(group string) string {
 	subs := map[string]string{
-		"project":  "list, inspect, add, update, archive, unarchive, remove, init, import, draft",
+		"project":  "list, inspect, progress, add, update, shift, archive, unarchive, remove, init, import, export, draft",
 		"node":     "add, inspect, update, remove",
-		"work":     "add, inspect, update, done, archive, remove",
-		"session":  "log, list, remove",
-		"template": "list, show",
+		"work":     "add, inspect, list, update, clone, move-up, move-down, bump, check, priority, preset, done, archive, remove",
+		"session":  "log, list, report, undo-last, remove",
+		"template": "list, show, validate",
 	}
 	if s, ok := subs[group]; ok {
 		return fmt.Sprintf("%s subcommands: %s", group, s)
@@ -73,6 +99,9 @@ func (c *commandBar) dispatchEntityCommand(group, sub string, args []string) tea
 		return outputCmd(fmt.Sprintf("Unknown entity group: %s", group))
 	}
 
+	if err != nil && flags["format"] == "json" {
+		return outputCmd(jsonError(err))
+	}
 	if err != nil {
 		return outputCmd(shellError(err))
 	}
@@ -95,17 +124,49 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 		if len(projects) == 0 {
 			return "No projects found.", nil
 		}
-		return formatter.FormatProjectList(projects), nil
+		list := formatter.FormatProjectList(projects, c.state.Width)
+		if flags["risk-summary"] != "true" {
+			return list, nil
+		}
+		summary, err := projectRiskSummary(ctx, app)
+		if err != nil {
+			return "", err
+		}
+		return summary + "\n" + list, nil
 
 	case "inspect":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: project inspect <id>")
+			return "", fmt.Errorf("usage: project inspect <id> [--progress] [--hide-done] [--depth N] [--sessions [--days N]]")
 		}
 		projectID, err := resolveProjectID(ctx, app, pos[0])
 		if err != nil {
 			return "", err
 		}
-		return buildInspectTree(app, ctx, projectID)
+		maxDepth := unlimitedDepth
+		if v, ok := flags["depth"]; ok {
+			if maxDepth, err = strconv.Atoi(v); err != nil || maxDepth < 0 {
+				return "", fmt.Errorf("--depth must be a whole number of levels, 0 for root nodes only (got %q)", v)
+			}
+		}
+		sessionDays := 0
+		if flags["sessions"] == "true" {
+			sessionDays = inspectSessionDays
+		}
+		if v, ok := flags["days"]; ok {
+			if sessionDays == 0 {
+				return "", fmt.Errorf("--days requires --sessions")
+			}
+			if sessionDays, err = strconv.Atoi(v); err != nil || sessionDays < 1 {
+				return "", fmt.Errorf("--days must be a positive number of days (got %q)", v)
+			}
+		}
+		return c.state.Inspect.render(ctx, app, inspectKey{
+			projectID:   projectID,
+			progress:    flags["progress"] == "true",
+			hideDone:    flags["hide-done"] == "true",
+			maxDepth:    maxDepth,
+			sessionDays: sessionDays,
+		})
 
 	case "add":
 		shortID := flags["id"]
@@ -113,7 +174,7 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 		domainStr := flags["domain"]
 		start := flags["start"]
 		if shortID == "" || name == "" || domainStr == "" || start == "" {
-			return "", fmt.Errorf("usage: project add --id ID --name NAME --domain DOMAIN --start YYYY-MM-DD [--due YYYY-MM-DD]")
+			return "", fmt.Errorf("usage: project add --id ID --name NAME --domain DOMAIN --start YYYY-MM-DD [--due \"YYYY-MM-DD[ HH:MM]\"] [--importance 1-5]")
 		}
 		startDate, err := time.Parse("2006-01-02", start)
 		if err != nil {
@@ -130,12 +191,17 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 			UpdatedAt: time.Now(),
 		}
 		if due, ok := flags["due"]; ok {
-			dueDate, err := time.Parse("2006-01-02", due)
+			dueDate, err := domain.ParseDeadline(due)
 			if err != nil {
-				return "", fmt.Errorf("invalid due date %q: %w", due, err)
+				return "", err
 			}
 			p.TargetDate = &dueDate
 		}
+		if v, ok := flags["importance"]; ok {
+			if p.Importance, err = parseImportance(v); err != nil {
+				return "", err
+			}
+		}
 		if err := app.Projects.Create(ctx, p); err != nil {
 			return "", err
 		}
@@ -143,7 +209,7 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 
 	case "update":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: project update <id> [--name NAME] [--domain DOMAIN] [--due YYYY-MM-DD] [--status STATUS]")
+			return "", fmt.Errorf("usage: project update <id> [--name NAME] [--domain DOMAIN] [--due \"YYYY-MM-DD[ HH:MM]\"] [--importance 1-5] [--status STATUS] [--default-type T] [--default-planned-min N] [--default-bounds MIN/MAX/DEFAULT] [--default-min-session N] [--default-max-session N] [--clear-defaults]")
 		}
 		projectID, err := resolveProjectID(ctx, app, pos[0])
 		if err != nil {
@@ -163,24 +229,46 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 			p.Domain = v
 		}
 		if v, ok := flags["due"]; ok {
-			dueDate, err := time.Parse("2006-01-02", v)
+			dueDate, err := domain.ParseDeadline(v)
 			if err != nil {
-				return "", fmt.Errorf("invalid due date %q: %w", v, err)
+				return "", err
 			}
 			p.TargetDate = &dueDate
 		}
+		if v, ok := flags["importance"]; ok {
+			if p.Importance, err = parseImportance(v); err != nil {
+				return "", err
+			}
+		}
 		if v, ok := flags["status"]; ok {
 			p.Status = domain.ProjectStatus(v)
 		}
+		defaultsSet, err := applyProjectDefaultFlags(flags, &p.WorkDefaults)
+		if err != nil {
+			return "", err
+		}
 		p.UpdatedAt = time.Now()
 		if err := app.Projects.Update(ctx, p); err != nil {
 			return "", err
 		}
-		return fmt.Sprintf("%s Updated project %s [%s]", formatter.StyleGreen.Render("✔"), p.Name, p.ShortID), nil
+		out := fmt.Sprintf("%s Updated project %s [%s]", formatter.StyleGreen.Render("✔"), p.Name, p.ShortID)
+		if defaultsSet {
+			out += "\n  " + formatter.Dim("Work defaults: "+formatter.WorkDefaultsSummary(p.WorkDefaults))
+		}
+		return out, nil
+
+	case "shift":
+		return shiftProjectDates(ctx, app, pos, flags)
+
+	case "progress":
+		return c.projectProgress(ctx, pos, flags)
 
 	case "archive":
+		if flags["with-done"] == "true" {
+			return archiveDoneItems(ctx, app, pos, flags)
+		}
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: project archive <id>")
+			return "", fmt.Errorf("usage: project archive <id> | project archive <id> --with-done | project archive --with-done --all")
 		}
 		projectID, err := resolveProjectID(ctx, app, pos[0])
 		if err != nil {
@@ -243,9 +331,26 @@ func (c *commandBar) dispatchProject(ctx context.Context, sub string, pos []stri
 
 	case "import":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: project import <file.json>")
+			return "", fmt.Errorf("usage: project import <file.json> [--merge] [--prune] [--dry-run]")
+		}
+		return execImport(ctx, app, pos[0], flags)
+
+	case "export":
+		if format := flags["format"]; format != "" && format != "dot" {
+			return "", fmt.Errorf("unsupported format %q (project export supports: dot; use 'export' for JSON)", format)
+		}
+		ref := c.state.ActiveProjectID
+		if len(pos) > 0 {
+			ref = pos[0]
+		}
+		if ref == "" {
+			return "", fmt.Errorf("usage: project export [id] --format dot [--out FILE]")
+		}
+		projectID, err := resolveProjectID(ctx, app, ref)
+		if err != nil {
+			return "", err
 		}
-		return execImport(ctx, app, pos[0])
+		return execProjectExportDOT(ctx, app, projectID, flags["out"])
 
 	default:
 		return "", fmt.Errorf("unknown project subcommand: %s", sub)
@@ -267,7 +372,13 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 		title := flags["title"]
 		kind := flags["kind"]
 		if pid == "" || title == "" || kind == "" {
-			return "", fmt.Errorf("usage: node add --project ID --title TITLE --kind KIND [--parent ID] [--order N]")
+			return "", fmt.Errorf("usage: node add --project ID --title TITLE --kind KIND [--parent ID] [--order N | --count N [--days-per D]]")
+		}
+		if _, ok := flags["count"]; ok {
+			return addNodeSeries(ctx, app, pid, title, kind, flags)
+		}
+		if _, ok := flags["days-per"]; ok {
+			return "", fmt.Errorf("--days-per needs --count")
 		}
 		n := &domain.PlanNode{
 			ID:        uuid.New().String(),
@@ -292,7 +403,7 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 
 	case "inspect":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: node inspect <id>")
+			return "", fmt.Errorf("usage: node inspect <id> [--tree [--progress] [--hide-done]]")
 		}
 		nodeID, err := resolveNodeID(ctx, app, pos[0], projectID)
 		if err != nil {
@@ -302,6 +413,13 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 		if err != nil {
 			return "", err
 		}
+		if flags["tree"] == "true" {
+			childMap, workItems, err := fetchSubtree(app, ctx, []*domain.PlanNode{n}, unlimitedDepth)
+			if err != nil {
+				return "", err
+			}
+			return formatter.FormatNodeSubtree(n, childMap, workItems, flags["progress"] == "true", flags["hide-done"] == "true"), nil
+		}
 		var b strings.Builder
 		b.WriteString(fmt.Sprintf("%s  %s\n", formatter.Bold(n.Title), formatter.Dim(string(n.Kind))))
 		if n.Seq > 0 {
@@ -315,7 +433,7 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 
 	case "update":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: node update <id> [--title TITLE] [--kind KIND] [--order N]")
+			return "", fmt.Errorf("usage: node update <id> [--title TITLE] [--kind KIND] [--order N] [--due DATE [--propagate]]")
 		}
 		nodeID, err := resolveNodeID(ctx, app, pos[0], projectID)
 		if err != nil {
@@ -336,7 +454,22 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 				n.OrderIndex = o
 			}
 		}
+		if v, ok := flags["due"]; ok {
+			due, err := domain.ParseDeadline(v)
+			if err != nil {
+				return "", fmt.Errorf("invalid --due date %q (use YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")", v)
+			}
+			n.DueDate = &due
+		}
 		n.UpdatedAt = time.Now()
+		if flags["propagate"] == "true" {
+			count, err := app.Nodes.UpdatePropagatingDue(ctx, n)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s Updated node: %s %s", formatter.StyleGreen.Render("✔"), formatter.Bold(n.Title),
+				formatter.Dim(fmt.Sprintf("(due %s applied to %d work items)", n.DueDate.Format("2006-01-02"), count))), nil
+		}
 		if err := app.Nodes.Update(ctx, n); err != nil {
 			return "", err
 		}
@@ -360,6 +493,68 @@ func (c *commandBar) dispatchNode(ctx context.Context, sub string, pos []string,
 	}
 }
 
+// maxNodeSeries caps node add --count.
+const maxNodeSeries = 100
+
+// addNodeSeries handles node add --count N: it creates N sibling nodes titled
+// from a "{n}" template ("Week {n}" → Week 1..N), ordered after the existing
+// siblings. With --days-per D, node i is due D*i days after the project start.
+func addNodeSeries(ctx context.Context, app *App, projectID, title, kind string, flags map[string]string) (string, error) {
+	count, err := strconv.Atoi(flags["count"])
+	if err != nil || count < 1 || count > maxNodeSeries {
+		return "", fmt.Errorf("--count must be between 1 and %d", maxNodeSeries)
+	}
+	if !strings.Contains(title, "{n}") {
+		return "", fmt.Errorf("--title needs {n} with --count, e.g. \"Week {n}\"")
+	}
+	if _, ok := flags["order"]; ok {
+		return "", fmt.Errorf("--order can't be combined with --count; the nodes follow the existing siblings")
+	}
+	daysPer := 0
+	var start time.Time
+	if v, ok := flags["days-per"]; ok {
+		if daysPer, err = strconv.Atoi(v); err != nil || daysPer < 1 {
+			return "", fmt.Errorf("--days-per must be a positive number of days")
+		}
+		p, err := app.Projects.GetByID(ctx, projectID)
+		if err != nil {
+			return "", err
+		}
+		start = p.StartDate
+	}
+
+	nodes := make([]*domain.PlanNode, count)
+	for i := range nodes {
+		n := &domain.PlanNode{
+			ProjectID: projectID,
+			Title:     strings.ReplaceAll(title, "{n}", strconv.Itoa(i+1)),
+			Kind:      domain.NodeKind(kind),
+		}
+		if parentID, ok := flags["parent"]; ok {
+			n.ParentID = &parentID
+		}
+		if daysPer > 0 {
+			due := start.AddDate(0, 0, daysPer*(i+1))
+			n.DueDate = &due
+		}
+		nodes[i] = n
+	}
+	if err := app.Nodes.CreateSiblings(ctx, nodes); err != nil {
+		return "", err
+	}
+
+	out := fmt.Sprintf("%s Created node: %s", formatter.StyleGreen.Render("✔"), formatter.Bold(nodes[0].Title))
+	if count > 1 {
+		out = fmt.Sprintf("%s Created %d nodes: %s", formatter.StyleGreen.Render("✔"), count,
+			formatter.Bold(nodes[0].Title+" … "+nodes[count-1].Title))
+	}
+	if daysPer > 0 {
+		out += " " + formatter.Dim(fmt.Sprintf("(due every %d days, %s to %s)", daysPer,
+			nodes[0].DueDate.Format("2006-01-02"), nodes[count-1].DueDate.Format("2006-01-02")))
+	}
+	return out, nil
+}
+
 // ── work dispatch ────────────────────────────────────────────────────────────
 
 func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string, flags map[string]string) (string, error) {
@@ -370,31 +565,60 @@ func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string,
 	case "add":
 		nodeID := flags["node"]
 		title := flags["title"]
-		typ := flags["type"]
-		if nodeID == "" || title == "" || typ == "" {
-			return "", fmt.Errorf("usage: work add --node ID --title TITLE --type TYPE [--planned-min N] [--due-date YYYY-MM-DD]")
+		usage := fmt.Errorf("usage: work add --node ID --title TITLE (--type TYPE | --preset NAME | project default type) [--planned-min N] [--bounds MIN/MAX/DEFAULT] [--min-session N] [--max-session N] [--default-session N] [--atomic] [--due-date \"YYYY-MM-DD[ HH:MM]\"] [--tag a,b]")
+		if nodeID == "" || title == "" {
+			return "", usage
 		}
 		w := &domain.WorkItem{
-			ID:        uuid.New().String(),
-			NodeID:    nodeID,
-			Title:     title,
-			Type:      typ,
-			Status:    domain.WorkItemTodo,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			ID:         uuid.New().String(),
+			NodeID:     nodeID,
+			Title:      title,
+			Status:     domain.WorkItemTodo,
+			Splittable: true,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
 		}
-		if v, ok := flags["planned-min"]; ok {
-			if m, err := strconv.Atoi(v); err == nil {
-				w.PlannedMin = m
+		if name, ok := flags["preset"]; ok {
+			if app.Presets == nil {
+				return "", fmt.Errorf("work presets are not configured")
+			}
+			preset, err := app.Presets.Get(ctx, name)
+			if err != nil {
+				return "", err
 			}
+			preset.ApplyTo(w)
+		}
+		if err := applyWorkShapeFlags(flags, &w.Type, &w.PlannedMin, &w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin); err != nil {
+			return "", err
+		}
+		if w.Type == "" {
+			// The project's default type, applied by WorkItems.Create, may
+			// stand in for --type.
+			n, err := app.Nodes.GetByID(ctx, nodeID)
+			if err != nil {
+				return "", err
+			}
+			p, err := app.Projects.GetByID(ctx, n.ProjectID)
+			if err != nil {
+				return "", err
+			}
+			if p.WorkDefaults.Type == "" {
+				return "", usage
+			}
+		}
+		if err := applyAtomicFlag(flags, w); err != nil {
+			return "", err
 		}
 		if v, ok := flags["due-date"]; ok {
-			t, err := time.Parse("2006-01-02", v)
+			t, err := domain.ParseDeadline(v)
 			if err != nil {
-				return "", fmt.Errorf("invalid due date %q: %w", v, err)
+				return "", err
 			}
 			w.DueDate = &t
 		}
+		if v, ok := flags["tag"]; ok {
+			w.Tags = domain.ParseTags(v)
+		}
 		if err := app.WorkItems.Create(ctx, w); err != nil {
 			return "", err
 		}
@@ -402,7 +626,7 @@ func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string,
 
 	case "inspect":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: work inspect <id>")
+			return "", fmt.Errorf("usage: work inspect <id> [--history]")
 		}
 		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
 		if err != nil {
@@ -418,16 +642,48 @@ func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string,
 		if w.Seq > 0 {
 			b.WriteString(fmt.Sprintf("  ID:      #%d\n", w.Seq))
 		}
-		b.WriteString(fmt.Sprintf("  Planned: %s\n", formatter.FormatMinutes(w.PlannedMin)))
+		b.WriteString(fmt.Sprintf("  Planned: %s\n", formatter.FormatEstimate(w.InitialPlannedMin, w.PlannedMin)))
 		b.WriteString(fmt.Sprintf("  Logged:  %s\n", formatter.FormatMinutes(w.LoggedMin)))
+		if w.UnitsTotal > 0 {
+			unitLabel := w.UnitsKind
+			if unitLabel == "" {
+				unitLabel = "units"
+			}
+			b.WriteString(fmt.Sprintf("  Units:   %d/%d %s\n", w.UnitsDone, w.UnitsTotal, unitLabel))
+		}
+		if !w.Splittable {
+			b.WriteString("  Atomic:  " + formatter.Dim("scheduled only as one unbroken block") + "\n")
+		}
 		if w.DueDate != nil {
 			b.WriteString(fmt.Sprintf("  Due:     %s\n", formatter.RelativeDateStyled(*w.DueDate)))
 		}
+		if len(w.Tags) > 0 {
+			b.WriteString(fmt.Sprintf("  Tags:    %s\n", formatter.Dim("@"+strings.Join(w.Tags, " @"))))
+		}
+		if w.ManualPriority != domain.PriorityNone {
+			b.WriteString(fmt.Sprintf("  Priority: %s\n", formatter.StyleYellow.Render(w.ManualPriority.String())))
+		}
+		if len(w.Checklist) > 0 {
+			done, total := w.ChecklistProgress()
+			b.WriteString(fmt.Sprintf("  Checklist: %d/%d\n", done, total))
+			b.WriteString(formatter.FormatChecklist(w.Checklist, "    "))
+		}
+		if flags["history"] == "true" {
+			sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+			if err != nil {
+				return "", err
+			}
+			if len(sessions) == 0 {
+				b.WriteString("  History: " + formatter.Dim("no sessions logged") + "\n")
+			} else {
+				b.WriteString("\n" + formatter.FormatSessionList(sessions, true, c.state.Width))
+			}
+		}
 		return b.String(), nil
 
 	case "update":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: work update <id> [--title T] [--type T] [--status S] [--planned-min N]")
+			return "", fmt.Errorf("usage: work update <id> [--title T] [--type T] [--status S] [--planned-min N | --reset-estimate] [--min-session N] [--max-session N] [--default-session N] [--atomic [false]] [--tag a,b] [--planned-units N] [--units-done N]")
 		}
 		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
 		if err != nil {
@@ -446,29 +702,238 @@ func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string,
 		if v, ok := flags["status"]; ok {
 			w.Status = domain.WorkItemStatus(v)
 		}
+		resetEstimate := flags["reset-estimate"] == "true"
 		if v, ok := flags["planned-min"]; ok {
+			if resetEstimate {
+				return "", fmt.Errorf("--planned-min and --reset-estimate are mutually exclusive")
+			}
 			if m, err := strconv.Atoi(v); err == nil {
 				w.PlannedMin = m
 			}
 		}
+		if resetEstimate {
+			if err := w.ResetEstimate(time.Now()); err != nil {
+				return "", err
+			}
+		}
+		if hasSessionFlags(flags) {
+			if v, ok := flags["bounds"]; ok {
+				lo, hi, def, err := parseSessionBounds(v)
+				if err != nil {
+					return "", err
+				}
+				w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin = lo, hi, def
+			}
+			if err := applySessionFlags(flags, &w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin); err != nil {
+				return "", err
+			}
+			w.ApplySessionDefaults()
+			if err := w.ValidateSessionBounds(); err != nil {
+				return "", err
+			}
+		}
+		if err := applyAtomicFlag(flags, w); err != nil {
+			return "", err
+		}
+		if v, ok := flags["tag"]; ok {
+			w.Tags = domain.ParseTags(v)
+		}
+		if err := applyUnitFlags(flags, w); err != nil {
+			return "", err
+		}
 		w.UpdatedAt = time.Now()
 		if err := app.WorkItems.Update(ctx, w); err != nil {
 			return "", err
 		}
+		if resetEstimate {
+			return fmt.Sprintf("%s Updated: %s (estimate reset to %s)", formatter.StyleGreen.Render("✔"),
+				formatter.Bold(w.Title), formatter.FormatMinutes(w.PlannedMin)), nil
+		}
 		return fmt.Sprintf("%s Updated: %s", formatter.StyleGreen.Render("✔"), formatter.Bold(w.Title)), nil
 
+	case "bump":
+		if len(pos) < 2 {
+			return "", fmt.Errorf("usage: work bump <id> <+N|-N> (minutes or 1h30m)")
+		}
+		delta, ok := parseSignedDuration(pos[1])
+		if !ok {
+			return "", fmt.Errorf("invalid bump %q (use e.g. +30, -15 or +1h)", pos[1])
+		}
+		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
+		if err != nil {
+			return "", err
+		}
+		w, prev, err := app.WorkItems.BumpEstimate(ctx, wiID, delta)
+		if err != nil {
+			return "", err
+		}
+		msg := fmt.Sprintf("%s %s estimate %s → %s", formatter.StyleGreen.Render("✔"), formatter.Bold(w.Title),
+			formatter.FormatMinutes(prev), formatter.FormatMinutes(w.PlannedMin))
+		if prev+delta < w.PlannedMin {
+			msg += formatter.Dim(" (clamped to logged time)")
+		}
+		return msg, nil
+
+	case "clone":
+		if len(pos) == 0 {
+			return "", fmt.Errorf("usage: work clone <id> [--node NODE] [--title TITLE]")
+		}
+		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
+		if err != nil {
+			return "", err
+		}
+		nodeID := ""
+		if v, ok := flags["node"]; ok {
+			if nodeID, err = resolveNodeID(ctx, app, v, projectID); err != nil {
+				return "", err
+			}
+		}
+		w, err := app.WorkItems.Clone(ctx, wiID, nodeID, flags["title"])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Cloned: %s %s", formatter.StyleGreen.Render("✔"), formatter.Bold(w.Title),
+			formatter.Dim(fmt.Sprintf("(#%d, %s planned)", w.Seq, formatter.FormatMinutes(w.PlannedMin)))), nil
+
+	case "move-up", "move-down":
+		if len(pos) == 0 {
+			return "", fmt.Errorf("usage: work %s <id>", sub)
+		}
+		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
+		if err != nil {
+			return "", err
+		}
+		offset, edge := 1, "last"
+		if sub == "move-up" {
+			offset, edge = -1, "first"
+		}
+		moved, err := app.WorkItems.Move(ctx, wiID, offset)
+		if err != nil {
+			return "", err
+		}
+		title, _ := resolveItemTitle(ctx, app, wiID)
+		if !moved {
+			return formatter.Dim(fmt.Sprintf("%s is already %s in its node.", title, edge)), nil
+		}
+		return fmt.Sprintf("%s Moved %s %s", formatter.StyleGreen.Render("✔"), formatter.Bold(title),
+			strings.TrimPrefix(sub, "move-")), nil
+
+	case "preset":
+		return c.workPresetCommand(ctx, pos, flags)
+
+	case "estimate":
+		return c.workEstimate(ctx, flags)
+
+	case "check":
+		usage := fmt.Errorf("usage: work check <id> [add <text> | toggle <n> | remove <n>]")
+		if len(pos) == 0 {
+			return "", usage
+		}
+		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
+		if err != nil {
+			return "", err
+		}
+		w, err := app.WorkItems.GetByID(ctx, wiID)
+		if err != nil {
+			return "", err
+		}
+		if len(pos) == 1 {
+			if len(w.Checklist) == 0 {
+				return formatter.Dim(fmt.Sprintf("No checklist items on %s. Add one with: work check %s add <text>", w.Title, pos[0])), nil
+			}
+			return formatter.Bold(w.Title) + "\n" + formatter.FormatChecklist(w.Checklist, "  "), nil
+		}
+		if len(pos) < 3 {
+			return "", usage
+		}
+		now := time.Now()
+		switch pos[1] {
+		case "add":
+			err = w.AddChecklistItem(strings.Join(pos[2:], " "), now)
+		case "toggle", "remove":
+			n, convErr := strconv.Atoi(pos[2])
+			if convErr != nil {
+				return "", fmt.Errorf("invalid checklist item number %q", pos[2])
+			}
+			if pos[1] == "toggle" {
+				err = w.ToggleChecklistItem(n, now)
+			} else {
+				err = w.RemoveChecklistItem(n, now)
+			}
+		default:
+			return "", usage
+		}
+		if err != nil {
+			return "", err
+		}
+		if err := app.WorkItems.Update(ctx, w); err != nil {
+			return "", err
+		}
+		done, total := w.ChecklistProgress()
+		return fmt.Sprintf("%s %s  %s\n%s", formatter.StyleGreen.Render("✔"), formatter.Bold(w.Title),
+			formatter.Dim(fmt.Sprintf("%d/%d done", done, total)),
+			formatter.FormatChecklist(w.Checklist, "  ")), nil
+
+	case "priority":
+		if len(pos) == 0 {
+			return "", fmt.Errorf("usage: work priority <id> [none|high|top]")
+		}
+		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
+		if err != nil {
+			return "", err
+		}
+		w, err := app.WorkItems.GetByID(ctx, wiID)
+		if err != nil {
+			return "", err
+		}
+		if len(pos) == 1 {
+			return fmt.Sprintf("%s priority: %s", formatter.Bold(w.Title), w.ManualPriority), nil
+		}
+		p, err := domain.ParseManualPriority(pos[1])
+		if err != nil {
+			return "", err
+		}
+		w.ManualPriority = p
+		if err := app.WorkItems.Update(ctx, w); err != nil {
+			return "", err
+		}
+		note := map[domain.ManualPriority]string{
+			domain.PriorityNone: "ranked by score again",
+			domain.PriorityHigh: "ranks first within its risk tier in what-now",
+			domain.PriorityTop:  "ranks first in what-now",
+		}[p]
+		return fmt.Sprintf("%s %s priority: %s %s", formatter.StyleGreen.Render("✔"), formatter.Bold(w.Title),
+			p, formatter.Dim("("+note+")")), nil
+
 	case "done":
 		if len(pos) == 0 {
-			return "", fmt.Errorf("usage: work done <id>")
+			return "", fmt.Errorf("usage: work done <id> [--log N]")
 		}
 		wiID, err := resolveWorkItemID(ctx, app, pos[0], projectID)
 		if err != nil {
 			return "", err
 		}
+		if v, ok := flags["log"]; ok {
+			minutes, err := strconv.Atoi(v)
+			if err != nil || minutes <= 0 {
+				return "", fmt.Errorf("invalid minutes: %s (usage: work done <id> --log N)", v)
+			}
+			title, _ := resolveItemTitle(ctx, app, wiID)
+			msg, err := execLogSession(ctx, app, c.state, LogSessionInput{
+				ItemID: wiID, Title: title, Minutes: minutes, Finish: true,
+			})
+			if err != nil {
+				return "", err
+			}
+			return msg + estimateOutcomeLine(ctx, app, wiID), nil
+		}
 		if err := app.WorkItems.MarkDone(ctx, wiID); err != nil {
 			return "", err
 		}
-		return fmt.Sprintf("%s Marked as done", formatter.StyleGreen.Render("✔")), nil
+		return fmt.Sprintf("%s Marked as done", formatter.StyleGreen.Render("✔")) + estimateOutcomeLine(ctx, app, wiID), nil
+
+	case "list":
+		return c.workList(ctx, flags)
 
 	case "archive":
 		if len(pos) == 0 {
@@ -501,18 +966,385 @@ func (c *commandBar) dispatchWork(ctx context.Context, sub string, pos []string,
 	}
 }
 
+// projectRiskSummary builds the project list --risk-summary line from the
+// status risk counts, which cover active projects only; every other
+// project, archived ones included, is tallied by status for the note.
+func projectRiskSummary(ctx context.Context, app *App) (string, error) {
+	req := contract.NewStatusRequest()
+	req.Recalc = false
+	status, err := app.Status.GetStatus(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	all, err := app.Projects.List(ctx, true)
+	if err != nil {
+		return "", err
+	}
+	notCounted := make(map[domain.ProjectStatus]int)
+	for _, p := range all {
+		if p.Status != domain.ProjectActive {
+			notCounted[p.Status]++
+		}
+	}
+	return formatter.FormatRiskSummaryLine(status.Summary, notCounted), nil
+}
+
+// archiveDoneItems handles `project archive <id> --with-done` and
+// `project archive --with-done --all`: it archives finished work items
+// (keeping them for history) rather than the project itself.
+func archiveDoneItems(ctx context.Context, app *App, pos []string, flags map[string]string) (string, error) {
+	projectID := ""
+	scope := "all projects"
+	switch {
+	case flags["all"] == "true":
+		if len(pos) > 0 {
+			return "", fmt.Errorf("--all archives done items in every project; drop the project ID")
+		}
+	case len(pos) > 0:
+		id, err := resolveProjectID(ctx, app, pos[0])
+		if err != nil {
+			return "", err
+		}
+		projectID, scope = id, pos[0]
+	default:
+		return "", fmt.Errorf("usage: project archive <id> --with-done | project archive --with-done --all")
+	}
+
+	n, err := app.WorkItems.ArchiveDone(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return formatter.Dim(fmt.Sprintf("No done work items to archive in %s.", scope)), nil
+	}
+	return fmt.Sprintf("%s Archived %d done work item(s) in %s",
+		formatter.StyleGreen.Render("✔"), n, scope), nil
+}
+
 // ── session dispatch ─────────────────────────────────────────────────────────
 
+// sessionLogPomodoro handles `session log --pomodoro N`: N focus blocks of the
+// profile's length, logged as separate sessions against --work-item, the
+// active item, or the last recommended item.
+// shiftProjectDates handles `project shift <id> --by +14d | --from DATE`:
+// every plan date in the project moves by the same number of days. --from
+// gives the new start date and derives the offset from the current one.
+// parseImportance reads a --importance value, which must be 1-5.
+func parseImportance(v string) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < domain.MinImportance || n > domain.MaxImportance {
+		return 0, fmt.Errorf("importance must be a number from %d to %d, got %q", domain.MinImportance, domain.MaxImportance, v)
+	}
+	return n, nil
+}
+
+func shiftProjectDates(ctx context.Context, app *App, pos []string, flags map[string]string) (string, error) {
+	by, hasBy := flags["by"]
+	from, hasFrom := flags["from"]
+	if len(pos) == 0 || hasBy == hasFrom {
+		return "", fmt.Errorf("usage: project shift <id> --by +14d | --from YYYY-MM-DD")
+	}
+	projectID, err := resolveProjectID(ctx, app, pos[0])
+	if err != nil {
+		return "", err
+	}
+	p, err := app.Projects.GetByID(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+
+	var days int
+	if hasBy {
+		var ok bool
+		if days, ok = parseDayOffset(by); !ok {
+			return "", fmt.Errorf("invalid --by %q (use e.g. +14d, -7d or 2w)", by)
+		}
+	} else {
+		start, err := time.Parse(domain.DeadlineDateLayout, from)
+		if err != nil {
+			return "", fmt.Errorf("invalid --from date %q (use YYYY-MM-DD)", from)
+		}
+		days = int(start.Sub(p.StartDate).Hours() / 24)
+		if days == 0 {
+			return fmt.Sprintf("%s already starts on %s; nothing to shift", p.Name, from), nil
+		}
+	}
+
+	nodes, items, err := app.Projects.ShiftDates(ctx, projectID, days)
+	if err != nil {
+		return "", err
+	}
+	p, err = app.Projects.GetByID(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	msg := fmt.Sprintf("%s Shifted %s [%s] by %+d days: starts %s",
+		formatter.StyleGreen.Render("✔"), p.Name, p.ShortID, days, p.StartDate.Format(domain.DeadlineDateLayout))
+	if p.TargetDate != nil {
+		msg += ", due " + domain.FormatDeadline(*p.TargetDate)
+	}
+	return msg + fmt.Sprintf("; moved %d nodes and %d work items with dates", nodes, items), nil
+}
+
+// undoLastMaxAge is how recently a session must have been logged for
+// `session undo-last` to remove it without --force.
+const undoLastMaxAge = 10 * time.Minute
+
+// undoLastSession handles `session undo-last [--project ID | --all]
+// [--force]`: it removes the latest logged session (in the active project
+// unless --all) and reports what was taken back off its work item.
+func undoLastSession(ctx context.Context, app *App, projectID string, flags map[string]string) (string, error) {
+	if ref := flags["project"]; ref != "" {
+		var err error
+		if projectID, err = resolveProjectID(ctx, app, ref); err != nil {
+			return "", err
+		}
+	}
+	if flags["all"] == "true" {
+		projectID = ""
+	}
+	maxAge := undoLastMaxAge
+	if flags["force"] == "true" {
+		maxAge = 0
+	}
+
+	removed, wi, err := app.Sessions.UndoLast(ctx, projectID, maxAge)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s Removed %s session on #%d %s (logged %s); item now has %s logged, %s",
+		formatter.StyleGreen.Render("✔"),
+		formatter.Bold(formatter.FormatMinutes(removed.Minutes)),
+		wi.Seq, wi.Title,
+		formatter.HumanTimestamp(removed.CreatedAt),
+		formatter.FormatMinutes(wi.LoggedMin),
+		wi.Status), nil
+}
+
+// defaultReportDays is the range session report covers without --from.
+const defaultReportDays = 7
+
+// sessionReport handles `session report --group-by tag [--from DATE]
+// [--to DATE] [--days N]`: minutes per session tag over a range of local
+// dates, both ends inclusive. Without --from the range is the last --days
+// days ending with --to (default today).
+func sessionReport(ctx context.Context, app *App, flags map[string]string, width int) (string, error) {
+	const usage = "usage: session report --group-by tag [--from YYYY-MM-DD] [--to YYYY-MM-DD] [--days N]"
+	if flags["group-by"] != "tag" {
+		return "", fmt.Errorf("%s", usage)
+	}
+
+	now := time.Now()
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if v := flags["to"]; v != "" {
+		d, err := time.ParseInLocation(domain.DeadlineDateLayout, v, time.Local)
+		if err != nil {
+			return "", fmt.Errorf("invalid --to date %q (use YYYY-MM-DD)", v)
+		}
+		last = d
+	}
+	days := defaultReportDays
+	if v, ok := flags["days"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return "", fmt.Errorf("invalid --days %q", v)
+		}
+		days = n
+	}
+	from := last.AddDate(0, 0, 1-days)
+	if v := flags["from"]; v != "" {
+		d, err := time.ParseInLocation(domain.DeadlineDateLayout, v, time.Local)
+		if err != nil {
+			return "", fmt.Errorf("invalid --from date %q (use YYYY-MM-DD)", v)
+		}
+		from = d
+	}
+
+	summaries, err := app.Sessions.SummaryByTag(ctx, from, last.AddDate(0, 0, 1))
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatSessionTagReport(summaries, from, last, width), nil
+}
+
+// sessionStartFlag reads a backdated session start from --at, or from the
+// --yesterday / --days-ago N shortcuts, which keep now's clock time N days
+// back. It returns the zero time when none is given, and refuses a future
+// --at unless --allow-future is set.
+func sessionStartFlag(flags map[string]string, now time.Time) (time.Time, error) {
+	days := 0
+	if flags["yesterday"] == "true" {
+		days = 1
+	}
+	if v, ok := flags["days-ago"]; ok {
+		if days != 0 {
+			return time.Time{}, fmt.Errorf("use either --yesterday or --days-ago, not both")
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return time.Time{}, fmt.Errorf("--days-ago must be a whole number of days, 1 or more (got %q)", v)
+		}
+		days = n
+	}
+	atFlag := flags["at"]
+	if days > 0 {
+		if atFlag != "" {
+			return time.Time{}, fmt.Errorf("use either --at or --yesterday/--days-ago, not both")
+		}
+		return now.Local().AddDate(0, 0, -days), nil
+	}
+	if atFlag == "" {
+		return time.Time{}, nil
+	}
+	startedAt, err := parseLocalTimestamp(atFlag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if startedAt.After(now) && flags["allow-future"] != "true" {
+		return time.Time{}, fmt.Errorf("--at %s is in the future (use --allow-future to override)", atFlag)
+	}
+	return startedAt, nil
+}
+
+// sessionDayOnly reports whether --at names only a day (YYYY-MM-DD), so
+// the session's start within that day is unknown and the profile's
+// validate-session-time check does not apply.
+func sessionDayOnly(flags map[string]string) bool {
+	_, err := time.Parse("2006-01-02", strings.TrimSpace(flags["at"]))
+	return err == nil
+}
+
+func (c *commandBar) sessionLogPomodoro(ctx context.Context, flags map[string]string) (string, error) {
+	app := c.state.App
+	count, err := strconv.Atoi(flags["pomodoro"])
+	if err != nil || count <= 0 {
+		return "", fmt.Errorf("usage: session log --pomodoro N [--work-item ID] [--note TEXT] [--tag a,b] [--at \"YYYY-MM-DD HH:MM\" | --yesterday | --days-ago N]")
+	}
+
+	var wiID string
+	switch {
+	case flags["work-item"] != "":
+		wiID, err = resolveWorkItemID(ctx, app, flags["work-item"], c.state.ActiveProjectID)
+		if err != nil {
+			return "", err
+		}
+	case c.state.ActiveItemID != "":
+		wiID = c.state.ActiveItemID
+	case c.state.LastRecommendedItemID != "":
+		wiID = c.state.LastRecommendedItemID
+	default:
+		return "", fmt.Errorf("no active or recommended item; pass --work-item ID")
+	}
+
+	now := time.Now()
+	template := &domain.WorkSessionLog{WorkItemID: wiID, Note: flags["note"], Tags: domain.ParseTags(flags["tag"]),
+		DayOnly: sessionDayOnly(flags)}
+	startedAt, err := sessionStartFlag(flags, now)
+	if err != nil {
+		return "", err
+	}
+	if !startedAt.IsZero() {
+		template.StartedAt = startedAt.UTC()
+	}
+	if v, ok := flags["units-done"]; ok {
+		if u, err := strconv.Atoi(v); err == nil {
+			template.UnitsDoneDelta = u
+		}
+	}
+
+	logged, err := app.Sessions.LogPomodoros(ctx, template, count)
+	if err != nil {
+		return "", err
+	}
+	title, seq := resolveItemTitle(ctx, app, wiID)
+	c.state.SetActiveItem(wiID, title, seq)
+	c.state.LastDuration = logged[0].Minutes
+
+	first := logged[0].StartedAt.Local()
+	last := logged[len(logged)-1]
+	end := last.StartedAt.Add(time.Duration(last.Minutes) * time.Minute).Local()
+	// The blocks are built by LogPomodoros (without --at the last one ends
+	// now), but a running timer is settled as for any other log.
+	in := LogSessionInput{ItemID: wiID, Minutes: count * logged[0].Minutes}
+	return fmt.Sprintf("%s Logged %d × %s to %s %s",
+		formatter.StyleGreen.Render("✔"), count,
+		formatter.Bold(formatter.FormatMinutes(logged[0].Minutes)), formatter.Bold(title),
+		formatter.Dim(fmt.Sprintf("(%s–%s)", first.Format("15:04"), end.Format("15:04")))) + settleTimer(c.state, in, now), nil
+}
+
+// sessionLogTimer handles session log --continue-timer: it logs the running
+// timer's elapsed time (or --minutes, when the timer ran through a break) as
+// a session starting when the timer did, and stops the timer.
+func (c *commandBar) sessionLogTimer(ctx context.Context, flags map[string]string) (string, error) {
+	if !c.state.TimerRunning() {
+		return "", fmt.Errorf("no timer is running; start one with: start <id>")
+	}
+	for _, f := range []string{"at", "yesterday", "days-ago"} {
+		if _, ok := flags[f]; ok {
+			return "", fmt.Errorf("--continue-timer starts the session when the timer started; drop --%s", f)
+		}
+	}
+	if v := flags["work-item"]; v != "" {
+		wiID, err := resolveWorkItemID(ctx, c.state.App, v, c.state.ActiveProjectID)
+		if err != nil {
+			return "", err
+		}
+		if wiID != c.state.TimerItemID {
+			return "", fmt.Errorf("the timer is running on %s, not that item", c.state.TimerItemTitle)
+		}
+	}
+
+	now := time.Now()
+	in := LogSessionInput{
+		ItemID:    c.state.TimerItemID,
+		Title:     c.state.TimerItemTitle,
+		Minutes:   c.state.TimerElapsedMin(now),
+		Note:      flags["note"],
+		Tags:      domain.ParseTags(flags["tag"]),
+		StartedAt: c.state.TimerStartedAt,
+		Finish:    flags["finish"] == "true",
+		FromTimer: true,
+	}
+	if v, ok := flags["minutes"]; ok {
+		minutes, err := strconv.Atoi(v)
+		if err != nil || minutes <= 0 {
+			return "", fmt.Errorf("invalid minutes: %s", v)
+		}
+		in.Minutes = minutes
+	}
+	if v, ok := flags["units-done"]; ok {
+		if u, err := strconv.Atoi(v); err == nil {
+			in.UnitsDelta = u
+		}
+	}
+
+	started := in.StartedAt.Local().Format("15:04")
+	msg, err := execLogSession(ctx, c.state.App, c.state, in)
+	if err != nil {
+		return "", err
+	}
+	return msg + " " + formatter.Dim("(timer from "+started+")"), nil
+}
+
 func (c *commandBar) dispatchSession(ctx context.Context, sub string, pos []string, flags map[string]string) (string, error) {
 	app := c.state.App
 	projectID := c.state.ActiveProjectID
 
 	switch sub {
 	case "log":
+		if _, ok := flags["split"]; ok {
+			return c.sessionLogSplit(ctx, flags)
+		}
+		if _, ok := flags["pomodoro"]; ok {
+			return c.sessionLogPomodoro(ctx, flags)
+		}
+		if flags["continue-timer"] == "true" {
+			return c.sessionLogTimer(ctx, flags)
+		}
 		wiFlag := flags["work-item"]
 		minFlag := flags["minutes"]
 		if wiFlag == "" || minFlag == "" {
-			return "", fmt.Errorf("usage: session log --work-item ID --minutes N [--units-done N] [--note TEXT]")
+			return "", fmt.Errorf("usage: session log --work-item ID --minutes N [--units-done N] [--note TEXT] [--tag a,b] [--at \"YYYY-MM-DD HH:MM\" | --yesterday | --days-ago N] [--allow-future] [--finish] | --continue-timer | --pomodoro N | --split \"ITEM=MIN,ITEM=MIN\"")
 		}
 		wiID, err := resolveWorkItemID(ctx, app, wiFlag, projectID)
 		if err != nil {
@@ -522,31 +1354,53 @@ func (c *commandBar) dispatchSession(ctx context.Context, sub string, pos []stri
 		if err != nil || minutes <= 0 {
 			return "", fmt.Errorf("invalid minutes: %s", minFlag)
 		}
-		s := &domain.WorkSessionLog{
-			ID:         uuid.New().String(),
-			WorkItemID: wiID,
-			StartedAt:  time.Now(),
-			Minutes:    minutes,
-			Note:       flags["note"],
-			CreatedAt:  time.Now(),
+		now := time.Now()
+		startedAt, err := sessionStartFlag(flags, now)
+		if err != nil {
+			return "", err
+		}
+		in := LogSessionInput{
+			ItemID:    wiID,
+			Minutes:   minutes,
+			Note:      flags["note"],
+			Tags:      domain.ParseTags(flags["tag"]),
+			StartedAt: startedAt,
+			DayOnly:   sessionDayOnly(flags),
+			Finish:    flags["finish"] == "true",
 		}
 		if v, ok := flags["units-done"]; ok {
 			if u, err := strconv.Atoi(v); err == nil {
-				s.UnitsDoneDelta = u
+				in.UnitsDelta = u
 			}
 		}
+		s := newWorkSessionLog(in, now)
 		logSession := app.logSessionUseCase()
 		if logSession == nil {
 			return "", fmt.Errorf("log-session use case is not configured")
 		}
+		if in.Finish {
+			if err := logSession.LogSessionAndFinish(ctx, s); err != nil {
+				return "", err
+			}
+			if c.state.ActiveItemID == wiID {
+				c.state.ClearItemContext()
+			}
+			return fmt.Sprintf("%s Logged %s session and marked the item done",
+				formatter.StyleGreen.Render("✔"),
+				formatter.Bold(formatter.FormatMinutes(minutes))) + settleTimer(c.state, in, now), nil
+		}
 		if err := logSession.LogSession(ctx, s); err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("%s Logged %s session",
 			formatter.StyleGreen.Render("✔"),
-			formatter.Bold(formatter.FormatMinutes(minutes))), nil
+			formatter.Bold(formatter.FormatMinutes(minutes))) + settleTimer(c.state, in, now), nil
 
 	case "list":
+		format := flags["format"]
+		if format != "" && format != "table" && format != "json" {
+			return "", fmt.Errorf("unsupported format %q (expected table or json)", format)
+		}
 		wiFlag := flags["work-item"]
 		daysStr := flags["days"]
 		days := 7
@@ -569,26 +1423,19 @@ func (c *commandBar) dispatchSession(ctx context.Context, sub string, pos []stri
 		if err != nil {
 			return "", err
 		}
+		if format == "json" {
+			return sessionListJSON(ctx, app, sessions)
+		}
 		if len(sessions) == 0 {
 			return "No sessions found.", nil
 		}
-		headers := []string{"ID", "WORK ITEM", "STARTED", "DURATION", "UNITS", "NOTE"}
-		rows := make([][]string, 0, len(sessions))
-		for _, s := range sessions {
-			notePreview := s.Note
-			if len(notePreview) > 40 {
-				notePreview = notePreview[:37] + "..."
-			}
-			rows = append(rows, []string{
-				formatter.TruncID(s.ID),
-				formatter.TruncID(s.WorkItemID),
-				formatter.HumanTimestamp(s.StartedAt),
-				formatter.FormatMinutes(s.Minutes),
-				fmt.Sprintf("%d", s.UnitsDoneDelta),
-				formatter.Dim(notePreview),
-			})
-		}
-		return formatter.RenderBox("Sessions", formatter.RenderTable(headers, rows)), nil
+		return formatter.FormatSessionList(sessions, false, c.state.Width), nil
+
+	case "report":
+		return sessionReport(ctx, app, flags, c.state.Width)
+
+	case "undo-last":
+		return undoLastSession(ctx, app, projectID, flags)
 
 	case "remove":
 		if len(pos) == 0 {
@@ -618,7 +1465,7 @@ func (c *commandBar) dispatchTemplate(ctx context.Context, sub string, pos []str
 		if len(templates) == 0 {
 			return "No templates found.", nil
 		}
-		return formatter.FormatTemplateList(templates), nil
+		return formatter.FormatTemplateList(templates, c.state.Width), nil
 
 	case "show":
 		if len(pos) == 0 {
@@ -630,6 +1477,16 @@ func (c *commandBar) dispatchTemplate(ctx context.Context, sub string, pos []str
 		}
 		return formatter.FormatTemplateShow(t), nil
 
+	case "validate":
+		if len(pos) == 0 {
+			return "", fmt.Errorf("usage: template validate <file>")
+		}
+		result, err := app.Templates.Validate(ctx, pos[0])
+		if err != nil {
+			return "", err
+		}
+		return formatter.FormatTemplateValidation(result), nil
+
 	default:
 		return "", fmt.Errorf("unknown template subcommand: %s", sub)
 	}
@@ -638,11 +1495,23 @@ func (c *commandBar) dispatchTemplate(ctx context.Context, sub string, pos []str
 // ── shared helpers ───────────────────────────────────────────────────────────
 
 // execImport runs a project import and returns formatted output.
-func execImport(ctx context.Context, app *App, filePath string) (string, error) {
+func execImport(ctx context.Context, app *App, filePath string, flags map[string]string) (string, error) {
 	importProject := app.importProjectUseCase()
 	if importProject == nil {
 		return "", fmt.Errorf("import-project use case is not configured")
 	}
+	if flags["dry-run"] == "true" {
+		if flags["merge"] == "true" || flags["prune"] == "true" {
+			return "", fmt.Errorf("--dry-run previews a new import and cannot be combined with --merge or --prune")
+		}
+		return execImportDryRun(ctx, importProject, filePath)
+	}
+	if flags["merge"] == "true" {
+		return execMergeImport(ctx, importProject, filePath, flags["prune"] == "true")
+	}
+	if flags["prune"] == "true" {
+		return "", fmt.Errorf("--prune requires --merge")
+	}
 	result, err := importProject.ImportProject(ctx, filePath)
 	if err != nil {
 		return "", err
@@ -654,24 +1523,152 @@ func execImport(ctx context.Context, app *App, filePath string) (string, error)
 		result.NodeCount, result.WorkItemCount, result.DependencyCount), nil
 }
 
-// buildInspectTree builds the inspect output for a project, returning the formatted tree.
-func buildInspectTree(app *App, ctx context.Context, projectID string) (string, error) {
-	p, err := app.Projects.GetByID(ctx, projectID)
+// execImportDryRun reports what importing filePath would create, or every
+// problem that would stop it, without writing anything.
+func execImportDryRun(ctx context.Context, importProject app.ImportProjectUseCase, filePath string) (string, error) {
+	preview, err := importProject.PreviewImport(ctx, filePath)
+	if err != nil {
+		return "", err
+	}
+	if len(preview.Problems) > 0 {
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("%s Dry run: %s has %d problem(s); nothing was imported",
+			formatter.StyleRed.Render("✘"), formatter.Bold(filePath), len(preview.Problems)))
+		for _, p := range preview.Problems {
+			b.WriteString("\n  - " + p)
+		}
+		return b.String(), nil
+	}
+	return fmt.Sprintf("%s Dry run: would create 1 project (%s [%s]), %d nodes, %d items, %d deps — nothing written; re-run without --dry-run to import",
+		formatter.StyleGreen.Render("✔"),
+		formatter.Bold(preview.Name),
+		preview.ShortID,
+		preview.NodeCount, preview.WorkItemCount, preview.DependencyCount), nil
+}
+
+// execMergeImport re-syncs an existing project (matched by short_id) from an
+// import file, updating nodes and work items by ref.
+func execMergeImport(ctx context.Context, importProject app.ImportProjectUseCase, filePath string, prune bool) (string, error) {
+	result, err := importProject.MergeProject(ctx, filePath, app.MergeImportOptions{Prune: prune})
 	if err != nil {
 		return "", err
 	}
+	if result.Created {
+		return fmt.Sprintf("%s No project matched %s — imported as new: %d nodes, %d items, %d deps",
+			formatter.StyleGreen.Render("✔"),
+			result.Project.ShortID,
+			result.NodesAdded, result.WorkItemsAdded, result.DependenciesAdded), nil
+	}
+	msg := fmt.Sprintf("%s Merged into %s [%s] — nodes: %d added, %d updated; items: %d added, %d updated",
+		formatter.StyleGreen.Render("✔"),
+		formatter.Bold(result.Project.Name),
+		result.Project.ShortID,
+		result.NodesAdded, result.NodesUpdated,
+		result.WorkItemsAdded, result.WorkItemsUpdated)
+	if prune {
+		msg += fmt.Sprintf(", %d archived", result.WorkItemsArchived)
+	}
+	return msg, nil
+}
+
+// unlimitedDepth makes fetchSubtree walk the whole tree.
+const unlimitedDepth = -1
+
+// inspectSessionDays is the project inspect --sessions window without --days.
+const inspectSessionDays = 14
+
+// buildInspectTree builds the inspect output for a project, returning the
+// formatted tree. key.progress adds per-node rollups (inspect --progress),
+// key.hideDone prunes finished work (inspect --hide-done), key.maxDepth
+// limits the node levels shown below the roots (inspect --depth) and
+// key.sessionDays annotates recent sessions (inspect --sessions).
+func buildInspectTree(app *App, ctx context.Context, key inspectKey) (string, error) {
+	data, err := loadInspectView(app, ctx, key)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatProjectInspect(data), nil
+}
+
+// loadInspectView loads a project's inspect data with the display options
+// in key applied.
+func loadInspectView(app *App, ctx context.Context, key inspectKey) (formatter.ProjectInspectData, error) {
+	data, err := loadInspectData(app, ctx, key.projectID, key.maxDepth)
+	if err != nil {
+		return formatter.ProjectInspectData{}, err
+	}
+	data.ShowProgress = key.progress
+	data.HideDone = key.hideDone
+	if key.maxDepth != unlimitedDepth {
+		maxDepth := key.maxDepth
+		data.MaxDepth = &maxDepth
+	}
+	if key.sessionDays > 0 {
+		sessions, err := app.Sessions.ListRecentByProject(ctx, key.projectID, key.sessionDays)
+		if err != nil {
+			return formatter.ProjectInspectData{}, fmt.Errorf("listing recent sessions: %w", err)
+		}
+		data.ActivityDays = key.sessionDays
+		data.Activity = summarizeItemActivity(sessions)
+	}
+	return data, nil
+}
+
+// summarizeItemActivity totals sessions per work item, keeping the latest
+// start, so the whole project's activity comes from one query.
+func summarizeItemActivity(sessions []*domain.WorkSessionLog) map[string]formatter.ItemActivity {
+	activity := make(map[string]formatter.ItemActivity)
+	for _, s := range sessions {
+		a := activity[s.WorkItemID]
+		a.Minutes += s.Minutes
+		a.Sessions++
+		if s.StartedAt.After(a.LastAt) {
+			a.LastAt = s.StartedAt
+		}
+		activity[s.WorkItemID] = a
+	}
+	return activity
+}
+
+// loadInspectData walks a project's node tree, collecting child nodes and
+// work items per node for inspect and DOT export, down to maxDepth.
+func loadInspectData(app *App, ctx context.Context, projectID string, maxDepth int) (formatter.ProjectInspectData, error) {
+	p, err := app.Projects.GetByID(ctx, projectID)
+	if err != nil {
+		return formatter.ProjectInspectData{}, err
+	}
 
 	rootNodes, err := app.Nodes.ListRoots(ctx, projectID)
 	if err != nil {
-		return "", fmt.Errorf("listing root nodes: %w", err)
+		return formatter.ProjectInspectData{}, fmt.Errorf("listing root nodes: %w", err)
+	}
+
+	childMap, workItems, err := fetchSubtree(app, ctx, rootNodes, maxDepth)
+	if err != nil {
+		return formatter.ProjectInspectData{}, err
 	}
 
+	return formatter.ProjectInspectData{
+		Project:   p,
+		RootNodes: rootNodes,
+		ChildMap:  childMap,
+		WorkItems: workItems,
+	}, nil
+}
+
+// fetchSubtree walks down from roots, returning the children of each node
+// and the work items attached to each node. roots may be a project's root
+// nodes or any single node (node inspect --tree). Nodes maxDepth levels below
+// the roots still list their children and work items, so the formatter can
+// count what it leaves out, but the walk goes no deeper; unlimitedDepth
+// fetches everything.
+func fetchSubtree(app *App, ctx context.Context, roots []*domain.PlanNode, maxDepth int) (map[string][]*domain.PlanNode, map[string][]*domain.WorkItem, error) {
 	childMap := make(map[string][]*domain.PlanNode)
 	workItems := make(map[string][]*domain.WorkItem)
 
 	var fetchErr error
-	var fetchChildren func(nodes []*domain.PlanNode)
-	fetchChildren = func(nodes []*domain.PlanNode) {
+	var fetchChildren func(nodes []*domain.PlanNode, depth int)
+	fetchChildren = func(nodes []*domain.PlanNode, depth int) {
 		for _, n := range nodes {
 			if fetchErr != nil {
 				return
@@ -683,7 +1680,9 @@ func buildInspectTree(app *App, ctx context.Context, projectID string) (string,
 			}
 			if len(children) > 0 {
 				childMap[n.ID] = children
-				fetchChildren(children)
+				if maxDepth == unlimitedDepth || depth < maxDepth {
+					fetchChildren(children, depth+1)
+				}
 			}
 			items, err := app.WorkItems.ListByNode(ctx, n.ID)
 			if err != nil {
@@ -695,17 +1694,9 @@ func buildInspectTree(app *App, ctx context.Context, projectID string) (string,
 			}
 		}
 	}
-	fetchChildren(rootNodes)
+	fetchChildren(roots, 0)
 	if fetchErr != nil {
-		return "", fetchErr
+		return nil, nil, fetchErr
 	}
-
-	data := formatter.ProjectInspectData{
-		Project:   p,
-		RootNodes: rootNodes,
-		ChildMap:  childMap,
-		WorkItems: workItems,
-	}
-
-	return formatter.FormatProjectInspect(data), nil
+	return childMap, workItems, nil
 }
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdExport handles "export [--since TIMESTAMP] [--format json|dot] [--out FILE]".
+// Without --out the JSON envelope is shown in the output viewport. --format dot
+// exports the active project's tree instead (see project export).
+func (c *commandBar) cmdExport(args []string) tea.Cmd {
+	_, flags := parseShellFlags(args)
+	return tea.Batch(
+		loadingCmd("Exporting..."),
+		asyncOutputCmd(func() string {
+			out, err := execExport(context.Background(), c.state.App, c.state.ActiveProjectID, flags)
+			if err != nil && flags["format"] == "json" {
+				return jsonError(err)
+			}
+			if err != nil {
+				return shellError(err)
+			}
+			return out
+		}),
+	)
+}
+
+func execExport(ctx context.Context, a *App, activeProjectID string, flags map[string]string) (string, error) {
+	switch flags["format"] {
+	case "", "json":
+	case "dot":
+		if flags["since"] != "" {
+			return "", fmt.Errorf("--since is not supported with --format dot")
+		}
+		if activeProjectID == "" {
+			return "", fmt.Errorf("--format dot exports the active project; pick one with 'use <id>' or run 'project export <id> --format dot'")
+		}
+		return execProjectExportDOT(ctx, a, activeProjectID, flags["out"])
+	default:
+		return "", fmt.Errorf("unsupported format %q (expected json or dot)", flags["format"])
+	}
+
+	if a.Export == nil {
+		return "", fmt.Errorf("export use case is not configured")
+	}
+
+	var req app.ExportRequest
+	if v := flags["since"]; v != "" {
+		since, err := parseLocalTimestamp(v)
+		if err != nil {
+			return "", err
+		}
+		req.Since = since
+	}
+
+	env, err := a.Export.Export(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding export: %w", err)
+	}
+
+	path := flags["out"]
+	if path == "" {
+		return string(data), nil
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return "", fmt.Errorf("writing export: %w", err)
+	}
+	return fmt.Sprintf("%s Exported %d projects, %d nodes, %d items, %d sessions, %d tombstones to %s",
+		formatter.StyleGreen.Render("✔"),
+		len(env.Projects), len(env.Nodes), len(env.WorkItems), len(env.Sessions), len(env.Tombstones),
+		formatter.Bold(path)), nil
+}
+
+// execProjectExportDOT renders a project's tree as Graphviz DOT, writing it
+// to path when given.
+func execProjectExportDOT(ctx context.Context, a *App, projectID, path string) (string, error) {
+	data, err := loadInspectData(a, ctx, projectID, unlimitedDepth)
+	if err != nil {
+		return "", err
+	}
+	dot := formatter.FormatProjectDOT(data)
+	if path == "" {
+		return dot, nil
+	}
+	if err := os.WriteFile(path, []byte(dot), 0o644); err != nil {
+		return "", fmt.Errorf("writing export: %w", err)
+	}
+	return fmt.Sprintf("%s Exported %s as DOT to %s",
+		formatter.StyleGreen.Render("✔"), formatter.Bold(data.Project.Name), formatter.Bold(path)), nil
+}
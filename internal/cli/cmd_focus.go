@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const focusUsage = "Usage: focus [list] | focus add <id> | focus remove <id>"
+
+// cmdFocus handles "focus list|add|remove". With no subcommand it lists.
+func (c *commandBar) cmdFocus(args []string) tea.Cmd {
+	if c.state.App.Focus == nil {
+		return outputCmd(shellError(fmt.Errorf("focus list is not configured")))
+	}
+	ctx := context.Background()
+
+	if len(args) == 0 || strings.ToLower(args[0]) == "list" {
+		out, err := execFocusList(ctx, c.state.App)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(out)
+	}
+
+	sub := strings.ToLower(args[0])
+	if (sub != "add" && sub != "remove") || len(args) != 2 {
+		return outputCmd(formatter.StyleYellow.Render(focusUsage))
+	}
+
+	id, err := resolveWorkItemID(ctx, c.state.App, stripItemPrefix(args[1]), c.state.ActiveProjectID)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	wi, err := c.state.App.WorkItems.GetByID(ctx, id)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+
+	var msg string
+	if sub == "add" {
+		if err := c.state.App.Focus.Add(ctx, wi.ID); err != nil {
+			return outputCmd(shellError(err))
+		}
+		msg = fmt.Sprintf("%s Focused: %s", formatter.StyleGreen.Render("✔"), formatter.Bold(wi.Title))
+	} else {
+		if err := c.state.App.Focus.Remove(ctx, wi.ID); err != nil {
+			return outputCmd(shellError(err))
+		}
+		msg = fmt.Sprintf("%s Unfocused: %s", formatter.StyleGreen.Render("✔"), formatter.Bold(wi.Title))
+	}
+	return tea.Batch(outputCmd(msg), func() tea.Msg { return refreshViewMsg{} })
+}
+
+func execFocusList(ctx context.Context, a *App) (string, error) {
+	items, err := a.Focus.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatFocusList(items), nil
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdHelpCommands handles "help commands [--search words]": an offline
+// reference of commands with their flags and examples, filtered with the
+// same matching the help chat fallback uses.
+func (c *commandBar) cmdHelpCommands(args []string) tea.Cmd {
+	pos, flags := parseShellFlags(args)
+	terms := pos
+	if v, ok := flags["search"]; ok {
+		if v == "true" {
+			return outputCmd(formatter.StyleYellow.Render("Usage: help commands [--search words]"))
+		}
+		terms = append([]string{v}, pos...)
+	}
+	query := strings.Join(terms, " ")
+
+	spec := ShellCommandSpec()
+	if query != "" {
+		spec = &CommandSpec{Commands: spec.FuzzyMatch(query, len(spec.Commands))}
+	}
+	return outputCmd(formatter.FormatCommandReference(buildHelpCommandInfos(spec), query))
+}
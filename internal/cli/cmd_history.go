@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdHistory handles "history <id>": replays an entity's audit log. Work item
+// refs (#seq, short refs) are resolved; anything else is used as a raw entity
+// ID, which also covers deleted items and projects.
+func (c *commandBar) cmdHistory(args []string) tea.Cmd {
+	if c.state.App.Audit == nil {
+		return outputCmd(shellError(fmt.Errorf("audit log is not configured")))
+	}
+	if len(args) != 1 {
+		return outputCmd(formatter.StyleYellow.Render("Usage: history <id>"))
+	}
+	ctx := context.Background()
+
+	entityID, label := args[0], args[0]
+	if id, err := resolveWorkItemID(ctx, c.state.App, stripItemPrefix(args[0]), c.state.ActiveProjectID); err == nil {
+		entityID = id
+		if wi, err := c.state.App.WorkItems.GetByID(ctx, id); err == nil {
+			label = wi.Title
+		}
+	}
+
+	events, err := c.state.App.Audit.History(ctx, entityID)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(formatter.FormatHistory(label, events))
+}
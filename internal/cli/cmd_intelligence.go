@@ -2,14 +2,18 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/contract"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -31,15 +35,27 @@ func (c *commandBar) cmdAsk(args []string) tea.Cmd {
 
 	return tea.Batch(
 		loadingCmd("Thinking..."),
-		asyncOutputCmd(func() string {
+		func() tea.Msg {
 			ctx := context.Background()
 
 			resolution, err := c.state.App.Intent.Parse(ctx, question)
+			if errors.Is(err, llm.ErrLLMUnavailable) {
+				// No parser without the model; point at matching commands instead.
+				answer := intelligence.DeterministicHelp(question, buildHelpCommandInfos(ShellCommandSpec()), buildHelpContext(c.state))
+				return cmdOutputMsg{output: formatter.FormatLLMUnavailable() + "\n" + formatter.FormatHelpAnswer(answer)}
+			}
 			if err != nil {
-				return shellError(fmt.Errorf("parse failed: %w", err))
+				return cmdOutputMsg{output: shellError(fmt.Errorf("parse failed: %w", err))}
 			}
 
 			resolution.CommandHint = CommandHint(resolution.ParsedIntent)
+
+			// Writes and low-confidence reads get a y/n preview instead of
+			// just printing the command, as long as the command is complete.
+			if askNeedsConfirmation(resolution) {
+				return c.confirmAskCmd(resolution)()
+			}
+
 			output := formatter.FormatAskResolution(resolution)
 
 			// Auto-execute read-only intents.
@@ -50,9 +66,52 @@ func (c *commandBar) cmdAsk(args []string) tea.Cmd {
 				}
 			}
 
-			return output
-		}),
+			return cmdOutputMsg{output: output}
+		},
+	)
+}
+
+// askNeedsConfirmation reports whether an ask resolution should go through
+// the confirmation prompt: it needs confirming (write) or clarifying (low
+// confidence), and its command hint has no <PLACEHOLDER> left to fill in.
+func askNeedsConfirmation(r *intelligence.AskResolution) bool {
+	if r.ExecutionState != intelligence.StateNeedsConfirmation &&
+		r.ExecutionState != intelligence.StateNeedsClarification {
+		return false
+	}
+	return r.CommandHint != "" && !strings.Contains(r.CommandHint, "<")
+}
+
+// confirmAskCmd previews the parsed command and runs it on "Yes".
+func (c *commandBar) confirmAskCmd(r *intelligence.AskResolution) tea.Cmd {
+	var confirmed bool
+	form := wizardConfirmPreview(
+		fmt.Sprintf("Run %q?", r.CommandHint),
+		formatter.FormatAskConfirmPreview(r),
+		&confirmed,
 	)
+	return startWizardCmd(c.state, "Confirm", form, func() tea.Cmd {
+		if !confirmed {
+			return outputCmd(formatter.Dim("Cancelled."))
+		}
+		return c.runConfirmedIntent(r)
+	})
+}
+
+// runConfirmedIntent executes an intent the user has confirmed. Intents with
+// a direct TUI dispatch use it; everything else runs its command hint, with
+// --yes for destructive commands since the preview was the confirmation.
+func (c *commandBar) runConfirmedIntent(r *intelligence.AskResolution) tea.Cmd {
+	switch r.ParsedIntent.Intent {
+	case intelligence.IntentWhatNow, intelligence.IntentStatus,
+		intelligence.IntentExplainNow, intelligence.IntentReviewWeekly:
+		return asyncOutputCmd(func() string { return c.dispatchIntentTUI(r.ParsedIntent) })
+	}
+	hint := r.CommandHint
+	if intelligence.IsDestructiveIntent(r.ParsedIntent.Intent) {
+		hint += " --yes"
+	}
+	return c.executeCommand(hint)
 }
 
 // dispatchIntentTUI maps a parsed intent to a service call, returning
@@ -76,14 +135,14 @@ func (c *commandBar) dispatchIntentTUI(intent *intelligence.ParsedIntent) string
 		if err != nil {
 			return shellError(err)
 		}
-		return formatter.FormatStatus(resp)
+		return formatter.FormatStatus(resp, c.state.Width)
 
 	case intelligence.IntentExplainNow:
 		min := intArg(intent.Arguments, "minutes", 60)
-		return c.runExplainNowTUI(min)
+		return c.runExplainNowTUI(min, false)
 
 	case intelligence.IntentReviewWeekly:
-		return c.runReviewWeeklyTUI()
+		return c.runReviewWeeklyTUI(false)
 
 	default:
 		hint := CommandHint(intent)
@@ -98,21 +157,26 @@ func (c *commandBar) dispatchIntentTUI(intent *intelligence.ParsedIntent) string
 
 func (c *commandBar) cmdExplain(args []string) tea.Cmd {
 	if len(args) == 0 {
-		return outputCmd(formatter.StyleYellow.Render("Usage: explain now [minutes] | explain why-not <id>"))
+		return outputCmd(formatter.StyleYellow.Render("Usage: explain now [minutes] [--verbose] | explain why-not <id>"))
 	}
 
 	sub := strings.ToLower(args[0])
 	switch sub {
 	case "now":
+		pos, flags := parseShellFlags(args[1:])
 		minutes := 60
-		if len(args) > 1 {
-			if m, err := strconv.Atoi(args[1]); err == nil && m > 0 {
+		if v, ok := flags["minutes"]; ok {
+			pos = append([]string{v}, pos...)
+		}
+		if len(pos) > 0 {
+			if m, err := strconv.Atoi(pos[0]); err == nil && m > 0 {
 				minutes = m
 			}
 		}
+		verbose := c.verbosity == formatter.VerbosityVerbose
 		return tea.Batch(
 			loadingCmd("Generating explanation..."),
-			asyncOutputCmd(func() string { return c.runExplainNowTUI(minutes) }),
+			asyncOutputCmd(func() string { return c.runExplainNowTUI(minutes, verbose) }),
 		)
 
 	case "why-not":
@@ -126,14 +190,17 @@ func (c *commandBar) cmdExplain(args []string) tea.Cmd {
 		)
 
 	default:
-		return outputCmd(formatter.StyleYellow.Render("Usage: explain now [minutes] | explain why-not <id>"))
+		return outputCmd(formatter.StyleYellow.Render("Usage: explain now [minutes] [--verbose] | explain why-not <id>"))
 	}
 }
 
-func (c *commandBar) runExplainNowTUI(minutes int) string {
+// runExplainNowTUI explains the current recommendations. verbose appends the
+// full candidate ranking, the deterministic view of the same decision.
+func (c *commandBar) runExplainNowTUI(minutes int, verbose bool) string {
 	ctx := context.Background()
 
 	req := contract.NewWhatNowRequest(minutes)
+	req.IncludeRanking = verbose
 	resp, err := c.state.App.WhatNow.Recommend(ctx, req)
 	if err != nil {
 		return shellError(err)
@@ -146,7 +213,11 @@ func (c *commandBar) runExplainNowTUI(minutes int) string {
 		func() *intelligence.LLMExplanation { return intelligence.DeterministicExplainNow(trace) },
 	)
 
-	return formatWhatNowResponse(ctx, c.state.App, resp) + "\n" + formatter.FormatExplanation(explanation)
+	out := formatWhatNowResponse(ctx, c.state.App, resp) + "\n" + formatter.FormatExplanation(explanation)
+	if verbose {
+		out += "\n" + formatter.FormatCandidateRanking(resp, c.state.Width)
+	}
+	return out
 }
 
 func (c *commandBar) runExplainWhyNotTUI(candidateRef string) string {
@@ -180,55 +251,72 @@ func (c *commandBar) runExplainWhyNotTUI(candidateRef string) string {
 
 // ── review command ───────────────────────────────────────────────────────────
 
+const reviewUsage = "Usage: review weekly [--plain|--email]"
+
 func (c *commandBar) cmdReview(args []string) tea.Cmd {
 	if len(args) == 0 {
-		return outputCmd(formatter.StyleYellow.Render("Usage: review weekly"))
+		return outputCmd(formatter.StyleYellow.Render(reviewUsage))
 	}
 
 	sub := strings.ToLower(args[0])
 	switch sub {
 	case "weekly":
+		_, flags := parseShellFlags(args[1:])
+		plain := flags["plain"] == "true" || flags["email"] == "true"
 		return tea.Batch(
 			loadingCmd("Generating weekly review..."),
-			asyncOutputCmd(c.runReviewWeeklyTUI),
+			asyncOutputCmd(func() string { return c.runReviewWeeklyTUI(plain) }),
 		)
 	default:
-		return outputCmd(formatter.StyleYellow.Render("Usage: review weekly"))
+		return outputCmd(formatter.StyleYellow.Render(reviewUsage))
 	}
 }
 
-func (c *commandBar) runReviewWeeklyTUI() string {
+// runReviewWeeklyTUI renders the weekly review. The structure always comes
+// from the review service; the LLM, when enabled, only rephrases the
+// summary. plain drops styling and the status board so the text can be
+// pasted into a journal or an email.
+func (c *commandBar) runReviewWeeklyTUI(plain bool) string {
 	ctx := context.Background()
+	if c.state.App.Review == nil {
+		return shellError(fmt.Errorf("weekly review is not configured"))
+	}
 
-	statusReq := contract.NewStatusRequest()
-	statusResp, err := c.state.App.Status.GetStatus(ctx, statusReq)
+	now := time.Now()
+	review, err := c.state.App.Review.Review(ctx, app.WeeklyReviewRequest{Now: &now})
 	if err != nil {
-		return shellError(fmt.Errorf("getting status: %w", err))
+		return shellError(err)
 	}
 
 	trace := intelligence.WeeklyReviewTrace{
-		PeriodDays: 7,
+		PeriodDays:     int(review.To.Sub(review.From).Hours() / 24),
+		TotalLoggedMin: review.LoggedMin,
+		SessionCount:   review.SessionCount,
 	}
-
-	totalLogged := 0
-	for _, p := range statusResp.Projects {
+	for _, p := range review.Projects {
 		trace.ProjectSummaries = append(trace.ProjectSummaries, intelligence.ProjectWeeklySummary{
-			ProjectID:   p.ProjectID,
-			ProjectName: p.ProjectName,
-			PlannedMin:  p.PlannedMinTotal,
-			LoggedMin:   p.LoggedMinTotal,
-			RiskLevel:   string(p.RiskLevel),
+			ProjectID:     p.ProjectID,
+			ProjectName:   p.ProjectName,
+			PlannedMin:    p.PlannedMin,
+			LoggedMin:     p.LoggedMin,
+			RiskLevel:     string(p.RiskLevel),
+			SessionsCount: p.Sessions,
 		})
-		totalLogged += p.LoggedMinTotal
 	}
-	trace.TotalLoggedMin = totalLogged
 
 	explanation := c.explainWithFallback(
 		func() (*intelligence.LLMExplanation, error) { return c.state.App.Explain.WeeklyReview(ctx, trace) },
 		func() *intelligence.LLMExplanation { return intelligence.DeterministicWeeklyReview(trace) },
 	)
+	if plain {
+		return formatter.FormatWeeklyReviewPlain(review, explanation.SummaryShort)
+	}
 
-	output := formatter.FormatStatus(statusResp) + "\n" + formatter.FormatExplanation(explanation)
+	statusResp, err := c.state.App.Status.GetStatus(ctx, contract.NewStatusRequest())
+	if err != nil {
+		return shellError(fmt.Errorf("getting status: %w", err))
+	}
+	output := formatter.FormatStatus(statusResp, c.state.Width) + "\n" + formatter.FormatExplanation(explanation)
 
 	// Keep parity with cobra `review weekly` by appending zettelkasten backlog.
 	summaries, err := c.state.App.Sessions.ListRecentSummaryByType(ctx, 7)
@@ -240,7 +328,7 @@ func (c *commandBar) runReviewWeeklyTUI() string {
 		output += "\n" + formatter.FormatZettelBacklog(backlog)
 	}
 
-	return output
+	return output + "\n" + formatter.FormatWeeklyReviewActions(review.NextWeek)
 }
 
 // buildZettelBacklog aggregates session summaries into reading/zettel data
@@ -2,10 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	"github.com/alexanderramin/kairos/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,6 +83,12 @@ func TestCommandBar_ExplainTUIFallbacks(t *testing.T) {
 
 	output := execCmdAsync(cb, "explain now 60")
 	assert.Contains(t, output, "EXPLANATION")
+	assert.NotContains(t, output, "CANDIDATE RANKING")
+
+	output = execCmdAsync(cb, "explain now --minutes 45 --verbose")
+	assert.Contains(t, output, "CANDIDATE RANKING (45M")
+	assert.Contains(t, output, "TOP FACTORS")
+	assert.Contains(t, output, "selected")
 
 	output = execCmdAsync(cb, "explain why-not "+wiID)
 	assert.Contains(t, output, "EXPLANATION")
@@ -185,3 +195,116 @@ func TestCommandBar_ReviewWeekly_HidesZettelkastenBacklogWhenRatioIsLow(t *testi
 
 	assert.NotContains(t, output, "ZETTELKASTEN BACKLOG")
 }
+
+func TestCommandBar_ReviewWeekly_PlainActionList(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, _, wiID := seedProjectCore(t, app, seedOpts{})
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 40)))
+	cb := testCommandBar(t, app)
+
+	output := execCmdAsync(cb, "review weekly")
+	assert.Contains(t, output, "NEXT WEEK")
+
+	for _, cmd := range []string{"review weekly --plain", "review weekly --email"} {
+		output = execCmdAsync(cb, cmd)
+		assert.NotContains(t, output, "\x1b[", cmd)
+		assert.Contains(t, output, "Past 7 days: 1 sessions, 40 minutes logged", cmd)
+		assert.Contains(t, output, "Accomplished\n- 40m logged over 1 session\n", cmd)
+		assert.Contains(t, output, "Risks that worsened\n", cmd)
+		assert.Contains(t, output, "Next week\n1. ", cmd)
+	}
+
+	// The LLM only rephrases the summary; the sections stay the same.
+	app.Explain = &stubExplainTUI{weeklyReviewRes: &intelligence.LLMExplanation{
+		Context:      intelligence.ExplainContextWeeklyReview,
+		SummaryShort: "A steady week.",
+		Confidence:   0.9,
+	}}
+	output = execCmdAsync(cb, "review weekly --plain")
+	assert.Contains(t, output, "A steady week.\n")
+	assert.NotContains(t, output, "Past 7 days")
+	assert.Contains(t, output, "Next week\n1. ")
+}
+
+func archiveIntent(projectID string, confidence float64) *intelligence.AskResolution {
+	return &intelligence.AskResolution{
+		ParsedIntent: &intelligence.ParsedIntent{
+			Intent:     intelligence.IntentProjectArchive,
+			Risk:       intelligence.RiskWrite,
+			Arguments:  map[string]interface{}{"project_id": projectID},
+			Confidence: confidence,
+		},
+		ExecutionState: intelligence.StateNeedsConfirmation,
+	}
+}
+
+func TestTUI_AskWriteIntentConfirmsThenRuns(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	proj := testutil.NewTestProject("Physics", testutil.WithShortID("PHYS01"))
+	require.NoError(t, app.Projects.Create(ctx, proj))
+	app.Intent = &stubIntentTUI{resolution: archiveIntent("PHYS01", 0.7)}
+	d := NewTestDriver(t, app)
+
+	d.Command("ask shelve physics for now")
+	require.Equal(t, ViewForm, d.ActiveViewID(), "write intent should open a confirmation preview")
+	assert.Contains(t, d.View(), "project archive PHYS01")
+	assert.Contains(t, d.View(), "70%")
+
+	p, err := app.Projects.GetByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Nil(t, p.ArchivedAt, "nothing runs before confirmation")
+
+	d.PressKey('y')
+	d.PressEnter()
+
+	p, err = app.Projects.GetByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, p.ArchivedAt, "confirmed archive should run without a second prompt")
+	assert.NotEqual(t, ViewForm, d.ActiveViewID())
+}
+
+func TestCommandBar_AskWithPlaceholderHintDoesNotPrompt(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+	app.Intent = &stubIntentTUI{resolution: archiveIntent("", 0.9)}
+
+	output := execCmdAsync(cb, "ask archive something")
+	assert.Contains(t, output, "project archive <PROJECT_ID>")
+	assert.Contains(t, output, "destructive")
+}
+
+func TestCommandBar_AskFallsBackWhenServerDown(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+	app.Intent = &stubIntentTUI{err: fmt.Errorf("llm parse failed: %w", llm.ErrLLMUnavailable)}
+
+	output := execCmdAsync(cb, "ask log a session")
+	assert.Contains(t, output, "model server isn't responding")
+	assert.Contains(t, output, "session log")
+	assert.NotContains(t, output, "parse failed")
+}
+
+func TestCommandBar_LLMStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"models":[{"name":"llama3.2:latest"}]}`))
+	}))
+	defer srv.Close()
+
+	app := testApp(t)
+	app.LLMConfig = llm.DefaultConfig()
+	app.LLMConfig.Endpoint = srv.URL
+	cb := testCommandBar(t, app)
+
+	output := execCmdAsync(cb, "llm status")
+	assert.Contains(t, output, "reachable")
+	assert.Contains(t, output, "available")
+
+	app.LLMConfig.Endpoint = "http://127.0.0.1:1"
+	output = execCmdAsync(cb, "llm status")
+	assert.Contains(t, output, "not responding")
+
+	output = execCmd(cb, "llm")
+	assert.Contains(t, output, "Usage: llm status")
+}
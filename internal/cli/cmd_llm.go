@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/llm"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdLLM handles "llm status": ping the configured model server and report
+// whether it responds and has the configured model pulled.
+func (c *commandBar) cmdLLM(args []string) tea.Cmd {
+	if len(args) == 0 || strings.ToLower(args[0]) != "status" {
+		return outputCmd(formatter.StyleYellow.Render("Usage: llm status"))
+	}
+	cfg := c.state.App.LLMConfig
+	if cfg.Endpoint == "" {
+		cfg = llm.LoadConfig()
+	}
+	return tea.Batch(
+		loadingCmd("Checking model server..."),
+		asyncOutputCmd(func() string {
+			status := llm.CheckServer(context.Background(), cfg)
+			return formatter.FormatLLMStatus(status, cfg.Enabled)
+		}),
+	)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -22,7 +24,7 @@ func (c *commandBar) cmdProjects() tea.Cmd {
 	if len(projects) == 0 {
 		return outputCmd(formatter.Dim("No projects found."))
 	}
-	return outputCmd(formatter.FormatProjectList(projects))
+	return outputCmd(formatter.FormatProjectList(projects, c.state.Width))
 }
 
 func (c *commandBar) cmdUse(args []string) tea.Cmd {
@@ -73,34 +75,399 @@ func (c *commandBar) cmdInspect(args []string) tea.Cmd {
 	return pushView(newTaskListView(c.state))
 }
 
-func (c *commandBar) cmdStatus() tea.Cmd {
+func (c *commandBar) cmdStatus(args []string) tea.Cmd {
 	ctx := context.Background()
 	req := contract.NewStatusRequest()
 	if c.state.ActiveProjectID != "" {
 		req.ProjectScope = []string{c.state.ActiveProjectID}
 	}
+
+	args, risks, err := extractRiskFilter(args)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	_, flags := parseShellFlags(args)
+	if v, ok := flags["compare"]; ok {
+		if v == "true" {
+			return outputCmd(shellError(fmt.Errorf("usage: status [--compare <date>] [--risk TIER]... [--export md | --watch [--interval 30s]]")))
+		}
+		compareTo, err := parseLocalTimestamp(v)
+		if err != nil {
+			return outputCmd(shellError(fmt.Errorf("--compare: %w", err)))
+		}
+		req.CompareTo = &compareTo
+	}
+	export, exporting := flags["export"]
+	if exporting && export != "md" {
+		return outputCmd(shellError(fmt.Errorf("unsupported export format %q (status supports: md)", export)))
+	}
+	if flags["watch"] == "true" {
+		if exporting {
+			return outputCmd(shellError(fmt.Errorf("--watch can't be combined with --export")))
+		}
+		interval := defaultWatchInterval
+		if v, ok := flags["interval"]; ok {
+			interval, err = time.ParseDuration(v)
+			if err != nil || interval < time.Second {
+				return outputCmd(shellError(fmt.Errorf("--interval must be a duration of at least 1s, e.g. 30s or 2m (got %q)", v)))
+			}
+		}
+		return pushView(newStatusWatchView(c.state, req, risks, interval))
+	}
 	resp, err := c.state.App.Status.GetStatus(ctx, req)
 	if err != nil {
 		return outputCmd(shellError(err))
 	}
-	return outputCmd(formatter.FormatStatus(resp))
+	if len(risks) > 0 {
+		filterStatusByRisk(resp, risks)
+		if len(resp.Projects) == 0 && !exporting {
+			return outputCmd(formatter.Dim("No projects at that risk level.") + "\n" + formatter.FormatStatus(resp, c.state.Width))
+		}
+	}
+	if exporting {
+		return outputCmd(formatter.FormatStatusMarkdown(resp, statusNextActions(ctx, c.state.App, resp.Projects)))
+	}
+	return outputCmd(formatter.FormatStatus(resp, c.state.Width))
 }
 
-func (c *commandBar) cmdWhatNow(args []string) tea.Cmd {
-	minutes := 60
-	if len(args) > 0 {
-		if m, err := strconv.Atoi(args[0]); err == nil && m > 0 {
-			minutes = m
+// filterStatusByRisk keeps only the project rows whose risk level is in
+// risks; an empty set keeps everything. The summary counts and global mode
+// still describe every project in scope.
+func filterStatusByRisk(resp *contract.StatusResponse, risks map[domain.RiskLevel]bool) {
+	if len(risks) == 0 {
+		return
+	}
+	filtered := resp.Projects[:0]
+	for _, p := range resp.Projects {
+		if risks[p.RiskLevel] {
+			filtered = append(filtered, p)
+		}
+	}
+	resp.Projects = filtered
+}
+
+// statusNextActions asks what-now, without writing anything, for the top
+// slice of each project on its own, keyed by project ID. Projects with
+// nothing schedulable are left out.
+func statusNextActions(ctx context.Context, app *App, projects []contract.ProjectStatusView) map[string]contract.WorkSlice {
+	next := make(map[string]contract.WorkSlice, len(projects))
+	if app.WhatNow == nil {
+		return next
+	}
+	for _, p := range projects {
+		req := contract.NewWhatNowRequest(60)
+		req.ProjectScope = []string{p.ProjectID}
+		req.MaxSlices = 1
+		req.DryRun = true
+		if resp, err := app.WhatNow.Recommend(ctx, req); err == nil && len(resp.Recommendations) > 0 {
+			next[p.ProjectID] = resp.Recommendations[0]
 		}
 	}
+	return next
+}
+
+// extractRiskFilter pulls every `--risk TIER` pair out of args and returns
+// the remaining args with the set of tiers to keep. Repeated flags union.
+// Tiers are critical, at-risk and on-track (underscores also accepted).
+func extractRiskFilter(args []string) ([]string, map[domain.RiskLevel]bool, error) {
+	var rest []string
+	var risks map[domain.RiskLevel]bool
+	for i := 0; i < len(args); i++ {
+		if args[i] != "--risk" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, nil, fmt.Errorf("usage: status --risk critical|at-risk|on-track")
+		}
+		i++
+		level := domain.RiskLevel(strings.ReplaceAll(strings.ToLower(args[i]), "-", "_"))
+		switch level {
+		case domain.RiskCritical, domain.RiskAtRisk, domain.RiskOnTrack:
+		default:
+			return nil, nil, fmt.Errorf("unknown risk tier %q (use critical, at-risk or on-track)", args[i])
+		}
+		if risks == nil {
+			risks = make(map[domain.RiskLevel]bool)
+		}
+		risks[level] = true
+	}
+	return rest, risks, nil
+}
 
+func (c *commandBar) cmdWhatNow(args []string) tea.Cmd {
 	ctx := context.Background()
-	req := contract.NewWhatNowRequest(minutes)
+	opts, err := parseWhatNowArgs(args)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	snoozeNote, err := applyCriticalSnooze(ctx, c.state.App, opts, time.Now())
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	req, err := buildWhatNowRequest(ctx, c.state.App, opts, c.state.ActiveItemID)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
 	resp, err := c.state.App.WhatNow.Recommend(ctx, req)
+	if opts.oneline {
+		line, err := whatNowOneline(ctx, c.state.App, resp, err)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(line)
+	}
 	if err != nil {
 		return outputCmd(shellError(err))
 	}
-	return outputCmd(formatter.FormatWhatNow(resp))
+	out := formatter.FormatWhatNow(resp)
+	if opts.groupBy == "project" {
+		out = formatter.FormatWhatNowGrouped(resp, loadProjectDisplayIDs(ctx, c.state.App))
+	}
+	if snoozeNote != "" {
+		out = snoozeNote + "\n" + out
+	}
+	if opts.savePlan {
+		if c.state.App.Plans == nil {
+			return outputCmd(shellError(fmt.Errorf("day plans are not configured")))
+		}
+		plan, err := c.state.App.Plans.Save(ctx, time.Now(), resp.RequestedMin, resp.Recommendations)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		out += fmt.Sprintf("\n%s Saved as today's plan (%s). Track it with: plan status",
+			formatter.StyleGreen.Render("✔"), formatter.FormatMinutes(plan.PlannedMin()))
+	}
+	return outputCmd(out)
+}
+
+// whatNowArgs holds the parsed what-now arguments.
+type whatNowArgs struct {
+	minutes      int
+	minBlock     int
+	maxProjects  int
+	budgetMin    int  // --budget-per-project N
+	evenBudget   bool // --budget-per-project even
+	strategy     string
+	continueItem bool
+	oneline      bool
+	savePlan     bool
+	respectHours bool
+	force        bool
+	forceEarly   bool
+	avoidRefs    []string
+	context      string
+	groupBy      string
+	snoozeRef    string
+	unsnoozeRef  string
+	// until sizes the request up to a time of day, or with --snooze-critical
+	// sets when the snooze ends.
+	until string
+}
+
+// parseWhatNowArgs parses `what-now [min] [flags]`. --continue and --oneline
+// are bare switches; they must not swallow the minutes argument. --avoid
+// takes a project ref and may be repeated.
+func parseWhatNowArgs(args []string) (whatNowArgs, error) {
+	opts := whatNowArgs{minutes: 60}
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--continue":
+			opts.continueItem = true
+		case "--oneline":
+			opts.oneline = true
+		case "--save-plan":
+			opts.savePlan = true
+		case "--respect-hours":
+			opts.respectHours = true
+		case "--force":
+			opts.force = true
+		case "--force-early":
+			opts.forceEarly = true
+		case "--avoid":
+			if i+1 >= len(args) {
+				return opts, fmt.Errorf("usage: what-now [min] [--avoid <project>]... [--min-block N]")
+			}
+			i++
+			opts.avoidRefs = append(opts.avoidRefs, args[i])
+		case "--min-block":
+			var ok bool
+			if i+1 < len(args) {
+				i++
+				opts.minBlock, ok = parseDurationArg(args[i])
+			}
+			if !ok {
+				return opts, fmt.Errorf("usage: what-now [min] --min-block N (minutes or e.g. 30m)")
+			}
+		case "--max-projects":
+			var err error
+			if i+1 < len(args) {
+				i++
+				opts.maxProjects, err = strconv.Atoi(args[i])
+			}
+			if err != nil || opts.maxProjects < 1 {
+				return opts, fmt.Errorf("usage: what-now [min] --max-projects N (1 or more)")
+			}
+		case "--budget-per-project":
+			ok := false
+			if i+1 < len(args) {
+				i++
+				opts.evenBudget = strings.EqualFold(args[i], "even")
+				opts.budgetMin, ok = parseDurationArg(args[i])
+				ok = opts.evenBudget || (ok && opts.budgetMin > 0)
+			}
+			if !ok {
+				return opts, fmt.Errorf("usage: what-now [min] --budget-per-project even|N (minutes or e.g. 45m)")
+			}
+		case "--context":
+			if i+1 >= len(args) {
+				return opts, fmt.Errorf("usage: what-now [min] --context <tag>")
+			}
+			i++
+			opts.context = args[i]
+		case "--snooze-critical", "--unsnooze-critical", "--until":
+			if i+1 >= len(args) {
+				return opts, fmt.Errorf("usage: what-now --until HH:MM | what-now [min] --snooze-critical <project> [--until TIME] | --unsnooze-critical <project>")
+			}
+			i++
+			switch args[i-1] {
+			case "--snooze-critical":
+				opts.snoozeRef = args[i]
+			case "--unsnooze-critical":
+				opts.unsnoozeRef = args[i]
+			default:
+				opts.until = args[i]
+			}
+		case "--group-by":
+			if i+1 >= len(args) || strings.ToLower(args[i+1]) != "project" {
+				return opts, fmt.Errorf("usage: what-now [min] --group-by project")
+			}
+			i++
+			opts.groupBy = "project"
+		case "--strategy":
+			if i+1 >= len(args) {
+				return opts, fmt.Errorf("usage: what-now [min] --strategy priority|warmup")
+			}
+			i++
+			opts.strategy = strings.ToLower(args[i])
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	if len(positional) > 0 {
+		if m, err := strconv.Atoi(positional[0]); err == nil && m > 0 {
+			if opts.until != "" && opts.snoozeRef == "" {
+				return opts, fmt.Errorf("give minutes or --until, not both")
+			}
+			opts.minutes = m
+		}
+	}
+	return opts, nil
+}
+
+// applyCriticalSnooze saves --snooze-critical or --unsnooze-critical on the
+// project before what-now runs, returning a line that confirms it. A snooze
+// lasts until the next local midnight unless --until gives another time;
+// either way it expires on its own. Without --snooze-critical, --until sizes
+// the request instead (buildWhatNowRequest).
+func applyCriticalSnooze(ctx context.Context, app *App, opts whatNowArgs, now time.Time) (string, error) {
+	ref := opts.snoozeRef
+	switch {
+	case opts.snoozeRef != "" && opts.unsnoozeRef != "":
+		return "", fmt.Errorf("use --snooze-critical or --unsnooze-critical, not both")
+	case opts.unsnoozeRef != "":
+		ref = opts.unsnoozeRef
+	case ref == "":
+		return "", nil
+	}
+
+	projectID, err := resolveProjectID(ctx, app, ref)
+	if err != nil {
+		return "", err
+	}
+	p, err := app.Projects.GetByID(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.unsnoozeRef != "" {
+		p.CriticalSnoozedUntil = nil
+		if err := app.Projects.Update(ctx, p); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Critical snooze lifted for %s", formatter.StyleGreen.Render("✔"), formatter.Bold(p.Name)), nil
+	}
+
+	y, m, d := now.Local().Date()
+	until := time.Date(y, m, d+1, 0, 0, 0, 0, time.Local)
+	if opts.until != "" {
+		if until, err = parseLocalTimestamp(opts.until); err != nil {
+			return "", fmt.Errorf("--until: %w", err)
+		}
+		if !until.After(now) {
+			return "", fmt.Errorf("--until must be in the future")
+		}
+	}
+	utc := until.UTC()
+	p.CriticalSnoozedUntil = &utc
+	if err := app.Projects.Update(ctx, p); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s Critical mode snoozed for %s until %s %s", formatter.StyleGreen.Render("✔"),
+		formatter.Bold(p.Name), until.Local().Format("2006-01-02 15:04"),
+		formatter.Dim("(undo with what-now --unsnooze-critical "+ref+")")), nil
+}
+
+// buildWhatNowRequest turns parsed arguments into a request, resolving
+// --avoid project refs and --until. activeItemID is the item --continue
+// keeps first.
+func buildWhatNowRequest(ctx context.Context, app *App, opts whatNowArgs, activeItemID string) (contract.WhatNowRequest, error) {
+	req := contract.NewWhatNowRequest(opts.minutes)
+	if opts.until != "" && opts.snoozeRef == "" {
+		until, err := parseUntilTime(opts.until, time.Now())
+		if err != nil {
+			return req, err
+		}
+		req.Until = &until
+	}
+	req.MinBlockMin = opts.minBlock
+	req.MaxProjects = opts.maxProjects
+	req.ProjectBudgetMin = opts.budgetMin
+	req.EvenProjectBudget = opts.evenBudget
+	req.Strategy = opts.strategy
+	req.Context = opts.context
+	req.RespectActiveHours = opts.respectHours
+	req.IgnoreActiveHours = opts.force
+	req.ForceEarly = opts.forceEarly
+	if opts.continueItem {
+		req.Continue = true
+		req.ContinueItemID = activeItemID
+	}
+	for _, ref := range opts.avoidRefs {
+		projectID, err := resolveProjectID(ctx, app, ref)
+		if err != nil {
+			return req, fmt.Errorf("--avoid: %w", err)
+		}
+		req.AvoidProjects = append(req.AvoidProjects, projectID)
+	}
+	return req, nil
+}
+
+// parseUntilTime parses what-now --until: a time of day (HH:MM) means that
+// time today, and a date with a time is taken as given. Whether it lies
+// ahead of now is checked by the what-now service.
+func parseUntilTime(v string, now time.Time) (time.Time, error) {
+	if clock, ok := parseClock(v); ok {
+		y, m, d := now.Local().Date()
+		return time.Date(y, m, d, clock/60, clock%60, 0, 0, time.Local), nil
+	}
+	t, err := parseLocalTimestamp(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --until %q (use HH:MM, e.g. 15:00, or \"YYYY-MM-DD HH:MM\")", v)
+	}
+	return t, nil
 }
 
 func (c *commandBar) cmdContext(args []string) tea.Cmd {
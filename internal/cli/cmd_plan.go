@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const planUsage = "Usage: plan [show | status] [--date YYYY-MM-DD]"
+
+// cmdPlan handles "plan [show | status] [--date D]": the day plan saved by
+// what-now --save-plan, on its own or against the time logged that day.
+func (c *commandBar) cmdPlan(args []string) tea.Cmd {
+	if c.state.App.Plans == nil {
+		return outputCmd(shellError(fmt.Errorf("day plans are not configured")))
+	}
+	ctx := context.Background()
+	positional, flags := parseShellFlags(args)
+	sub := "show"
+	if len(positional) > 0 {
+		sub = strings.ToLower(positional[0])
+	}
+	if (sub != "show" && sub != "status") || len(positional) > 1 {
+		return outputCmd(formatter.StyleYellow.Render(planUsage))
+	}
+
+	now := time.Now()
+	day := now
+	if v, ok := flags["date"]; ok {
+		t, err := parseLocalTimestamp(v)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		day = t
+	}
+
+	if sub == "status" {
+		res, err := c.state.App.Plans.Adherence(ctx, day, now)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(formatter.FormatDayPlanStatus(res))
+	}
+	plan, err := c.state.App.Plans.Get(ctx, day)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(formatter.FormatDayPlan(plan))
+}
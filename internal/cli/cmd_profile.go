@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const profileUsage = "Usage: profile [show] | profile export [--out FILE] | profile import <file> | profile set key=value... (keys: active-hours, respect-active-hours, auto-replan, autocorrect, validate-session-time, availability, deadline-buffer, focus-block, break, baseline-daily, max-daily, pace-window, spacing-lookback, weight-importance)"
+
+// profileSetters apply one "profile set" key to the profile.
+var profileSetters = map[string]func(p *domain.UserProfile, v string) error{
+	"active-hours": func(p *domain.UserProfile, v string) error {
+		if v == "" || strings.EqualFold(v, "off") {
+			p.ActiveHoursStart, p.ActiveHoursEnd = 0, 0
+			return nil
+		}
+		from, to, ok := strings.Cut(v, "-")
+		start, okStart := parseClock(from)
+		end, okEnd := parseClock(to)
+		if !ok || !okStart || !okEnd {
+			return fmt.Errorf("active-hours: expected START-END times of day (e.g. 07:00-22:30 or 7-23), or off, got %q", v)
+		}
+		p.ActiveHoursStart, p.ActiveHoursEnd = start, end
+		return nil
+	},
+	"respect-active-hours": func(p *domain.UserProfile, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("respect-active-hours: expected true or false, got %q", v)
+		}
+		p.RespectActiveHours = b
+		return nil
+	},
+	"auto-replan": func(p *domain.UserProfile, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("auto-replan: expected true or false, got %q", v)
+		}
+		p.AutoReplan = b
+		return nil
+	},
+	"autocorrect": func(p *domain.UserProfile, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("autocorrect: expected true or false, got %q", v)
+		}
+		p.Autocorrect = b
+		return nil
+	},
+	"validate-session-time": func(p *domain.UserProfile, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("validate-session-time: expected true or false, got %q", v)
+		}
+		p.ValidateSessionTime = b
+		return nil
+	},
+	"availability": func(p *domain.UserProfile, v string) error {
+		if v == "" {
+			p.WeekdayMin = nil
+			return nil
+		}
+		days := strings.Split(v, ",")
+		if len(days) != 7 {
+			return fmt.Errorf("availability: expected 7 comma-separated durations, Monday first (e.g. 2h,2h,2h,2h,2h,1h,0), got %q", v)
+		}
+		mins := make([]int, 7)
+		for i, d := range days {
+			if d = strings.TrimSpace(d); d == "0" {
+				continue
+			}
+			m, ok := parseDurationArg(d)
+			if !ok {
+				return fmt.Errorf("availability: expected minutes or a duration for each day (e.g. 90 or 1h30m), got %q", d)
+			}
+			mins[i] = m
+		}
+		p.WeekdayMin = mins
+		return nil
+	},
+	"deadline-buffer": func(p *domain.UserProfile, v string) error {
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(v, "%"), 64)
+		if err != nil {
+			return fmt.Errorf("deadline-buffer: expected a percentage (e.g. 25 or 25%%), got %q", v)
+		}
+		p.BufferPct = pct / 100
+		return nil
+	},
+	"focus-block": func(p *domain.UserProfile, v string) error {
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return fmt.Errorf("focus-block: expected minutes (e.g. 25 or 50m), got %q", v)
+		}
+		p.FocusBlockMin = m
+		return nil
+	},
+	"break": func(p *domain.UserProfile, v string) error {
+		if v == "0" {
+			p.BreakMin = 0
+			return nil
+		}
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return fmt.Errorf("break: expected minutes (e.g. 5 or 10m), got %q", v)
+		}
+		p.BreakMin = m
+		return nil
+	},
+	"baseline-daily": func(p *domain.UserProfile, v string) error {
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return fmt.Errorf("baseline-daily: expected minutes (e.g. 45 or 1h), got %q", v)
+		}
+		p.BaselineDailyMin = m
+		return nil
+	},
+	"max-daily": func(p *domain.UserProfile, v string) error {
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return fmt.Errorf("max-daily: expected minutes (e.g. 360 or 6h), got %q", v)
+		}
+		p.MaxDailyMin = m
+		return nil
+	},
+	"pace-window": func(p *domain.UserProfile, v string) error {
+		d, err := strconv.Atoi(strings.TrimSuffix(v, "d"))
+		if err != nil {
+			return fmt.Errorf("pace-window: expected days (e.g. 7 or 14d), got %q", v)
+		}
+		p.PaceWindowDays = d
+		return nil
+	},
+	"spacing-lookback": func(p *domain.UserProfile, v string) error {
+		d, err := strconv.Atoi(strings.TrimSuffix(v, "d"))
+		if err != nil {
+			return fmt.Errorf("spacing-lookback: expected days (e.g. 7 or 14d), got %q", v)
+		}
+		p.SpacingLookbackDays = d
+		return nil
+	},
+	"weight-importance": func(p *domain.UserProfile, v string) error {
+		w, err := strconv.ParseFloat(v, 64)
+		if err != nil || w < 0 {
+			return fmt.Errorf("weight-importance: expected a non-negative number (e.g. 1 or 2.5), got %q", v)
+		}
+		p.WeightImportance = w
+		return nil
+	},
+}
+
+// cmdProfile handles "profile [show]", "profile set key=value..." and
+// "profile export|import" (cmd_profile_transfer.go).
+func (c *commandBar) cmdProfile(args []string) tea.Cmd {
+	if c.state.App.Profile == nil {
+		return outputCmd(shellError(fmt.Errorf("profile is not configured")))
+	}
+	ctx := context.Background()
+
+	if len(args) == 0 || strings.ToLower(args[0]) == "show" {
+		p, err := c.state.App.Profile.Get(ctx)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(formatter.FormatProfile(p))
+	}
+	switch strings.ToLower(args[0]) {
+	case "export":
+		_, flags := parseShellFlags(args[1:])
+		out, err := execProfileExport(ctx, c.state.App, flags["out"])
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(out)
+	case "import":
+		pos, _ := parseShellFlags(args[1:])
+		if len(pos) != 1 {
+			return outputCmd(formatter.StyleYellow.Render(profileUsage))
+		}
+		out, err := execProfileImport(ctx, c.state.App, pos[0])
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(out)
+	}
+	if strings.ToLower(args[0]) != "set" || len(args) < 2 {
+		return outputCmd(formatter.StyleYellow.Render(profileUsage))
+	}
+
+	p, err := c.state.App.Profile.Get(ctx)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	for _, arg := range args[1:] {
+		key, value, ok := strings.Cut(arg, "=")
+		set, known := profileSetters[strings.ToLower(key)]
+		if !ok || !known {
+			return outputCmd(formatter.StyleYellow.Render(profileUsage))
+		}
+		if err := set(p, value); err != nil {
+			return outputCmd(shellError(err))
+		}
+	}
+	if err := c.state.App.Profile.Update(ctx, p); err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(fmt.Sprintf("%s Profile updated\n%s", formatter.StyleGreen.Render("✔"), formatter.FormatProfile(p)))
+}
+
+// parseClock reads a time of day as HH:MM or a bare hour ("7", "23") and
+// returns minutes after midnight. "24" and "24:00" mean midnight.
+func parseClock(v string) (int, bool) {
+	v = strings.TrimSpace(v)
+	h, m, hasMin := strings.Cut(v, ":")
+	hour, err := strconv.Atoi(h)
+	if err != nil {
+		return 0, false
+	}
+	minute := 0
+	if hasMin {
+		if len(m) != 2 {
+			return 0, false
+		}
+		if minute, err = strconv.Atoi(m); err != nil {
+			return 0, false
+		}
+	}
+	if hour == 24 && minute == 0 {
+		return 0, true
+	}
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
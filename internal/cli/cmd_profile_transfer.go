@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// profileFileVersion is the format version written by profile export.
+const profileFileVersion = 1
+
+// profileFile is the JSON written by profile export and read by profile
+// import. Settings are keyed by config key and hold the values config get
+// prints, so a file can be edited by hand and a partial one only changes
+// the keys it lists.
+type profileFile struct {
+	Version  int               `json:"version"`
+	Settings map[string]string `json:"settings"`
+	Presets  []profilePreset   `json:"presets,omitempty"`
+}
+
+type profilePreset struct {
+	Name              string `json:"name"`
+	Type              string `json:"type,omitempty"`
+	PlannedMin        int    `json:"planned_min,omitempty"`
+	MinSessionMin     int    `json:"min_session_min,omitempty"`
+	MaxSessionMin     int    `json:"max_session_min,omitempty"`
+	DefaultSessionMin int    `json:"default_session_min,omitempty"`
+}
+
+func (p profilePreset) toDomain() *domain.WorkPreset {
+	return &domain.WorkPreset{
+		Name:              strings.ToLower(strings.TrimSpace(p.Name)),
+		Type:              p.Type,
+		PlannedMin:        p.PlannedMin,
+		MinSessionMin:     p.MinSessionMin,
+		MaxSessionMin:     p.MaxSessionMin,
+		DefaultSessionMin: p.DefaultSessionMin,
+	}
+}
+
+// execProfileExport writes the profile settings and work presets as JSON to
+// path, or returns the JSON when path is empty.
+func execProfileExport(ctx context.Context, a *App, path string) (string, error) {
+	p, err := a.Profile.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	file := profileFile{Version: profileFileVersion, Settings: map[string]string{}}
+	for _, s := range configSettings() {
+		file.Settings[s.key] = s.get(p)
+	}
+	if a.Presets != nil {
+		presets, err := a.Presets.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, wp := range presets {
+			file.Presets = append(file.Presets, profilePreset{
+				Name:              wp.Name,
+				Type:              wp.Type,
+				PlannedMin:        wp.PlannedMin,
+				MinSessionMin:     wp.MinSessionMin,
+				MaxSessionMin:     wp.MaxSessionMin,
+				DefaultSessionMin: wp.DefaultSessionMin,
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding profile: %w", err)
+	}
+	if path == "" {
+		return string(data), nil
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return "", fmt.Errorf("writing profile: %w", err)
+	}
+	return fmt.Sprintf("%s Exported profile (%d settings, %d presets) to %s",
+		formatter.StyleGreen.Render("✔"), len(file.Settings), len(file.Presets), formatter.Bold(path)), nil
+}
+
+// execProfileImport restores settings and work presets from a profile
+// export. Everything is checked before anything is written: unknown keys,
+// values the setters reject, ranges ProfileService.Update enforces, and
+// invalid presets. Presets in the file are added or replaced; others are
+// kept. The result lists each setting and preset that changed.
+func execProfileImport(ctx context.Context, a *App, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading profile: %w", err)
+	}
+	var file profileFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if file.Version != profileFileVersion {
+		return "", fmt.Errorf("unsupported profile file version %d (expected %d)", file.Version, profileFileVersion)
+	}
+
+	settings := configSettings()
+	known := make(map[string]bool, len(settings))
+	for _, s := range settings {
+		known[s.key] = true
+	}
+	for key := range file.Settings {
+		if !known[key] {
+			return "", fmt.Errorf("unknown profile setting %q (see: config list)", key)
+		}
+	}
+
+	presets := make([]*domain.WorkPreset, len(file.Presets))
+	for i, fp := range file.Presets {
+		wp := fp.toDomain()
+		if err := wp.Validate(); err != nil {
+			return "", fmt.Errorf("preset %q: %w", fp.Name, err)
+		}
+		presets[i] = wp
+	}
+	if len(presets) > 0 && a.Presets == nil {
+		return "", fmt.Errorf("work presets are not configured")
+	}
+
+	p, err := a.Profile.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	var changes []string
+	for _, s := range settings {
+		v, ok := file.Settings[s.key]
+		if !ok {
+			continue
+		}
+		before := s.get(p)
+		if err := s.set(p, v); err != nil {
+			return "", err
+		}
+		if after := s.get(p); after != before {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", s.key, before, after))
+		}
+	}
+	// Validate the whole profile before saving presets, so a bad range
+	// leaves both untouched.
+	if err := a.Profile.Update(ctx, p); err != nil {
+		return "", err
+	}
+
+	if len(presets) > 0 {
+		existing, err := a.Presets.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		byName := make(map[string]*domain.WorkPreset, len(existing))
+		for _, wp := range existing {
+			byName[wp.Name] = wp
+		}
+		for _, wp := range presets {
+			old, had := byName[wp.Name]
+			if had && reflect.DeepEqual(old, wp) {
+				continue
+			}
+			if err := a.Presets.Save(ctx, wp); err != nil {
+				return "", err
+			}
+			verb := "added"
+			if had {
+				verb = "updated"
+			}
+			changes = append(changes, fmt.Sprintf("preset %s %s  %s", wp.Name, verb, formatter.Dim(formatter.WorkPresetSummary(wp))))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s Imported profile from %s", formatter.StyleGreen.Render("✔"), formatter.Bold(path))
+	if len(changes) == 0 {
+		b.WriteString("\n  " + formatter.Dim("Nothing changed"))
+	}
+	for _, c := range changes {
+		b.WriteString("\n  " + c)
+	}
+	return b.String(), nil
+}
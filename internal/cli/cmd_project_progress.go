@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// projectProgress handles "project progress [--chart]": time elapsed against
+// work done for every active project, soonest deadline first.
+func (c *commandBar) projectProgress(ctx context.Context, pos []string, flags map[string]string) (string, error) {
+	if len(pos) > 0 {
+		return "", fmt.Errorf("usage: project progress [--chart]")
+	}
+	req := contract.NewStatusRequest()
+	req.Recalc = false
+	resp, err := c.state.App.Status.GetStatus(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	views := resp.Projects
+	sortByDeadline(views)
+	return formatter.FormatPortfolioProgress(views, flags["chart"] == "true", c.state.Width), nil
+}
+
+// sortByDeadline orders status views by due date, projects without one
+// last, ties broken by name.
+func sortByDeadline(views []contract.ProjectStatusView) {
+	sort.SliceStable(views, func(i, j int) bool {
+		a, b := views[i], views[j]
+		if (a.DueDate == nil) != (b.DueDate == nil) {
+			return a.DueDate != nil
+		}
+		if a.DueDate != nil {
+			ta, errA := domain.ParseDeadline(*a.DueDate)
+			tb, errB := domain.ParseDeadline(*b.DueDate)
+			if errA == nil && errB == nil && !ta.Equal(tb) {
+				return ta.Before(tb)
+			}
+		}
+		return a.ProjectName < b.ProjectName
+	})
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// sessionRecord is one session in session list --format json. Field names
+// are part of the output format; add fields rather than renaming them.
+type sessionRecord struct {
+	ID             string   `json:"id"`
+	WorkItemID     string   `json:"work_item_id"`
+	WorkItemTitle  string   `json:"work_item_title"`
+	ProjectID      string   `json:"project_id"`
+	ProjectShortID string   `json:"project_short_id"`
+	ProjectName    string   `json:"project_name"`
+	StartedAt      string   `json:"started_at"`
+	Minutes        int      `json:"minutes"`
+	UnitsDoneDelta int      `json:"units_done_delta"`
+	Tags           []string `json:"tags"`
+	Note           string   `json:"note"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+// sessionListJSON renders sessions as a JSON array for session list --format
+// json, resolving each work item's title and project (once per item).
+// Timestamps are RFC3339 in UTC; an empty list is "[]".
+func sessionListJSON(ctx context.Context, app *App, sessions []*domain.WorkSessionLog) (string, error) {
+	type owner struct {
+		title   string
+		project *domain.Project
+	}
+	owners := make(map[string]owner)
+	projects := make(map[string]*domain.Project)
+
+	records := make([]sessionRecord, 0, len(sessions))
+	for _, s := range sessions {
+		o, ok := owners[s.WorkItemID]
+		if !ok {
+			wi, err := app.WorkItems.GetByID(ctx, s.WorkItemID)
+			if err != nil {
+				return "", fmt.Errorf("loading work item for session %s: %w", s.ID, err)
+			}
+			node, err := app.Nodes.GetByID(ctx, wi.NodeID)
+			if err != nil {
+				return "", fmt.Errorf("loading node for session %s: %w", s.ID, err)
+			}
+			p, ok := projects[node.ProjectID]
+			if !ok {
+				p, err = app.Projects.GetByID(ctx, node.ProjectID)
+				if err != nil {
+					return "", fmt.Errorf("loading project for session %s: %w", s.ID, err)
+				}
+				projects[node.ProjectID] = p
+			}
+			o = owner{title: wi.Title, project: p}
+			owners[s.WorkItemID] = o
+		}
+
+		tags := s.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		records = append(records, sessionRecord{
+			ID:             s.ID,
+			WorkItemID:     s.WorkItemID,
+			WorkItemTitle:  o.title,
+			ProjectID:      o.project.ID,
+			ProjectShortID: o.project.ShortID,
+			ProjectName:    o.project.Name,
+			StartedAt:      s.StartedAt.UTC().Format(time.RFC3339),
+			Minutes:        s.Minutes,
+			UnitsDoneDelta: s.UnitsDoneDelta,
+			Tags:           tags,
+			Note:           s.Note,
+			CreatedAt:      s.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding sessions: %w", err)
+	}
+	return string(data), nil
+}
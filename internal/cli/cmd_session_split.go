@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+const sessionSplitUsage = `usage: session log --split "ITEM=MIN,ITEM=MIN" [--minutes TOTAL] [--note TEXT] [--tag a,b] [--at "YYYY-MM-DD HH:MM" | --yesterday | --days-ago N]`
+
+// splitPart is one "ITEM=MIN" entry of a --split spec.
+type splitPart struct {
+	ref     string
+	minutes int
+}
+
+// parseSplitSpec reads a --split spec such as "3=60,4=30" or, with a total,
+// "3=60,4". Parts without minutes share what the total leaves over evenly,
+// earlier parts taking any odd minutes; with every part explicit, the total
+// (when given) must match their sum.
+func parseSplitSpec(spec string, total int) ([]splitPart, error) {
+	var parts []splitPart
+	explicit, implicit := 0, 0
+	for _, entry := range strings.Split(spec, ",") {
+		ref, dur, hasDur := strings.Cut(strings.TrimSpace(entry), "=")
+		ref = strings.TrimPrefix(strings.TrimSpace(ref), "#")
+		if ref == "" {
+			return nil, fmt.Errorf("--split: empty work item in %q", spec)
+		}
+		p := splitPart{ref: ref}
+		if hasDur {
+			m, ok := parseDurationArg(strings.TrimSpace(dur))
+			if !ok {
+				return nil, fmt.Errorf("--split: expected minutes or a duration for %s (e.g. 60 or 1h), got %q", ref, dur)
+			}
+			p.minutes = m
+			explicit += m
+		} else {
+			implicit++
+		}
+		parts = append(parts, p)
+	}
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("--split needs at least two work items, got %q", spec)
+	}
+
+	switch {
+	case implicit > 0 && total <= 0:
+		return nil, fmt.Errorf("--split: give every item its minutes, or pass --minutes with the total to share out")
+	case implicit > 0:
+		left := total - explicit
+		if left < implicit {
+			return nil, fmt.Errorf("--split: %s explicit leaves %s of the %s total for %d other item(s)",
+				formatter.FormatMinutes(explicit), formatter.FormatMinutes(max(left, 0)), formatter.FormatMinutes(total), implicit)
+		}
+		share, odd := left/implicit, left%implicit
+		for i := range parts {
+			if parts[i].minutes > 0 {
+				continue
+			}
+			parts[i].minutes = share
+			if odd > 0 {
+				parts[i].minutes++
+				odd--
+			}
+		}
+	case total > 0 && explicit != total:
+		return nil, fmt.Errorf("--split: parts add up to %s, not the %s given by --minutes",
+			formatter.FormatMinutes(explicit), formatter.FormatMinutes(total))
+	}
+	return parts, nil
+}
+
+// sessionLogSplit handles `session log --split`: one sitting logged as a
+// session per work item, in one transaction and with one start time.
+func (c *commandBar) sessionLogSplit(ctx context.Context, flags map[string]string) (string, error) {
+	app := c.state.App
+	if strings.TrimSpace(flags["split"]) == "" {
+		return "", fmt.Errorf("%s", sessionSplitUsage)
+	}
+	total := 0
+	if v, ok := flags["minutes"]; ok {
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return "", fmt.Errorf("invalid minutes: %s", v)
+		}
+		total = m
+	}
+	parts, err := parseSplitSpec(flags["split"], total)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	startedAt, err := sessionStartFlag(flags, now)
+	if err != nil {
+		return "", err
+	}
+
+	sessions := make([]*domain.WorkSessionLog, len(parts))
+	var in LogSessionInput
+	for i, p := range parts {
+		wiID, err := resolveWorkItemID(ctx, app, p.ref, c.state.ActiveProjectID)
+		if err != nil {
+			return "", err
+		}
+		in = LogSessionInput{
+			ItemID:    wiID,
+			Minutes:   p.minutes,
+			Note:      flags["note"],
+			Tags:      domain.ParseTags(flags["tag"]),
+			StartedAt: startedAt,
+			DayOnly:   sessionDayOnly(flags),
+		}
+		sessions[i] = newWorkSessionLog(in, now)
+	}
+	if err := app.Sessions.LogSplit(ctx, sessions); err != nil {
+		return "", err
+	}
+
+	sum := 0
+	lines := make([]string, len(sessions))
+	for i, s := range sessions {
+		sum += s.Minutes
+		title, seq := resolveItemTitle(ctx, app, s.WorkItemID)
+		lines[i] = fmt.Sprintf("  #%d %s  %s", seq, title, formatter.Bold(formatter.FormatMinutes(s.Minutes)))
+	}
+	return fmt.Sprintf("%s Logged %s split across %d items %s\n%s",
+		formatter.StyleGreen.Render("✔"), formatter.Bold(formatter.FormatMinutes(sum)), len(sessions),
+		formatter.Dim("(from "+sessions[0].StartedAt.Local().Format("15:04")+")"),
+		strings.Join(lines, "\n")) + settleTimer(c.state, in, now), nil
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSplitSpec(t *testing.T) {
+	parts, err := parseSplitSpec("3=60, #4=30m", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []splitPart{{"3", 60}, {"4", 30}}, parts)
+
+	parts, err = parseSplitSpec("3=60,4=30", 90)
+	require.NoError(t, err)
+	assert.Len(t, parts, 2, "a matching total is accepted")
+
+	parts, err = parseSplitSpec("3=1h,4,5", 91)
+	require.NoError(t, err)
+	assert.Equal(t, []splitPart{{"3", 60}, {"4", 16}, {"5", 15}}, parts, "the rest is shared, odd minutes first")
+
+	_, err = parseSplitSpec("3=60,4=20", 90)
+	assert.ErrorContains(t, err, "parts add up to 1h 20m")
+	_, err = parseSplitSpec("3=60,4", 0)
+	assert.ErrorContains(t, err, "pass --minutes")
+	_, err = parseSplitSpec("3=90,4", 90)
+	assert.ErrorContains(t, err, "for 1 other item")
+	_, err = parseSplitSpec("3=60", 0)
+	assert.ErrorContains(t, err, "at least two")
+	_, err = parseSplitSpec("3=lots,4=30", 0)
+	assert.ErrorContains(t, err, "expected minutes or a duration for 3")
+}
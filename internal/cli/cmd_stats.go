@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdStats handles "stats <subcommand>". Currently only "accuracy".
+func (c *commandBar) cmdStats(args []string) tea.Cmd {
+	if len(args) == 0 {
+		return outputCmd(formatter.StyleYellow.Render("Usage: stats accuracy"))
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "accuracy":
+		out, err := execStatsAccuracy(context.Background(), c.state.App, c.state.Width)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		return outputCmd(out)
+	default:
+		return outputCmd(formatter.StyleYellow.Render("Usage: stats accuracy"))
+	}
+}
+
+func execStatsAccuracy(ctx context.Context, a *App, termWidth int) (string, error) {
+	if a.Stats == nil {
+		return "", fmt.Errorf("stats use case is not configured")
+	}
+	resp, err := a.Stats.EstimateAccuracy(ctx)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatEstimateAccuracy(resp, termWidth), nil
+}
@@ -28,20 +28,54 @@ func testApp(t *testing.T) *App {
 	depRepo := repository.NewSQLiteDependencyRepo(db)
 	sessRepo := repository.NewSQLiteSessionRepo(db)
 	profRepo := repository.NewSQLiteUserProfileRepo(db)
+	snapRepo := repository.NewSQLiteRiskSnapshotRepo(db)
+	auditRepo := repository.NewSQLiteAuditRepo(db)
+	audit := service.NewAuditUseCaseObserver(auditRepo, nil)
+
+	replanSvc := service.NewReplanService(projRepo, wiRepo, sessRepo, profRepo, uow)
+	sessionSvc := service.NewAutoReplanSessionService(
+		service.NewSessionService(sessRepo, uow, audit), replanSvc, profRepo, wiRepo, nodeRepo)
 
 	return &App{
-		Projects:  service.NewProjectService(projRepo),
+		Projects:  service.NewProjectService(projRepo, uow),
 		Nodes:     service.NewNodeService(nodeRepo, uow),
-		WorkItems: service.NewWorkItemService(wiRepo, nodeRepo, uow),
-		Sessions:  service.NewSessionService(sessRepo, uow),
+		WorkItems: service.NewWorkItemService(wiRepo, nodeRepo, uow, audit),
+		Sessions:  sessionSvc,
 		WhatNow:   service.NewWhatNowService(wiRepo, sessRepo, depRepo, profRepo),
-		Status:    service.NewStatusService(projRepo, wiRepo, sessRepo, profRepo),
-		Replan:    service.NewReplanService(projRepo, wiRepo, sessRepo, profRepo, uow),
+		Status:    service.NewStatusService(projRepo, nodeRepo, wiRepo, sessRepo, profRepo, snapRepo),
+		Replan:    replanSvc,
+		Export:    service.NewExportService(uow),
+		Stats:     service.NewStatsService(wiRepo),
+		Focus:     service.NewFocusService(repository.NewSQLiteFocusRepo(db), wiRepo),
+		Audit:     service.NewAuditService(auditRepo),
+		Presets:   service.NewWorkPresetService(repository.NewSQLiteWorkPresetRepo(db)),
+		Plans:     service.NewDayPlanService(repository.NewSQLiteDayPlanRepo(db), sessRepo, wiRepo, uow),
+		Weekly:    service.NewWeeklyPlanService(wiRepo, sessRepo, depRepo, profRepo),
+		Review:    testReviewService(wiRepo, sessRepo, depRepo, profRepo, projRepo, snapRepo),
+		Profile:   service.NewProfileService(profRepo),
+		Archive:   service.NewArchiveService(repository.NewSQLiteArchiveRepo(db), uow),
 		// Templates and Import left nil — not tested here.
 		// Intelligence services left nil — LLM disabled.
 	}
 }
 
+// testReviewService wires a weekly review over its own status and weekly
+// plan services.
+func testReviewService(
+	wiRepo repository.WorkItemRepo,
+	sessRepo repository.SessionRepo,
+	depRepo repository.DependencyRepo,
+	profRepo repository.UserProfileRepo,
+	projRepo repository.ProjectRepo,
+	snapRepo repository.RiskSnapshotRepo,
+) service.WeeklyReviewService {
+	return service.NewWeeklyReviewService(
+		service.NewStatusService(projRepo, nil, wiRepo, sessRepo, profRepo, snapRepo),
+		service.NewWeeklyPlanService(wiRepo, sessRepo, depRepo, profRepo),
+		wiRepo, sessRepo,
+	)
+}
+
 // seedOpts configures seedProjectCore.
 type seedOpts struct {
 	shortID    string
@@ -102,22 +136,34 @@ func testAppFull(t *testing.T) *App {
 	depRepo := repository.NewSQLiteDependencyRepo(db)
 	sessRepo := repository.NewSQLiteSessionRepo(db)
 	profRepo := repository.NewSQLiteUserProfileRepo(db)
+	snapRepo := repository.NewSQLiteRiskSnapshotRepo(db)
+	auditRepo := repository.NewSQLiteAuditRepo(db)
+	audit := service.NewAuditUseCaseObserver(auditRepo, nil)
 
 	templateDir := findTemplatesDir(t)
-	sessionSvc := service.NewSessionService(sessRepo, uow)
+	sessionSvc := service.NewSessionService(sessRepo, uow, audit)
 	templateSvc := service.NewTemplateService(templateDir, uow)
 	importSvc := service.NewImportService(uow)
 
 	return &App{
-		Projects:      service.NewProjectService(projRepo),
+		Projects:      service.NewProjectService(projRepo, uow),
 		Nodes:         service.NewNodeService(nodeRepo, uow),
-		WorkItems:     service.NewWorkItemService(wiRepo, nodeRepo, uow),
+		WorkItems:     service.NewWorkItemService(wiRepo, nodeRepo, uow, audit),
 		Sessions:      sessionSvc,
 		WhatNow:       service.NewWhatNowService(wiRepo, sessRepo, depRepo, profRepo),
-		Status:        service.NewStatusService(projRepo, wiRepo, sessRepo, profRepo),
+		Status:        service.NewStatusService(projRepo, nodeRepo, wiRepo, sessRepo, profRepo, snapRepo),
 		Replan:        service.NewReplanService(projRepo, wiRepo, sessRepo, profRepo, uow),
 		Templates:     templateSvc,
 		Import:        importSvc,
+		Export:        service.NewExportService(uow),
+		Stats:         service.NewStatsService(wiRepo),
+		Focus:         service.NewFocusService(repository.NewSQLiteFocusRepo(db), wiRepo),
+		Audit:         service.NewAuditService(auditRepo),
+		Presets:       service.NewWorkPresetService(repository.NewSQLiteWorkPresetRepo(db)),
+		Plans:         service.NewDayPlanService(repository.NewSQLiteDayPlanRepo(db), sessRepo, wiRepo, uow),
+		Weekly:        service.NewWeeklyPlanService(wiRepo, sessRepo, depRepo, profRepo),
+		Review:        testReviewService(wiRepo, sessRepo, depRepo, profRepo, projRepo, snapRepo),
+		Archive:       service.NewArchiveService(repository.NewSQLiteArchiveRepo(db), uow),
 		LogSession:    sessionSvc,
 		InitProject:   templateSvc,
 		ImportProject: importSvc,
@@ -286,6 +332,33 @@ func TestDispatchNode_Add(t *testing.T) {
 	assert.Equal(t, "Week 1", nodes[0].Title)
 }
 
+func TestDispatchNode_UpdateDuePropagate(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+
+	state := &SharedState{App: app, ActiveProjectID: projID}
+	cb := &commandBar{state: state}
+
+	// Without --propagate only the node changes.
+	_, err := cb.dispatchNode(ctx, "update", []string{nodeID}, map[string]string{"due": "2026-03-13"})
+	require.NoError(t, err)
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Nil(t, wi.DueDate)
+
+	result, err := cb.dispatchNode(ctx, "update", []string{nodeID}, map[string]string{"due": "2026-03-20", "propagate": "true"})
+	require.NoError(t, err)
+	assert.Contains(t, result, "applied to 1 work items")
+	wi, err = app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	require.NotNil(t, wi.DueDate)
+	assert.Equal(t, "2026-03-20", wi.DueDate.Format("2006-01-02"))
+
+	_, err = cb.dispatchNode(ctx, "update", []string{nodeID}, map[string]string{"due": "next week"})
+	assert.ErrorContains(t, err, "invalid --due")
+}
+
 func TestDispatchWork_Add(t *testing.T) {
 	app := testApp(t)
 	ctx := context.Background()
@@ -380,6 +453,32 @@ func TestDispatchProject_Import(t *testing.T) {
 	assert.Equal(t, "IMP01", projects[0].ShortID)
 }
 
+func TestDispatchTemplate_Validate(t *testing.T) {
+	app := testAppFull(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "custom.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"id": "custom", "name": "Custom", "domain": "test",
+		"nodes": [{"id": "n1", "title": "Part", "kind": "chapter"}],
+		"work_items": []
+	}`), 0644))
+
+	cb := &commandBar{state: &SharedState{App: app}}
+	result, err := cb.dispatchTemplate(ctx, "validate", []string{path}, map[string]string{})
+	require.NoError(t, err)
+	assert.Contains(t, result, "2 error(s)")
+	assert.Contains(t, result, `invalid kind "chapter"`)
+	assert.Contains(t, result, "at least one work item is required")
+
+	projects, err := app.Projects.List(ctx, true)
+	require.NoError(t, err)
+	assert.Empty(t, projects, "validate must not create anything")
+
+	_, err = cb.dispatchTemplate(ctx, "validate", nil, map[string]string{})
+	assert.ErrorContains(t, err, "usage: template validate")
+}
+
 // --- E2E round-trip tests using services directly ---
 
 // seedCriticalAndOnTrack creates two projects: one critical and one on-track.
@@ -640,6 +739,12 @@ func TestParseShellFlags(t *testing.T) {
 	assert.Equal(t, "true", flags["all"])
 }
 
+func TestParseShellFlags_NegativeNumberIsPositional(t *testing.T) {
+	pos, flags := parseShellFlags([]string{"#3", "-5", "-v"})
+	assert.Equal(t, []string{"#3", "-5"}, pos)
+	assert.Equal(t, "true", flags["v"])
+}
+
 func TestParseShellFlags_Empty(t *testing.T) {
 	pos, flags := parseShellFlags(nil)
 	assert.Empty(t, pos)
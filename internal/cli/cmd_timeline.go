@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultTimelineDays is how far ahead timeline looks without --days.
+const defaultTimelineDays = 30
+
+// timelineKindOrder breaks ties between deadlines on the same date: the
+// project target first, then nodes, then items.
+var timelineKindOrder = map[formatter.TimelineKind]int{
+	formatter.TimelineProject: 0,
+	formatter.TimelineNode:    1,
+	formatter.TimelineItem:    2,
+}
+
+// cmdTimeline handles "timeline [--days N]": every project target, node due
+// date and work item due date across active projects within the next N days,
+// in date order, with anything still open and overdue first.
+func (c *commandBar) cmdTimeline(args []string) tea.Cmd {
+	positional, flags := parseShellFlags(args)
+	days := defaultTimelineDays
+	if v, ok := flags["days"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 || len(positional) > 0 {
+			return outputCmd(shellError(fmt.Errorf("usage: timeline [--days N]")))
+		}
+		days = n
+	} else if len(positional) > 0 {
+		return outputCmd(shellError(fmt.Errorf("usage: timeline [--days N]")))
+	}
+
+	now := time.Now()
+	entries, err := buildTimeline(context.Background(), c.state.App, days, now)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(formatter.FormatTimeline(entries, days, now, c.state.Width))
+}
+
+// buildTimeline collects the dated deadlines of every active project that
+// fall within days of now, plus all overdue ones that are still open, sorted
+// by date. Done, skipped and archived items are left out, as are nodes whose
+// work is all finished.
+func buildTimeline(ctx context.Context, app *App, days int, now time.Time) ([]formatter.TimelineEntry, error) {
+	projects, err := app.Projects.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	risks := make(map[string]domain.RiskLevel)
+	if app.Status != nil {
+		req := contract.NewStatusRequest()
+		req.Recalc = false
+		if resp, err := app.Status.GetStatus(ctx, req); err == nil {
+			for _, v := range resp.Projects {
+				risks[v.ProjectID] = v.RiskLevel
+			}
+		}
+	}
+
+	var entries []formatter.TimelineEntry
+	add := func(due *time.Time, e formatter.TimelineEntry) {
+		if due == nil || formatter.TimelineDaysAway(*due, now) > days {
+			return
+		}
+		e.Date = *due
+		entries = append(entries, e)
+	}
+
+	for _, p := range projects {
+		if p.Status != domain.ProjectActive {
+			continue
+		}
+		risk := risks[p.ID]
+		add(p.TargetDate, formatter.TimelineEntry{
+			Kind: formatter.TimelineProject, Title: p.Name, ProjectName: p.Name, Risk: risk,
+		})
+
+		nodes, err := app.Nodes.ListByProject(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing nodes of %s: %w", p.Name, err)
+		}
+		items, err := app.WorkItems.ListByProject(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing work items of %s: %w", p.Name, err)
+		}
+
+		// A node stays on the timeline while it or a descendant has open work.
+		parents := make(map[string]string, len(nodes))
+		for _, n := range nodes {
+			if n.ParentID != nil {
+				parents[n.ID] = *n.ParentID
+			}
+		}
+		open := make(map[string]bool)
+		for _, w := range items {
+			if w.IsTerminal() {
+				continue
+			}
+			add(w.DueDate, formatter.TimelineEntry{
+				Kind: formatter.TimelineItem, Title: w.Title, Seq: w.Seq, ProjectName: p.Name, Risk: risk,
+			})
+			for id := w.NodeID; id != "" && !open[id]; id = parents[id] {
+				open[id] = true
+			}
+		}
+		for _, n := range nodes {
+			if !open[n.ID] {
+				continue
+			}
+			add(n.DueDate, formatter.TimelineEntry{
+				Kind: formatter.TimelineNode, Title: n.Title, Seq: n.Seq, ProjectName: p.Name, Risk: risk,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.Kind != b.Kind {
+			return timelineKindOrder[a.Kind] < timelineKindOrder[b.Kind]
+		}
+		return a.ProjectName < b.ProjectName
+	})
+	return entries, nil
+}
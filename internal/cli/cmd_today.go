@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// todayDefaultMin sizes today's suggestion when no daily goal is set, as
+// what-now does without minutes.
+const todayDefaultMin = 60
+
+// cmdToday handles `today`: the day's goal progress, what was logged, the
+// saved or suggested plan and what falls due, in one box.
+func (c *commandBar) cmdToday(args []string) tea.Cmd {
+	if len(args) > 0 {
+		return outputCmd(shellError(fmt.Errorf("usage: today")))
+	}
+	now := time.Now()
+	summary, err := buildToday(context.Background(), c.state.App, c.state.ActiveItemID, now)
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(formatter.FormatToday(summary, now))
+}
+
+// buildToday gathers the today summary. Missing pieces degrade rather than
+// fail: no profile or a day without availability means no goal, and a plan
+// that cannot be saved or suggested becomes a note.
+func buildToday(ctx context.Context, app *App, activeItemID string, now time.Time) (*formatter.TodaySummary, error) {
+	s := &formatter.TodaySummary{}
+	if app.Profile != nil {
+		if p, err := app.Profile.Get(ctx); err == nil {
+			s.GoalMin = p.AvailableMinOn(now.Weekday())
+		}
+	}
+
+	if err := loadTodayLogged(ctx, app, s, now); err != nil {
+		return nil, err
+	}
+
+	if app.Plans != nil {
+		if a, err := app.Plans.Adherence(ctx, now, now); err == nil {
+			s.SavedPlan = a
+		}
+	}
+	if s.SavedPlan == nil {
+		suggestTodayPlan(ctx, app, s, activeItemID)
+	}
+
+	entries, err := buildTimeline(ctx, app, 0, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Kind == formatter.TimelineItem && formatter.TimelineDaysAway(e.Date, now) == 0 {
+			s.Due = append(s.Due, e)
+		}
+	}
+	return s, nil
+}
+
+// loadTodayLogged totals the sessions started on now's local calendar day,
+// grouped by work item, most time first.
+func loadTodayLogged(ctx context.Context, app *App, s *formatter.TodaySummary, now time.Time) error {
+	sessions, err := app.Sessions.ListRecent(ctx, 2)
+	if err != nil {
+		return err
+	}
+	local := now.Local()
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 1)
+
+	byItem := make(map[string]*formatter.TodayLogged)
+	projects := make(map[string]string)
+	for _, sess := range sessions {
+		if sess.StartedAt.Before(start) || !sess.StartedAt.Before(end) {
+			continue
+		}
+		s.LoggedMin += sess.Minutes
+		entry, ok := byItem[sess.WorkItemID]
+		if !ok {
+			entry = &formatter.TodayLogged{}
+			if wi, err := app.WorkItems.GetByID(ctx, sess.WorkItemID); err == nil {
+				entry.Title, entry.Seq = wi.Title, wi.Seq
+				entry.ProjectName = todayProjectName(ctx, app, wi, projects)
+			} else {
+				entry.Title = formatter.TruncID(sess.WorkItemID)
+			}
+			byItem[sess.WorkItemID] = entry
+		}
+		entry.Minutes += sess.Minutes
+		entry.Sessions++
+	}
+	for _, e := range byItem {
+		s.Logged = append(s.Logged, *e)
+	}
+	sort.Slice(s.Logged, func(i, j int) bool {
+		if s.Logged[i].Minutes != s.Logged[j].Minutes {
+			return s.Logged[i].Minutes > s.Logged[j].Minutes
+		}
+		return s.Logged[i].Title < s.Logged[j].Title
+	})
+	return nil
+}
+
+// todayProjectName looks up a work item's project name, caching by node.
+func todayProjectName(ctx context.Context, app *App, wi *domain.WorkItem, cache map[string]string) string {
+	if name, ok := cache[wi.NodeID]; ok {
+		return name
+	}
+	name := ""
+	if node, err := app.Nodes.GetByID(ctx, wi.NodeID); err == nil {
+		if p, err := app.Projects.GetByID(ctx, node.ProjectID); err == nil {
+			name = p.Name
+		}
+	}
+	cache[wi.NodeID] = name
+	return name
+}
+
+// suggestTodayPlan asks what-now for the rest of today's goal (an hour when
+// there is none). When the goal is met or nothing fits, it leaves a note.
+func suggestTodayPlan(ctx context.Context, app *App, s *formatter.TodaySummary, activeItemID string) {
+	minutes := todayDefaultMin
+	if s.GoalMin > 0 {
+		minutes = s.GoalMin - s.LoggedMin
+		if minutes <= 0 {
+			s.PlanNote = "Daily goal met. Anything more is a bonus: what-now"
+			return
+		}
+	}
+	req, err := buildWhatNowRequest(ctx, app, whatNowArgs{minutes: minutes}, activeItemID)
+	if err != nil {
+		s.PlanNote = err.Error()
+		return
+	}
+	resp, err := app.WhatNow.Recommend(ctx, req)
+	var wnErr *contract.WhatNowError
+	switch {
+	case errors.As(err, &wnErr) && wnErr.Code == contract.ErrNoCandidates:
+		s.PlanNote = "Nothing to schedule right now."
+	case errors.As(err, &wnErr) && wnErr.Code == contract.ErrOutsideActiveHours:
+		s.PlanNote = "Outside your active hours. Working late on purpose? what-now --force"
+	case err != nil:
+		s.PlanNote = "No plan: " + err.Error()
+	case len(resp.Recommendations) == 0:
+		s.PlanNote = "Nothing fits in the time left."
+	default:
+		s.Suggested = resp.Recommendations
+		s.SuggestedMin = resp.RequestedMin
+	}
+}
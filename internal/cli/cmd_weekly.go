@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const weeklyUsage = "Usage: weekly plan"
+
+// cmdWeekly handles "weekly plan": the remaining work spread over the next
+// seven days by the weekly plan service.
+func (c *commandBar) cmdWeekly(args []string) tea.Cmd {
+	if c.state.App.Weekly == nil {
+		return outputCmd(shellError(fmt.Errorf("weekly planning is not configured")))
+	}
+	if len(args) != 1 || strings.ToLower(args[0]) != "plan" {
+		return outputCmd(formatter.StyleYellow.Render(weeklyUsage))
+	}
+	plan, err := c.state.App.Weekly.Plan(context.Background(), time.Now())
+	if err != nil {
+		return outputCmd(shellError(err))
+	}
+	return outputCmd(formatter.FormatWeeklyPlan(plan))
+}
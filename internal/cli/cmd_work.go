@@ -60,17 +60,18 @@ func (c *commandBar) resolveOrSelectItem(
 // ── log command ──────────────────────────────────────────────────────────────
 
 func (c *commandBar) cmdLog(args []string) tea.Cmd {
+	args, finish := trimFinishArg(args)
 	itemArg, minutesArg := parseLogArgs(args)
 	return c.ensureProject(func() tea.Cmd {
 		return c.resolveOrSelectItem(itemArg, nil, func(itemID string) tea.Cmd {
-			return c.logAfterItem(itemID, minutesArg)
+			return c.logAfterItem(itemID, minutesArg, finish)
 		})
 	})
 }
 
-func (c *commandBar) logAfterItem(itemID, minutesArg string) tea.Cmd {
+func (c *commandBar) logAfterItem(itemID, minutesArg string, finish bool) tea.Cmd {
 	if minutesArg != "" {
-		return c.logExecute(itemID, minutesArg)
+		return c.logExecute(itemID, minutesArg, finish)
 	}
 
 	defaultMin := 60
@@ -84,11 +85,11 @@ func (c *commandBar) logAfterItem(itemID, minutesArg string) tea.Cmd {
 		if result == "" {
 			result = strconv.Itoa(defaultMin)
 		}
-		return c.logExecute(itemID, result)
+		return c.logExecute(itemID, result, finish)
 	})
 }
 
-func (c *commandBar) logExecute(itemID, minutesStr string) tea.Cmd {
+func (c *commandBar) logExecute(itemID, minutesStr string, finish bool) tea.Cmd {
 	ctx := context.Background()
 	minutes, err := strconv.Atoi(minutesStr)
 	if err != nil || minutes <= 0 {
@@ -99,12 +100,15 @@ func (c *commandBar) logExecute(itemID, minutesStr string) tea.Cmd {
 	c.state.SetActiveItem(itemID, title, seq)
 
 	msg, err := execLogSession(ctx, c.state.App, c.state, LogSessionInput{
-		ItemID: itemID, Title: title, Minutes: minutes,
+		ItemID: itemID, Title: title, Minutes: minutes, Finish: finish,
 	})
 	if err != nil {
 		return outputCmd(shellError(err))
 	}
-	return outputCmd(msg)
+	return tea.Batch(
+		outputCmd(msg),
+		func() tea.Msg { return refreshViewMsg{} },
+	)
 }
 
 // ── start command ────────────────────────────────────────────────────────────
@@ -270,12 +274,13 @@ func (c *commandBar) addExecute(nodeID, title string, minutes int, dueDate strin
 	ctx := context.Background()
 
 	w := &domain.WorkItem{
-		ID:        uuid.New().String(),
-		NodeID:    nodeID,
-		Title:     title,
-		Type:      "task",
-		Status:    domain.WorkItemTodo,
+		ID:         uuid.New().String(),
+		NodeID:     nodeID,
+		Title:      title,
+		Type:       "task",
+		Status:     domain.WorkItemTodo,
 		PlannedMin: minutes,
+		Splittable: true,
 	}
 	if dueDate != "" {
 		if t, err := time.Parse("2006-01-02", dueDate); err == nil {
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+)
+
+// workEstimate handles "work estimate --type T [--units N] [--unit-label L]":
+// a suggested planned-min for a new item from the logged time of completed
+// items of the same type, to pass on to work add.
+func (c *commandBar) workEstimate(ctx context.Context, flags map[string]string) (string, error) {
+	usage := fmt.Errorf("usage: work estimate --type TYPE [--units N] [--unit-label LABEL]")
+	if c.state.App.Stats == nil {
+		return "", fmt.Errorf("stats use case is not configured")
+	}
+
+	req := app.EstimateSuggestionRequest{Type: strings.ToLower(flags["type"])}
+	if req.Type == "" || req.Type == "true" {
+		return "", usage
+	}
+	if v, ok := flags["units"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return "", usage
+		}
+		req.Units = n
+	}
+	if v, ok := flags["unit-label"]; ok {
+		if v == "true" {
+			return "", usage
+		}
+		req.UnitLabel = v
+	}
+
+	sug, err := c.state.App.Stats.SuggestEstimate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatEstimateSuggestion(sug), nil
+}
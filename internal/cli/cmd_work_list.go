@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// workListStatuses are the values accepted by work list --status.
+var workListStatuses = map[domain.WorkItemStatus]bool{
+	domain.WorkItemTodo:       true,
+	domain.WorkItemInProgress: true,
+	domain.WorkItemDone:       true,
+	domain.WorkItemSkipped:    true,
+	domain.WorkItemArchived:   true,
+}
+
+// workList handles "work list [--project ID] [--status S] [--type T]": a flat
+// table of a project's work items across all nodes. Archived items only show
+// when asked for with --status archived.
+func (c *commandBar) workList(ctx context.Context, flags map[string]string) (string, error) {
+	app := c.state.App
+	usage := fmt.Errorf("usage: work list [--project ID] [--status todo|in_progress|done|skipped|archived] [--type TYPE]")
+
+	projectID := c.state.ActiveProjectID
+	if ref, ok := flags["project"]; ok {
+		if ref == "true" {
+			return "", usage
+		}
+		id, err := resolveProjectID(ctx, app, ref)
+		if err != nil {
+			return "", err
+		}
+		projectID = id
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("no active project; pass --project ID or run 'use <id>' first")
+	}
+
+	var status domain.WorkItemStatus
+	if v, ok := flags["status"]; ok {
+		status = domain.WorkItemStatus(strings.ReplaceAll(strings.ToLower(v), "-", "_"))
+		if !workListStatuses[status] {
+			return "", usage
+		}
+	}
+	wiType := strings.ToLower(flags["type"])
+	if wiType == "true" {
+		return "", usage
+	}
+
+	project, err := app.Projects.GetByID(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	items, err := app.WorkItems.ListByProject(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	nodes, err := app.Nodes.ListByProject(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	nodeTitles := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		nodeTitles[n.ID] = n.Title
+	}
+
+	filtered := make([]*domain.WorkItem, 0, len(items))
+	for _, w := range items {
+		switch {
+		case status != "" && w.Status != status:
+			continue
+		case status == "" && w.Status == domain.WorkItemArchived:
+			continue
+		case wiType != "" && !strings.EqualFold(w.Type, wiType):
+			continue
+		}
+		filtered = append(filtered, w)
+	}
+	return formatter.FormatWorkItemList(project.Name, filtered, nodeTitles, c.state.Width), nil
+}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// workPresetCommand handles "work preset [list | save <name> ... | remove <name>]".
+func (c *commandBar) workPresetCommand(ctx context.Context, pos []string, flags map[string]string) (string, error) {
+	app := c.state.App
+	if app.Presets == nil {
+		return "", fmt.Errorf("work presets are not configured")
+	}
+	usage := fmt.Errorf("usage: work preset [list | save <name> [--type T] [--planned-min N] [--bounds MIN/MAX/DEFAULT] [--min-session N] [--max-session N] [--default-session N] | remove <name>]")
+
+	action := "list"
+	if len(pos) > 0 {
+		action = pos[0]
+	}
+	switch action {
+	case "list":
+		presets, err := app.Presets.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		return formatter.FormatWorkPresets(presets), nil
+
+	case "save":
+		if len(pos) != 2 {
+			return "", usage
+		}
+		p := &domain.WorkPreset{Name: pos[1]}
+		if err := applyWorkShapeFlags(flags, &p.Type, &p.PlannedMin, &p.MinSessionMin, &p.MaxSessionMin, &p.DefaultSessionMin); err != nil {
+			return "", err
+		}
+		if p.Type == "" && p.PlannedMin == 0 && p.MinSessionMin == 0 && p.MaxSessionMin == 0 && p.DefaultSessionMin == 0 {
+			return "", fmt.Errorf("a preset needs at least one of --type, --planned-min or session bounds")
+		}
+		if err := app.Presets.Save(ctx, p); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Saved preset %s  %s", formatter.StyleGreen.Render("✔"),
+			formatter.Bold(p.Name), formatter.Dim(formatter.WorkPresetSummary(p))), nil
+
+	case "remove":
+		if len(pos) != 2 {
+			return "", usage
+		}
+		if err := app.Presets.Delete(ctx, pos[1]); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Removed preset %s", formatter.StyleGreen.Render("✔"), formatter.Bold(pos[1])), nil
+	}
+	return "", usage
+}
+
+// applyWorkShapeFlags overwrites the given fields from --type, --planned-min,
+// --bounds and the individual session flags when those flags are present. Shared by work add (explicit
+// flags override a preset) and work preset save.
+func applyWorkShapeFlags(flags map[string]string, typ *string, plannedMin, minSession, maxSession, defSession *int) error {
+	if v, ok := flags["type"]; ok {
+		*typ = strings.ToLower(v)
+	}
+	if v, ok := flags["planned-min"]; ok {
+		m, ok := parseDurationArg(v)
+		if !ok && v != "0" {
+			return fmt.Errorf("invalid --planned-min %q", v)
+		}
+		*plannedMin = m
+	}
+	if v, ok := flags["bounds"]; ok {
+		lo, hi, def, err := parseSessionBounds(v)
+		if err != nil {
+			return err
+		}
+		*minSession, *maxSession, *defSession = lo, hi, def
+	}
+	return applySessionFlags(flags, minSession, maxSession, defSession)
+}
+
+// projectDefaultFlags are the project update flags that set work defaults,
+// each the work add flag of the same name prefixed with "default-".
+var projectDefaultFlags = []string{"type", "planned-min", "bounds", "min-session", "max-session"}
+
+// applyProjectDefaultFlags updates a project's work defaults from
+// --default-type, --default-planned-min, --default-bounds,
+// --default-min-session and --default-max-session, after resetting them
+// when --clear-defaults is set. It reports whether any of those flags was
+// given.
+func applyProjectDefaultFlags(flags map[string]string, d *domain.WorkDefaults) (bool, error) {
+	shape := make(map[string]string)
+	for _, name := range projectDefaultFlags {
+		if v, ok := flags["default-"+name]; ok {
+			shape[name] = v
+		}
+	}
+	_, cleared := flags["clear-defaults"]
+	if cleared {
+		*d = domain.WorkDefaults{}
+	}
+	if len(shape) == 0 {
+		return cleared, nil
+	}
+	if err := applyWorkShapeFlags(shape, &d.Type, &d.PlannedMin, &d.MinSessionMin, &d.MaxSessionMin, &d.DefaultSessionMin); err != nil {
+		return true, fmt.Errorf("project defaults: %w", err)
+	}
+	return true, nil
+}
+
+// applySessionFlags overwrites session bounds from --min-session,
+// --max-session and --default-session when present. They take precedence
+// over --bounds so a single bound can be adjusted.
+func applySessionFlags(flags map[string]string, minSession, maxSession, defSession *int) error {
+	for _, f := range []struct {
+		name string
+		dst  *int
+	}{
+		{"min-session", minSession},
+		{"max-session", maxSession},
+		{"default-session", defSession},
+	} {
+		v, ok := flags[f.name]
+		if !ok {
+			continue
+		}
+		m, ok := parseDurationArg(v)
+		if !ok {
+			return fmt.Errorf("invalid --%s %q (use e.g. 15, 45m or 1h)", f.name, v)
+		}
+		*f.dst = m
+	}
+	return nil
+}
+
+// applyUnitFlags sets the unit counts from --planned-units and --units-done,
+// keeping the current value for whichever flag is absent.
+func applyUnitFlags(flags map[string]string, w *domain.WorkItem) error {
+	total, done := w.UnitsTotal, w.UnitsDone
+	for _, f := range []struct {
+		name string
+		dst  *int
+	}{
+		{"planned-units", &total},
+		{"units-done", &done},
+	} {
+		v, ok := flags[f.name]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid --%s %q (use a whole number)", f.name, v)
+		}
+		*f.dst = n
+	}
+	if total == w.UnitsTotal && done == w.UnitsDone {
+		return nil
+	}
+	return w.SetUnits(total, done, time.Now())
+}
+
+// applyAtomicFlag sets w.Splittable from --atomic ("--atomic" or
+// "--atomic false"). Atomic items are only scheduled in one block covering
+// all remaining work.
+func applyAtomicFlag(flags map[string]string, w *domain.WorkItem) error {
+	v, ok := flags["atomic"]
+	if !ok {
+		return nil
+	}
+	atomic, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("invalid --atomic %q (use --atomic or --atomic false)", v)
+	}
+	w.Splittable = !atomic
+	return nil
+}
+
+// hasSessionFlags reports whether any session bound flag is present.
+func hasSessionFlags(flags map[string]string) bool {
+	for _, name := range []string{"bounds", "min-session", "max-session", "default-session"} {
+		if _, ok := flags[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSessionBounds parses "MIN/MAX" or "MIN/MAX/DEFAULT" session bounds,
+// each a duration accepted by parseDurationArg (e.g. "15/60/30", "15m/1h").
+func parseSessionBounds(s string) (minMin, maxMin, defMin int, err error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("invalid --bounds %q: expected MIN/MAX or MIN/MAX/DEFAULT", s)
+	}
+	vals := make([]int, len(parts))
+	for i, p := range parts {
+		v, ok := parseDurationArg(strings.TrimSpace(p))
+		if !ok {
+			return 0, 0, 0, fmt.Errorf("invalid --bounds %q: %q is not a duration", s, p)
+		}
+		vals[i] = v
+	}
+	if vals[0] > vals[1] {
+		return 0, 0, 0, fmt.Errorf("invalid --bounds %q: min exceeds max", s)
+	}
+	if len(vals) == 3 {
+		if vals[2] < vals[0] || vals[2] > vals[1] {
+			return 0, 0, 0, fmt.Errorf("invalid --bounds %q: default must lie between min and max", s)
+		}
+		return vals[0], vals[1], vals[2], nil
+	}
+	return vals[0], vals[1], 0, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 )
 
@@ -38,48 +39,87 @@ func ShellCommandSpec() *CommandSpec {
 			{FullPath: "projects", Short: "List all projects"},
 			{FullPath: "use", Short: "Set active project context", Flags: []FlagEntry{{Name: "id", Type: "string", Description: "Project short ID or UUID"}}},
 			{FullPath: "inspect", Short: "Show project tree for active project"},
-			{FullPath: "status", Short: "Show status overview across all projects"},
-			{FullPath: "what-now", Short: "Get work recommendations for available time", Flags: []FlagEntry{{Name: "minutes", Type: "int", Default: "60", Description: "Available minutes"}}},
-			{FullPath: "log", Short: "Log a completed work session", Flags: []FlagEntry{{Name: "item", Type: "string", Description: "Work item ref (#N or ID)"}, {Name: "minutes", Type: "int", Description: "Duration in minutes"}}},
+			{FullPath: "status", Short: "Show status overview across all projects", Flags: []FlagEntry{{Name: "compare", Type: "string", Description: "Show progress and risk change since this date (YYYY-MM-DD)"}, {Name: "risk", Type: "string", Description: "Only show projects at this risk tier (critical|at-risk|on-track); repeatable"}, {Name: "export", Type: "string", Description: "Print the report as markdown (md) instead of the styled view"}, {Name: "watch", Type: "bool", Description: "Keep the status on screen and refresh it until q or esc"}, {Name: "interval", Type: "string", Default: "30s", Description: "How often --watch refreshes, e.g. 10s or 2m"}}, Examples: "status --risk critical\nstatus --risk critical --risk at-risk\nstatus --export md\nstatus --watch --interval 10s"},
+			{FullPath: "what-now", Short: "Get work recommendations for available time", Flags: []FlagEntry{{Name: "minutes", Type: "int", Default: "60", Description: "Available minutes"}, {Name: "continue", Type: "bool", Description: "Keep the in-progress (or active context) item first, waiving the spacing penalty"}, {Name: "avoid", Type: "string", Description: "Skip this project for this query only (repeatable)"}, {Name: "context", Type: "string", Description: "Only recommend items tagged with this context (e.g. office or @office); falls back to all items when none are tagged"}, {Name: "min-block", Type: "int", Description: "Shortest slice worth suggesting; items that cannot use this much time in one session are skipped"}, {Name: "max-projects", Type: "int", Description: "Cap how many projects the slices come from; the top-ranked ones share the time"}, {Name: "budget-per-project", Type: "string", Description: "Cap each project's share of the time: even splits it across the projects with work, or a number of minutes"}, {Name: "strategy", Type: "string", Default: "priority", Description: "Slice order: priority, or warmup to start with a short item before the top one"}, {Name: "oneline", Type: "bool", Description: "Print only the next action as one plain line (also works as `kairos what-now --oneline` outside the shell)"}, {Name: "save-plan", Type: "bool", Description: "Save the recommended slices as today's plan (replaces an earlier one); see plan status"}, {Name: "respect-hours", Type: "bool", Description: "Suggest nothing outside the profile's active-hours; shows when the next window opens"}, {Name: "force", Type: "bool", Description: "Recommend even outside active hours"}, {Name: "force-early", Type: "bool", Description: "Include projects whose start date is still ahead (left out as NOT_STARTED by default)"}, {Name: "group-by", Type: "string", Description: "Cluster the recommendations under a header per project with its allocated minutes (project)"}, {Name: "snooze-critical", Type: "string", Description: "Stop this critical project forcing critical mode (e.g. while waiting on feedback) until --until or the next midnight"}, {Name: "until", Type: "string", Description: "Plan the time from now until this time (HH:MM today, or \"YYYY-MM-DD HH:MM\") instead of a minute count; with --snooze-critical, when the snooze expires"}, {Name: "unsnooze-critical", Type: "string", Description: "End a critical snooze early"}}, Examples: "what-now --until 15:00\nwhat-now --snooze-critical PHI01\nwhat-now 90 --snooze-critical PHI01 --until \"2026-10-20 09:00\""},
+			{FullPath: "plan", Short: "Show the day plan saved with what-now --save-plan", Flags: []FlagEntry{{Name: "date", Type: "string", Description: "Day to show (YYYY-MM-DD), defaults to today"}}},
+			{FullPath: "plan status", Short: "Compare the saved day plan with the time logged on each item that day", Flags: []FlagEntry{{Name: "date", Type: "string", Description: "Day to compare (YYYY-MM-DD), defaults to today"}}, Examples: "plan status\nplan status --date 2026-03-09"},
+			{FullPath: "weekly plan", Short: "Spread remaining work over the next 7 days using the profile's availability per weekday, and flag projects that won't fit before their deadline", Examples: "profile set availability=2h,2h,2h,2h,2h,1h,0\nweekly plan"},
+			{FullPath: "log", Short: "Log a completed work session (trailing 'done' or '!' also finishes the item)", Flags: []FlagEntry{{Name: "item", Type: "string", Description: "Work item ref (#N or ID)"}, {Name: "minutes", Type: "int", Description: "Duration in minutes"}}},
 			{FullPath: "start", Short: "Start working on an item (sets status to in-progress)"},
 			{FullPath: "finish", Short: "Mark a work item as done"},
 			{FullPath: "add", Short: "Quick-add a work item to active project"},
-			{FullPath: "replan", Short: "Rebalance project schedules", Flags: []FlagEntry{{Name: "strategy", Type: "string", Default: "rebalance", Description: "Replan strategy (rebalance|deadline_first)"}}},
-			{FullPath: "import", Short: "Import a project from a JSON file"},
-			{FullPath: "draft", Short: "Start interactive project drafting wizard"},
+			{FullPath: "replan", Short: "Rebalance project schedules", Flags: []FlagEntry{{Name: "strategy", Type: "string", Default: "rebalance", Description: "Replan strategy (rebalance|deadline_first)"}, {Name: "dry-run", Type: "bool", Description: "Show risk and estimate changes without saving them"}}},
+			{FullPath: "import", Short: "Import a project from a JSON file", Flags: []FlagEntry{{Name: "merge", Type: "bool", Description: "Update the project with the same short_id in place, matching nodes/items by ref"}, {Name: "prune", Type: "bool", Description: "With --merge, archive work items whose ref is no longer in the file"}, {Name: "dry-run", Type: "bool", Description: "Validate the file and report what would be created without writing anything"}}},
+			{FullPath: "export", Short: "Export entities as JSON for backup or sync", Flags: []FlagEntry{{Name: "since", Type: "string", Description: "Only include changes after this timestamp (YYYY-MM-DD, \"YYYY-MM-DD HH:MM\" or RFC3339)"}, {Name: "out", Type: "string", Description: "Write JSON to this file instead of the screen"}, {Name: "format", Type: "string", Default: "json", Description: "Output format (json|dot); dot exports the active project tree for Graphviz"}}},
+			{FullPath: "draft", Short: "Start interactive project drafting wizard", Flags: []FlagEntry{{Name: "resume", Type: "bool", Description: "Reopen the unfinished draft saved when the wizard was left"}, {Name: "discard", Type: "bool", Description: "Delete the saved unfinished draft"}}, Examples: "draft --resume"},
 			{FullPath: "context", Short: "Show or set active project/item context"},
 			{FullPath: "help", Short: "Show available commands"},
 			{FullPath: "help chat", Short: "Interactive LLM-powered help session"},
+			{FullPath: "help commands", Short: "Offline command reference with flags and examples", Flags: []FlagEntry{{Name: "search", Type: "string", Description: "Only show commands whose name or description matches these words"}}, Examples: "help commands\nhelp commands --search session"},
 			{FullPath: "ask", Short: "Ask a natural language question (LLM)", Flags: []FlagEntry{{Name: "question", Type: "string", Description: "Natural language question"}}},
-			{FullPath: "explain now", Short: "Explain current recommendations with LLM narrative"},
+			{FullPath: "explain now", Short: "Explain current recommendations with LLM narrative", Flags: []FlagEntry{{Name: "verbose", Type: "bool", Description: "Append the full candidate ranking: scores, top factors and why each unselected item lost (also on with KAIROS_VERBOSITY=verbose)"}, {Name: "minutes", Type: "int", Description: "Available minutes (same as the positional argument, default 60)"}}, Examples: "explain now 90 --verbose"},
 			{FullPath: "explain why-not", Short: "Explain why a specific item was not recommended"},
-			{FullPath: "review weekly", Short: "Summarize the past 7 days with actionable insights"},
+			{FullPath: "review weekly", Short: "Summarize the past 7 days with actionable insights", Flags: []FlagEntry{{Name: "plain", Type: "bool", Description: "Print plain text (no colors) for a journal or email"}, {Name: "email", Type: "bool", Description: "Same as --plain"}}, Examples: "review weekly\nreview weekly --plain"},
+			{FullPath: "focus list", Short: "Show the pinned focus list"},
+			{FullPath: "focus add", Short: "Pin a work item to the focus list so what-now ranks it first"},
+			{FullPath: "focus remove", Short: "Unpin a work item from the focus list"},
+			{FullPath: "profile", Short: "Show profile settings (auto-replan, pomodoro lengths, baseline pace)"},
+			{FullPath: "profile set", Short: "Change profile settings, e.g. profile set auto-replan=true focus-block=50", Flags: []FlagEntry{{Name: "active-hours", Type: "string", Description: "Times of day what-now may suggest work, e.g. 07:00-22:30 or off (key=value)"}, {Name: "respect-active-hours", Type: "bool", Description: "Apply active-hours to every what-now, not just --respect-hours (key=value)"}, {Name: "auto-replan", Type: "bool", Description: "Replan the logged item's project after each session (key=value)"}, {Name: "availability", Type: "string", Description: "Time available per weekday for weekly plan, Monday first, e.g. 2h,2h,2h,2h,2h,1h,0; empty uses baseline-daily (key=value)"}, {Name: "autocorrect", Type: "bool", Description: "Run single-edit command typos such as stauts as the assumed command, on by default (key=value)"}, {Name: "validate-session-time", Type: "bool", Description: "Reject a session whose minutes exceed the time since its --at start, plus 5m; a day-only --at is exempt (key=value)"}, {Name: "focus-block", Type: "int", Description: "Pomodoro focus block minutes (key=value)"}, {Name: "break", Type: "int", Description: "Pomodoro break minutes (key=value)"}, {Name: "baseline-daily", Type: "int", Description: "Baseline daily minutes used for pace (key=value)"}, {Name: "max-daily", Type: "int", Description: "Most minutes a day can hold, default 8h; deadlines needing more are flagged infeasible (key=value)"}, {Name: "pace-window", Type: "int", Description: "Days of sessions averaged into the recent daily pace, default 7; shorter reacts faster to bursts and lulls (key=value)"}, {Name: "spacing-lookback", Type: "int", Description: "Days what-now looks back for an item's last session when scoring spacing, default 7 (key=value)"}, {Name: "weight-importance", Type: "float", Description: "Weight of project importance in what-now scoring (key=value)"}}},
+			{FullPath: "profile export", Short: "Export profile settings and work presets as JSON", Flags: []FlagEntry{{Name: "out", Type: "string", Description: "Write JSON to this file instead of the screen"}}},
+			{FullPath: "profile import", Short: "Restore profile settings and work presets from a profile export, listing what changed; values are range-checked before anything is saved", Examples: "profile import ~/kairos-profile.json"},
+			{FullPath: "config list", Short: "List profile settings with their ranges, and the read-only settings taken from the environment"},
+			{FullPath: "config get", Short: "Show one setting, e.g. config get weight.spacing"},
+			{FullPath: "config set", Short: "Change a profile setting; values are range-checked", Examples: "config set weight.spacing 3\nconfig set deadline-buffer 25"},
+			{FullPath: "today", Short: "Show today's goal progress, time logged, saved or suggested plan, and work items due today"},
+			{FullPath: "timeline", Short: "List upcoming project, node and work item deadlines across all projects by date", Flags: []FlagEntry{{Name: "days", Type: "int", Default: "30", Description: "How many days ahead to look; overdue deadlines always show"}}},
+			{FullPath: "history", Short: "Show the audit log of changes to a work item (or any entity ID)", Examples: "history #3"},
+			{FullPath: "archive list", Short: "List archived projects and work items with when each was archived and how many sessions it holds"},
+			{FullPath: "archive purge", Short: "Permanently delete projects and work items archived longer ago than a retention window, with their nodes and sessions, in one transaction", Flags: []FlagEntry{{Name: "older-than", Type: "string", Description: "Retention window in days or weeks (90d, 12w); entries archived before it are purged", Required: true}, {Name: "dry-run", Type: "bool", Description: "List what would be purged without deleting anything"}, {Name: "yes", Type: "bool", Description: "Skip the confirmation prompt"}}, Examples: "archive purge --older-than 90d --dry-run\narchive purge --older-than 90d"},
+			{FullPath: "stats accuracy", Short: "Show logged vs. original estimate ratios per work type"},
+			{FullPath: "debug timings", Short: "Show per-use-case call counts and p50/p95 latency since shell start"},
+			{FullPath: "llm status", Short: "Ping the model server and report whether the configured model is available"},
 			// Entity group commands
-			{FullPath: "project list", Short: "List all projects", Flags: []FlagEntry{{Name: "all", Type: "bool", Description: "Include archived projects"}}},
-			{FullPath: "project inspect", Short: "Show project tree"},
-			{FullPath: "project add", Short: "Create a new project", Flags: []FlagEntry{{Name: "id", Type: "string", Description: "Short ID", Required: true}, {Name: "name", Type: "string", Description: "Project name", Required: true}, {Name: "domain", Type: "string", Description: "Domain", Required: true}, {Name: "start", Type: "string", Description: "Start date (YYYY-MM-DD)", Required: true}, {Name: "due", Type: "string", Description: "Due date (YYYY-MM-DD)"}}},
-			{FullPath: "project update", Short: "Update project fields"},
+			{FullPath: "project list", Short: "List all projects", Flags: []FlagEntry{{Name: "all", Type: "bool", Description: "Include archived projects"}, {Name: "risk-summary", Type: "bool", Description: "Start with a portfolio health line: active projects by risk tier, with paused/done/archived ones noted separately"}}, Examples: "project list --risk-summary"},
+			{FullPath: "project inspect", Short: "Show project tree", Flags: []FlagEntry{{Name: "progress", Type: "bool", Description: "Annotate each node with rolled-up logged/planned minutes and % complete"}, {Name: "hide-done", Type: "bool", Description: "Leave out finished work items and fully finished nodes, with a count per node"}, {Name: "depth", Type: "int", Description: "Node levels to draw below the roots (0 = root nodes only); deeper contents show as a count"}, {Name: "sessions", Type: "bool", Description: "Annotate each work item with its recent sessions and flag open work untouched in the window"}, {Name: "days", Type: "int", Description: "With --sessions, the window in days (default 14)"}}},
+			{FullPath: "project progress", Short: "Compare time elapsed with work done across all active projects, soonest deadline first", Flags: []FlagEntry{{Name: "chart", Type: "bool", Description: "Draw time and work as bars in each project's risk color"}}},
+			{FullPath: "project add", Short: "Create a new project", Flags: []FlagEntry{{Name: "id", Type: "string", Description: "Short ID", Required: true}, {Name: "name", Type: "string", Description: "Project name", Required: true}, {Name: "domain", Type: "string", Description: "Domain", Required: true}, {Name: "start", Type: "string", Description: "Start date (YYYY-MM-DD)", Required: true}, {Name: "due", Type: "string", Description: "Due date (YYYY-MM-DD, or \"YYYY-MM-DD HH:MM\" for a time of day)"}, {Name: "importance", Type: "int", Description: "Importance 1-5, independent of the deadline (default 3)"}}},
+			{FullPath: "project update", Short: "Update project fields", Flags: []FlagEntry{{Name: "importance", Type: "int", Description: "Importance 1-5; higher ranks the project's work first in what-now"}, {Name: "default-type", Type: "string", Description: "Type for new work items that don't set one; work add can then omit --type"}, {Name: "default-planned-min", Type: "int", Description: "Planned minutes for new work items that don't set them"}, {Name: "default-bounds", Type: "string", Description: "Session bounds MIN/MAX[/DEFAULT] for new work items that don't set them"}, {Name: "default-min-session", Type: "int", Description: "Default shortest session in minutes for new work items"}, {Name: "default-max-session", Type: "int", Description: "Default longest session in minutes for new work items"}, {Name: "clear-defaults", Type: "bool", Description: "Remove the project's work defaults (other --default-* flags then set fresh ones)"}}, Examples: "project update PHI01 --default-type reading --default-bounds 20/90/45\nproject update PHI01 --clear-defaults"},
+			{FullPath: "project shift", Short: "Move every date in a project by the same number of days", Flags: []FlagEntry{{Name: "by", Type: "string", Description: "Offset in days or weeks (+14d, -7d, 2w)"}, {Name: "from", Type: "string", Description: "New start date (YYYY-MM-DD); the offset is taken from the current start"}}, Examples: "project shift PHI01 --by +14d\nproject shift PHI01 --from 2026-03-02"},
 			{FullPath: "project archive", Short: "Archive a project"},
 			{FullPath: "project unarchive", Short: "Unarchive a project"},
 			{FullPath: "project remove", Short: "Delete a project"},
 			{FullPath: "project init", Short: "Initialize project from template", Flags: []FlagEntry{{Name: "template", Type: "string", Description: "Template reference", Required: true}, {Name: "id", Type: "string", Description: "Short ID", Required: true}, {Name: "name", Type: "string", Description: "Project name", Required: true}, {Name: "start", Type: "string", Description: "Start date", Required: true}}},
-			{FullPath: "project import", Short: "Import project from JSON file"},
-			{FullPath: "project draft", Short: "Start interactive project drafting"},
-			{FullPath: "node add", Short: "Create a new plan node", Flags: []FlagEntry{{Name: "project", Type: "string", Description: "Project ID"}, {Name: "title", Type: "string", Description: "Node title", Required: true}, {Name: "kind", Type: "string", Description: "Node kind (module|milestone|week)", Required: true}}},
-			{FullPath: "node inspect", Short: "Show node details"},
-			{FullPath: "node update", Short: "Update node fields"},
+			{FullPath: "project import", Short: "Import project from JSON file", Flags: []FlagEntry{{Name: "merge", Type: "bool", Description: "Update the project with the same short_id in place, matching nodes/items by ref"}, {Name: "prune", Type: "bool", Description: "With --merge, archive work items whose ref is no longer in the file"}, {Name: "dry-run", Type: "bool", Description: "Validate the file and report what would be created without writing anything"}}},
+			{FullPath: "project export", Short: "Export the project tree for Graphviz", Flags: []FlagEntry{{Name: "format", Type: "string", Default: "dot", Description: "Output format (dot)"}, {Name: "out", Type: "string", Description: "Write to this file instead of the screen"}}},
+			{FullPath: "project archive", Short: "Archive a project, or with --with-done archive its finished work items", Flags: []FlagEntry{{Name: "with-done", Type: "bool", Description: "Archive done work items instead of the project (kept for history)"}, {Name: "all", Type: "bool", Description: "With --with-done, cover every project"}, {Name: "yes", Type: "bool", Description: "Skip the confirmation prompt"}}},
+			{FullPath: "project draft", Short: "Start interactive project drafting", Flags: []FlagEntry{{Name: "resume", Type: "bool", Description: "Reopen the unfinished draft saved when the wizard was left"}, {Name: "discard", Type: "bool", Description: "Delete the saved unfinished draft"}}},
+			{FullPath: "node add", Short: "Create a new plan node", Flags: []FlagEntry{{Name: "project", Type: "string", Description: "Project ID"}, {Name: "title", Type: "string", Description: "Node title", Required: true}, {Name: "kind", Type: "string", Description: "Node kind (module|milestone|week)", Required: true}, {Name: "count", Type: "int", Description: "Create this many sibling nodes after the existing ones; {n} in the title becomes 1..N"}, {Name: "days-per", Type: "int", Description: "With --count, make node i due this many days times i after the project start"}}, Examples: "node add --title \"Week {n}\" --kind week --count 10 --days-per 7"},
+			{FullPath: "node inspect", Short: "Show node details", Flags: []FlagEntry{{Name: "tree", Type: "bool", Description: "Show the plan tree under the node with its work items"}, {Name: "progress", Type: "bool", Description: "With --tree, annotate each node with rolled-up logged/planned minutes and % complete"}, {Name: "hide-done", Type: "bool", Description: "With --tree, leave out finished work"}}},
+			{FullPath: "node update", Short: "Update node fields", Flags: []FlagEntry{{Name: "due", Type: "string", Description: "Due date (YYYY-MM-DD, or \"YYYY-MM-DD HH:MM\" for a time of day)"}, {Name: "propagate", Type: "bool", Description: "Also set the due date on descendant work items that have none of their own"}}},
 			{FullPath: "node remove", Short: "Delete a plan node"},
-			{FullPath: "work add", Short: "Create a new work item", Flags: []FlagEntry{{Name: "node", Type: "string", Description: "Parent node ID", Required: true}, {Name: "title", Type: "string", Description: "Item title", Required: true}, {Name: "type", Type: "string", Description: "Item type (task|reading|exercise|zettel)", Required: true}, {Name: "planned-min", Type: "int", Description: "Planned minutes"}, {Name: "due-date", Type: "string", Description: "Due date (YYYY-MM-DD)"}}},
-			{FullPath: "work inspect", Short: "Show work item details"},
-			{FullPath: "work update", Short: "Update work item fields"},
-			{FullPath: "work done", Short: "Mark work item as done"},
+			{FullPath: "work add", Short: "Create a new work item", Flags: []FlagEntry{{Name: "node", Type: "string", Description: "Parent node ID", Required: true}, {Name: "title", Type: "string", Description: "Item title", Required: true}, {Name: "type", Type: "string", Description: "Item type (task|reading|exercise|zettel); required unless --preset or the project's default type sets it"}, {Name: "preset", Type: "string", Description: "Work preset filling type, planned minutes and session bounds (see work preset list)"}, {Name: "planned-min", Type: "int", Description: "Planned minutes"}, {Name: "bounds", Type: "string", Description: "Session bounds MIN/MAX[/DEFAULT], e.g. 15/60/30"}, {Name: "min-session", Type: "int", Description: "Shortest useful session in minutes (default 15)"}, {Name: "max-session", Type: "int", Description: "Longest session in minutes (default 60)"}, {Name: "default-session", Type: "int", Description: "Preferred session length in minutes (default 30)"}, {Name: "atomic", Type: "bool", Description: "Not splittable: only schedule in one block covering all remaining work"}, {Name: "due-date", Type: "string", Description: "Due date (YYYY-MM-DD, or \"YYYY-MM-DD HH:MM\" for a time of day)"}, {Name: "tag", Type: "string", Description: "Comma-separated context tags, e.g. office,online (used by what-now --context)"}}},
+			{FullPath: "work inspect", Short: "Show work item details", Flags: []FlagEntry{{Name: "history", Type: "bool", Description: "Append the item's sessions oldest first with a running total"}}, Examples: "work inspect #3\nwork inspect #3 --history"},
+			{FullPath: "work list", Short: "List a project's work items across all nodes as a flat table", Flags: []FlagEntry{{Name: "project", Type: "string", Description: "Project ID (defaults to the active project)"}, {Name: "status", Type: "string", Description: "Only items with this status (todo|in_progress|done|skipped|archived)"}, {Name: "type", Type: "string", Description: "Only items of this type"}}, Examples: "work list --status in_progress\nwork list --type reading"},
+			{FullPath: "work update", Short: "Update work item fields", Flags: []FlagEntry{{Name: "planned-min", Type: "int", Description: "New planned minutes"}, {Name: "reset-estimate", Type: "bool", Description: "Restore planned minutes to the original estimate"}, {Name: "min-session", Type: "int", Description: "Shortest useful session in minutes"}, {Name: "max-session", Type: "int", Description: "Longest session in minutes"}, {Name: "default-session", Type: "int", Description: "Preferred session length in minutes"}, {Name: "atomic", Type: "bool", Description: "Mark not splittable (--atomic false to allow splitting again)"}, {Name: "tag", Type: "string", Description: "Replace the item's context tags (comma-separated; \"\" clears them)"}, {Name: "planned-units", Type: "int", Description: "Total units (pages, problems, ...) the item covers; 0 stops unit tracking"}, {Name: "units-done", Type: "int", Description: "Units completed so far; may not exceed --planned-units"}}},
+			{FullPath: "work preset", Short: "List, save or remove named work item presets for work add --preset", Flags: []FlagEntry{{Name: "type", Type: "string", Description: "Preset item type"}, {Name: "planned-min", Type: "int", Description: "Preset planned minutes"}, {Name: "bounds", Type: "string", Description: "Preset session bounds MIN/MAX[/DEFAULT]"}}, Examples: "work preset save reading45 --type reading --planned-min 45 --bounds 15/60/30\nwork preset list\nwork preset remove reading45"},
+			{FullPath: "work estimate", Short: "Suggest planned minutes for a new item from completed items of the same type", Flags: []FlagEntry{{Name: "type", Type: "string", Description: "Work item type to look up", Required: true}, {Name: "units", Type: "int", Description: "Units the new item covers; scales the observed minutes per unit"}, {Name: "unit-label", Type: "string", Description: "Only use past items counting this unit (e.g. pages)"}}, Examples: "work estimate --type reading\nwork estimate --type writing --units 3 --unit-label pages"},
+			{FullPath: "work clone", Short: "Copy a work item's type, estimate, session bounds and tags into a fresh item", Flags: []FlagEntry{{Name: "node", Type: "string", Description: "Target node (default: the item's own node)"}, {Name: "title", Type: "string", Description: "Title for the copy (default: bump the trailing number in the same node)"}}, Examples: "work clone #3\nwork clone #3 --node #7\nwork clone #3 --title \"Read Ch5\""},
+			{FullPath: "work move-up", Short: "Move a work item one place up among its node's items", Examples: "work move-up #3"},
+			{FullPath: "work move-down", Short: "Move a work item one place down among its node's items", Examples: "work move-down #3"},
+			{FullPath: "work bump", Short: "Adjust a work item's estimate up or down (e.g. work bump #3 +30)", Examples: "work bump #3 +30\nwork bump #3 -15\nwork bump #3 +1h"},
+			{FullPath: "work check", Short: "Show or edit a work item's checklist steps"},
+			{FullPath: "work priority", Short: "Override what-now ranking: high leads its risk tier, top leads everything", Examples: "work priority #3 top\nwork priority #3 none"},
+			{FullPath: "work done", Short: "Mark work item as done", Flags: []FlagEntry{{Name: "log", Type: "int", Description: "Record N final minutes and mark done in one transaction"}}},
 			{FullPath: "work archive", Short: "Archive a work item"},
 			{FullPath: "work remove", Short: "Delete a work item"},
-			{FullPath: "session log", Short: "Log a work session", Flags: []FlagEntry{{Name: "work-item", Type: "string", Description: "Work item ID", Required: true}, {Name: "minutes", Type: "int", Description: "Duration in minutes", Required: true}, {Name: "note", Type: "string", Description: "Session note"}, {Name: "units-done", Type: "int", Description: "Units completed"}}},
-			{FullPath: "session list", Short: "List recent sessions", Flags: []FlagEntry{{Name: "work-item", Type: "string", Description: "Filter by work item"}, {Name: "days", Type: "int", Default: "7", Description: "Number of days"}}},
+			{FullPath: "session log", Short: "Log a work session", Flags: []FlagEntry{{Name: "work-item", Type: "string", Description: "Work item ID", Required: true}, {Name: "minutes", Type: "int", Description: "Duration in minutes", Required: true}, {Name: "note", Type: "string", Description: "Session note"}, {Name: "tag", Type: "string", Description: "Comma-separated session tags, e.g. billable,research (stored lowercase)"}, {Name: "units-done", Type: "int", Description: "Units completed"}, {Name: "at", Type: "string", Description: "Session start time (YYYY-MM-DD or \"YYYY-MM-DD HH:MM\"), defaults to now"}, {Name: "yesterday", Type: "bool", Description: "Start the session at this time yesterday"}, {Name: "days-ago", Type: "int", Description: "Start the session at this time N days ago"}, {Name: "allow-future", Type: "bool", Description: "Allow --at timestamps in the future"}, {Name: "finish", Type: "bool", Description: "Also mark the work item done, in the same transaction"}, {Name: "continue-timer", Type: "bool", Description: "Log the running timer (from start) instead of --minutes: its elapsed time, starting when it started; stops the timer"}, {Name: "pomodoro", Type: "int", Description: "Log N focus blocks (profile focus_block_min each, spaced by break_min) instead of --minutes; defaults to the active or recommended item"}, {Name: "split", Type: "string", Description: "Split one sitting across items, e.g. \"3=60,4=30\"; with --minutes as the total, items without minutes share what is left"}}, Examples: "session log --work-item 5 --minutes 45 --yesterday\nsession log --continue-timer\nsession log --split \"3=60,4=30\"\nsession log --split \"3=1h,4\" --minutes 90"},
+			{FullPath: "session list", Short: "List recent sessions", Flags: []FlagEntry{{Name: "work-item", Type: "string", Description: "Filter by work item"}, {Name: "days", Type: "int", Default: "7", Description: "Number of days"}, {Name: "format", Type: "string", Default: "table", Description: "Output format (table|json); json prints a session array with work item and project, timestamps in RFC3339 UTC"}}, Examples: "session list --days 30 --format json"},
+			{FullPath: "session report", Short: "Sum logged minutes per session tag over a date range", Flags: []FlagEntry{{Name: "group-by", Type: "string", Description: "Grouping; only tag is supported", Required: true}, {Name: "from", Type: "string", Description: "First day (YYYY-MM-DD)"}, {Name: "to", Type: "string", Description: "Last day (YYYY-MM-DD), defaults to today"}, {Name: "days", Type: "int", Default: "7", Description: "Days ending with --to, when --from is not given"}}, Examples: "session report --group-by tag\nsession report --group-by tag --from 2026-09-01 --to 2026-09-30"},
+			{FullPath: "session undo-last", Short: "Remove the session you just logged and take its minutes back off the item", Flags: []FlagEntry{{Name: "project", Type: "string", Description: "Only consider this project (defaults to the active project)"}, {Name: "all", Type: "bool", Description: "Consider every project"}, {Name: "force", Type: "bool", Description: "Allow removing a session logged more than 10 minutes ago"}}},
 			{FullPath: "session remove", Short: "Delete a session"},
 			{FullPath: "template list", Short: "List available templates"},
 			{FullPath: "template show", Short: "Show template details"},
+			{FullPath: "template validate", Short: "Check a template file for errors without creating a project"},
 			{FullPath: "clear", Short: "Clear the screen"},
 			{FullPath: "exit", Short: "Exit the shell"},
 		},
@@ -122,8 +162,9 @@ func (spec *CommandSpec) FindCommand(path string) *CommandEntry {
 	return nil
 }
 
-// FuzzyMatch returns up to n commands whose paths or descriptions
-// contain any of the query terms (case-insensitive).
+// FuzzyMatch returns up to n commands whose paths, descriptions, flags or
+// examples contain any of the query terms (case-insensitive), ranked by the
+// number of terms matched, then by how many of them are in the path.
 func (spec *CommandSpec) FuzzyMatch(query string, n int) []CommandEntry {
 	terms := strings.Fields(strings.ToLower(query))
 	if len(terms) == 0 {
@@ -131,33 +172,24 @@ func (spec *CommandSpec) FuzzyMatch(query string, n int) []CommandEntry {
 	}
 
 	type scored struct {
-		entry CommandEntry
-		hits  int
+		entry    CommandEntry
+		hits     int
+		pathHits int
 	}
 
 	var matches []scored
 	for _, cmd := range spec.Commands {
-		lowerPath := strings.ToLower(cmd.FullPath)
-		lowerShort := strings.ToLower(cmd.Short)
-		hits := 0
-		for _, term := range terms {
-			if strings.Contains(lowerPath, term) || strings.Contains(lowerShort, term) {
-				hits++
-			}
-		}
-		if hits > 0 {
-			matches = append(matches, scored{entry: cmd, hits: hits})
+		if hits := termHits(cmd.searchText(), terms); hits > 0 {
+			matches = append(matches, scored{entry: cmd, hits: hits, pathHits: termHits(cmd.FullPath, terms)})
 		}
 	}
 
-	// Sort by hit count descending (simple selection for small N).
-	for i := 0; i < len(matches); i++ {
-		for j := i + 1; j < len(matches); j++ {
-			if matches[j].hits > matches[i].hits {
-				matches[i], matches[j] = matches[j], matches[i]
-			}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].hits != matches[j].hits {
+			return matches[i].hits > matches[j].hits
 		}
-	}
+		return matches[i].pathHits > matches[j].pathHits
+	})
 
 	result := make([]CommandEntry, 0, n)
 	for i := 0; i < len(matches) && i < n; i++ {
@@ -166,6 +198,28 @@ func (spec *CommandSpec) FuzzyMatch(query string, n int) []CommandEntry {
 	return result
 }
 
+// termHits counts how many of the lowercased query terms occur in text, the
+// relevance measure FuzzyMatch and the quick-jump palette rank by.
+func termHits(text string, terms []string) int {
+	hits := 0
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			hits++
+		}
+	}
+	return hits
+}
+
+// searchText returns the lowercased text FuzzyMatch searches: path,
+// description, flag names and descriptions, and examples.
+func (cmd CommandEntry) searchText() string {
+	parts := []string{cmd.FullPath, cmd.Short, cmd.Examples}
+	for _, f := range cmd.Flags {
+		parts = append(parts, f.Name, f.Description)
+	}
+	return strings.ToLower(strings.Join(parts, "\n"))
+}
+
 // SerializeCommandSpec serializes the spec to compact JSON for embedding in LLM prompts.
 func SerializeCommandSpec(spec *CommandSpec) string {
 	data, err := json.Marshal(spec)
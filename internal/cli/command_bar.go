@@ -19,6 +19,10 @@ type commandBar struct {
 	// history
 	history    []string
 	historyIdx int
+
+	// verbosity applies to the command being dispatched: the session
+	// default or a --quiet/--verbose on its line.
+	verbosity formatter.Verbosity
 }
 
 func newCommandBar(state *SharedState) commandBar {
@@ -2,14 +2,17 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	kairosapp "github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -18,15 +21,102 @@ type quitMsg struct{}
 
 // executeCommand dispatches a text command and returns a tea.Cmd.
 // Commands may return cmdOutputMsg for display, navigation messages
-// for view transitions, or quitMsg for exit.
+// for view transitions, or quitMsg for exit. A --quiet or --verbose
+// anywhere on the line overrides the session verbosity for that command.
 func (c *commandBar) executeCommand(input string) tea.Cmd {
 	parts, err := splitShellArgs(input)
 	if err != nil {
 		return outputCmd(shellError(err))
 	}
+	parts, c.verbosity = extractVerbosity(parts, c.state.Verbosity)
 	if len(parts) == 0 {
 		return nil
 	}
+	fix := c.correctTypo(parts)
+	if fix.unknown != "" {
+		return outputCmd(fix.unknown + " Type 'help' for available commands.")
+	}
+	cmd := c.withVerbosity(c.dispatchCommand(fix.parts), time.Now())
+	if fix.assumed != "" {
+		note := formatter.Dim(fmt.Sprintf("(assuming: %s)", fix.assumed))
+		cmd = mapOutput(cmd, func(out string) string { return note + "\n" + out })
+	}
+	return cmd
+}
+
+// extractVerbosity removes --quiet and --verbose from parts, returning the
+// verbosity they select (the last one wins) or def when neither is given.
+func extractVerbosity(parts []string, def formatter.Verbosity) ([]string, formatter.Verbosity) {
+	kept := parts[:0:0]
+	for _, p := range parts {
+		switch p {
+		case "--quiet":
+			def = formatter.VerbosityQuiet
+		case "--verbose":
+			def = formatter.VerbosityVerbose
+		default:
+			kept = append(kept, p)
+		}
+	}
+	return kept, def
+}
+
+// withVerbosity applies the command's verbosity to the output cmd produces:
+// quiet trims success confirmations to one line, verbose appends timing and
+// the active project and item IDs.
+func (c *commandBar) withVerbosity(cmd tea.Cmd, startedAt time.Time) tea.Cmd {
+	verbosity := c.verbosity
+	if cmd == nil || verbosity == formatter.VerbosityNormal {
+		return cmd
+	}
+	state := c.state
+	return mapOutput(cmd, func(out string) string {
+		if verbosity == formatter.VerbosityQuiet {
+			return formatter.QuietOutput(out)
+		}
+		return out + "\n" + formatter.VerboseFooter(time.Since(startedAt), activeContextIDs(state))
+	})
+}
+
+// mapOutput rewrites the text of every cmdOutputMsg cmd produces. Batched
+// commands are wrapped one by one; navigation and other messages pass
+// through.
+func mapOutput(cmd tea.Cmd, fn func(string) string) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case cmdOutputMsg:
+			msg.output = fn(msg.output)
+			return msg
+		case tea.BatchMsg:
+			wrapped := make(tea.BatchMsg, len(msg))
+			for i, sub := range msg {
+				wrapped[i] = mapOutput(sub, fn)
+			}
+			return wrapped
+		default:
+			return msg
+		}
+	}
+}
+
+// activeContextIDs lists the full IDs of the active project and item for
+// verbose output.
+func activeContextIDs(state *SharedState) []string {
+	var ids []string
+	if state.ActiveProjectID != "" {
+		ids = append(ids, "project "+state.ActiveProjectID)
+	}
+	if state.ActiveItemID != "" {
+		ids = append(ids, "item "+state.ActiveItemID)
+	}
+	return ids
+}
+
+// dispatchCommand routes a split command line to its handler.
+func (c *commandBar) dispatchCommand(parts []string) tea.Cmd {
 	cmd := strings.ToLower(parts[0])
 	args := parts[1:]
 
@@ -38,9 +128,17 @@ func (c *commandBar) executeCommand(input string) tea.Cmd {
 	case "inspect":
 		return c.cmdInspect(args)
 	case "status":
-		return c.cmdStatus()
+		return c.cmdStatus(args)
+	case "timeline":
+		return c.cmdTimeline(args)
+	case "today":
+		return c.cmdToday(args)
 	case "what-now":
 		return c.cmdWhatNow(args)
+	case "plan":
+		return c.cmdPlan(args)
+	case "weekly":
+		return c.cmdWeekly(args)
 	case "log":
 		return c.cmdLog(args)
 	case "start":
@@ -60,11 +158,7 @@ func (c *commandBar) executeCommand(input string) tea.Cmd {
 	case "context":
 		return c.cmdContext(args)
 	case "draft":
-		description := ""
-		if len(args) > 0 {
-			description = strings.Join(args, " ")
-		}
-		return pushView(newDraftView(c.state, description))
+		return c.cmdDraft(args)
 	case "help":
 		if len(args) > 0 && args[0] == "chat" {
 			question := ""
@@ -76,19 +170,23 @@ func (c *commandBar) executeCommand(input string) tea.Cmd {
 			}
 			return pushView(newHelpChatView(c.state))
 		}
+		if len(args) > 0 && args[0] == "commands" {
+			return c.cmdHelpCommands(args[1:])
+		}
 		return outputCmd(formatter.FormatShellHelp())
 	case "clear":
 		return nil
 	case "exit", "quit":
 		return tea.Quit
 	case "import":
-		if len(args) == 0 {
-			return outputCmd(formatter.StyleYellow.Render("Usage: import <file.json>"))
+		positional, flags := parseShellFlags(args)
+		if len(positional) == 0 {
+			return outputCmd(formatter.StyleYellow.Render("Usage: import <file.json> [--merge] [--prune] [--dry-run]"))
 		}
 		return tea.Batch(
 			asyncOutputCmd(func() string {
 				ctx := context.Background()
-				result, err := execImport(ctx, c.state.App, args[0])
+				result, err := execImport(ctx, c.state.App, positional[0], flags)
 				if err != nil {
 					return shellError(err)
 				}
@@ -96,6 +194,24 @@ func (c *commandBar) executeCommand(input string) tea.Cmd {
 			}),
 			func() tea.Msg { return refreshViewMsg{} },
 		)
+	case "export":
+		return c.cmdExport(args)
+	case "stats":
+		return c.cmdStats(args)
+	case "focus":
+		return c.cmdFocus(args)
+	case "profile":
+		return c.cmdProfile(args)
+	case "config":
+		return c.cmdConfig(args)
+	case "history":
+		return c.cmdHistory(args)
+	case "archive":
+		return c.cmdArchive(args)
+	case "debug":
+		return c.cmdDebug(args)
+	case "llm":
+		return c.cmdLLM(args)
 	case "project":
 		return c.cmdEntityGroup(parts)
 	case "node", "work", "session", "template":
@@ -134,6 +250,15 @@ func stripItemPrefix(s string) string {
 	return strings.TrimPrefix(s, "#")
 }
 
+// trimFinishArg strips a trailing "done" or "!" from log args, reporting
+// whether it was present: "log #5 30 done" logs and finishes the item.
+func trimFinishArg(args []string) ([]string, bool) {
+	if n := len(args); n > 0 && (args[n-1] == "done" || args[n-1] == "!") {
+		return args[:n-1], true
+	}
+	return args, false
+}
+
 // parseLogArgs separates a mixed arg list into an item reference and a duration.
 // Supports: "log 60", "log #5 45", "log #5", "log myitem 30".
 func parseLogArgs(args []string) (itemArg, minutesArg string) {
@@ -172,17 +297,22 @@ func (c *commandBar) ensureProject(next func() tea.Cmd) tea.Cmd {
 }
 
 // explainWithFallback tries the LLM explain service, falling back to a
-// deterministic function if the service is nil or returns an error.
+// deterministic function if the service is nil or returns an error. A
+// fallback caused by an unreachable server is flagged so the notice shows.
 func (c *commandBar) explainWithFallback(
 	llmFn func() (*intelligence.LLMExplanation, error),
 	fallback func() *intelligence.LLMExplanation,
 ) *intelligence.LLMExplanation {
-	if c.state.App.Explain != nil {
-		if explanation, err := llmFn(); err == nil {
-			return explanation
-		}
+	if c.state.App.Explain == nil {
+		return fallback()
+	}
+	explanation, err := llmFn()
+	if err == nil {
+		return explanation
 	}
-	return fallback()
+	result := fallback()
+	result.Unavailable = errors.Is(err, llm.ErrLLMUnavailable)
+	return result
 }
 
 // ── replan command ───────────────────────────────────────────────────────────
@@ -194,11 +324,12 @@ func (c *commandBar) cmdReplan(args []string) tea.Cmd {
 			ctx := context.Background()
 			req := kairosapp.NewReplanRequest(domain.TriggerManual)
 
-			// Parse --strategy flag if present.
+			// Parse --strategy and --dry-run flags if present.
 			_, flags := parseShellFlags(args)
 			if v, ok := flags["strategy"]; ok {
 				req.Strategy = v
 			}
+			req.DryRun = flags["dry-run"] == "true"
 
 			resp, err := c.state.App.Replan.Replan(ctx, req)
 			if err != nil {
@@ -206,7 +337,12 @@ func (c *commandBar) cmdReplan(args []string) tea.Cmd {
 			}
 
 			var b strings.Builder
-			b.WriteString(formatter.Header("Replan Results"))
+			if resp.DryRun {
+				b.WriteString(formatter.Header("Replan Preview"))
+				b.WriteString("\n  " + formatter.StyleYellow.Render("Dry run — nothing was saved. Run 'replan' to apply."))
+			} else {
+				b.WriteString(formatter.Header("Replan Results"))
+			}
 			b.WriteString(fmt.Sprintf("\n  Trigger:    %s\n", string(resp.Trigger)))
 			b.WriteString(fmt.Sprintf("  Strategy:   %s\n", resp.Strategy))
 			b.WriteString(fmt.Sprintf("  Projects:   %d recomputed\n", resp.RecomputedProjects))
@@ -225,7 +361,8 @@ func (c *commandBar) cmdReplan(args []string) tea.Cmd {
 						fmt.Sprintf("%d items", d.ChangedItemsCount),
 					})
 				}
-				b.WriteString(formatter.RenderTable(headers, rows))
+				b.WriteString(formatter.RenderTable(headers, rows, c.state.Width))
+				b.WriteString(formatter.FormatReplanItemChanges(resp.Deltas))
 			} else {
 				b.WriteString(formatter.Dim("  No changes needed."))
 			}
@@ -2,13 +2,19 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/service"
 	"github.com/alexanderramin/kairos/internal/testutil"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +25,7 @@ func testCommandBar(t *testing.T, app *App) *commandBar {
 	state := &SharedState{
 		App:   app,
 		Cache: newShellProjectCache(),
+		Inspect: newShellInspectCache(),
 		Width: 120,
 		Height: 40,
 	}
@@ -229,6 +236,51 @@ func TestCommandBar_ReplanPassesThrough(t *testing.T) {
 	}
 }
 
+func TestCommandBar_ReplanDryRunDoesNotSave(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+	ctx := context.Background()
+
+	before, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+
+	out := execCmdAsync(cb, "replan --dry-run")
+	assert.Contains(t, out, "REPLAN PREVIEW")
+	assert.Contains(t, out, "nothing was saved")
+
+	after, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, before.PlannedMin, after.PlannedMin)
+	assert.Equal(t, before.UpdatedAt, after.UpdatedAt)
+}
+
+func TestCommandBar_ReplanListsEstimateChanges(t *testing.T) {
+	app := testApp(t)
+	_, nodeID, _ := seedProjectCore(t, app, seedOpts{name: "Study"})
+	cb := testCommandBar(t, app)
+	ctx := context.Background()
+
+	wi := testutil.NewTestWorkItem(nodeID, "Read Chapters",
+		testutil.WithPlannedMin(100),
+		testutil.WithLoggedMin(60),
+		testutil.WithUnits("chapters", 10, 3),
+		testutil.WithDurationMode(domain.DurationEstimate),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, app.WorkItems.Create(ctx, wi))
+
+	out := execCmdAsync(cb, "replan")
+	assert.Contains(t, out, "REPLAN RESULTS")
+	assert.Contains(t, out, "Estimate changes")
+	assert.Contains(t, out, "Read Chapters  1h 40m → 2h 10m  +30m")
+	assert.Contains(t, out, "20m per unit (1h over 3/10 chapters) → 3h 20m at that pace")
+
+	stored, err := app.WorkItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 130, stored.PlannedMin)
+}
+
 // --- Multi-step journey test ---
 
 func TestCommandBar_MultiStepJourney(t *testing.T) {
@@ -465,6 +517,74 @@ func TestCommandBar_ProjectImport_EmitsRefreshForDashboard(t *testing.T) {
 	assert.Equal(t, "RFS01", projects[0].ShortID)
 }
 
+func TestCommandBar_ImportMergeUpdatesExistingProject(t *testing.T) {
+	app := testAppFull(t)
+	ctx := context.Background()
+
+	writePlan := func(title string) string {
+		planJSON := `{
+			"project": {"short_id": "MRG01", "name": "Merge Test", "domain": "education", "start_date": "2026-01-15"},
+			"nodes": [{"ref": "n1", "title": "Week 1", "kind": "week", "order": 0}],
+			"work_items": [{"ref": "w1", "node_ref": "n1", "title": "` + title + `", "type": "reading", "planned_min": 60}]
+		}`
+		path := filepath.Join(t.TempDir(), "plan.json")
+		require.NoError(t, os.WriteFile(path, []byte(planJSON), 0o644))
+		return path
+	}
+
+	cb := testCommandBar(t, app)
+	execCmdAsync(cb, "import "+writePlan("Read"))
+	out := execCmdAsync(cb, "import "+writePlan("Read carefully")+" --merge")
+	assert.Contains(t, out, "Merged into")
+
+	projects, err := app.Projects.List(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, projects, 1, "merge should not create a second project")
+	items, err := app.WorkItems.ListByProject(ctx, projects[0].ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Read carefully", items[0].Title)
+}
+
+func TestCommandBar_ImportPruneRequiresMerge(t *testing.T) {
+	app := testAppFull(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "project import plan.json --prune")
+	assert.Contains(t, out, "--prune requires --merge")
+}
+
+func TestCommandBar_ImportDryRun(t *testing.T) {
+	app := testAppFull(t)
+	ctx := context.Background()
+
+	writePlan := func(nodeRef string) string {
+		planJSON := `{
+			"project": {"short_id": "DRY01", "name": "Dry Run", "domain": "education", "start_date": "2026-01-15"},
+			"nodes": [{"ref": "n1", "title": "Week 1", "kind": "week", "order": 0}],
+			"work_items": [{"ref": "w1", "node_ref": "` + nodeRef + `", "title": "Read", "type": "reading", "planned_min": 60}]
+		}`
+		path := filepath.Join(t.TempDir(), "plan.json")
+		require.NoError(t, os.WriteFile(path, []byte(planJSON), 0o644))
+		return path
+	}
+
+	cb := testCommandBar(t, app)
+	out := execCmdAsync(cb, "import "+writePlan("n1")+" --dry-run")
+	assert.Contains(t, out, "would create 1 project")
+	assert.Contains(t, out, "1 nodes, 1 items")
+
+	out = execCmdAsync(cb, "project import "+writePlan("n2")+" --dry-run")
+	assert.Contains(t, out, "1 problem(s)")
+	assert.Contains(t, out, "n2")
+
+	projects, err := app.Projects.List(ctx, true)
+	require.NoError(t, err)
+	assert.Empty(t, projects, "dry runs write nothing")
+
+	assert.Contains(t, execCmdAsync(cb, "import plan.json --dry-run --merge"), "cannot be combined")
+}
+
 func TestCommandBar_UseContextScopesScheduling(t *testing.T) {
 	app := testApp(t)
 	ctx := context.Background()
@@ -506,6 +626,359 @@ func TestCommandBar_SessionLogViaShell(t *testing.T) {
 	assert.Equal(t, domain.WorkItemInProgress, wi.Status, "should auto-transition to in_progress")
 }
 
+func TestCommandBar_SessionLogFinish(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --finish")
+	assert.Contains(t, out, "marked the item done")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, wi.LoggedMin)
+	assert.Equal(t, domain.WorkItemDone, wi.Status)
+}
+
+func TestCommandBar_SessionLogContinueTimer(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	assert.Contains(t, execCmdAsync(cb, "session log --continue-timer"), "no timer is running")
+
+	cb.startExecute(wiID)
+	require.True(t, cb.state.TimerRunning())
+	started := time.Now().Add(-40 * time.Minute)
+	cb.state.TimerStartedAt = started
+
+	assert.Contains(t, execCmdAsync(cb, "session log --continue-timer --yesterday"), "drop --yesterday")
+
+	// A manual log while the timer runs restarts it instead of letting the
+	// same time be logged again later.
+	out := execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30")
+	assert.Contains(t, out, "had run 40m")
+	assert.Contains(t, out, "--continue-timer")
+	require.True(t, cb.state.TimerRunning())
+	assert.WithinDuration(t, time.Now(), cb.state.TimerStartedAt, time.Minute)
+
+	cb.state.TimerStartedAt = started
+	out = execCmdAsync(cb, "session log --continue-timer --note drafting")
+	assert.Contains(t, out, "Logged 40m")
+	assert.False(t, cb.state.TimerRunning(), "logging the timer stops it")
+
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	var timed *domain.WorkSessionLog
+	for _, s := range sessions {
+		if s.Note == "drafting" {
+			timed = s
+		}
+	}
+	require.NotNil(t, timed)
+	assert.Equal(t, 40, timed.Minutes)
+	assert.WithinDuration(t, started, timed.StartedAt, time.Second, "the session starts when the timer did")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 70, wi.LoggedMin)
+}
+
+func TestCommandBar_WorkInspectHistory(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "work inspect "+wiID+" --history")
+	assert.Contains(t, out, "no sessions logged")
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --note yday --yesterday")
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 45 --note early --days-ago 3")
+
+	out = execCmdAsync(cb, "work inspect "+wiID)
+	assert.NotContains(t, out, "SESSION HISTORY", "history is opt-in")
+
+	out = execCmdAsync(cb, "work inspect "+wiID+" --history")
+	assert.Contains(t, out, "SESSION HISTORY")
+	assert.Contains(t, out, "TOTAL")
+	assert.NotContains(t, out, "WORK ITEM", "scoped to one item")
+	early, yday := strings.Index(out, "early"), strings.Index(out, "yday")
+	require.True(t, early >= 0 && yday >= 0)
+	assert.Less(t, early, yday, "oldest session first")
+	assert.Equal(t, 2, strings.Count(out, "1h 15m"), "logged total and the running total after both sessions")
+}
+
+func TestCommandBar_WorkDoneWithLog(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "work done "+wiID+" --log 25")
+	assert.Contains(t, out, "25m")
+	assert.Contains(t, out, "marked it done")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 25, wi.LoggedMin)
+	assert.Equal(t, domain.WorkItemDone, wi.Status)
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	assert.Contains(t, execCmdAsync(cb, "work done "+wiID+" --log soon"), "invalid minutes")
+}
+
+func TestCommandBar_SessionTagsAndReport(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 60 --tag Billable,research,billable")
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --tag billable")
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 15")
+
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	assert.Equal(t, []string{"billable", "research"}, sessions[0].Tags, "tags should be lowercased and deduplicated")
+
+	out := execCmdAsync(cb, "session report --group-by tag")
+	assert.Contains(t, out, "billable")
+	assert.Contains(t, out, "1h 30m")
+	assert.Contains(t, out, "research")
+	assert.Contains(t, out, "(untagged)")
+	assert.Contains(t, out, "15m")
+
+	past := time.Now().AddDate(0, 0, -30).Format("2006-01-02")
+	assert.Contains(t, execCmdAsync(cb, "session report --group-by tag --from "+past+" --to "+past), "No sessions logged")
+	assert.Contains(t, execCmdAsync(cb, "session report"), "usage: session report")
+}
+
+func TestCommandBar_SessionUndoLast(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	assert.Contains(t, execCmdAsync(cb, "session undo-last"), "no logged sessions")
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30")
+	out := execCmdAsync(cb, "session undo-last")
+	assert.Contains(t, out, "Removed")
+	assert.Contains(t, out, "Reading")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, wi.LoggedMin)
+	assert.Equal(t, domain.WorkItemTodo, wi.Status)
+}
+
+func TestCommandBar_ErrorCodes(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 60")
+	assert.Contains(t, out, "no schedulable work items found")
+	assert.NotContains(t, out, "NO_CANDIDATES", "codes are for scripts, not the message")
+	assert.Contains(t, out, "Add work with: work add")
+
+	out = execCmdAsync(cb, "work inspect nope")
+	assert.Contains(t, out, "Error: work item: not found")
+	assert.Contains(t, out, "Check the ID with")
+
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	out = execCmdAsync(cb, "project inspect nope --format json")
+	require.NoError(t, json.Unmarshal([]byte(out), &body), out)
+	assert.Equal(t, "NOT_FOUND", body.Error.Code)
+	assert.Equal(t, `project not found: "nope"`, body.Error.Message)
+}
+
+func TestCommandBar_SessionLogBackdated(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	at := time.Now().AddDate(0, 0, -1).Format("2006-01-02") + " 14:00"
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 45 --at \""+at+"\"")
+
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	want, err := time.ParseInLocation("2006-01-02 15:04", at, time.Local)
+	require.NoError(t, err)
+	assert.True(t, sessions[0].StartedAt.Equal(want), "started_at should be backdated, got %s", sessions[0].StartedAt)
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 45, wi.LoggedMin)
+	assert.Equal(t, domain.WorkItemInProgress, wi.Status, "backdated session should still transition status")
+}
+
+func TestCommandBar_SessionLogYesterday(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+
+	before := time.Now()
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --yesterday")
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 20 --days-ago 3")
+	after := time.Now()
+
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	byMinutes := map[int]time.Time{}
+	for _, s := range sessions {
+		byMinutes[s.Minutes] = s.StartedAt
+	}
+	for minutes, days := range map[int]int{30: 1, 20: 3} {
+		got := byMinutes[minutes]
+		assert.False(t, got.Before(before.AddDate(0, 0, -days).Truncate(time.Second)) || got.After(after.AddDate(0, 0, -days)),
+			"%dm session should start at the same clock time %d days back, got %s", minutes, days, got)
+	}
+
+	assert.Contains(t, execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 10 --days-ago 0"), "--days-ago must be")
+	assert.Contains(t, execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 10 --yesterday --at 2026-01-01"), "not both")
+	sessions, err = app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2)
+}
+
+func TestCommandBar_SessionLogRejectsFutureUnlessAllowed(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+	future := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+
+	out := execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --at "+future)
+	assert.Contains(t, out, "in the future")
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 30 --at "+future+" --allow-future")
+	sessions, err = app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestCommandBar_SessionLogValidateSessionTime(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+	execCmd(cb, "profile set validate-session-time=true")
+
+	hourAgo := time.Now().Add(-time.Hour).Format("2006-01-02 15:04")
+	out := execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 600 --at \""+hourAgo+"\"")
+	assert.Contains(t, out, "can't log 600m")
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 600 --at "+yesterday)
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 45 --at \""+hourAgo+"\"")
+	sessions, err = app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2, "a day-only --at and a session that fits are both logged")
+}
+
+func TestParseLocalTimestamp(t *testing.T) {
+	got, err := parseLocalTimestamp("2026-02-03 14:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 2, 3, 14, 0, 0, 0, time.Local), got)
+
+	got, err = parseLocalTimestamp("2026-02-03")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 2, 3, 0, 0, 0, 0, time.Local), got)
+
+	_, err = parseLocalTimestamp("yesterday-ish")
+	assert.Error(t, err)
+}
+
+func TestCommandBar_ExportWritesEnvelope(t *testing.T) {
+	app := testApp(t)
+	projID, wiID := seedProjectWithWork(t, app)
+
+	cb := testCommandBar(t, app)
+	path := filepath.Join(t.TempDir(), "export.json")
+
+	out := execCmdAsync(cb, "export --since 2020-01-01 --out "+path)
+	assert.Contains(t, out, "Exported 1 projects")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var env struct {
+		Since     string                `json:"since"`
+		Projects  []struct{ ID string } `json:"projects"`
+		WorkItems []struct{ ID string } `json:"work_items"`
+	}
+	require.NoError(t, json.Unmarshal(data, &env))
+	assert.NotEmpty(t, env.Since)
+	require.Len(t, env.Projects, 1)
+	assert.Equal(t, projID, env.Projects[0].ID)
+	require.Len(t, env.WorkItems, 1)
+	assert.Equal(t, wiID, env.WorkItems[0].ID)
+}
+
+func TestCommandBar_ExportRejectsBadSince(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "export --since last-week")
+	assert.Contains(t, out, "invalid timestamp")
+}
+
+func TestCommandBar_ProjectExportDOT(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "project export "+projID+" --format dot")
+	assert.Contains(t, out, "digraph kairos {")
+	assert.Contains(t, out, "subgraph \"cluster_n")
+	assert.Contains(t, out, "fillcolor=white")
+	assert.Equal(t, out, execCmdAsync(cb, "project export "+projID+" --format dot"), "repeated exports are identical")
+
+	out = execCmdAsync(cb, "project export "+projID+" --format svg")
+	assert.Contains(t, out, "unsupported format")
+
+	out = execCmdAsync(cb, "export --format dot")
+	assert.Contains(t, out, "active project")
+
+	cb.state.SetActiveProject(context.Background(), projID)
+	path := filepath.Join(t.TempDir(), "plan.dot")
+	out = execCmdAsync(cb, "export --format dot --out "+path)
+	assert.Contains(t, out, "as DOT to")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "digraph kairos {")
+}
+
 func TestCommandBar_DestructiveProjectRemove_ForceBypasses(t *testing.T) {
 	app := testApp(t)
 	ctx := context.Background()
@@ -606,3 +1079,1568 @@ func TestCommandBar_WorkArchive_RequiresConfirmation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, wi.ArchivedAt, "work item should not be archived before confirmation")
 }
+
+func TestCommandBar_ProjectArchiveWithDone(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, wiID := seedProjectWithWork(t, app)
+	require.NoError(t, app.WorkItems.MarkDone(ctx, wiID))
+	cb := testCommandBar(t, app)
+
+	// Bulk archive goes through the destructive-command confirmation.
+	cmd := cb.executeCommand("project archive " + projID + " --with-done")
+	require.NotNil(t, cmd)
+	_, isPush := cmd().(pushViewMsg)
+	assert.True(t, isPush, "bulk archive should push a confirmation wizard")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemDone, wi.Status)
+
+	out := execCmdAsync(cb, "project archive "+projID+" --with-done --yes")
+	assert.Contains(t, out, "Archived 1 done work item(s)")
+
+	wi, err = app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemArchived, wi.Status)
+	p, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Nil(t, p.ArchivedAt, "the project itself stays active")
+
+	out = execCmdAsync(cb, "project archive --with-done --all --yes")
+	assert.Contains(t, out, "No done work items to archive in all projects")
+
+	out = execCmdAsync(cb, "project archive --with-done --yes")
+	assert.Contains(t, out, "usage: project archive <id> --with-done")
+}
+
+func TestCommandBar_History(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	execCmd(cb, "work bump "+wiID+" +30")
+	execCmd(cb, "work done "+wiID)
+
+	out := execCmd(cb, "history "+wiID)
+	assert.Contains(t, out, "HISTORY: READING")
+	assert.Contains(t, out, "bump-estimate")
+	assert.Contains(t, out, "60 → 90")
+	assert.Contains(t, out, "mark-done")
+
+	out = execCmd(cb, "history unknown-id")
+	assert.Contains(t, out, "No recorded changes")
+
+	out = execCmd(cb, "history")
+	assert.Contains(t, out, "Usage: history <id>")
+}
+
+func TestCommandBar_DebugTimings(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "debug timings")
+	assert.Contains(t, out, "not configured")
+
+	app.Timings = service.NewTimingUseCaseObserver()
+	out = execCmd(cb, "debug timings")
+	assert.Contains(t, out, "No use cases have run yet")
+
+	app.Timings.ObserveUseCase(context.Background(), service.UseCaseEvent{Name: "what-now", Duration: 120 * time.Millisecond, Success: true})
+	out = execCmd(cb, "debug timings")
+	assert.Contains(t, out, "what-now")
+	assert.Contains(t, out, "120.0ms")
+
+	out = execCmd(cb, "debug")
+	assert.Contains(t, out, "Usage: debug timings")
+}
+
+func TestCommandBar_HelpCommands(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "help commands")
+	assert.Contains(t, out, "what-now")
+	assert.Contains(t, out, "template validate")
+
+	out = execCmd(cb, "help commands --search pomodoro")
+	assert.Contains(t, out, `COMMANDS MATCHING "POMODORO"`)
+	assert.Contains(t, out, "session log")
+	assert.Contains(t, out, "--work-item STRING")
+	assert.Contains(t, out, "(required)")
+	assert.NotContains(t, out, "template validate")
+
+	out = execCmd(cb, "help commands --search xyzzy")
+	assert.Contains(t, out, "No matching commands")
+
+	out = execCmd(cb, "help commands --search")
+	assert.Contains(t, out, "Usage: help commands")
+}
+
+func TestCommandBar_WorkPresets(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+	ctx := context.Background()
+
+	out := execCmd(cb, "work preset list")
+	assert.Contains(t, out, "No presets yet")
+
+	out = execCmd(cb, "work preset save Reading45 --type reading --planned-min 45 --bounds 15/60/30")
+	assert.Contains(t, out, "Saved preset reading45")
+	assert.Contains(t, out, "sessions 15m–1h (30m)")
+
+	out = execCmd(cb, "work preset save bad --bounds 60/15")
+	assert.Contains(t, out, "min exceeds max")
+
+	out = execCmd(cb, "work preset list")
+	assert.Contains(t, out, "reading45")
+
+	nodes, err := app.Nodes.ListByProject(ctx, projID)
+	require.NoError(t, err)
+	nodeID := nodes[0].ID
+
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Chapter --preset reading45")
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Skim --preset reading45 --planned-min 20 --type review")
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	byTitle := map[string]*domain.WorkItem{}
+	for _, w := range items {
+		byTitle[w.Title] = w
+	}
+	require.Contains(t, byTitle, "Chapter")
+	assert.Equal(t, "reading", byTitle["Chapter"].Type)
+	assert.Equal(t, 45, byTitle["Chapter"].PlannedMin)
+	assert.Equal(t, []int{15, 60, 30}, []int{byTitle["Chapter"].MinSessionMin, byTitle["Chapter"].MaxSessionMin, byTitle["Chapter"].DefaultSessionMin})
+	require.Contains(t, byTitle, "Skim")
+	assert.Equal(t, "review", byTitle["Skim"].Type, "explicit flags override the preset")
+	assert.Equal(t, 20, byTitle["Skim"].PlannedMin)
+	assert.Equal(t, 60, byTitle["Skim"].MaxSessionMin)
+
+	out = execCmdAsync(cb, "work add --node "+nodeID+" --title X --preset nope")
+	assert.Contains(t, out, `no work preset named "nope"`)
+
+	out = execCmd(cb, "work preset remove reading45")
+	assert.Contains(t, out, "Removed preset reading45")
+}
+
+func TestCommandBar_ArchiveListAndPurge(t *testing.T) {
+	app := testApp(t)
+	projID, wiID := seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	cb := testCommandBar(t, app)
+	ctx := context.Background()
+
+	out := execCmdAsync(cb, "archive list")
+	assert.Contains(t, out, "Nothing is archived.")
+
+	require.NoError(t, app.WorkItems.Archive(ctx, wiID))
+	out = execCmdAsync(cb, "archive list")
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "Philosophy")
+
+	out = execCmdAsync(cb, "archive purge")
+	assert.Contains(t, out, "needs --older-than")
+	out = execCmdAsync(cb, "archive purge --older-than soon")
+	assert.Contains(t, out, `invalid --older-than "soon"`)
+
+	// Archived just now, so a 90-day window keeps it.
+	out = execCmdAsync(cb, "archive purge --older-than 90d --dry-run")
+	assert.Contains(t, out, "Nothing archived before")
+	_, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+
+	out, err = execArchivePurge(ctx, app, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged 0 project(s) and 1 work item(s)")
+	_, err = app.WorkItems.GetByID(ctx, wiID)
+	assert.Error(t, err)
+	_, err = app.Projects.GetByID(ctx, projID)
+	assert.NoError(t, err, "purging an item leaves its active project")
+}
+
+func TestCommandBar_ProjectWorkDefaults(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	cb := testCommandBar(t, app)
+	ctx := context.Background()
+
+	nodes, err := app.Nodes.ListByProject(ctx, projID)
+	require.NoError(t, err)
+	nodeID := nodes[0].ID
+
+	out := execCmdAsync(cb, "work add --node "+nodeID+" --title NoType")
+	assert.Contains(t, out, "usage: work add", "without a default type --type is still required")
+
+	out = execCmdAsync(cb, "project update PHI01 --default-type reading --default-planned-min 45 --default-bounds 20/90/45")
+	assert.Contains(t, out, "Work defaults: reading · 45m · sessions 20m–1h 30m (45m)")
+
+	out = execCmdAsync(cb, "project update PHI01 --default-type novel")
+	assert.Contains(t, out, `invalid default work item type "novel"`)
+
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Chapter")
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Drill --type exercise --planned-min 20 --max-session 30")
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	byTitle := map[string]*domain.WorkItem{}
+	for _, w := range items {
+		byTitle[w.Title] = w
+	}
+	require.Contains(t, byTitle, "Chapter")
+	assert.Equal(t, "reading", byTitle["Chapter"].Type)
+	assert.Equal(t, 45, byTitle["Chapter"].PlannedMin)
+	assert.Equal(t, []int{20, 90, 45}, []int{byTitle["Chapter"].MinSessionMin, byTitle["Chapter"].MaxSessionMin, byTitle["Chapter"].DefaultSessionMin})
+	require.Contains(t, byTitle, "Drill")
+	assert.Equal(t, "exercise", byTitle["Drill"].Type, "explicit flags win over project defaults")
+	assert.Equal(t, 20, byTitle["Drill"].PlannedMin)
+	assert.Equal(t, 30, byTitle["Drill"].MaxSessionMin)
+
+	out = execCmdAsync(cb, "project update PHI01 --clear-defaults")
+	assert.Contains(t, out, "Work defaults: none")
+	p, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.True(t, p.WorkDefaults.IsZero())
+}
+
+func TestCommandBar_NarrowTerminalStacksTables(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+	cb.state.Width = 40
+
+	for _, cmd := range []string{"projects", "status"} {
+		out := execCmd(cb, cmd)
+		assert.Contains(t, out, "CLI Test Project", cmd)
+		for _, line := range strings.Split(out, "\n") {
+			assert.LessOrEqual(t, lipgloss.Width(line), 40, "%s: line %q", cmd, line)
+		}
+	}
+}
+
+func TestCommandBar_StatusCompare(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "status --compare 2020-01-01")
+	assert.Contains(t, out, "CHANGE")
+	assert.Contains(t, out, "new")
+
+	out = execCmd(cb, "status --compare soon")
+	assert.Contains(t, out, "invalid timestamp")
+}
+
+func TestCommandBar_StatusRiskFilter(t *testing.T) {
+	app := testApp(t)
+	seedProjectCore(t, app, seedOpts{name: "Relaxed Reading"})
+	seedProjectCore(t, app, seedOpts{name: "Crunch Thesis", plannedMin: 100000})
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "status --risk critical")
+	assert.Contains(t, out, "Crunch Thesis")
+	assert.NotContains(t, out, "Relaxed Reading")
+	assert.Contains(t, out, "1 Critical", "summary still counts every project")
+	assert.Contains(t, out, "1 On Track")
+
+	out = execCmd(cb, "status --risk on-track")
+	assert.Contains(t, out, "Relaxed Reading")
+	assert.NotContains(t, out, "Crunch Thesis")
+
+	out = execCmd(cb, "status --risk critical --risk on_track")
+	assert.Contains(t, out, "Relaxed Reading")
+	assert.Contains(t, out, "Crunch Thesis")
+
+	assert.Contains(t, execCmd(cb, "status --risk at-risk"), "No projects at that risk level")
+	assert.Contains(t, execCmd(cb, "status --risk burning"), "unknown risk tier")
+}
+
+func TestCommandBar_Timeline(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, _ := seedProjectCore(t, app, seedOpts{name: "Timeline Project"})
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	overdue := testutil.NewTestWorkItem(nodeID, "Overdue essay", testutil.WithWorkItemDueDate(today.AddDate(0, 0, -2)))
+	require.NoError(t, app.WorkItems.Create(ctx, overdue))
+	finished := testutil.NewTestWorkItem(nodeID, "Finished quiz",
+		testutil.WithWorkItemDueDate(today.AddDate(0, 0, 1)), testutil.WithWorkItemStatus(domain.WorkItemDone))
+	require.NoError(t, app.WorkItems.Create(ctx, finished))
+	later := testutil.NewTestWorkItem(nodeID, "Far exam", testutil.WithWorkItemDueDate(today.AddDate(0, 0, 60)))
+	require.NoError(t, app.WorkItems.Create(ctx, later))
+	week := testutil.NewTestNode(projID, "Week 2", testutil.WithNodeDueDate(today.AddDate(0, 0, 5)), testutil.WithOrderIndex(1))
+	require.NoError(t, app.Nodes.Create(ctx, week))
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(week.ID, "Problem set")))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "timeline")
+	assert.Contains(t, out, "2d overdue")
+	assert.Contains(t, out, "in 5d")
+	assert.Contains(t, out, "Week 2")
+	assert.Less(t, strings.Index(out, "Overdue essay"), strings.Index(out, "Week 2"), "overdue first")
+	assert.NotContains(t, out, "Finished quiz")
+	assert.NotContains(t, out, "Far exam")
+	assert.NotContains(t, out, "project", "target date is months away")
+
+	out = execCmd(cb, "timeline --days 120")
+	assert.Contains(t, out, "Far exam")
+	assert.Contains(t, out, "Timeline Project")
+
+	assert.Contains(t, execCmd(cb, "timeline --days soon"), "usage: timeline")
+}
+
+func TestCommandBar_Today(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, wiID := seedProjectCore(t, app, seedOpts{name: "Today Project", plannedMin: 300})
+	y, m, d := time.Now().Date()
+	due := testutil.NewTestWorkItem(nodeID, "Quiz prep", testutil.WithPlannedMin(60),
+		testutil.WithWorkItemDueDate(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, app.WorkItems.Create(ctx, due))
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 30,
+		testutil.WithStartedAt(time.Now().Add(-time.Minute)))))
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 45,
+		testutil.WithStartedAt(time.Now().AddDate(0, 0, -2)))))
+
+	profile, err := app.Profile.Get(ctx)
+	require.NoError(t, err)
+	profile.BaselineDailyMin = 120
+	profile.WeekdayMin = nil
+	require.NoError(t, app.Profile.Update(ctx, profile))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "today")
+	assert.Contains(t, out, "30m of 2h")
+	assert.Contains(t, out, "Reading  Today Project  30m · 1 session", "only today's session counts")
+	assert.Contains(t, out, "Suggested for 1h 30m")
+	assert.Contains(t, out, "what-now 90 --save-plan")
+	assert.Contains(t, out, "Quiz prep  Today Project")
+
+	execCmd(cb, "what-now 90 --save-plan")
+	out = execCmd(cb, "today")
+	assert.Contains(t, out, "Saved plan")
+	assert.NotContains(t, out, "Suggested for")
+
+	// No time set aside today: no goal, and the plan still shows.
+	profile.WeekdayMin = make([]int, 7)
+	require.NoError(t, app.Profile.Update(ctx, profile))
+	out = execCmd(cb, "today")
+	assert.Contains(t, out, "No time set aside for "+time.Now().Weekday().String())
+	assert.Contains(t, out, "Saved plan")
+
+	assert.Contains(t, execCmd(cb, "today please"), "usage: today")
+}
+
+func TestBuildToday_GoalMetAndNothingLogged(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, _, wiID := seedProjectCore(t, app, seedOpts{})
+	now := time.Now()
+
+	s, err := buildToday(ctx, app, "", now)
+	require.NoError(t, err)
+	assert.Empty(t, s.Logged)
+	assert.NotEmpty(t, s.Suggested)
+	assert.Contains(t, formatter.FormatToday(s, now), "Nothing logged yet today.")
+	assert.Contains(t, formatter.FormatToday(s, now), "Nothing due today.")
+
+	profile, err := app.Profile.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, profile.AvailableMinOn(now.Weekday()),
+		testutil.WithStartedAt(now.Add(-time.Minute)))))
+	s, err = buildToday(ctx, app, "", now)
+	require.NoError(t, err)
+	assert.Empty(t, s.Suggested)
+	assert.Contains(t, s.PlanNote, "Daily goal met")
+}
+
+func TestCommandBar_ProjectProgressChart(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	seedProjectCore(t, app, seedOpts{name: "Later Project"})
+	soon := testutil.NewTestProject("Sooner Project", testutil.WithTargetDate(time.Now().UTC().AddDate(0, 0, 10)))
+	require.NoError(t, app.Projects.Create(ctx, soon))
+	require.NoError(t, app.Projects.Create(ctx, testutil.NewTestProject("Open Ended")))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "project progress --chart")
+	assert.Contains(t, out, "PORTFOLIO PROGRESS")
+	assert.Contains(t, out, "pts behind")
+	assert.Contains(t, out, "no target date")
+	assert.Less(t, strings.Index(out, "Sooner Project"), strings.Index(out, "Later Project"), "soonest deadline first")
+	assert.Less(t, strings.Index(out, "Later Project"), strings.Index(out, "Open Ended"), "no deadline last")
+
+	out = execCmd(cb, "project progress")
+	assert.Contains(t, out, "PACE")
+	assert.Contains(t, out, "Sooner Project")
+
+	assert.Contains(t, execCmd(cb, "project progress PHI01"), "usage: project progress")
+}
+
+func TestCommandBar_WorkEstimate(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "work estimate --type reading")
+	assert.Contains(t, out, "No completed reading items")
+	assert.Contains(t, out, "--planned-min 60")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	wi.Type = "reading"
+	require.NoError(t, app.WorkItems.Update(ctx, wi))
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 50)))
+	require.NoError(t, app.WorkItems.MarkDone(ctx, wiID))
+
+	out = execCmd(cb, "work estimate --type reading")
+	assert.Contains(t, out, "50m")
+	assert.Contains(t, out, "--planned-min 50")
+
+	assert.Contains(t, execCmd(cb, "work estimate"), "usage: work estimate")
+}
+
+func TestCommandBar_ProjectDueWithTimeOfDay(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	day := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	execCmdAsync(cb, "project update "+projID+" --due \""+day+" 17:00\"")
+
+	p, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	require.NotNil(t, p.TargetDate)
+	want, err := time.ParseInLocation("2006-01-02 15:04", day+" 17:00", time.Local)
+	require.NoError(t, err)
+	assert.True(t, p.TargetDate.Equal(want), "target date should keep its time, got %s", p.TargetDate)
+
+	assert.Contains(t, execCmd(cb, "status"), "17:00")
+
+	// Date-only input is still stored as a plain date.
+	execCmdAsync(cb, "project update "+projID+" --due "+day)
+	p, err = app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, day, domain.FormatDeadline(*p.TargetDate))
+}
+
+func TestCommandBar_ProjectInspectProgress(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, wiID := seedProjectWithWork(t, app)
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 30)))
+	cb := testCommandBar(t, app)
+
+	assert.NotContains(t, execCmd(cb, "project inspect "+projID), "50%")
+	assert.Contains(t, execCmd(cb, "project inspect "+projID+" --progress"), "30m/1h 50%")
+}
+
+func TestCommandBar_ProjectInspectHideDone(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(nodeID, "Summary notes")))
+	require.NoError(t, app.WorkItems.MarkDone(ctx, wiID))
+	cb := testCommandBar(t, app)
+
+	assert.Contains(t, execCmd(cb, "project inspect "+projID), "Reading")
+
+	out := execCmd(cb, "project inspect "+projID+" --hide-done")
+	assert.NotContains(t, out, "Reading")
+	assert.Contains(t, out, "Week 1  [ 1h  (1 done hidden) ]", "the open item collapses onto its node")
+}
+
+func TestCommandBar_ProjectInspectDepth(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, _ := seedProjectCore(t, app, seedOpts{})
+	child := testutil.NewTestNode(projID, "Day 1", testutil.WithParentID(nodeID))
+	require.NoError(t, app.Nodes.Create(ctx, child))
+	grandchild := testutil.NewTestNode(projID, "Morning", testutil.WithParentID(child.ID))
+	require.NoError(t, app.Nodes.Create(ctx, grandchild))
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(grandchild.ID, "Exercises")))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "project inspect "+projID)
+	assert.Contains(t, out, "Morning", "unlimited by default")
+
+	out = execCmd(cb, "project inspect "+projID+" --depth 0")
+	assert.Contains(t, out, "Week 1")
+	assert.Contains(t, out, "(+2 nested items)", "Day 1 and Reading")
+	assert.NotContains(t, out, "Day 1")
+
+	out = execCmd(cb, "project inspect "+projID+" --depth 1")
+	assert.Contains(t, out, "Day 1")
+	assert.Contains(t, out, "(+1 nested items)")
+	assert.NotContains(t, out, "Morning")
+
+	assert.Contains(t, execCmd(cb, "project inspect "+projID+" --depth -1"), "--depth must be")
+}
+
+func TestCommandBar_ProjectInspectCache(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	cb := testCommandBar(t, app)
+
+	require.Contains(t, execCmd(cb, "project inspect "+projID), "Week 1")
+
+	// An unchanged project's tree is served from the cache.
+	key := inspectKey{projectID: projID, maxDepth: unlimitedDepth}
+	entry, ok := cb.state.Inspect.entries[key]
+	require.True(t, ok)
+	entry.tree = "cached tree"
+	cb.state.Inspect.entries[key] = entry
+	assert.Contains(t, execCmd(cb, "project inspect "+projID), "cached tree")
+
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Drills --type practice --planned-min 45")
+	added := execCmd(cb, "project inspect "+projID)
+	assert.Contains(t, added, "Drills", "adding an item invalidates the tree")
+
+	execCmdAsync(cb, "work done "+wiID)
+	assert.NotEqual(t, added, execCmd(cb, "project inspect "+projID), "finishing an item invalidates the tree")
+	assert.NotContains(t, execCmd(cb, "project inspect "+projID+" --hide-done"), "Reading")
+
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	for _, w := range items {
+		if w.Title == "Drills" {
+			execCmdAsync(cb, "work remove "+w.ID+" --yes")
+		}
+	}
+	assert.NotContains(t, execCmd(cb, "project inspect "+projID), "Drills", "removing an item invalidates the tree")
+}
+
+func TestCommandBar_ProjectInspectSessions(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(nodeID, "Drills", testutil.WithPlannedMin(45))))
+	cb := testCommandBar(t, app)
+
+	assert.NotContains(t, execCmd(cb, "project inspect "+projID), "no sessions in")
+
+	out := execCmd(cb, "project inspect "+projID+" --sessions")
+	assert.Equal(t, 3, strings.Count(out, "no sessions in 14d"), "both items and their node")
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 40")
+	out = execCmd(cb, "project inspect "+projID+" --sessions --days 7")
+	assert.Contains(t, out, "40m in 1 session · last today", "a new session invalidates the cached tree")
+	assert.Equal(t, 1, strings.Count(out, "no sessions in 7d"), "only the untouched item")
+
+	assert.Contains(t, execCmd(cb, "project inspect "+projID+" --days 7"), "--days requires --sessions")
+	assert.Contains(t, execCmd(cb, "project inspect "+projID+" --sessions --days 0"), "--days must be")
+}
+
+func TestCommandBar_NodeInspectTree(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, nodeID, _ := seedProjectCore(t, app, seedOpts{})
+
+	child := testutil.NewTestNode(projID, "Day 1", testutil.WithParentID(nodeID))
+	require.NoError(t, app.Nodes.Create(ctx, child))
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(child.ID, "Exercises", testutil.WithPlannedMin(45))))
+	sibling := testutil.NewTestNode(projID, "Week 2", testutil.WithOrderIndex(1))
+	require.NoError(t, app.Nodes.Create(ctx, sibling))
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(sibling.ID, "Essay")))
+	cb := testCommandBar(t, app)
+
+	assert.NotContains(t, execCmd(cb, "node inspect "+nodeID), "Day 1")
+
+	out := execCmd(cb, "node inspect "+nodeID+" --tree")
+	assert.Contains(t, out, "Day 1")
+	assert.Contains(t, out, "45m")
+	assert.Contains(t, out, "Reading")
+	assert.NotContains(t, out, "Week 2")
+	assert.NotContains(t, out, "Essay")
+
+	assert.Contains(t, execCmd(cb, "node inspect "+child.ID+" --tree --progress"), "0m/45m 0%")
+}
+
+func TestCommandBar_ProjectShift(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, _ := seedProjectWithWork(t, app)
+	before, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "project shift "+projID+" --by +2w")
+	assert.Contains(t, out, "by +14 days")
+	after, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, before.StartDate.AddDate(0, 0, 14).Format("2006-01-02"), after.StartDate.Format("2006-01-02"))
+	assert.Equal(t, before.TargetDate.AddDate(0, 0, 14).Format("2006-01-02"), after.TargetDate.Format("2006-01-02"))
+
+	out = execCmd(cb, "project shift "+projID+" --from "+before.StartDate.Format("2006-01-02"))
+	assert.Contains(t, out, "by -14 days")
+	after, err = app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, before.StartDate.Format("2006-01-02"), after.StartDate.Format("2006-01-02"))
+
+	assert.Contains(t, execCmd(cb, "project shift "+projID), "usage: project shift")
+	assert.Contains(t, execCmd(cb, "project shift "+projID+" --by soon"), "invalid --by")
+}
+
+func TestCommandBar_ProjectUpdateImportance(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	before, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultImportance, before.Importance)
+
+	out := execCmdAsync(cb, "project update "+projID+" --importance 5")
+	assert.Contains(t, out, "Updated project")
+	after, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, after.Importance)
+
+	out = execCmdAsync(cb, "project inspect "+projID)
+	assert.Contains(t, out, "5/5")
+
+	out = execCmdAsync(cb, "project update "+projID+" --importance 9")
+	assert.Contains(t, out, "importance must be a number from 1 to 5")
+	after, err = app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, after.Importance)
+
+	out = execCmd(cb, "profile set weight-importance=2")
+	assert.Contains(t, out, "weight-importance 2.0")
+}
+
+func TestCommandBar_StatsAccuracy(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "stats accuracy")
+	assert.Contains(t, out, "No completed work items")
+
+	_, wiID := seedProjectWithWork(t, app)
+	ctx := context.Background()
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 30)))
+	require.NoError(t, app.WorkItems.MarkDone(ctx, wiID))
+
+	out = execCmd(cb, "stats accuracy")
+	assert.Contains(t, out, "TYPE")
+	assert.Contains(t, out, "MEAN")
+
+	out = execCmd(cb, "stats")
+	assert.Contains(t, out, "Usage: stats accuracy")
+}
+
+func TestCommandBar_WorkUpdateResetEstimate(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	execCmdAsync(cb, "work update "+wiID+" --planned-min 91")
+	out := execCmd(cb, "work inspect "+wiID)
+	assert.Contains(t, out, "est 1h → now 1h 31m")
+
+	out = execCmdAsync(cb, "work update "+wiID+" --reset-estimate")
+	assert.Contains(t, out, "estimate reset to 1h")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 60, wi.PlannedMin)
+	assert.Equal(t, 60, wi.InitialPlannedMin)
+
+	out = execCmdAsync(cb, "work update "+wiID+" --planned-min 30 --reset-estimate")
+	assert.Contains(t, out, "mutually exclusive")
+}
+
+func TestCommandBar_WorkUpdateUnits(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	execCmdAsync(cb, "work update "+wiID+" --planned-units 30 --units-done 12")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, wi.UnitsTotal)
+	assert.Equal(t, 12, wi.UnitsDone)
+	assert.Contains(t, execCmd(cb, "work inspect "+wiID), "12/30 units")
+
+	out := execCmdAsync(cb, "work update "+wiID+" --units-done 31")
+	assert.Contains(t, out, "exceeds planned units")
+
+	out = execCmdAsync(cb, "work update "+wiID+" --planned-units lots")
+	assert.Contains(t, out, "invalid --planned-units")
+}
+
+func TestCommandBar_WorkListFilters(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "work list")
+	assert.Contains(t, out, "no active project")
+
+	nodes, err := app.Nodes.ListByProject(ctx, projID)
+	require.NoError(t, err)
+	execCmdAsync(cb, "work add --node "+nodes[0].ID+" --title Drills --type practice --planned-min 45")
+	require.NoError(t, app.WorkItems.MarkInProgress(ctx, wiID))
+
+	out = execCmd(cb, "work list --project "+projID)
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "Drills")
+	assert.Contains(t, out, "2 items")
+
+	cb.state.SetActiveProject(ctx, projID)
+	out = execCmd(cb, "work list --status in-progress")
+	assert.Contains(t, out, "Reading")
+	assert.NotContains(t, out, "Drills")
+
+	out = execCmd(cb, "work list --type practice")
+	assert.Contains(t, out, "Drills")
+	assert.NotContains(t, out, "Reading")
+
+	out = execCmd(cb, "work list --status finished")
+	assert.Contains(t, out, "usage: work list")
+}
+
+func TestCommandBar_WorkSessionBounds(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	nodes, err := app.Nodes.ListByProject(ctx, projID)
+	require.NoError(t, err)
+	nodeID := nodes[0].ID
+
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Plain --type task")
+	execCmdAsync(cb, "work add --node "+nodeID+" --title Deep --type task --min-session 45 --max-session 2h --default-session 90")
+	out := execCmdAsync(cb, "work add --node "+nodeID+" --title Bad --type task --min-session 30 --default-session 20")
+	assert.Contains(t, out, "default session (20m) must lie between")
+
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	bounds := map[string][]int{}
+	for _, w := range items {
+		bounds[w.Title] = []int{w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin}
+	}
+	assert.Equal(t, []int{15, 60, 30}, bounds["Plain"], "unset bounds fall back to defaults")
+	assert.Equal(t, []int{45, 120, 90}, bounds["Deep"])
+	assert.NotContains(t, bounds, "Bad")
+
+	execCmdAsync(cb, "work update "+wiID+" --max-session 40")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 40, wi.MaxSessionMin)
+
+	out = execCmdAsync(cb, "work update "+wiID+" --min-session 50")
+	assert.Contains(t, out, "max session (40m) is below min session (50m)")
+
+	out = execCmdAsync(cb, "work update "+wiID+" --min-session soon")
+	assert.Contains(t, out, "invalid --min-session")
+}
+
+func TestCommandBar_WorkAtomic(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	execCmdAsync(cb, "work update "+wiID+" --atomic")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.False(t, wi.Splittable)
+	assert.Contains(t, execCmd(cb, "work inspect "+wiID), "Atomic:")
+
+	out := execCmd(cb, "what-now 30")
+	assert.NotContains(t, out, "Reading", "a 60m atomic item doesn't fit a 30m block")
+
+	execCmdAsync(cb, "work update "+wiID+" --atomic false")
+	wi, err = app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.True(t, wi.Splittable)
+
+	out = execCmdAsync(cb, "work update "+wiID+" --atomic maybe")
+	assert.Contains(t, out, "invalid --atomic")
+}
+
+func TestCommandBar_WorkBump(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "work bump "+wiID+" +30")
+	assert.Contains(t, out, "1h → 1h 30m")
+
+	out = execCmd(cb, "work bump "+wiID+" -5")
+	assert.Contains(t, out, "1h 30m → 1h 25m")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 85, wi.PlannedMin)
+	assert.Equal(t, 85, wi.InitialPlannedMin)
+
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 70)))
+	out = execCmd(cb, "work bump "+wiID+" -1h")
+	assert.Contains(t, out, "→ 1h 10m")
+	assert.Contains(t, out, "clamped to logged time")
+
+	out = execCmd(cb, "work bump "+wiID+" lots")
+	assert.Contains(t, out, "invalid bump")
+
+	out = execCmd(cb, "work bump "+wiID)
+	assert.Contains(t, out, "usage: work bump")
+}
+
+func TestCommandBar_WorkMoveUpDown(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	second := testutil.NewTestWorkItem(nodeID, "Essay")
+	require.NoError(t, app.WorkItems.Create(ctx, second))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "work move-up "+second.ID)
+	assert.Contains(t, out, "Moved Essay up")
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "Essay", items[0].Title)
+
+	out = execCmd(cb, "work move-up "+second.ID)
+	assert.Contains(t, out, "Essay is already first in its node")
+	out = execCmd(cb, "work move-down "+wiID)
+	assert.Contains(t, out, "Reading is already last in its node")
+
+	out = execCmd(cb, "work move-down")
+	assert.Contains(t, out, "usage: work move-down")
+}
+
+func TestCommandBar_WorkClone(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	cb := testCommandBar(t, app)
+
+	src, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+
+	out := execCmd(cb, "work clone "+wiID)
+	assert.Contains(t, out, "Cloned: "+domain.NextCloneTitle(src.Title, []string{src.Title}))
+
+	out = execCmd(cb, "work clone "+wiID+" --title Sequel")
+	assert.Contains(t, out, "Cloned: Sequel")
+
+	items, err := app.WorkItems.ListByNode(ctx, nodeID)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+
+	out = execCmd(cb, "work clone")
+	assert.Contains(t, out, "usage: work clone")
+}
+
+func TestCommandBar_WorkPriority(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, _, wiID := seedProjectCore(t, app, seedOpts{})
+	cb := testCommandBar(t, app)
+
+	assert.Contains(t, execCmd(cb, "work priority "+wiID), "priority: none")
+
+	out := execCmd(cb, "work priority "+wiID+" top")
+	assert.Contains(t, out, "priority: top")
+	w, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityTop, w.ManualPriority)
+	assert.Contains(t, execCmd(cb, "work inspect "+wiID), "top")
+
+	assert.Contains(t, execCmd(cb, "work priority "+wiID+" urgent"), "invalid priority")
+	execCmd(cb, "work priority "+wiID+" none")
+	w, err = app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityNone, w.ManualPriority)
+}
+
+func TestCommandBar_SessionLogSplit(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, wiID := seedProjectCore(t, app, seedOpts{})
+	notes := testutil.NewTestWorkItem(nodeID, "Notes", testutil.WithPlannedMin(60))
+	require.NoError(t, app.WorkItems.Create(ctx, notes))
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "session log --split \""+wiID+"=1h,"+notes.ID+"\" --minutes 90 --at \"2026-03-02 09:00\"")
+	assert.Contains(t, out, "Logged 1h 30m split across 2 items")
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "Notes")
+
+	reading, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, reading, 1)
+	other, err := app.Sessions.ListByWorkItem(ctx, notes.ID)
+	require.NoError(t, err)
+	require.Len(t, other, 1)
+	assert.Equal(t, 60, reading[0].Minutes)
+	assert.Equal(t, 30, other[0].Minutes)
+	assert.True(t, reading[0].StartedAt.Equal(other[0].StartedAt), "parts share the start time")
+
+	// A split logged while the timer runs restarts it, as a single log does.
+	cb.startExecute(wiID)
+	cb.state.TimerStartedAt = time.Now().Add(-40 * time.Minute)
+	out = execCmd(cb, "session log --split \""+wiID+"=20,"+notes.ID+"=20\"")
+	assert.Contains(t, out, "had run 40m")
+	assert.WithinDuration(t, time.Now(), cb.state.TimerStartedAt, time.Minute)
+	cb.state.StopTimer()
+
+	out = execCmd(cb, "session log --split \""+wiID+"=60,"+notes.ID+"=20\" --minutes 90")
+	assert.Contains(t, out, "parts add up to 1h 20m")
+	out = execCmd(cb, "session log --split \""+wiID+"=60,"+wiID+"=30\"")
+	assert.Contains(t, out, "appears more than once")
+	reading, err = app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	assert.Len(t, reading, 2, "rejected splits log nothing")
+}
+
+func TestCommandBar_SessionLogPomodoro(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "session log --pomodoro 2")
+	assert.Contains(t, out, "no active or recommended item")
+
+	out = execCmd(cb, "session log --pomodoro 2 --work-item "+wiID)
+	assert.Contains(t, out, "Logged 2 × 25m to")
+	assert.Contains(t, out, "Reading")
+	assert.Equal(t, wiID, cb.state.ActiveItemID)
+
+	// Falls back to the now-active item.
+	execCmd(cb, "session log --pomodoro 1 --at \"2026-03-02 09:00\"")
+
+	sessions, err := app.Sessions.ListByWorkItem(ctx, wiID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	for _, s := range sessions {
+		assert.Equal(t, 25, s.Minutes)
+	}
+
+	// Pomodoros logged while the timer runs restart it.
+	cb.startExecute(wiID)
+	cb.state.TimerStartedAt = time.Now().Add(-60 * time.Minute)
+	out = execCmd(cb, "session log --pomodoro 2")
+	assert.Contains(t, out, "had run 1h")
+	require.True(t, cb.state.TimerRunning())
+	assert.WithinDuration(t, time.Now(), cb.state.TimerStartedAt, time.Minute)
+
+	out = execCmd(cb, "session log --pomodoro zero")
+	assert.Contains(t, out, "usage: session log --pomodoro N")
+}
+
+func TestCommandBar_WorkCheck(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "work check "+wiID)
+	assert.Contains(t, out, "No checklist items")
+
+	execCmdAsync(cb, "work check "+wiID+" add Outline intro")
+	execCmdAsync(cb, "work check "+wiID+" add Write draft")
+	out = execCmdAsync(cb, "work check "+wiID+" toggle 1")
+	assert.Contains(t, out, "1/2 done")
+
+	out = execCmd(cb, "work inspect "+wiID)
+	assert.Contains(t, out, "Checklist: 1/2")
+	assert.Contains(t, out, "[x] Outline intro")
+	assert.Contains(t, out, "[ ] Write draft")
+
+	execCmdAsync(cb, "work check "+wiID+" remove 1")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ChecklistItem{{Text: "Write draft"}}, wi.Checklist)
+
+	out = execCmdAsync(cb, "work check "+wiID+" toggle 5")
+	assert.Contains(t, out, "out of range")
+	out = execCmdAsync(cb, "work check "+wiID+" rename 1")
+	assert.Contains(t, out, "usage: work check")
+}
+
+func TestCommandBar_WhatNowContinueUsesActiveItem(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+	cb.state.ActiveItemID = wiID
+
+	out := execCmd(cb, "what-now --continue 45")
+	assert.Contains(t, out, "Reading")
+	assert.NotContains(t, out, "--continue:")
+}
+
+func TestCommandBar_WhatNowAvoidSkipsProject(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 45 --avoid "+projID)
+	assert.Contains(t, out, "AVOIDED:")
+	assert.NotContains(t, out, "Reading")
+
+	out = execCmd(cb, "what-now 45 --avoid")
+	assert.Contains(t, out, "usage: what-now")
+	out = execCmd(cb, "what-now 45 --avoid NOPE99")
+	assert.Contains(t, out, "--avoid:")
+}
+
+func TestCommandBar_WhatNowContext(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, wiID := seedProjectCore(t, app, seedOpts{name: "Errands", shortID: "ERR01", plannedMin: 60})
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "work add --node "+nodeID+" --title Groceries --type task --planned-min 30 --tag @Home,errands")
+	assert.Contains(t, out, "Created")
+
+	out = execCmd(cb, "what-now 60 --context home")
+	assert.Contains(t, out, "Groceries")
+	assert.Contains(t, out, "OTHER CONTEXT: 1 items")
+
+	out = execCmd(cb, "what-now 60 --context office")
+	assert.Contains(t, out, "no open work is tagged @office")
+
+	execCmdAsync(cb, "work update "+wiID+" --tag Office")
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"office"}, wi.Tags)
+
+	execCmdAsync(cb, "work update "+wiID+" --tag \"\"")
+	wi, err = app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Empty(t, wi.Tags)
+
+	assert.Contains(t, execCmd(cb, "what-now 60 --context"), "usage: what-now")
+}
+
+func TestCommandBar_WhatNowMinBlock(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 120 --min-block 90")
+	assert.Contains(t, out, "TOO SHORT:")
+
+	out = execCmd(cb, "what-now 60 --min-block 30m")
+	assert.Contains(t, out, "Reading")
+	assert.NotContains(t, out, "TOO SHORT:")
+
+	out = execCmd(cb, "what-now 60 --min-block 0")
+	assert.Contains(t, out, "usage: what-now")
+}
+
+func TestCommandBar_WhatNowMaxProjects(t *testing.T) {
+	app := testApp(t)
+	seedProjectCore(t, app, seedOpts{shortID: "ALP01", name: "Alpha"})
+	seedProjectCore(t, app, seedOpts{shortID: "BET01", name: "Beta"})
+	cb := testCommandBar(t, app)
+
+	// Group headers carry the short IDs; the risk footer names every project.
+	out := execCmd(cb, "what-now 120 --group-by project")
+	assert.Contains(t, out, "ALP01")
+	assert.Contains(t, out, "BET01")
+
+	out = execCmd(cb, "what-now 120 --max-projects 1 --group-by project")
+	assert.NotEqual(t, strings.Contains(out, "ALP01"), strings.Contains(out, "BET01"), "exactly one project: %s", out)
+
+	assert.Contains(t, execCmd(cb, "what-now 60 --max-projects 0"), "usage: what-now")
+	assert.Contains(t, execCmd(cb, "what-now 60 --max-projects"), "usage: what-now")
+}
+
+func TestCommandBar_WhatNowBudgetPerProject(t *testing.T) {
+	app := testApp(t)
+	seedProjectCore(t, app, seedOpts{shortID: "ALP01", name: "Alpha"})
+	seedProjectCore(t, app, seedOpts{shortID: "BET01", name: "Beta"})
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 120 --budget-per-project even --group-by project")
+	assert.Contains(t, out, "ALP01")
+	assert.Contains(t, out, "BET01")
+
+	opts, err := parseWhatNowArgs([]string{"90", "--budget-per-project", "45m"})
+	require.NoError(t, err)
+	req, err := buildWhatNowRequest(context.Background(), app, opts, "")
+	require.NoError(t, err)
+	assert.Equal(t, 45, req.ProjectBudgetMin)
+	assert.False(t, req.EvenProjectBudget)
+
+	assert.Contains(t, execCmd(cb, "what-now 60 --budget-per-project"), "usage: what-now")
+	assert.Contains(t, execCmd(cb, "what-now 60 --budget-per-project fair"), "usage: what-now")
+	assert.Contains(t, execCmd(cb, "what-now 60 --budget-per-project 0"), "usage: what-now")
+}
+
+func TestCommandBar_WhatNowRespectHours(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	// A one-hour window starting two hours from now, so now is outside it.
+	start := time.Now().Add(2 * time.Hour)
+	window := start.Format("15:04") + "-" + start.Add(time.Hour).Format("15:04")
+	out := execCmd(cb, "profile set active-hours="+window)
+	assert.NotContains(t, out, "Usage")
+	assert.NotContains(t, out, "Error")
+
+	out = execCmd(cb, "what-now 60")
+	assert.Contains(t, out, "Reading", "without --respect-hours the window is ignored")
+
+	out = execCmd(cb, "what-now 60 --respect-hours")
+	assert.Contains(t, out, "You're outside your active hours ("+window+")")
+	assert.Contains(t, out, "the next window opens "+start.Format("Mon 15:04"))
+	assert.NotContains(t, out, "Reading")
+
+	out = execCmd(cb, "what-now 60 --respect-hours --force")
+	assert.Contains(t, out, "Reading")
+
+	execCmd(cb, "config set respect-active-hours true")
+	out = execCmd(cb, "what-now 60")
+	assert.Contains(t, out, "outside your active hours")
+
+	out = execCmd(cb, "profile set active-hours=25-3")
+	assert.Contains(t, out, "active-hours: expected START-END")
+}
+
+func TestCommandBar_WhatNowStrategy(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	// The only item has 60m left, too much for a warmup: priority order is kept.
+	out := execCmd(cb, "what-now 60 --strategy warmup")
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "using priority order")
+
+	out = execCmd(cb, "what-now 60 --strategy fastest")
+	assert.Contains(t, out, "unknown strategy")
+	out = execCmd(cb, "what-now 60 --strategy")
+	assert.Contains(t, out, "usage: what-now")
+}
+
+func TestCommandBar_WeeklyPlan(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "profile set availability=1h,1h,1h,1h,1h,0,0")
+	assert.Contains(t, out, "Mon 1h")
+	assert.Contains(t, execCmdAsync(cb, "profile set availability=1h,1h"), "expected 7 comma-separated durations")
+
+	out = execCmd(cb, "weekly plan")
+	assert.Contains(t, out, "WEEKLY PLAN")
+	assert.Contains(t, out, "(today)")
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "planned of 5h available")
+	assert.NotContains(t, out, "Won't finish", "the seeded project is due in months")
+
+	assert.Contains(t, execCmd(cb, "weekly"), "Usage: weekly plan")
+}
+
+func TestCommandBar_Autocorrect(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "sttaus")
+	assert.Contains(t, out, "(assuming: status)")
+	assert.Contains(t, out, "STATUS")
+
+	out = execCmd(cb, "proejct lsit")
+	assert.Contains(t, out, "(assuming: project list)")
+	assert.Contains(t, out, "Test Project")
+
+	out = execCmd(cb, "exot")
+	assert.Contains(t, out, "Unknown command: exot. Did you mean: exit")
+
+	out = execCmd(cb, "work inpsect-all")
+	assert.Contains(t, out, "unknown work subcommand", "too far off to correct or suggest")
+
+	execCmdAsync(cb, "profile set autocorrect=false")
+	out = execCmd(cb, "sttaus")
+	assert.Contains(t, out, "Unknown command: sttaus. Did you mean: status")
+	assert.NotContains(t, out, "assuming")
+}
+
+func TestCommandBar_WhatNowSavePlan(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "plan")
+	assert.Contains(t, out, "no plan saved")
+
+	out = execCmd(cb, "what-now 45 --save-plan")
+	assert.Contains(t, out, "Saved as today's plan (30m)")
+
+	out = execCmd(cb, "plan show")
+	assert.Contains(t, out, "Reading")
+	assert.Contains(t, out, "30m planned of 45m available")
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 20")
+	out = execCmd(cb, "plan status")
+	assert.Contains(t, out, "20m / 30m")
+	assert.Contains(t, out, "67%")
+
+	out = execCmd(cb, "plan tomorrow")
+	assert.Contains(t, out, "Usage: plan")
+}
+
+func TestCommandBar_WhatNowOneline(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	var buf strings.Builder
+	err := RunWhatNowOneline(app, []string{"45", "--oneline"}, &buf)
+	require.ErrorIs(t, err, errNothingToDo)
+	assert.Empty(t, buf.String())
+
+	seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	require.NoError(t, RunWhatNowOneline(app, []string{"45", "--oneline"}, &buf))
+	assert.Equal(t, "NEXT: Reading (30m) · PHI01\n", buf.String())
+	assert.NotContains(t, buf.String(), "\x1b[", "no colour codes")
+
+	assert.Equal(t, strings.TrimSpace(buf.String()), execCmd(cb, "what-now 45 --oneline"))
+	require.Error(t, RunWhatNowOneline(app, []string{"45"}, &buf))
+}
+
+func TestCommandBar_WhatNowGroupByProject(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 45 --group-by project")
+	assert.Contains(t, out, "Philosophy")
+	assert.Contains(t, out, "30m · 1 item")
+	assert.Contains(t, out, "Reading")
+	assert.NotContains(t, out, "Project:")
+
+	assert.Contains(t, execCmd(cb, "what-now 45 --group-by node"), "usage: what-now [min] --group-by project")
+}
+
+func TestCommandBar_WhatNowSnoozeCritical(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, _ := seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "what-now 45 --snooze-critical PHI01")
+	assert.Contains(t, out, "Critical mode snoozed for Philosophy until")
+	assert.Contains(t, out, "Allocated:")
+	p, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	require.NotNil(t, p.CriticalSnoozedUntil)
+	y, m, d := time.Now().Date()
+	assert.True(t, p.CriticalSnoozedUntil.Equal(time.Date(y, m, d+1, 0, 0, 0, 0, time.Local)), "defaults to the next midnight")
+
+	until := time.Now().Add(48 * time.Hour).Format("2006-01-02 15:04")
+	out = execCmd(cb, "what-now 45 --snooze-critical PHI01 --until \""+until+"\"")
+	assert.Contains(t, out, "until "+until)
+
+	out = execCmd(cb, "what-now 45 --unsnooze-critical PHI01")
+	assert.Contains(t, out, "Critical snooze lifted for Philosophy")
+	p, err = app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	assert.Nil(t, p.CriticalSnoozedUntil)
+
+	assert.Contains(t, execCmd(cb, "what-now 45 --snooze-critical PHI01 --until 2020-01-01"), "--until must be in the future")
+	assert.Contains(t, execCmd(cb, "what-now 45 --until 2030-01-01"), "give minutes or --until, not both")
+}
+
+func TestCommandBar_WhatNowUntil(t *testing.T) {
+	app := testApp(t)
+	seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	until := time.Now().Add(2*time.Hour + 30*time.Second).Format("2006-01-02 15:04")
+	out := execCmd(cb, "what-now --until \""+until+"\"")
+	assert.Contains(t, out, "Reading")
+	assert.Regexp(t, `\((1H 59M|2H) AVAILABLE\)`, out, "minutes run from now to the target time")
+
+	assert.Contains(t, execCmd(cb, "what-now --until \"2020-01-01 09:00\""), "is not in the future")
+	assert.Contains(t, execCmd(cb, "what-now --until soon"), `invalid --until "soon"`)
+}
+
+func TestParseUntilTime(t *testing.T) {
+	now := time.Date(2026, 10, 16, 11, 20, 0, 0, time.Local)
+
+	got, err := parseUntilTime("15:00", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 16, 15, 0, 0, 0, time.Local), got, "a clock time means today")
+
+	got, err = parseUntilTime("2026-10-17 09:30", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 9, 30, 0, 0, time.Local), got)
+
+	_, err = parseUntilTime("25:00", now)
+	assert.Error(t, err)
+}
+
+func TestCommandBar_ProfileSetAutoReplan(t *testing.T) {
+	app := testApp(t)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "profile")
+	assert.Contains(t, out, "auto-replan")
+	assert.Contains(t, out, "off")
+
+	out = execCmd(cb, "profile set auto-replan=true focus-block=50m")
+	assert.Contains(t, out, "Profile updated")
+	p, err := app.Profile.Get(context.Background())
+	require.NoError(t, err)
+	assert.True(t, p.AutoReplan)
+	assert.Equal(t, 50, p.FocusBlockMin)
+
+	execCmd(cb, "profile set deadline-buffer=25%")
+	p, err = app.Profile.Get(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25, p.BufferPct, 1e-9)
+	out = execCmd(cb, "profile set deadline-buffer=150")
+	assert.Contains(t, out, "deadline buffer must be between 0% and 100%")
+
+	out = execCmd(cb, "profile set auto-replan=maybe")
+	assert.Contains(t, out, "auto-replan: expected true or false")
+	out = execCmd(cb, "profile set colour=blue")
+	assert.Contains(t, out, "Usage: profile")
+}
+
+func TestCommandBar_ConfigListGetSet(t *testing.T) {
+	app := testApp(t)
+	app.DBPath = "/tmp/kairos-test.db"
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "config")
+	assert.Contains(t, out, "weight.spacing")
+	assert.Contains(t, out, "baseline-daily")
+	assert.Contains(t, out, "/tmp/kairos-test.db")
+	assert.Contains(t, out, "KAIROS_LLM_MODEL")
+
+	out = execCmd(cb, "config set weight.spacing 3")
+	assert.Contains(t, out, "weight.spacing = 3")
+	p, err := app.Profile.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, p.WeightSpacing)
+	assert.Equal(t, "weight.spacing = 3", execCmd(cb, "config get weight.spacing"))
+
+	out = execCmd(cb, "config set weight.spacing 12")
+	assert.Contains(t, out, "weight spacing must be between 0 and 10")
+	out = execCmd(cb, "config set weight.spacing -1")
+	assert.Contains(t, out, "weight spacing must be between 0 and 10")
+	out = execCmd(cb, "config set weight.spacing lots")
+	assert.Contains(t, out, "weight.spacing: expected a number")
+	p, err = app.Profile.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, p.WeightSpacing, "rejected values leave the profile unchanged")
+
+	execCmd(cb, "config set deadline-buffer=25")
+	assert.Equal(t, "deadline-buffer = 25%", execCmd(cb, "config get deadline-buffer"))
+	execCmd(cb, "config set baseline-daily 1h30m")
+	assert.Equal(t, "baseline-daily = 1h30m", execCmd(cb, "config get baseline-daily"))
+
+	out = execCmd(cb, "config get db")
+	assert.Contains(t, out, "/tmp/kairos-test.db")
+	out = execCmd(cb, "config set db /elsewhere.db")
+	assert.Contains(t, out, "set KAIROS_DB and restart")
+	out = execCmd(cb, "config get colour")
+	assert.Contains(t, out, `unknown config key "colour"`)
+}
+
+func TestCommandBar_ProfileExportImport(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "profile.json")
+
+	src := testApp(t)
+	cb := testCommandBar(t, src)
+	execCmd(cb, "config set weight.spacing 3")
+	execCmd(cb, "profile set active-hours=07:00-22:30 focus-block=50m")
+	execCmd(cb, "work preset save reading --type reading --planned-min 45")
+	out := execCmd(cb, "profile export --out "+path)
+	assert.Contains(t, out, "Exported profile")
+	assert.Contains(t, out, "1 presets")
+
+	dst := testApp(t)
+	cb = testCommandBar(t, dst)
+	out = execCmd(cb, "profile import "+path)
+	assert.Contains(t, out, "Imported profile")
+	assert.Contains(t, out, "weight.spacing: ")
+	assert.Contains(t, out, "→ 3")
+	assert.Contains(t, out, "active-hours: off → 07:00-22:30")
+	assert.Contains(t, out, "preset reading added")
+	assert.NotContains(t, out, "weight.focus", "unchanged settings are not listed")
+
+	p, err := dst.Profile.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, p.WeightSpacing)
+	assert.Equal(t, 50, p.FocusBlockMin)
+	assert.Equal(t, 7*60, p.ActiveHoursStart)
+	wp, err := dst.Presets.Get(ctx, "reading")
+	require.NoError(t, err)
+	assert.Equal(t, 45, wp.PlannedMin)
+
+	assert.Contains(t, execCmd(cb, "profile import "+path), "Nothing changed")
+
+	// Out-of-range values are rejected before anything is saved.
+	bad := filepath.Join(t.TempDir(), "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"version":1,"settings":{"weight.spacing":"12","focus-block":"25"}}`), 0o644))
+	assert.Contains(t, execCmd(cb, "profile import "+bad), "weight spacing must be between 0 and 10")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"version":1,"settings":{"colour":"blue"}}`), 0o644))
+	assert.Contains(t, execCmd(cb, "profile import "+bad), `unknown profile setting "colour"`)
+	p, err = dst.Profile.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, p.WeightSpacing)
+	assert.Equal(t, 50, p.FocusBlockMin)
+}
+
+func TestCommandBar_FocusAddListRemove(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	out := execCmd(cb, "focus")
+	assert.Contains(t, out, "Focus list is empty")
+
+	out = execCmdAsync(cb, "focus add "+wiID)
+	assert.Contains(t, out, "Focused: Reading")
+	out = execCmd(cb, "focus list")
+	assert.Contains(t, out, "1. ")
+	assert.Contains(t, out, "Reading")
+
+	out = execCmd(cb, "what-now 30")
+	assert.Contains(t, out, "Reading")
+
+	out = execCmdAsync(cb, "focus remove "+wiID)
+	assert.Contains(t, out, "Unfocused: Reading")
+	out = execCmdAsync(cb, "focus remove "+wiID)
+	assert.Contains(t, out, "not on the focus list")
+
+	out = execCmd(cb, "focus pin "+wiID)
+	assert.Contains(t, out, "Usage: focus")
+}
+
+func TestCommandBar_ProjectListRiskSummary(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	seedProjectCore(t, app, seedOpts{shortID: "ALP01", name: "Alpha"})
+	seedProjectCore(t, app, seedOpts{shortID: "BET01", name: "Beta"})
+	pausedID, _, _ := seedProjectCore(t, app, seedOpts{shortID: "GAM01", name: "Gamma"})
+	archivedID, _, _ := seedProjectCore(t, app, seedOpts{shortID: "DEL01", name: "Delta"})
+
+	paused, err := app.Projects.GetByID(ctx, pausedID)
+	require.NoError(t, err)
+	paused.Status = domain.ProjectPaused
+	require.NoError(t, app.Projects.Update(ctx, paused))
+	require.NoError(t, app.Projects.Archive(ctx, archivedID))
+
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, "project list --risk-summary")
+	assert.Contains(t, out, "2 projects · 0 critical · 0 at-risk · 2 on-track")
+	assert.Contains(t, out, "(not counted: 1 paused, 1 archived)")
+	assert.Less(t, strings.Index(out, "on-track"), strings.Index(out, "Alpha"), "the summary comes before the list")
+
+	assert.NotContains(t, execCmdAsync(cb, "project list"), "on-track")
+}
+
+func TestCommandBar_VerbosityFlags(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithWork(t, app)
+	cb := testCommandBar(t, app)
+
+	p, err := app.Projects.GetByID(context.Background(), projID)
+	require.NoError(t, err)
+
+	out := execCmdAsync(cb, "project update "+projID+" --name Renamed --quiet")
+	assert.Equal(t, "✔ Updated project Renamed ["+p.ShortID+"]", out)
+
+	out = execCmdAsync(cb, "project list --quiet")
+	assert.Contains(t, out, "Renamed", "quiet leaves requested data alone")
+
+	execCmd(cb, "use "+projID)
+	out = execCmdAsync(cb, "project update "+projID+" --name Again --verbose")
+	assert.Contains(t, out, "Updated project Again")
+	assert.Contains(t, out, "took ")
+	assert.Contains(t, out, "project "+projID)
+
+	cb.state.Verbosity = formatter.VerbosityQuiet
+	out = execCmdAsync(cb, "project update "+projID+" --name Third")
+	assert.Equal(t, "✔ Updated project Third ["+p.ShortID+"]", out)
+}
+
+func TestCommandBar_NodeAddCount(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	projID, defaultNodeID, _ := seedProjectCore(t, app, seedOpts{})
+	proj, err := app.Projects.GetByID(ctx, projID)
+	require.NoError(t, err)
+	cb := testCommandBar(t, app)
+
+	out := execCmdAsync(cb, `node add --project `+projID+` --title "Week {n}" --kind week --count 3 --days-per 7`)
+	assert.Contains(t, out, "Created 3 nodes")
+	assert.Contains(t, out, "due every 7 days")
+
+	roots, err := app.Nodes.ListRoots(ctx, projID)
+	require.NoError(t, err)
+	require.Len(t, roots, 4)
+	var base int
+	for _, n := range roots {
+		if n.ID == defaultNodeID {
+			base = n.OrderIndex
+		}
+	}
+	for i, want := range []string{"Week 1", "Week 2", "Week 3"} {
+		n := roots[i+1]
+		assert.Equal(t, want, n.Title)
+		assert.Equal(t, base+i+1, n.OrderIndex)
+		require.NotNil(t, n.DueDate)
+		assert.Equal(t, proj.StartDate.AddDate(0, 0, 7*(i+1)).Format("2006-01-02"), n.DueDate.Format("2006-01-02"))
+	}
+
+	assert.Contains(t, execCmdAsync(cb, `node add --project `+projID+` --title Week --kind week --count 3`), "{n}")
+	assert.Contains(t, execCmdAsync(cb, `node add --project `+projID+` --title "Week {n}" --kind week --count 0`), "--count must be")
+	assert.Contains(t, execCmdAsync(cb, `node add --project `+projID+` --title "Week {n}" --kind week --days-per 7`), "--days-per needs --count")
+	roots, err = app.Nodes.ListRoots(ctx, projID)
+	require.NoError(t, err)
+	assert.Len(t, roots, 4, "rejected series create nothing")
+}
+
+func TestCommandBar_SessionListJSON(t *testing.T) {
+	app := testApp(t)
+	projID, wiID := seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	cb := testCommandBar(t, app)
+
+	assert.Equal(t, "[]", execCmdAsync(cb, "session list --format json"))
+
+	execCmdAsync(cb, "session log --work-item "+wiID+" --minutes 45 --tag billable")
+	out := execCmdAsync(cb, "session list --days 3 --format json")
+
+	var records []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &records), out)
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.Equal(t, wiID, r["work_item_id"])
+	assert.NotEmpty(t, r["work_item_title"])
+	assert.Equal(t, projID, r["project_id"])
+	assert.Equal(t, "PHI01", r["project_short_id"])
+	assert.Equal(t, "Philosophy", r["project_name"])
+	assert.Equal(t, float64(45), r["minutes"])
+	assert.Equal(t, []any{"billable"}, r["tags"])
+	started, err := time.Parse(time.RFC3339, r["started_at"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, started.Location())
+	assert.True(t, strings.HasSuffix(r["started_at"].(string), "Z"))
+
+	out = execCmdAsync(cb, "session list --work-item "+wiID+" --format json")
+	require.NoError(t, json.Unmarshal([]byte(out), &records))
+	assert.Len(t, records, 1)
+
+	assert.Contains(t, execCmdAsync(cb, "session list --format csv"), "unsupported format")
+}
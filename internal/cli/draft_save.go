@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/alexanderramin/kairos/internal/intelligence"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// savedDraft is an unfinished draft as written to App.DraftPath: the wizard
+// state, the transcript and prompt to redraw, and the LLM conversation when
+// there is one.
+type savedDraft struct {
+	SavedAt          time.Time                       `json:"saved_at"`
+	Phase            draftPhase                      `json:"phase"`
+	Description      string                          `json:"description"`
+	StartDate        string                          `json:"start_date,omitempty"`
+	Deadline         string                          `json:"deadline,omitempty"`
+	Groups           []wizardGroup                   `json:"groups,omitempty"`
+	WorkItems        []wizardWorkItem                `json:"work_items,omitempty"`
+	SpecialNodes     []wizardSpecialNode             `json:"special_nodes,omitempty"`
+	GroupTotal       int                             `json:"group_total,omitempty"`
+	CurrentGroupIdx  int                             `json:"current_group_idx,omitempty"`
+	CurrentGroup     wizardGroup                     `json:"current_group"`
+	CurrentWI        wizardWorkItem                  `json:"current_work_item"`
+	CurrentSpecial   wizardSpecialNode               `json:"current_special"`
+	CurrentSpecialWI wizardWorkItem                  `json:"current_special_work_item"`
+	Wizard           *wizardResult                   `json:"wizard,omitempty"`
+	Schema           *importer.ImportSchema          `json:"schema,omitempty"`
+	Conv             *intelligence.DraftConversation `json:"conversation,omitempty"`
+	Transcript       []string                        `json:"transcript"`
+	Prompt           string                          `json:"prompt"`
+}
+
+// saveDraft writes the draft to App.DraftPath after each step, so leaving
+// the view (or a crash) keeps it for `draft --resume`. Nothing is written
+// before the description is in, and errors are ignored: saving is
+// best-effort, like shell history.
+func (v *draftView) saveDraft() {
+	path := v.state.App.DraftPath
+	if path == "" || v.draft.description == "" {
+		return
+	}
+	d := v.draft
+	data, err := json.Marshal(savedDraft{
+		SavedAt: time.Now().UTC(), Phase: d.phase,
+		Description: d.description, StartDate: d.startDate, Deadline: d.deadline,
+		Groups: d.groups, WorkItems: d.workItems, SpecialNodes: d.specialNodes,
+		GroupTotal: d.groupTotal, CurrentGroupIdx: d.currentGroupIdx,
+		CurrentGroup: d.currentGroup, CurrentWI: d.currentWI,
+		CurrentSpecial: d.currentSpecial, CurrentSpecialWI: d.currentSpecialWI,
+		Wizard: d.wizard, Schema: d.schema, Conv: d.conv,
+		Transcript: v.transcript, Prompt: v.currentPrompt,
+	})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o644)
+}
+
+// loadSavedDraft reads the draft saved at path, nil when there is none.
+func loadSavedDraft(path string) (*savedDraft, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading saved draft: %w", err)
+	}
+	var saved savedDraft
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("reading saved draft %s: %w", path, err)
+	}
+	return &saved, nil
+}
+
+// clearSavedDraft removes the saved draft once it is accepted or discarded.
+func clearSavedDraft(path string) {
+	if path != "" {
+		_ = os.Remove(path)
+	}
+}
+
+// newResumedDraftView reopens a saved draft where it was left. A draft that
+// was talking to the LLM goes back to the wizard review (or the start) when
+// LLM features are now disabled.
+func newResumedDraftView(state *SharedState, saved *savedDraft) *draftView {
+	v := newDraftView(state, "")
+	v.transcript = append(saved.Transcript, formatter.Dim(
+		fmt.Sprintf("Resumed the draft you left (last saved: %s).", formatter.HumanTimestamp(saved.SavedAt))))
+	v.currentPrompt = saved.Prompt
+	v.draft = &draftWizardState{
+		phase:       saved.Phase,
+		description: saved.Description, startDate: saved.StartDate, deadline: saved.Deadline,
+		conv:   saved.Conv,
+		groups: saved.Groups, workItems: saved.WorkItems, specialNodes: saved.SpecialNodes,
+		groupTotal: saved.GroupTotal, currentGroupIdx: saved.CurrentGroupIdx,
+		currentGroup: saved.CurrentGroup, currentWI: saved.CurrentWI,
+		currentSpecial: saved.CurrentSpecial, currentSpecialWI: saved.CurrentSpecialWI,
+		wizard: saved.Wizard, schema: saved.Schema,
+		presets: v.draft.presets,
+	}
+	if v.draft.phase >= draftPhaseConversation && state.App.ProjectDraft == nil {
+		v.draft.conv = nil
+		v.transcript = append(v.transcript, formatter.Dim("LLM features are disabled; continuing without them."))
+		v.resumeWithoutLLM()
+	}
+	return v
+}
+
+// cmdDraft handles `draft [description]`, `draft --resume` and
+// `draft --discard`.
+func (c *commandBar) cmdDraft(args []string) tea.Cmd {
+	path := c.state.App.DraftPath
+	if len(args) == 1 && (args[0] == "--resume" || args[0] == "--discard") {
+		saved, err := loadSavedDraft(path)
+		if err != nil {
+			return outputCmd(shellError(err))
+		}
+		if saved == nil {
+			return outputCmd(formatter.Dim("No unfinished draft is saved."))
+		}
+		if args[0] == "--discard" {
+			clearSavedDraft(path)
+			return outputCmd(fmt.Sprintf("%s Discarded the draft for %q", formatter.StyleGreen.Render("✔"), saved.Description))
+		}
+		return pushView(newResumedDraftView(c.state, saved))
+	}
+	return pushView(newDraftView(c.state, strings.Join(args, " ")))
+}
@@ -25,6 +25,49 @@ type wizardWorkItem struct {
 	Title      string
 	Type       string
 	PlannedMin int
+
+	// Session bounds, set only when the item came from a work preset.
+	MinSessionMin     int
+	MaxSessionMin     int
+	DefaultSessionMin int
+}
+
+// applyPreset copies a work preset's type, estimate and session bounds.
+func (w *wizardWorkItem) applyPreset(p *domain.WorkPreset) {
+	if p.Type != "" {
+		w.Type = p.Type
+	}
+	if p.PlannedMin > 0 {
+		w.PlannedMin = p.PlannedMin
+	}
+	w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin = p.MinSessionMin, p.MaxSessionMin, p.DefaultSessionMin
+}
+
+// toImport converts the collected item into an import work item.
+func (w wizardWorkItem) toImport(ref, nodeRef string) importer.WorkItemImport {
+	wi := importer.WorkItemImport{
+		Ref:     ref,
+		NodeRef: nodeRef,
+		Title:   w.Title,
+		Type:    w.Type,
+	}
+	if w.PlannedMin > 0 {
+		wi.PlannedMin = intPtr(w.PlannedMin)
+	}
+	if w.MinSessionMin > 0 || w.MaxSessionMin > 0 || w.DefaultSessionMin > 0 {
+		policy := &importer.SessionPolicyImport{}
+		if w.MinSessionMin > 0 {
+			policy.MinSessionMin = intPtr(w.MinSessionMin)
+		}
+		if w.MaxSessionMin > 0 {
+			policy.MaxSessionMin = intPtr(w.MaxSessionMin)
+		}
+		if w.DefaultSessionMin > 0 {
+			policy.DefaultSessionMin = intPtr(w.DefaultSessionMin)
+		}
+		wi.SessionPolicy = policy
+	}
+	return wi
 }
 
 // wizardSpecialNode represents a one-off node like an exam or milestone.
@@ -353,20 +396,7 @@ func buildSchemaFromWizard(result *wizardResult) *importer.ImportSchema {
 			// Stamp work items onto this node.
 			for _, wiTemplate := range result.WorkItems {
 				wiIdx++
-				wiRef := fmt.Sprintf("w%d", wiIdx)
-
-				wi := importer.WorkItemImport{
-					Ref:     wiRef,
-					NodeRef: nodeRef,
-					Title:   wiTemplate.Title,
-					Type:    wiTemplate.Type,
-				}
-				if wiTemplate.PlannedMin > 0 {
-					pm := wiTemplate.PlannedMin
-					wi.PlannedMin = &pm
-				}
-
-				schema.WorkItems = append(schema.WorkItems, wi)
+				schema.WorkItems = append(schema.WorkItems, wiTemplate.toImport(fmt.Sprintf("w%d", wiIdx), nodeRef))
 			}
 		}
 	}
@@ -401,20 +431,7 @@ func buildSchemaFromWizard(result *wizardResult) *importer.ImportSchema {
 
 		for _, wiTemplate := range sn.WorkItems {
 			wiIdx++
-			wiRef := fmt.Sprintf("w%d", wiIdx)
-
-			wi := importer.WorkItemImport{
-				Ref:     wiRef,
-				NodeRef: nodeRef,
-				Title:   wiTemplate.Title,
-				Type:    wiTemplate.Type,
-			}
-			if wiTemplate.PlannedMin > 0 {
-				pm := wiTemplate.PlannedMin
-				wi.PlannedMin = &pm
-			}
-
-			schema.WorkItems = append(schema.WorkItems, wi)
+			schema.WorkItems = append(schema.WorkItems, wiTemplate.toImport(fmt.Sprintf("w%d", wiIdx), nodeRef))
 		}
 	}
 
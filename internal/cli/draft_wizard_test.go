@@ -2,14 +2,18 @@ package cli
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/importer"
 	"github.com/alexanderramin/kairos/internal/repository"
 	"github.com/alexanderramin/kairos/internal/service"
 	"github.com/alexanderramin/kairos/internal/testutil"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -250,6 +254,100 @@ func TestBuildSchemaFromWizard_SpecialNodeUsesDeadlineAsDueDate(t *testing.T) {
 	assert.Equal(t, "2026-06-30", *schema.Nodes[1].DueDate) // Uses deadline.
 }
 
+func TestBuildSchemaFromWizard_PresetSessionBounds(t *testing.T) {
+	result := &wizardResult{
+		Description: "Reading Plan",
+		StartDate:   "2026-02-08",
+		Groups:      []wizardGroup{{Label: "Chapter", Count: 1, Kind: "module"}},
+		WorkItems: []wizardWorkItem{
+			{Title: "Read", Type: "reading", PlannedMin: 45, MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30},
+			{Title: "Notes", Type: "task", PlannedMin: 20},
+		},
+	}
+
+	schema := buildSchemaFromWizard(result)
+	require.Len(t, schema.WorkItems, 2)
+	policy := schema.WorkItems[0].SessionPolicy
+	require.NotNil(t, policy)
+	assert.Equal(t, 15, *policy.MinSessionMin)
+	assert.Equal(t, 60, *policy.MaxSessionMin)
+	assert.Equal(t, 30, *policy.DefaultSessionMin)
+	assert.Nil(t, schema.WorkItems[1].SessionPolicy, "items without a preset use the defaults")
+	assert.Empty(t, importer.ValidateImportSchema(schema))
+}
+
+func TestDraftView_WorkItemTypeAcceptsPreset(t *testing.T) {
+	app := testApp(t)
+	require.NoError(t, app.Presets.Save(context.Background(), &domain.WorkPreset{
+		Name: "reading45", Type: "reading", PlannedMin: 45, MinSessionMin: 15, MaxSessionMin: 60,
+	}))
+
+	v := newDraftView(&SharedState{App: app}, "")
+	for _, in := range []string{"Book", "", "", "", "Chapter", "2", "", "", "Read"} {
+		v.handleInput(in)
+	}
+	assert.Contains(t, v.currentPrompt, "or preset [reading45]")
+
+	v.handleInput("reading45")
+	assert.Equal(t, draftPhaseWorkItemTitle, v.draft.phase, "a preset with an estimate skips the minutes prompt")
+	require.Len(t, v.draft.workItems, 1)
+	assert.Equal(t, wizardWorkItem{Title: "Read", Type: "reading", PlannedMin: 45, MinSessionMin: 15, MaxSessionMin: 60}, v.draft.workItems[0])
+}
+
+func TestDraftView_SaveAndResume(t *testing.T) {
+	app := testAppFull(t)
+	app.DraftPath = filepath.Join(t.TempDir(), "draft.json")
+	cb := testCommandBar(t, app)
+
+	assert.Contains(t, execCmd(cb, "draft --resume"), "No unfinished draft is saved")
+
+	v := newDraftView(cb.state, "")
+	for _, in := range []string{"Book", "", "", "", "Chapter", "2", "", "", "Read"} {
+		v.handleInput(in)
+	}
+	_, cmd := v.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	done, ok := cmd().(wizardCompleteMsg)
+	require.True(t, ok)
+	assert.Contains(t, done.nextCmd().(cmdOutputMsg).output, "Resume it with: draft --resume")
+
+	saved, err := loadSavedDraft(app.DraftPath)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Contains(t, newDraftView(cb.state, "").View(), `An unfinished draft for "Book"`)
+
+	v = newResumedDraftView(cb.state, saved)
+	assert.Equal(t, draftPhaseWorkItemType, v.draft.phase)
+	assert.Contains(t, v.View(), "Group: Chapter x2", "the transcript is restored")
+	for _, in := range []string{"reading", "45", "", ""} {
+		v.handleInput(in)
+	}
+	require.Equal(t, draftPhaseWizardReview, v.draft.phase)
+	require.Len(t, v.draft.groups, 1)
+	assert.Equal(t, 2, v.draft.groups[0].Count, "answers from before the save are kept")
+	assert.Equal(t, []wizardWorkItem{{Title: "Read", Type: "reading", PlannedMin: 45}}, v.draft.workItems)
+
+	v.handleInput("a")
+	_, err = os.Stat(app.DraftPath)
+	assert.True(t, os.IsNotExist(err), "accepting clears the saved draft")
+	projects, err := app.Projects.List(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Book", projects[0].Name)
+}
+
+func TestCommandBar_DraftDiscard(t *testing.T) {
+	app := testApp(t)
+	app.DraftPath = filepath.Join(t.TempDir(), "draft.json")
+	cb := testCommandBar(t, app)
+
+	v := newDraftView(cb.state, "")
+	v.handleInput("Thesis")
+
+	out := execCmd(cb, "draft --discard")
+	assert.Contains(t, out, `Discarded the draft for "Thesis"`)
+	assert.Contains(t, execCmd(cb, "project draft --resume"), "No unfinished draft is saved")
+}
+
 func TestBuildSchemaFromWizard_UniqueRefs(t *testing.T) {
 	result := &wizardResult{
 		Description: "Ref Test",
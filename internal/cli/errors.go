@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// errorHints suggests a next step for errors with a known code, shown
+// under the message. Commands return errors as they are; shellError adds
+// the hint, so wording for a code lives only here.
+var errorHints = map[domain.ErrorCode]string{
+	domain.CodeNotFound:            "Check the ID with: projects, work list",
+	domain.CodeSessionTooOld:       "Remove it anyway with: session undo-last --force",
+	contract.ErrNoCandidates:       "Nothing to schedule yet. Add work with: work add, draft or project init",
+	contract.ErrOutsideActiveHours: "Working late on purpose? what-now --force",
+}
+
+// shellError formats an error for display in the shell, with the hint for
+// its code. Being outside active hours is a notice, not a failure, so it
+// is shown in yellow without the "Error:" prefix.
+func shellError(err error) string {
+	code := domain.CodeOf(err)
+	var out string
+	if code == contract.ErrOutsideActiveHours {
+		msg := errorMessage(err)
+		out = formatter.StyleYellow.Render(strings.ToUpper(msg[:1]) + msg[1:] + ".")
+	} else {
+		out = formatter.StyleRed.Render("Error: " + errorMessage(err))
+	}
+	if hint, ok := errorHints[code]; ok {
+		out += "\n" + formatter.Dim(hint)
+	}
+	return out
+}
+
+// errorMessage is err's text for people. A what-now error drops its code
+// prefix, which is there for logs and is reported separately in JSON.
+func errorMessage(err error) string {
+	if wnErr, ok := err.(*contract.WhatNowError); ok {
+		return wnErr.Message
+	}
+	return err.Error()
+}
+
+// jsonError renders err as {"error":{"code":...,"message":...}} for
+// commands run with --format json, so scripts can branch on the code.
+func jsonError(err error) string {
+	var body struct {
+		Error struct {
+			Code    domain.ErrorCode `json:"code"`
+			Message string           `json:"message"`
+		} `json:"error"`
+	}
+	body.Error.Code = domain.CodeOf(err)
+	body.Error.Message = errorMessage(err)
+	data, _ := json.MarshalIndent(body, "", "  ") // two strings always marshal
+	return string(data)
+}
@@ -14,6 +14,19 @@ func dateInput(title, placeholder string, value *string) *huh.Input {
 		Validate(validateOptionalDate)
 }
 
+// deadlineInput returns a huh.Input for an optional deadline field that also
+// accepts a time of day ("YYYY-MM-DD HH:MM").
+func deadlineInput(title, placeholder string, value *string) *huh.Input {
+	if placeholder == "" {
+		placeholder = "2025-06-30 17:00"
+	}
+	return huh.NewInput().
+		Title(title).
+		Placeholder(placeholder).
+		Value(value).
+		Validate(validateOptionalDeadline)
+}
+
 // dueDateForm returns a themed single-field Form for collecting an optional due date.
 func dueDateForm(value *string) *huh.Form {
 	return huh.NewForm(
@@ -0,0 +1,47 @@
+package formatter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatArchived renders archived projects and work items with their archive
+// date and age, and the sessions each would take with it if purged. empty is
+// shown when there is nothing to list, and note, if set, below the table.
+func FormatArchived(title string, entities []domain.ArchivedEntity, empty, note string, now time.Time, termWidth int) string {
+	if len(entities) == 0 {
+		return RenderBox(title, Dim(empty))
+	}
+
+	headers := []string{"KIND", "ID", "TITLE", "PROJECT", "ARCHIVED", "SESSIONS"}
+	rows := make([][]string, 0, len(entities))
+	projects, items := 0, 0
+	for _, e := range entities {
+		kind, id := "item", Dim("-")
+		if e.EntityType == domain.TombstoneEntityProject {
+			kind, id = "project", e.ProjectShortID
+			projects++
+		} else {
+			items++
+			if e.Seq > 0 {
+				id = Dim(fmt.Sprintf("#%d", e.Seq))
+			}
+		}
+		rows = append(rows, []string{
+			kind,
+			id,
+			Truncate(e.Title, 36),
+			Dim(Truncate(e.ProjectName, 24)),
+			e.ArchivedAt.Local().Format("2006-01-02") + " " + Dim(RelativeDateFrom(e.ArchivedAt, now)),
+			fmt.Sprintf("%d", e.SessionCount),
+		})
+	}
+	out := RenderTable(headers, rows, BoxContentWidth(termWidth)) + "\n" +
+		Dim(fmt.Sprintf("%d project(s) · %d work item(s)", projects, items))
+	if note != "" {
+		out += "\n" + Dim(note)
+	}
+	return RenderBox(title, out)
+}
@@ -0,0 +1,44 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConfigEntry is one `config list` row; Note is a dim hint after the value
+// (the allowed range, or the environment variable it came from).
+type ConfigEntry struct {
+	Key   string
+	Value string
+	Note  string
+}
+
+// FormatConfig renders the editable profile settings and, below them, the
+// read-only settings taken from the environment.
+func FormatConfig(profile, env []ConfigEntry) string {
+	width := 0
+	for _, entries := range [][]ConfigEntry{profile, env} {
+		for _, e := range entries {
+			width = max(width, len(e.Key))
+		}
+	}
+	row := func(e ConfigEntry) string {
+		value := e.Value
+		if value == "" {
+			value = Dim("(unset)")
+		}
+		return fmt.Sprintf("  %-*s  %s  %s\n", width, e.Key, value, Dim(e.Note))
+	}
+
+	var b strings.Builder
+	b.WriteString(Bold("Profile") + "\n")
+	for _, e := range profile {
+		b.WriteString(row(e))
+	}
+	b.WriteString("\n" + Bold("Environment") + " " + Dim("(read-only, set before starting kairos)") + "\n")
+	for _, e := range env {
+		b.WriteString(row(e))
+	}
+	b.WriteString("\n" + Dim("Change with: config set weight.spacing 3"))
+	return RenderBox("Config", b.String())
+}
@@ -0,0 +1,57 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatDayPlan renders a saved day plan in its saved order.
+func FormatDayPlan(p *domain.DayPlan) string {
+	var b strings.Builder
+	for i, it := range p.Items {
+		b.WriteString(fmt.Sprintf("%d. %s  %s\n", i+1, dayPlanItemLabel(it), Dim(FormatMinutes(it.AllocatedMin))))
+	}
+	b.WriteString(fmt.Sprintf("\n%s planned of %s available · saved %s\n",
+		FormatMinutes(p.PlannedMin()), FormatMinutes(p.AvailableMin), p.CreatedAt.Local().Format("15:04")))
+	b.WriteString(Dim("Compare with what you logged: plan status"))
+	return RenderBox(dayPlanTitle(p), b.String())
+}
+
+// FormatDayPlanStatus renders a day plan next to the time logged on each
+// item that day, with the overall adherence.
+func FormatDayPlanStatus(a *app.DayPlanAdherence) string {
+	var b strings.Builder
+	for i, it := range a.Items {
+		mark := Dim("·")
+		if it.Done {
+			mark = StyleGreen.Render("✔")
+		}
+		pct := 0.0
+		if it.AllocatedMin > 0 {
+			pct = float64(it.LoggedMin) / float64(it.AllocatedMin)
+		}
+		b.WriteString(fmt.Sprintf("%d. %s %s  %s %s\n", i+1, mark, dayPlanItemLabel(it.DayPlanItem),
+			RenderCompactBar(pct, 10, false),
+			Dim(fmt.Sprintf("%s / %s", FormatMinutes(it.LoggedMin), FormatMinutes(it.AllocatedMin)))))
+	}
+	b.WriteString(fmt.Sprintf("\nAdherence: %s %s\n", Bold(fmt.Sprintf("%.0f%%", a.AdherencePct())),
+		Dim(fmt.Sprintf("(%s of %s planned)", FormatMinutes(a.CoveredMin), FormatMinutes(a.PlannedMin)))))
+	if a.UnplannedMin > 0 {
+		b.WriteString(Dim(fmt.Sprintf("%s logged on items outside the plan", FormatMinutes(a.UnplannedMin))))
+	}
+	return RenderBox(dayPlanTitle(a.Plan), strings.TrimRight(b.String(), "\n"))
+}
+
+func dayPlanTitle(p *domain.DayPlan) string {
+	return "Plan · " + p.Date.Format("Mon Jan 2, 2006")
+}
+
+func dayPlanItemLabel(it domain.DayPlanItem) string {
+	if it.WorkItemSeq > 0 {
+		return Dim(fmt.Sprintf("#%d ", it.WorkItemSeq)) + Bold(it.Title)
+	}
+	return Bold(it.Title)
+}
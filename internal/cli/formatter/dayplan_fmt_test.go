@@ -0,0 +1,37 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDayPlanStatus(t *testing.T) {
+	plan := &domain.DayPlan{
+		Date:         time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		AvailableMin: 90,
+		Items: []domain.DayPlanItem{
+			{WorkItemSeq: 3, Title: "Read ch. 2", AllocatedMin: 45},
+			{Title: "Problem set", AllocatedMin: 30},
+		},
+	}
+	assert.Contains(t, FormatDayPlan(plan), "1h 15m planned of 1h 30m available")
+
+	out := FormatDayPlanStatus(&app.DayPlanAdherence{
+		Plan: plan,
+		Items: []app.DayPlanProgress{
+			{DayPlanItem: plan.Items[0], LoggedMin: 50, Done: true},
+			{DayPlanItem: plan.Items[1]},
+		},
+		PlannedMin: 75, CoveredMin: 45, UnplannedMin: 20,
+	})
+	assert.Contains(t, out, "PLAN · TUE MAR 10, 2026")
+	assert.Contains(t, out, "#3")
+	assert.Contains(t, out, "50m / 45m")
+	assert.Contains(t, out, "0m / 30m")
+	assert.Contains(t, out, "60%")
+	assert.Contains(t, out, "20m logged on items outside the plan")
+}
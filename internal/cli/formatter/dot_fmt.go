@@ -0,0 +1,88 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// dotStatusFill maps work item status to a Graphviz fill color.
+var dotStatusFill = map[domain.WorkItemStatus]string{
+	domain.WorkItemTodo:       "white",
+	domain.WorkItemInProgress: "gold",
+	domain.WorkItemDone:       "palegreen",
+	domain.WorkItemSkipped:    "lightgrey",
+	domain.WorkItemArchived:   "gainsboro",
+}
+
+// FormatProjectDOT renders the project's node hierarchy and work items as a
+// Graphviz digraph. Plan nodes become nested clusters and work items become
+// leaf nodes filled by status. Identifiers derive from project-scoped seq
+// numbers, so re-rendering an unchanged plan yields identical output.
+func FormatProjectDOT(data ProjectInspectData) string {
+	var b strings.Builder
+	b.WriteString("digraph kairos {\n")
+	b.WriteString(fmt.Sprintf("  label=%s;\n", dotQuote(data.Project.Name)))
+	b.WriteString("  labelloc=t;\n  compound=true;\n  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	writeDOTNodes(&b, data.RootNodes, data.ChildMap, data.WorkItems, 1)
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func writeDOTNodes(
+	b *strings.Builder,
+	nodes []*domain.PlanNode,
+	childMap map[string][]*domain.PlanNode,
+	workItems map[string][]*domain.WorkItem,
+	depth int,
+) {
+	sorted := make([]*domain.PlanNode, len(nodes))
+	copy(sorted, nodes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].OrderIndex < sorted[j].OrderIndex
+	})
+
+	indent := strings.Repeat("  ", depth)
+	for _, n := range sorted {
+		id := dotID("n", n.Seq, n.ID)
+		b.WriteString(fmt.Sprintf("%ssubgraph %s {\n", indent, dotQuote("cluster_"+id)))
+		b.WriteString(fmt.Sprintf("%s  label=%s;\n", indent, dotQuote(n.Title)))
+
+		children := childMap[n.ID]
+		items := workItems[n.ID]
+		if len(children) == 0 && len(items) == 0 {
+			// Graphviz drops empty clusters; keep the node visible.
+			b.WriteString(fmt.Sprintf("%s  %s [label=\"\", shape=point, style=invis];\n", indent, dotQuote(id)))
+		}
+		writeDOTNodes(b, children, childMap, workItems, depth+1)
+		for _, wi := range items {
+			fill, ok := dotStatusFill[wi.Status]
+			if !ok {
+				fill = "white"
+			}
+			b.WriteString(fmt.Sprintf("%s  %s [label=%s, fillcolor=%s];\n",
+				indent, dotQuote(dotID("w", wi.Seq, wi.ID)), dotQuote(wi.Title), fill))
+		}
+		b.WriteString(indent + "}\n")
+	}
+}
+
+// dotID returns a stable identifier: the seq number when assigned, otherwise
+// the entity UUID (legacy rows).
+func dotID(prefix string, seq int, id string) string {
+	if seq > 0 {
+		return fmt.Sprintf("%s%d", prefix, seq)
+	}
+	return prefix + "_" + id
+}
+
+// dotQuote returns s as a double-quoted DOT string.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,40 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatProjectDOT(t *testing.T) {
+	root := &domain.PlanNode{ID: "root-uuid", Seq: 1, Title: "Part \"A\"", OrderIndex: 0}
+	child := &domain.PlanNode{ID: "child-uuid", Seq: 2, Title: "Chapter 1", OrderIndex: 0}
+	empty := &domain.PlanNode{ID: "empty-uuid", Title: "Later", OrderIndex: 1}
+	data := ProjectInspectData{
+		Project:   &domain.Project{Name: "Book"},
+		RootNodes: []*domain.PlanNode{empty, root},
+		ChildMap:  map[string][]*domain.PlanNode{root.ID: {child}},
+		WorkItems: map[string][]*domain.WorkItem{
+			child.ID: {
+				{ID: "w1", Seq: 3, Title: "Read", Status: domain.WorkItemDone},
+				{ID: "w2", Seq: 4, Title: "Notes", Status: domain.WorkItemInProgress},
+			},
+		},
+	}
+
+	out := FormatProjectDOT(data)
+
+	assert.Contains(t, out, "digraph kairos {")
+	assert.Contains(t, out, `label="Book";`)
+	assert.Contains(t, out, `subgraph "cluster_n1" {`)
+	assert.Contains(t, out, `label="Part \"A\"";`)
+	assert.Contains(t, out, `"w3" [label="Read", fillcolor=palegreen];`)
+	assert.Contains(t, out, `"w4" [label="Notes", fillcolor=gold];`)
+	assert.Contains(t, out, `subgraph "cluster_n_empty-uuid" {`, "nodes without seq fall back to UUID")
+	assert.Less(t, strings.Index(out, "cluster_n1"), strings.Index(out, "cluster_n2"), "child cluster nests inside parent")
+	assert.Less(t, strings.Index(out, "cluster_n1"), strings.Index(out, "cluster_n_empty"), "roots ordered by OrderIndex")
+	assert.Equal(t, out, FormatProjectDOT(data), "output is deterministic")
+}
+
@@ -46,7 +46,18 @@ func FormatExplanation(e *intelligence.LLMExplanation) string {
 	}
 
 	b.WriteString(Dim(fmt.Sprintf("  Confidence: %.0f%%\n", e.Confidence*100)))
-	return RenderBox("Explanation", b.String())
+	box := RenderBox("Explanation", b.String())
+	if e.Unavailable {
+		return FormatLLMUnavailable() + "\n" + box
+	}
+	return box
+}
+
+// FormatLLMUnavailable renders the notice shown when AI features are enabled
+// but the model server does not respond.
+func FormatLLMUnavailable() string {
+	return StyleYellow.Render("AI is enabled but the model server isn't responding — falling back to guided mode.") +
+		"\n" + Dim("Run 'llm status' to check the server.")
 }
 
 // FormatAskResolution renders the result of an `ask` command.
@@ -55,7 +66,7 @@ func FormatAskResolution(r *intelligence.AskResolution) string {
 
 	intent := r.ParsedIntent
 	b.WriteString(fmt.Sprintf("  Intent:     %s\n", StyleBold.Render(string(intent.Intent))))
-	b.WriteString(fmt.Sprintf("  Risk:       %s\n", riskStyle(intent.Risk).Render(string(intent.Risk))))
+	b.WriteString(fmt.Sprintf("  Risk:       %s\n", formatIntentRisk(intent)))
 	b.WriteString(fmt.Sprintf("  Confidence: %.0f%%\n", intent.Confidence*100))
 
 	if len(intent.Arguments) > 0 {
@@ -135,6 +146,40 @@ func FormatTemplateDraft(d *intelligence.TemplateDraft) string {
 	return RenderBox("Template Draft", b.String())
 }
 
+// FormatAskConfirmPreview renders the body of the y/n prompt shown before
+// running an LLM-parsed command: what will run, how sure the parser is, and
+// why it needs confirming.
+func FormatAskConfirmPreview(r *intelligence.AskResolution) string {
+	var b strings.Builder
+	intent := r.ParsedIntent
+
+	b.WriteString(fmt.Sprintf("Command:    %s\n", StyleGreen.Render(r.CommandHint)))
+	b.WriteString(fmt.Sprintf("Intent:     %s (%s)\n", intent.Intent, formatIntentRisk(intent)))
+	b.WriteString(fmt.Sprintf("Confidence: %.0f%%\n", intent.Confidence*100))
+	if intent.Rationale != "" {
+		b.WriteString(Dim("Why: "+intent.Rationale) + "\n")
+	}
+
+	switch {
+	case intelligence.IsDestructiveIntent(intent.Intent):
+		b.WriteString(StyleRed.Render("This archives or deletes data."))
+	case r.ExecutionState == intelligence.StateNeedsClarification:
+		b.WriteString(StyleYellow.Render("Low confidence — check this is what you meant."))
+	default:
+		b.WriteString(StyleYellow.Render("This will modify your data."))
+	}
+	return b.String()
+}
+
+// formatIntentRisk renders the risk label, flagging destructive intents.
+func formatIntentRisk(intent *intelligence.ParsedIntent) string {
+	label := riskStyle(intent.Risk).Render(string(intent.Risk))
+	if intelligence.IsDestructiveIntent(intent.Intent) {
+		label += ", " + StyleRed.Render("destructive")
+	}
+	return label
+}
+
 func riskStyle(risk intelligence.IntentRisk) lipgloss.Style {
 	if risk == intelligence.RiskWrite {
 		return StyleYellow
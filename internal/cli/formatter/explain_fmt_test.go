@@ -44,6 +44,17 @@ func TestFormatExplanation_IncludesFactorsCounterfactualsAndConfidence(t *testin
 	assert.Contains(t, out, "Confidence: 82%")
 }
 
+func TestFormatExplanation_UnavailableAddsNotice(t *testing.T) {
+	e := &intelligence.LLMExplanation{SummaryShort: "Work on Chapter 3.", Confidence: 1}
+	assert.NotContains(t, FormatExplanation(e), "isn't responding")
+
+	e.Unavailable = true
+	out := FormatExplanation(e)
+	assert.Contains(t, out, "isn't responding")
+	assert.Contains(t, out, "llm status")
+	assert.Contains(t, out, "Work on Chapter 3.")
+}
+
 func TestFormatAskResolution_IncludesCommandHint(t *testing.T) {
 	r := &intelligence.AskResolution{
 		ParsedIntent: &intelligence.ParsedIntent{
@@ -63,6 +74,29 @@ func TestFormatAskResolution_IncludesCommandHint(t *testing.T) {
 	assert.NotContains(t, out, "Proceed? [Y/n]")
 }
 
+func TestFormatAskConfirmPreview_FlagsDestructiveIntent(t *testing.T) {
+	r := &intelligence.AskResolution{
+		ParsedIntent: &intelligence.ParsedIntent{
+			Intent:     intelligence.IntentProjectArchive,
+			Risk:       intelligence.RiskWrite,
+			Confidence: 0.72,
+			Arguments:  map[string]interface{}{"project_id": "PHYS01"},
+			Rationale:  "user asked to shelve physics",
+		},
+		ExecutionState: intelligence.StateNeedsConfirmation,
+		CommandHint:    "project archive PHYS01",
+	}
+
+	out := FormatAskConfirmPreview(r)
+	assert.Contains(t, out, "project archive PHYS01")
+	assert.Contains(t, out, "Confidence: 72%")
+	assert.Contains(t, out, "destructive")
+	assert.Contains(t, out, "shelve physics")
+	assert.Contains(t, out, "archives or deletes data")
+
+	assert.Contains(t, FormatAskResolution(r), "destructive")
+}
+
 func TestFormatAskResolution_AutoExecuteReadOnly(t *testing.T) {
 	r := &intelligence.AskResolution{
 		ParsedIntent: &intelligence.ParsedIntent{
@@ -0,0 +1,28 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatFocusList renders the pinned focus list in the order items were added.
+func FormatFocusList(items []*domain.WorkItem) string {
+	if len(items) == 0 {
+		return RenderBox("Focus", Dim("Focus list is empty. Pin an item with 'focus add <id>'."))
+	}
+
+	var b strings.Builder
+	for i, w := range items {
+		seq := ""
+		if w.Seq > 0 {
+			seq = Dim(fmt.Sprintf("#%d ", w.Seq))
+		}
+		b.WriteString(fmt.Sprintf("%d. %s%s  %s  %s\n", i+1, seq, Bold(w.Title),
+			WorkItemStatusPill(w.Status),
+			Dim(fmt.Sprintf("%s / %s", FormatMinutes(w.LoggedMin), FormatMinutes(w.PlannedMin)))))
+	}
+	b.WriteString("\n" + Dim("Focused items rank first in what-now; finished items drop off automatically."))
+	return RenderBox("Focus", b.String())
+}
@@ -194,7 +194,7 @@ func TestFormatStatus_Golden_MultiProject(t *testing.T) {
 		},
 	}
 
-	out := FormatStatus(resp)
+	out := FormatStatus(resp, 0)
 	goldenTest(t, "status_multiproject", out)
 }
 
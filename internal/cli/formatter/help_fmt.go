@@ -124,3 +124,38 @@ func FormatCommandList(commands []intelligence.HelpCommandInfo) string {
 	}
 	return RenderBox("Commands", b.String())
 }
+
+// FormatCommandReference renders commands with their flags and examples, as
+// shown by "help commands". query is the search that selected them, if any.
+func FormatCommandReference(commands []intelligence.HelpCommandInfo, query string) string {
+	title := "Commands"
+	if query != "" {
+		title = fmt.Sprintf("Commands matching %q", query)
+	}
+	if len(commands) == 0 {
+		return RenderBox(title, Dim("No matching commands. Try 'help chat' to ask in plain words."))
+	}
+
+	var b strings.Builder
+	for i, cmd := range commands {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(fmt.Sprintf("%s  %s\n", StyleGreen.Render(cmd.FullPath), Dim(cmd.Short)))
+		for _, f := range cmd.Flags {
+			name := "--" + f.Name
+			if f.Type != "" && f.Type != "bool" {
+				name += " " + strings.ToUpper(f.Type)
+			}
+			desc := f.Description
+			if f.Required {
+				desc += " (required)"
+			}
+			b.WriteString(fmt.Sprintf("    %s  %s\n", StyleBlue.Render(name), Dim(desc)))
+		}
+		for _, ex := range cmd.Examples {
+			b.WriteString(fmt.Sprintf("    %s\n", Dim("$ "+ex)))
+		}
+	}
+	return RenderBox(title, b.String())
+}
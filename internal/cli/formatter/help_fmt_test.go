@@ -54,6 +54,24 @@ func TestFormatHelpChatWelcomeAndCommandList(t *testing.T) {
 	assert.Contains(t, list, "what-now")
 }
 
+func TestFormatCommandReference(t *testing.T) {
+	out := FormatCommandReference([]intelligence.HelpCommandInfo{
+		{
+			FullPath: "work bump",
+			Short:    "Adjust an estimate",
+			Flags:    []intelligence.HelpFlagInfo{{Name: "node", Type: "string", Description: "Parent node", Required: true}, {Name: "yes", Type: "bool", Description: "Skip confirmation"}},
+			Examples: []string{"work bump #3 +30"},
+		},
+	}, "bump")
+	assert.Contains(t, out, `COMMANDS MATCHING "BUMP"`)
+	assert.Contains(t, out, "--node STRING")
+	assert.Contains(t, out, "Parent node (required)")
+	assert.Contains(t, out, "--yes  ")
+	assert.Contains(t, out, "$ work bump #3 +30")
+
+	assert.Contains(t, FormatCommandReference(nil, "nothing"), "No matching commands")
+}
+
 func TestWrapText(t *testing.T) {
 	got := wrapText("one two three four five", 10)
 	assert.Equal(t, "one two\nthree four\nfive", got)
@@ -78,6 +78,23 @@ func RelativeDateStyled(t time.Time) string {
 	return StyleFg.Render(text)
 }
 
+// DeadlineStyled renders a deadline string (YYYY-MM-DD, "YYYY-MM-DD HH:MM"
+// or RFC3339) as RelativeDateStyled, adding the time of day for deadlines
+// that have one. ok is false when the value does not parse.
+func DeadlineStyled(value string) (s string, ok bool) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = domain.ParseDeadline(value); err != nil {
+			return "", false
+		}
+	}
+	s = RelativeDateStyled(t)
+	if domain.DeadlineHasTime(t) {
+		s += " " + StyleFg.Render(t.Local().Format("15:04"))
+	}
+	return s, true
+}
+
 // HumanDate returns a human-friendly absolute date string.
 func HumanDate(t time.Time) string {
 	now := time.Now()
@@ -188,3 +205,39 @@ func FormatMinutes(min int) string {
 	}
 	return fmt.Sprintf("%dm", m)
 }
+
+// FormatEstimateOutcome compares a finished item's original estimate with
+// the time actually logged against it, e.g.
+// "estimated 1h, actually took 1h 31m (+52%)". It returns "" when either side
+// is missing, since there is nothing to learn from the comparison.
+func FormatEstimateOutcome(initialMin, loggedMin int) string {
+	if initialMin <= 0 || loggedMin <= 0 {
+		return ""
+	}
+	pct := int(math.Round(float64(loggedMin-initialMin) * 100 / float64(initialMin)))
+	return fmt.Sprintf("estimated %s, actually took %s (%+d%%)",
+		FormatMinutes(initialMin), FormatMinutes(loggedMin), pct)
+}
+
+// FormatChecklist renders checklist steps as numbered "[x]"/"[ ]" lines,
+// each indented by the given prefix. Checked steps are dimmed.
+func FormatChecklist(items []domain.ChecklistItem, indent string) string {
+	var b strings.Builder
+	for i, c := range items {
+		if c.Done {
+			b.WriteString(fmt.Sprintf("%s%d. %s\n", indent, i+1, StyleDim.Render("[x] "+c.Text)))
+		} else {
+			b.WriteString(fmt.Sprintf("%s%d. [ ] %s\n", indent, i+1, c.Text))
+		}
+	}
+	return b.String()
+}
+
+// FormatEstimate renders a work item's current estimate, prefixed with the
+// original one when re-estimation has moved it (e.g. "est 1h → now 1h 31m").
+func FormatEstimate(initialMin, plannedMin int) string {
+	if initialMin <= 0 || initialMin == plannedMin {
+		return FormatMinutes(plannedMin)
+	}
+	return fmt.Sprintf("est %s → now %s", FormatMinutes(initialMin), FormatMinutes(plannedMin))
+}
@@ -150,6 +150,27 @@ func TestFormatMinutes(t *testing.T) {
 	}
 }
 
+func TestFormatEstimateOutcome(t *testing.T) {
+	assert.Equal(t, "estimated 1h, actually took 1h 31m (+52%)", FormatEstimateOutcome(60, 91))
+	assert.Equal(t, "estimated 1h, actually took 45m (-25%)", FormatEstimateOutcome(60, 45))
+	assert.Empty(t, FormatEstimateOutcome(0, 45), "no original estimate")
+	assert.Empty(t, FormatEstimateOutcome(60, 0), "nothing logged")
+}
+
+func TestFormatEstimate(t *testing.T) {
+	assert.Equal(t, "1h", FormatEstimate(60, 60))
+	assert.Equal(t, "45m", FormatEstimate(0, 45))
+	assert.Equal(t, "est 1h → now 1h 31m", FormatEstimate(60, 91))
+}
+
+func TestFormatChecklist(t *testing.T) {
+	out := FormatChecklist([]domain.ChecklistItem{{Text: "outline", Done: true}, {Text: "draft"}}, "  ")
+	assert.Contains(t, out, "1. ")
+	assert.Contains(t, out, "[x] outline")
+	assert.Contains(t, out, "  2. [ ] draft\n")
+	assert.Empty(t, FormatChecklist(nil, "  "))
+}
+
 func TestRenderBox(t *testing.T) {
 	result := RenderBox("TEST", "content here")
 	assert.Contains(t, result, "TEST")
@@ -164,3 +185,20 @@ func TestRenderBoxWithoutTitle(t *testing.T) {
 	assert.Contains(t, result, "just content")
 	assert.Contains(t, result, "╭")
 }
+
+func TestParseVerbosity(t *testing.T) {
+	for in, want := range map[string]Verbosity{"": VerbosityNormal, "Quiet": VerbosityQuiet, " verbose ": VerbosityVerbose} {
+		got, ok := ParseVerbosity(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, ok := ParseVerbosity("loud")
+	assert.False(t, ok)
+}
+
+func TestQuietOutput_OnlyTrimsConfirmations(t *testing.T) {
+	assert.Equal(t, "✔ Created project X [X01]",
+		QuietOutput(StyleGreen.Render("✔")+" Created project X [X01]\n  details"))
+	table := "NAME  STATUS\nX     active"
+	assert.Equal(t, table, QuietOutput(table))
+}
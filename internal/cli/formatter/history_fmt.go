@@ -0,0 +1,51 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatHistory renders an entity's audit events oldest first, one line per
+// event with the fields it changed.
+func FormatHistory(label string, events []*domain.AuditEvent) string {
+	title := "History: " + label
+	if len(events) == 0 {
+		return RenderBox(title, Dim("No recorded changes."))
+	}
+
+	var b strings.Builder
+	for _, e := range events {
+		b.WriteString(fmt.Sprintf("%s  %-18s %s\n",
+			Dim(e.OccurredAt.Local().Format("2006-01-02 15:04")),
+			e.Action,
+			formatChanges(e.Changes)))
+	}
+	return RenderBox(title, strings.TrimRight(b.String(), "\n"))
+}
+
+// formatChanges renders field changes as "field from → to", sorted by field.
+// Set-from-nothing shows just the new value.
+func formatChanges(changes map[string]domain.FieldChange) string {
+	keys := make([]string, 0, len(changes))
+	for k := range changes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		c := changes[k]
+		switch {
+		case c.From == "":
+			parts = append(parts, fmt.Sprintf("%s %s", Dim(k), c.To))
+		case c.To == "":
+			parts = append(parts, fmt.Sprintf("%s %s → %s", Dim(k), c.From, Dim("(unset)")))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s → %s", Dim(k), c.From, c.To))
+		}
+	}
+	return strings.Join(parts, Dim(" · "))
+}
@@ -0,0 +1,29 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatHistory(t *testing.T) {
+	at := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	out := FormatHistory("Essay", []*domain.AuditEvent{
+		{Action: "create-work-item", OccurredAt: at, Changes: map[string]domain.FieldChange{
+			"title": {To: "Essay"}, "planned_min": {To: "60"},
+		}},
+		{Action: "update-work-item", OccurredAt: at.Add(time.Hour), Changes: map[string]domain.FieldChange{
+			"due_date": {From: "2026-03-10"}, "status": {From: "todo", To: "in_progress"},
+		}},
+	})
+
+	assert.Contains(t, out, "HISTORY: ESSAY")
+	assert.Contains(t, out, "2026-03-02 09:00")
+	assert.Contains(t, out, "planned_min 60")
+	assert.Contains(t, out, "todo → in_progress")
+	assert.Contains(t, out, "2026-03-10 → (unset)")
+
+	assert.Contains(t, FormatHistory("x", nil), "No recorded changes.")
+}
@@ -0,0 +1,49 @@
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/llm"
+)
+
+// FormatLLMStatus renders the result of pinging the model server. enabled
+// reports whether AI features are switched on for this shell.
+func FormatLLMStatus(s llm.ServerStatus, enabled bool) string {
+	var b strings.Builder
+
+	if enabled {
+		b.WriteString(fmt.Sprintf("  AI features: %s\n", StyleGreen.Render("enabled")))
+	} else {
+		b.WriteString(fmt.Sprintf("  AI features: %s %s\n", StyleYellow.Render("disabled"),
+			Dim("(enable with KAIROS_LLM_ENABLED=true)")))
+	}
+	b.WriteString(fmt.Sprintf("  Endpoint:    %s\n", s.Endpoint))
+
+	switch {
+	case errors.Is(s.Err, llm.ErrLLMUnavailable):
+		b.WriteString(fmt.Sprintf("  Server:      %s\n", StyleRed.Render("not responding")))
+		b.WriteString("\n" + Dim("  Start it with 'ollama serve'. Commands use their guided fallbacks meanwhile.") + "\n")
+		return RenderBox("LLM Status", b.String())
+	case !s.Reachable:
+		b.WriteString(fmt.Sprintf("  Server:      %s\n", StyleRed.Render(fmt.Sprintf("error: %v", s.Err))))
+		return RenderBox("LLM Status", b.String())
+	}
+
+	b.WriteString(fmt.Sprintf("  Server:      %s %s\n", StyleGreen.Render("reachable"),
+		Dim(fmt.Sprintf("(%s)", formatLatency(s.Latency)))))
+	if s.ModelAvailable {
+		b.WriteString(fmt.Sprintf("  Model:       %s %s\n", s.Model, StyleGreen.Render("✔ available")))
+	} else {
+		b.WriteString(fmt.Sprintf("  Model:       %s %s\n", s.Model, StyleRed.Render("✘ not pulled")))
+		b.WriteString(Dim(fmt.Sprintf("  Pull it with 'ollama pull %s'.", s.Model)) + "\n")
+	}
+	if s.Err != nil {
+		b.WriteString(fmt.Sprintf("  %s\n", StyleYellow.Render(s.Err.Error())))
+	}
+	if len(s.Models) > 0 {
+		b.WriteString(Dim("  Pulled:      "+strings.Join(s.Models, ", ")) + "\n")
+	}
+	return RenderBox("LLM Status", b.String())
+}
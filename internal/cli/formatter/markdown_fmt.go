@@ -0,0 +1,96 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatStatusMarkdown renders a status response as plain markdown for
+// pasting into chat or email: a one-line summary, a table of projects with
+// risk, progress, due date and next action, then any warnings. next maps a
+// project ID to its top recommendation; projects without one show "—".
+// The output carries no ANSI styling and does not depend on terminal width.
+func FormatStatusMarkdown(resp *contract.StatusResponse, next map[string]contract.WorkSlice) string {
+	var b strings.Builder
+	s := resp.Summary
+	b.WriteString(fmt.Sprintf("## Status — %s\n\n", s.GeneratedAt.Local().Format("2006-01-02")))
+	b.WriteString(fmt.Sprintf("%d projects: %d critical, %d at risk, %d on track · mode: %s\n",
+		s.CountsTotal, s.CountsCritical, s.CountsAtRisk, s.CountsOnTrack, s.GlobalModeIfNow))
+	if s.PolicyMessage != "" {
+		b.WriteString("\n> " + s.PolicyMessage + "\n")
+	}
+
+	if len(resp.Projects) == 0 {
+		b.WriteString("\n_No projects to report._\n")
+	} else {
+		b.WriteString("\n| Project | Risk | Progress | Due | Next action |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, p := range resp.Projects {
+			progress := fmt.Sprintf("%.0f%% (%s / %s)", p.ProgressTimePct,
+				FormatMinutes(p.LoggedMinTotal), FormatMinutes(p.PlannedMinTotal))
+			b.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				markdownCell(p.ProjectName), markdownRisk(p.RiskLevel), progress,
+				markdownDue(p), markdownNext(next, p.ProjectID)))
+		}
+	}
+
+	warnings := append([]string(nil), resp.Warnings...)
+	for _, p := range resp.Projects {
+		if p.Infeasible {
+			warnings = append(warnings, "Infeasible: "+infeasibleMessage(p.ProjectName, p.DueDate, p.ShortfallMin))
+		}
+	}
+	if len(warnings) > 0 {
+		b.WriteString("\n**Warnings**\n\n")
+		for _, w := range warnings {
+			b.WriteString("- " + w + "\n")
+		}
+	}
+	return b.String()
+}
+
+// markdownRisk is the risk level as plain capitalized text.
+func markdownRisk(risk domain.RiskLevel) string {
+	label := riskShortLabel(risk)
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// markdownDue renders the due date with the days left, or "—".
+func markdownDue(p contract.ProjectStatusView) string {
+	if p.DueDate == nil {
+		return "—"
+	}
+	if p.DaysLeft == nil {
+		return *p.DueDate
+	}
+	switch d := *p.DaysLeft; {
+	case d < 0:
+		return fmt.Sprintf("%s (%dd overdue)", *p.DueDate, -d)
+	case d == 0:
+		return fmt.Sprintf("%s (today)", *p.DueDate)
+	default:
+		return fmt.Sprintf("%s (%dd)", *p.DueDate, d)
+	}
+}
+
+// markdownNext renders a project's next recommended slice, or "—".
+func markdownNext(next map[string]contract.WorkSlice, projectID string) string {
+	slice, ok := next[projectID]
+	if !ok {
+		return "—"
+	}
+	title := markdownCell(slice.Title)
+	if slice.WorkItemSeq > 0 {
+		title = fmt.Sprintf("#%d %s", slice.WorkItemSeq, title)
+	}
+	return fmt.Sprintf("%s (%s)", title, FormatMinutes(slice.AllocatedMin))
+}
+
+// markdownCell escapes text for use inside a markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,36 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatStatusMarkdown(t *testing.T) {
+	due := "2026-03-20"
+	days := 3
+	resp := &contract.StatusResponse{
+		Summary: contract.GlobalStatusSummary{
+			CountsTotal: 2, CountsCritical: 1, CountsOnTrack: 1,
+			GlobalModeIfNow: domain.ModeCritical,
+			GeneratedAt:     time.Date(2026, 3, 17, 12, 0, 0, 0, time.Local),
+		},
+		Projects: []contract.ProjectStatusView{
+			{ProjectID: "p1", ProjectName: "Thesis | Draft", RiskLevel: domain.RiskCritical, DueDate: &due, DaysLeft: &days,
+				ProgressTimePct: 42, LoggedMinTotal: 120, PlannedMinTotal: 300},
+			{ProjectID: "p2", ProjectName: "Guitar", RiskLevel: domain.RiskOnTrack},
+		},
+	}
+	next := map[string]contract.WorkSlice{"p1": {Title: "Write intro", WorkItemSeq: 4, AllocatedMin: 45}}
+
+	out := FormatStatusMarkdown(resp, next)
+	assert.Contains(t, out, "## Status — 2026-03-17")
+	assert.Contains(t, out, "2 projects: 1 critical, 0 at risk, 1 on track")
+	assert.Contains(t, out, `| Thesis \| Draft | Critical | 42% (2h / 5h) | 2026-03-20 (3d) | #4 Write intro (45m) |`)
+	assert.Contains(t, out, "| Guitar | On track | 0% (0m / 0m) | — | — |")
+	assert.False(t, strings.Contains(out, "\x1b["), "markdown must not carry ANSI styling")
+}
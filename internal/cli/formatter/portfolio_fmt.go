@@ -0,0 +1,118 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// portfolioOnScheduleBand is how many percentage points work may trail or
+// lead elapsed time and still count as on schedule.
+const portfolioOnScheduleBand = 5
+
+// FormatPortfolioProgress compares time elapsed against work done for every
+// project, in the order given (callers sort by deadline). With chart, each
+// project gets a pair of bars in its risk color; otherwise a compact table.
+func FormatPortfolioProgress(views []contract.ProjectStatusView, chart bool, termWidth int) string {
+	if len(views) == 0 {
+		return RenderBox("Portfolio Progress", Dim("No active projects."))
+	}
+	if !chart {
+		return formatPortfolioTable(views, termWidth)
+	}
+
+	contentWidth := BoxContentWidth(termWidth)
+	barWidth := min(max(contentWidth-32, 10), 40)
+
+	var b strings.Builder
+	for i, p := range views {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		style := riskLevelStyle(p.RiskLevel)
+		b.WriteString(fmt.Sprintf("%s  %s  %s\n",
+			Bold(Truncate(p.ProjectName, max(contentWidth-32, 16))), RiskIndicator(p.RiskLevel), portfolioDue(p)))
+
+		if p.DueDate == nil {
+			b.WriteString(fmt.Sprintf("  %s  %s\n", Dim("time"), Dim("no target date")))
+		} else {
+			b.WriteString(fmt.Sprintf("  %s  %s %s\n", Dim("time"),
+				style.Render(portfolioBar(p.TimeElapsedPct, barWidth)), fmt.Sprintf("%3.0f%%", p.TimeElapsedPct)))
+		}
+		b.WriteString(fmt.Sprintf("  %s  %s %s  %s\n", Dim("work"),
+			style.Render(portfolioBar(p.WorkDonePct, barWidth)), fmt.Sprintf("%3.0f%%", p.WorkDonePct), portfolioPace(p)))
+	}
+	b.WriteString("\n" + Dim("time = share of start-to-target elapsed · work = share of planned minutes finished"))
+	return RenderBox("Portfolio Progress", b.String())
+}
+
+func formatPortfolioTable(views []contract.ProjectStatusView, termWidth int) string {
+	headers := []string{"PROJECT", "RISK", "DUE", "TIME", "WORK", "PACE"}
+	rows := make([][]string, 0, len(views))
+	for _, p := range views {
+		elapsed := Dim("--")
+		if p.DueDate != nil {
+			elapsed = fmt.Sprintf("%.0f%%", p.TimeElapsedPct)
+		}
+		rows = append(rows, []string{
+			Bold(p.ProjectName),
+			RiskIndicator(p.RiskLevel),
+			portfolioDue(p),
+			elapsed,
+			fmt.Sprintf("%.0f%%", p.WorkDonePct),
+			portfolioPace(p),
+		})
+	}
+	return RenderBox("Portfolio Progress", RenderTable(headers, rows, BoxContentWidth(termWidth)))
+}
+
+// portfolioBar renders pct (0-100) as a bar of block characters.
+func portfolioBar(pct float64, width int) string {
+	filled := int(math.Round(math.Max(0, math.Min(100, pct)) / 100 * float64(width)))
+	return strings.Repeat(filledBlock, filled) + strings.Repeat(emptyBlock, width-filled)
+}
+
+// portfolioDue renders the project's deadline, or a dim placeholder.
+func portfolioDue(p contract.ProjectStatusView) string {
+	if p.DueDate == nil {
+		return Dim("no deadline")
+	}
+	if s, ok := DeadlineStyled(*p.DueDate); ok {
+		return s
+	}
+	return StyleFg.Render(*p.DueDate)
+}
+
+// portfolioPace says whether work done is ahead of or behind time elapsed.
+func portfolioPace(p contract.ProjectStatusView) string {
+	if p.DueDate == nil {
+		return Dim("--")
+	}
+	diff := p.WorkDonePct - p.TimeElapsedPct
+	switch {
+	case diff >= portfolioOnScheduleBand:
+		return StyleGreen.Render(fmt.Sprintf("%.0f pts ahead", diff))
+	case diff <= -portfolioOnScheduleBand:
+		return StyleRed.Render(fmt.Sprintf("%.0f pts behind", -diff))
+	default:
+		return Dim("on schedule")
+	}
+}
+
+// riskLevelStyle is the color used for a risk level.
+func riskLevelStyle(risk domain.RiskLevel) lipgloss.Style {
+	switch risk {
+	case domain.RiskCritical:
+		return StyleRed
+	case domain.RiskAtRisk:
+		return StyleYellow
+	case domain.RiskOnTrack:
+		return StyleGreen
+	default:
+		return StyleDim
+	}
+}
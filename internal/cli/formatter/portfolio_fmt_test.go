@@ -0,0 +1,41 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatPortfolioProgress(t *testing.T) {
+	due := "2026-03-20"
+	views := []contract.ProjectStatusView{
+		{ProjectName: "Philosophy", RiskLevel: domain.RiskCritical, DueDate: &due, TimeElapsedPct: 80, WorkDonePct: 40},
+		{ProjectName: "Spanish", RiskLevel: domain.RiskOnTrack, DueDate: &due, TimeElapsedPct: 30, WorkDonePct: 50},
+		{ProjectName: "Reading", RiskLevel: domain.RiskOnTrack, WorkDonePct: 10},
+	}
+
+	chart := FormatPortfolioProgress(views, true, 100)
+	assert.Contains(t, chart, "PORTFOLIO PROGRESS")
+	assert.Contains(t, chart, "40 pts behind")
+	assert.Contains(t, chart, "20 pts ahead")
+	assert.Contains(t, chart, "no target date")
+	assert.Contains(t, chart, filledBlock)
+	assert.Less(t, strings.Index(chart, "Philosophy"), strings.Index(chart, "Spanish"), "keeps the caller's order")
+
+	table := FormatPortfolioProgress(views, false, 100)
+	assert.Contains(t, table, "PACE")
+	assert.Contains(t, table, "40 pts behind")
+	assert.NotContains(t, table, filledBlock)
+
+	assert.Contains(t, FormatPortfolioProgress(nil, true, 100), "No active projects.")
+}
+
+func TestPortfolioPace(t *testing.T) {
+	due := "2026-03-20"
+	assert.Contains(t, portfolioPace(contract.ProjectStatusView{DueDate: &due, TimeElapsedPct: 50, WorkDonePct: 53}), "on schedule")
+	assert.Contains(t, portfolioPace(contract.ProjectStatusView{DueDate: &due, TimeElapsedPct: 50, WorkDonePct: 45}), "5 pts behind")
+	assert.Contains(t, portfolioPace(contract.ProjectStatusView{WorkDonePct: 90}), "--")
+}
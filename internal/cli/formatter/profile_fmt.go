@@ -0,0 +1,80 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatProfile renders the user profile settings that `profile set` can
+// change.
+func FormatProfile(p *domain.UserProfile) string {
+	block, brk := p.PomodoroLengths()
+	autoReplan := Dim("off")
+	if p.AutoReplan {
+		autoReplan = StyleGreen.Render("on")
+	}
+
+	autocorrect := Dim("off")
+	if p.Autocorrect {
+		autocorrect = StyleGreen.Render("on")
+	}
+
+	validateTime := Dim("off")
+	if p.ValidateSessionTime {
+		validateTime = StyleGreen.Render("on")
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("  auto-replan     %s %s\n", autoReplan,
+		Dim("(replan the project after each logged session)")))
+	b.WriteString(fmt.Sprintf("  autocorrect     %s %s\n", autocorrect,
+		Dim("(run one-letter command typos, noting the assumed command)")))
+	b.WriteString(fmt.Sprintf("  validate-session-time %s %s\n", validateTime,
+		Dim("(reject a backdated session longer than the time since it started)")))
+	b.WriteString(fmt.Sprintf("  deadline-buffer %.0f%% %s\n", p.BufferPct*100,
+		Dim("(safety margin on remaining work when judging risk)")))
+	b.WriteString(fmt.Sprintf("  focus-block     %s\n", FormatMinutes(block)))
+	b.WriteString(fmt.Sprintf("  break           %s\n", FormatMinutes(brk)))
+	b.WriteString(fmt.Sprintf("  baseline-daily  %s\n", FormatMinutes(p.BaselineDailyMin)))
+	b.WriteString(fmt.Sprintf("  max-daily       %s %s\n", FormatMinutes(p.MaxDailyCapacity()),
+		Dim("(most work a day can hold; deadlines needing more are flagged infeasible)")))
+	b.WriteString(fmt.Sprintf("  pace-window     %dd %s\n", p.PaceWindow(),
+		Dim("(days of sessions averaged into the recent daily pace)")))
+	b.WriteString(fmt.Sprintf("  spacing-lookback %dd %s\n", p.SpacingLookback(),
+		Dim("(how far back what-now looks for an item's last session)")))
+	b.WriteString(fmt.Sprintf("  availability    %s\n", formatAvailability(p)))
+	b.WriteString(fmt.Sprintf("  active-hours    %s\n", formatActiveHours(p)))
+	b.WriteString(fmt.Sprintf("  weight-importance %.1f %s\n", p.WeightImportance,
+		Dim("(how much project importance moves what-now scores; 0 ignores it)")))
+	b.WriteString("\n" + Dim("Change with: profile set auto-replan=true focus-block=50"))
+	return RenderBox("Profile", b.String())
+}
+
+// formatAvailability lists the weekly plan availability per weekday, or
+// notes that every day uses baseline-daily.
+func formatAvailability(p *domain.UserProfile) string {
+	if len(p.WeekdayMin) != 7 {
+		return Dim("baseline-daily every day (weekly plan)")
+	}
+	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	parts := make([]string, 7)
+	for i, m := range p.WeekdayMin {
+		parts[i] = days[i] + " " + FormatMinutes(m)
+	}
+	return strings.Join(parts, Dim(" · "))
+}
+
+// formatActiveHours describes the what-now active hours window and whether
+// every what-now respects it.
+func formatActiveHours(p *domain.UserProfile) string {
+	if !p.HasActiveHours() {
+		return Dim("off (what-now suggests work at any time)")
+	}
+	window := domain.FormatClock(p.ActiveHoursStart) + "-" + domain.FormatClock(p.ActiveHoursEnd)
+	if p.RespectActiveHours {
+		return window + " " + Dim("(every what-now; --force to override)")
+	}
+	return window + " " + Dim("(what-now --respect-hours; respect-active-hours=true for always)")
+}
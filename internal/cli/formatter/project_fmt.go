@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/alexanderramin/kairos/internal/contract"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -15,10 +17,34 @@ type ProjectInspectData struct {
 	RootNodes []*domain.PlanNode
 	ChildMap  map[string][]*domain.PlanNode  // parentID -> children
 	WorkItems map[string][]*domain.WorkItem  // nodeID -> work items
+	// ShowProgress annotates each node with the logged/planned minutes and
+	// completion of everything beneath it (inspect --progress).
+	ShowProgress bool
+	// HideDone omits finished work items and fully finished nodes, noting
+	// how many were hidden under each remaining node (inspect --hide-done).
+	HideDone bool
+	// MaxDepth limits how many node levels below the roots are drawn, with
+	// deeper contents summarized as "(+N nested items)" (inspect --depth);
+	// nil draws every level.
+	MaxDepth *int
+	// ActivityDays turns on inspect --sessions: each work item is annotated
+	// with its sessions in the last ActivityDays days, taken from Activity
+	// (keyed by work item ID), and open work without any is flagged.
+	ActivityDays int
+	Activity     map[string]ItemActivity
 }
 
-// FormatProjectList renders a styled project list inside a bordered box.
-func FormatProjectList(projects []*domain.Project) string {
+// ItemActivity sums a work item's sessions within the inspect --sessions
+// window.
+type ItemActivity struct {
+	LastAt   time.Time
+	Minutes  int
+	Sessions int
+}
+
+// FormatProjectList renders a styled project list inside a bordered box,
+// stacking rows as cards when the table doesn't fit termWidth.
+func FormatProjectList(projects []*domain.Project, termWidth int) string {
 	headers := []string{"ID", "NAME", "DOMAIN", "STATUS", "DUE"}
 	rows := make([][]string, 0, len(projects))
 
@@ -45,25 +71,92 @@ func FormatProjectList(projects []*domain.Project) string {
 		})
 	}
 
-	table := RenderTable(headers, rows)
+	table := RenderTable(headers, rows, BoxContentWidth(termWidth))
 	return RenderBox("Projects", table)
 }
 
+// FormatRiskSummaryLine renders the portfolio health line shown above the
+// project list with --risk-summary, e.g. "12 projects · 2 critical · 3
+// at-risk · 7 on-track". Only active projects are counted; notCounted names
+// the others by status (paused, done, archived) in a dimmed note.
+func FormatRiskSummaryLine(summary contract.GlobalStatusSummary, notCounted map[domain.ProjectStatus]int) string {
+	noun := "projects"
+	if summary.CountsTotal == 1 {
+		noun = "project"
+	}
+	parts := []string{
+		Bold(fmt.Sprintf("%d %s", summary.CountsTotal, noun)),
+		StyleRed.Render(fmt.Sprintf("%d critical", summary.CountsCritical)),
+		StyleYellow.Render(fmt.Sprintf("%d at-risk", summary.CountsAtRisk)),
+		StyleGreen.Render(fmt.Sprintf("%d on-track", summary.CountsOnTrack)),
+	}
+	if summary.CountsUpcoming > 0 {
+		parts = append(parts, StyleBlue.Render(fmt.Sprintf("%d upcoming", summary.CountsUpcoming)))
+	}
+	line := strings.Join(parts, Dim(" · "))
+
+	var excluded []string
+	for _, status := range []domain.ProjectStatus{domain.ProjectPaused, domain.ProjectDone, domain.ProjectArchived} {
+		if n := notCounted[status]; n > 0 {
+			excluded = append(excluded, fmt.Sprintf("%d %s", n, status))
+		}
+	}
+	if len(excluded) > 0 {
+		line += "  " + Dim("(not counted: "+strings.Join(excluded, ", ")+")")
+	}
+	return line
+}
+
 // FormatProjectInspect renders a styled project inspect card with side-by-side layout.
 func FormatProjectInspect(data ProjectInspectData) string {
-	// Build left panel (metadata)
-	leftPanel := buildMetadataPanel(data.Project)
+	return FormatProjectInspectCard(data.Project, FormatProjectInspectTree(data))
+}
+
+// FormatProjectInspectTree renders the plan tree panel of the inspect card.
+// It depends only on the nodes and work items, so the shell can cache it
+// while the metadata panel, with its relative timestamps, is redrawn.
+func FormatProjectInspectTree(data ProjectInspectData) string {
+	var progress map[string]nodeProgress
+	if data.ShowProgress {
+		progress = make(map[string]nodeProgress)
+		rollupNodeProgress(data.RootNodes, data.ChildMap, data.WorkItems, progress)
+	}
+	maxDepth := -1
+	if data.MaxDepth != nil {
+		maxDepth = *data.MaxDepth
+	}
+	var activity *treeActivity
+	if data.ActivityDays > 0 {
+		activity = &treeActivity{days: data.ActivityDays, byItem: data.Activity}
+	}
+	return buildTreePanel(data.RootNodes, data.ChildMap, data.WorkItems, progress, activity, data.HideDone, maxDepth)
+}
 
-	// Build right panel (tree)
-	rightPanel := buildTreePanel(data.RootNodes, data.ChildMap, data.WorkItems)
+// FormatProjectInspectCard joins the project's metadata panel with an
+// already rendered tree panel.
+func FormatProjectInspectCard(p *domain.Project, treePanel string) string {
+	leftPanel := buildMetadataPanel(p)
 
 	// Join panels horizontally with spacing
 	spacing := "    "
-	combined := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, spacing, rightPanel)
+	combined := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, spacing, treePanel)
 
 	return RenderBox("", combined)
 }
 
+// FormatNodeSubtree renders the plan tree below a single node, with the node
+// itself as the root (node inspect --tree). showProgress and hideDone work as
+// in project inspect.
+func FormatNodeSubtree(node *domain.PlanNode, childMap map[string][]*domain.PlanNode, workItems map[string][]*domain.WorkItem, showProgress, hideDone bool) string {
+	roots := []*domain.PlanNode{node}
+	var progress map[string]nodeProgress
+	if showProgress {
+		progress = make(map[string]nodeProgress)
+		rollupNodeProgress(roots, childMap, workItems, progress)
+	}
+	return RenderBox(node.Title, buildTreePanel(roots, childMap, workItems, progress, nil, hideDone, -1))
+}
+
 // buildMetadataPanel creates the left panel with project metadata.
 func buildMetadataPanel(p *domain.Project) string {
 	var b strings.Builder
@@ -84,6 +177,10 @@ func buildMetadataPanel(p *domain.Project) string {
 		b.WriteString(fmt.Sprintf("%s  %s %s\n", StyleDim.Render("DUE   "), dueRelative, Dim("("+dueAbsolute+")")))
 	}
 
+	if imp := p.ImportanceOrDefault(); imp != domain.DefaultImportance {
+		b.WriteString(fmt.Sprintf("%s  %s\n", StyleDim.Render("IMPORT"), StyleFg.Render(fmt.Sprintf("%d/5", imp))))
+	}
+
 	if p.ArchivedAt != nil {
 		b.WriteString(fmt.Sprintf("%s  %s\n", StyleDim.Render("ARCHVD"), HumanTimestamp(*p.ArchivedAt)))
 	}
@@ -95,8 +192,10 @@ func buildMetadataPanel(p *domain.Project) string {
 	return panel
 }
 
-// buildTreePanel creates the right panel with the plan tree.
-func buildTreePanel(rootNodes []*domain.PlanNode, childMap map[string][]*domain.PlanNode, workItems map[string][]*domain.WorkItem) string {
+// buildTreePanel creates the right panel with the plan tree. The header
+// progress bar covers all loaded work; hideDone only prunes the tree below it.
+// maxDepth is passed to buildProjectTree.
+func buildTreePanel(rootNodes []*domain.PlanNode, childMap map[string][]*domain.PlanNode, workItems map[string][]*domain.WorkItem, progress map[string]nodeProgress, activity *treeActivity, hideDone bool, maxDepth int) string {
 	if len(rootNodes) == 0 {
 		return StyleDim.Render("No plan nodes")
 	}
@@ -124,19 +223,152 @@ func buildTreePanel(rootNodes []*domain.PlanNode, childMap map[string][]*domain.
 	underline := StyleDim.Render(strings.Repeat("─", 4))
 	b.WriteString(headerText + "\n" + underline + "\n")
 
-	items := buildProjectTree(rootNodes, childMap, workItems, 0)
+	var hidden map[string]int
+	rootHidden := 0
+	if hideDone {
+		rootNodes, childMap, workItems, hidden, rootHidden = pruneDone(rootNodes, childMap, workItems)
+	}
+
+	items := buildProjectTree(rootNodes, childMap, workItems, progress, activity, hidden, maxDepth, 0)
 	if len(items) > 0 {
 		b.WriteString(RenderTree(items))
 	}
+	if rootHidden > 0 {
+		if len(items) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(StyleDim.Render(fmt.Sprintf("(%d done hidden)", rootHidden)))
+	} else if len(items) == 0 && hideDone {
+		b.WriteString(StyleDim.Render("Nothing left to do"))
+	}
 
 	return b.String()
 }
 
+// pruneDone drops finished work items, and nodes whose whole subtree is
+// finished, from a plan tree. hidden counts the finished items removed under
+// each remaining node, including those in dropped child nodes; rootHidden
+// counts the items in dropped root nodes. Nodes that never had work stay.
+func pruneDone(
+	rootNodes []*domain.PlanNode,
+	childMap map[string][]*domain.PlanNode,
+	workItems map[string][]*domain.WorkItem,
+) (keptRoots []*domain.PlanNode, keptChildren map[string][]*domain.PlanNode, keptItems map[string][]*domain.WorkItem, hidden map[string]int, rootHidden int) {
+	keptChildren = make(map[string][]*domain.PlanNode)
+	keptItems = make(map[string][]*domain.WorkItem)
+	hidden = make(map[string]int)
+
+	// prune reports whether node stays, and how many finished items it
+	// holds when it doesn't.
+	var prune func(node *domain.PlanNode) (bool, int)
+	prune = func(node *domain.PlanNode) (bool, int) {
+		count := 0
+		for _, wi := range workItems[node.ID] {
+			if wi.IsTerminal() {
+				count++
+			} else {
+				keptItems[node.ID] = append(keptItems[node.ID], wi)
+			}
+		}
+		for _, child := range childMap[node.ID] {
+			if keep, n := prune(child); keep {
+				keptChildren[node.ID] = append(keptChildren[node.ID], child)
+			} else {
+				count += n
+			}
+		}
+		if len(keptItems[node.ID]) == 0 && len(keptChildren[node.ID]) == 0 && count > 0 {
+			return false, count
+		}
+		hidden[node.ID] = count
+		return true, 0
+	}
+
+	for _, node := range rootNodes {
+		if keep, n := prune(node); keep {
+			keptRoots = append(keptRoots, node)
+		} else {
+			rootHidden += n
+		}
+	}
+	return keptRoots, keptChildren, keptItems, hidden, rootHidden
+}
+
+// nodeProgress is a node's work rolled up over its own and all descendant
+// work items.
+type nodeProgress struct {
+	LoggedMin   int
+	PlannedMin  int
+	CompleteMin int // planned minutes of finished items plus logged minutes of open ones
+	Items       int
+	OpenItems   int
+}
+
+func (p *nodeProgress) add(o nodeProgress) {
+	p.LoggedMin += o.LoggedMin
+	p.PlannedMin += o.PlannedMin
+	p.CompleteMin += o.CompleteMin
+	p.Items += o.Items
+	p.OpenItems += o.OpenItems
+}
+
+// Complete reports whether the node has work items and all are finished.
+func (p nodeProgress) Complete() bool {
+	return p.Items > 0 && p.OpenItems == 0
+}
+
+// Detail renders the rollup as "logged/planned pct%".
+func (p nodeProgress) Detail() string {
+	pct := 0
+	if p.Complete() {
+		pct = 100
+	} else if p.PlannedMin > 0 {
+		pct = p.CompleteMin * 100 / p.PlannedMin
+	}
+	return fmt.Sprintf("%s/%s %d%%", FormatMinutes(p.LoggedMin), FormatMinutes(p.PlannedMin), pct)
+}
+
+// rollupNodeProgress walks the same tree as buildProjectTree, recording each
+// node's rollup in out, and returns the total for nodes.
+func rollupNodeProgress(
+	nodes []*domain.PlanNode,
+	childMap map[string][]*domain.PlanNode,
+	workItems map[string][]*domain.WorkItem,
+	out map[string]nodeProgress,
+) nodeProgress {
+	var total nodeProgress
+	for _, node := range nodes {
+		np := rollupNodeProgress(childMap[node.ID], childMap, workItems, out)
+		for _, wi := range workItems[node.ID] {
+			np.LoggedMin += wi.LoggedMin
+			np.PlannedMin += wi.PlannedMin
+			np.Items++
+			if wi.IsTerminal() {
+				np.CompleteMin += wi.PlannedMin
+			} else {
+				np.CompleteMin += min(wi.LoggedMin, wi.PlannedMin)
+				np.OpenItems++
+			}
+		}
+		out[node.ID] = np
+		total.add(np)
+	}
+	return total
+}
+
 // buildProjectTree recursively converts nodes and work items into TreeItems.
+// With progress set, node lines show their rollup and finished nodes a check;
+// hidden adds a "(N done hidden)" note to nodes pruned by pruneDone. Nodes
+// at level maxDepth draw no children or work items, only a "(+N nested
+// items)" count of them; a negative maxDepth draws every level.
 func buildProjectTree(
 	nodes []*domain.PlanNode,
 	childMap map[string][]*domain.PlanNode,
 	workItems map[string][]*domain.WorkItem,
+	progress map[string]nodeProgress,
+	activity *treeActivity,
+	hidden map[string]int,
+	maxDepth int,
 	level int,
 ) []TreeItem {
 	var items []TreeItem
@@ -158,12 +390,16 @@ func buildProjectTree(
 			wi := nodeWorkItems[0]
 			detail := ""
 			if wi.PlannedMin > 0 {
-				detail = FormatMinutes(wi.PlannedMin)
+				detail = FormatEstimate(wi.InitialPlannedMin, wi.PlannedMin)
 			} else if node.DueDate != nil {
 				detail = "DUE " + RelativeDate(*node.DueDate)
 			} else if node.PlannedMinBudget != nil {
 				detail = FormatMinutes(*node.PlannedMinBudget)
 			}
+			if np, ok := progress[node.ID]; ok {
+				detail = np.Detail()
+			}
+			detail = withHiddenNote(detail, hidden[node.ID])
 
 			items = append(items, TreeItem{
 				Title:  node.Title,
@@ -172,11 +408,13 @@ func buildProjectTree(
 				IsLast: isLastNode,
 				Status: string(wi.Status),
 				Detail: detail,
+				Note:   activity.itemNote(wi),
 			})
 			continue
 		}
 
 		hasChildren := len(children) > 0 || len(nodeWorkItems) > 0
+		truncated := hasChildren && level == maxDepth
 
 		// Build detail badge
 		detail := ""
@@ -185,18 +423,41 @@ func buildProjectTree(
 		} else if node.PlannedMinBudget != nil {
 			detail = FormatMinutes(*node.PlannedMinBudget)
 		}
+		nodeStatus := ""
+		if np, ok := progress[node.ID]; ok {
+			if detail != "" {
+				detail += "  "
+			}
+			detail += np.Detail()
+			if np.Complete() {
+				nodeStatus = string(domain.WorkItemDone)
+			}
+		}
+		detail = withHiddenNote(detail, hidden[node.ID])
+		if truncated {
+			note := fmt.Sprintf("(+%d nested items)", len(children)+len(nodeWorkItems))
+			if detail != "" {
+				note = detail + "  " + note
+			}
+			detail = note
+		}
 
 		items = append(items, TreeItem{
 			Title:  node.Title,
 			Seq:    node.Seq,
 			Level:  level + 1,
-			IsLast: isLastNode && !hasChildren,
+			IsLast: isLastNode && (!hasChildren || truncated),
+			Status: nodeStatus,
 			Detail: detail,
+			Note:   activity.branchNote(node, childMap, workItems),
 		})
+		if truncated {
+			continue
+		}
 
 		// Recurse into child nodes
 		if len(children) > 0 {
-			childItems := buildProjectTree(children, childMap, workItems, level+1)
+			childItems := buildProjectTree(children, childMap, workItems, progress, activity, hidden, maxDepth, level+1)
 			items = append(items, childItems...)
 		}
 
@@ -204,7 +465,7 @@ func buildProjectTree(
 		for j, wi := range nodeWorkItems {
 			wiDetail := ""
 			if wi.PlannedMin > 0 {
-				wiDetail = FormatMinutes(wi.PlannedMin)
+				wiDetail = FormatEstimate(wi.InitialPlannedMin, wi.PlannedMin)
 			}
 
 			items = append(items, TreeItem{
@@ -214,9 +475,85 @@ func buildProjectTree(
 				IsLast: j == len(nodeWorkItems)-1,
 				Status: string(wi.Status),
 				Detail: wiDetail,
+				Note:   activity.itemNote(wi),
 			})
 		}
 	}
 
 	return items
 }
+
+// treeActivity is the session window drawn by inspect --sessions; a nil
+// *treeActivity adds no notes.
+type treeActivity struct {
+	days   int
+	byItem map[string]ItemActivity
+}
+
+// itemNote describes a work item's sessions in the window, or flags open
+// work that has none. Finished items without sessions get no note.
+func (a *treeActivity) itemNote(wi *domain.WorkItem) string {
+	if a == nil {
+		return ""
+	}
+	act, ok := a.byItem[wi.ID]
+	if !ok {
+		if wi.IsTerminal() {
+			return ""
+		}
+		return StyleYellow.Render(fmt.Sprintf("no sessions in %dd", a.days))
+	}
+	return Dim(fmt.Sprintf("%s in %s · last %s", FormatMinutes(act.Minutes), pluralSessions(act.Sessions), activityDay(act.LastAt)))
+}
+
+// branchNote flags a node whose open work, its own and its descendants',
+// has had no sessions in the window, so neglected branches stand out.
+func (a *treeActivity) branchNote(node *domain.PlanNode, childMap map[string][]*domain.PlanNode, workItems map[string][]*domain.WorkItem) string {
+	if a == nil {
+		return ""
+	}
+	if open, touched := a.branchActivity(node, childMap, workItems); open > 0 && !touched {
+		return StyleYellow.Render(fmt.Sprintf("no sessions in %dd", a.days))
+	}
+	return ""
+}
+
+// branchActivity counts the open work items under node and reports whether
+// any item there has sessions in the window.
+func (a *treeActivity) branchActivity(node *domain.PlanNode, childMap map[string][]*domain.PlanNode, workItems map[string][]*domain.WorkItem) (open int, touched bool) {
+	for _, wi := range workItems[node.ID] {
+		if !wi.IsTerminal() {
+			open++
+		}
+		if _, ok := a.byItem[wi.ID]; ok {
+			touched = true
+		}
+	}
+	for _, child := range childMap[node.ID] {
+		n, t := a.branchActivity(child, childMap, workItems)
+		open += n
+		touched = touched || t
+	}
+	return open, touched
+}
+
+// activityDay names the day of a session: "today", "yesterday" or "Jan 2".
+func activityDay(t time.Time) string {
+	switch d := HumanDate(t.Local()); d {
+	case "Today", "Yesterday":
+		return strings.ToLower(d)
+	}
+	return t.Local().Format("Jan 2")
+}
+
+// withHiddenNote appends "(N done hidden)" to a tree detail when n > 0.
+func withHiddenNote(detail string, n int) string {
+	if n == 0 {
+		return detail
+	}
+	note := fmt.Sprintf("(%d done hidden)", n)
+	if detail == "" {
+		return note
+	}
+	return detail + "  " + note
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFormatProjectList_UsesShortIDWhenPresent(t *testing.T) {
@@ -23,7 +24,7 @@ func TestFormatProjectList_UsesShortIDWhenPresent(t *testing.T) {
 		},
 	}
 
-	out := FormatProjectList(projects)
+	out := FormatProjectList(projects, 0)
 
 	assert.Contains(t, out, "PSY01")
 	assert.NotContains(t, out, "12345678")
@@ -44,7 +45,7 @@ func TestFormatProjectList_FallsBackToUUIDPrefixWhenShortIDMissing(t *testing.T)
 		},
 	}
 
-	out := FormatProjectList(projects)
+	out := FormatProjectList(projects, 0)
 
 	assert.Contains(t, out, "abcdef12")
 }
@@ -64,7 +65,7 @@ func TestFormatProjectList_UsesPlaceholderWhenIDAndShortIDMissing(t *testing.T)
 		},
 	}
 
-	out := FormatProjectList(projects)
+	out := FormatProjectList(projects, 0)
 
 	assert.Contains(t, out, "--")
 }
@@ -117,7 +118,7 @@ func TestBuildProjectTree_CollapseSingleWorkItem(t *testing.T) {
 		"n1": {{Title: "Read The Odyssey", Seq: 2, Status: domain.WorkItemDone, PlannedMin: 720}},
 	}
 
-	items := buildProjectTree(nodes, nil, workItems, 0)
+	items := buildProjectTree(nodes, nil, workItems, nil, nil, nil, -1, 0)
 
 	assert.Len(t, items, 1, "should collapse node+work item into one item")
 	assert.Equal(t, "Homer – The Odyssey", items[0].Title, "should use node title")
@@ -137,7 +138,7 @@ func TestBuildProjectTree_NoCollapseMultipleWorkItems(t *testing.T) {
 		},
 	}
 
-	items := buildProjectTree(nodes, nil, workItems, 0)
+	items := buildProjectTree(nodes, nil, workItems, nil, nil, nil, -1, 0)
 
 	assert.Len(t, items, 3, "should not collapse: 1 node + 2 work items")
 	assert.Equal(t, "Week 1", items[0].Title)
@@ -156,7 +157,7 @@ func TestBuildProjectTree_NoCollapseWithChildNodes(t *testing.T) {
 		"n1": {{Title: "Overview", Seq: 3, Status: domain.WorkItemTodo, PlannedMin: 30}},
 	}
 
-	items := buildProjectTree(nodes, childMap, workItems, 0)
+	items := buildProjectTree(nodes, childMap, workItems, nil, nil, nil, -1, 0)
 
 	assert.True(t, len(items) > 1, "should not collapse when node has child nodes")
 	assert.Equal(t, "Part 1", items[0].Title)
@@ -172,7 +173,159 @@ func TestBuildTreePanel_ShowsProgressBar(t *testing.T) {
 			{Title: "Task B", Status: domain.WorkItemTodo, PlannedMin: 30},
 		},
 	}
-	out := buildTreePanel(nodes, nil, workItems)
+	out := buildTreePanel(nodes, nil, workItems, nil, nil, false, -1)
 	assert.Contains(t, out, "PLAN")
 	assert.Contains(t, out, "50%")
 }
+
+func TestBuildProjectTree_ProgressRollsUpDescendants(t *testing.T) {
+	nodes := []*domain.PlanNode{
+		{ID: "n1", Title: "Part 1", Seq: 1, OrderIndex: 0},
+	}
+	childMap := map[string][]*domain.PlanNode{
+		"n1": {
+			{ID: "n2", Title: "Chapter 1", Seq: 2, OrderIndex: 0},
+			{ID: "n3", Title: "Chapter 2", Seq: 3, OrderIndex: 1},
+		},
+	}
+	workItems := map[string][]*domain.WorkItem{
+		"n2": {
+			{Title: "Read", Seq: 4, Status: domain.WorkItemDone, PlannedMin: 60, LoggedMin: 45},
+			{Title: "Notes", Seq: 5, Status: domain.WorkItemDone, PlannedMin: 30, LoggedMin: 30},
+		},
+		"n3": {
+			{Title: "Read", Seq: 6, Status: domain.WorkItemInProgress, PlannedMin: 60, LoggedMin: 15},
+			{Title: "Notes", Seq: 7, Status: domain.WorkItemTodo, PlannedMin: 30},
+		},
+	}
+
+	progress := make(map[string]nodeProgress)
+	rollupNodeProgress(nodes, childMap, workItems, progress)
+	items := buildProjectTree(nodes, childMap, workItems, progress, nil, nil, -1, 0)
+
+	byTitle := make(map[string]TreeItem)
+	for _, it := range items {
+		if it.Seq <= 3 {
+			byTitle[it.Title] = it
+		}
+	}
+	// Part 1: logged 90 of 180 planned; finished items count in full, so 105/180.
+	assert.Equal(t, "1h 30m/3h 58%", byTitle["Part 1"].Detail)
+	assert.Empty(t, byTitle["Part 1"].Status)
+	assert.Equal(t, "1h 15m/1h 30m 100%", byTitle["Chapter 1"].Detail)
+	assert.Equal(t, string(domain.WorkItemDone), byTitle["Chapter 1"].Status, "finished node gets a checkmark")
+	assert.Equal(t, "15m/1h 30m 16%", byTitle["Chapter 2"].Detail)
+}
+
+func TestBuildTreePanel_HideDonePrunesFinishedWork(t *testing.T) {
+	nodes := []*domain.PlanNode{
+		{ID: "n1", Title: "Part 1", Seq: 1, OrderIndex: 0},
+		{ID: "n4", Title: "Part 2", Seq: 8, OrderIndex: 1},
+	}
+	childMap := map[string][]*domain.PlanNode{
+		"n1": {
+			{ID: "n2", Title: "Chapter 1", Seq: 2, OrderIndex: 0},
+			{ID: "n3", Title: "Chapter 2", Seq: 3, OrderIndex: 1},
+		},
+	}
+	workItems := map[string][]*domain.WorkItem{
+		"n2": {
+			{Title: "Read one", Seq: 4, Status: domain.WorkItemDone, PlannedMin: 60},
+			{Title: "Notes one", Seq: 5, Status: domain.WorkItemDone, PlannedMin: 30},
+		},
+		"n3": {
+			{Title: "Read two", Seq: 6, Status: domain.WorkItemInProgress, PlannedMin: 60},
+			{Title: "Notes two", Seq: 7, Status: domain.WorkItemDone, PlannedMin: 30},
+			{Title: "Quiz two", Seq: 10, Status: domain.WorkItemTodo, PlannedMin: 15},
+		},
+		"n4": {
+			{Title: "Essay", Seq: 9, Status: domain.WorkItemDone, PlannedMin: 60},
+		},
+	}
+
+	full := buildTreePanel(nodes, childMap, workItems, nil, nil, false, -1)
+	assert.Contains(t, full, "Chapter 1")
+	assert.NotContains(t, full, "hidden")
+
+	out := buildTreePanel(nodes, childMap, workItems, nil, nil, true, -1)
+	assert.Contains(t, out, "67%", "header progress still counts hidden work")
+	assert.NotContains(t, out, "Chapter 1", "fully finished node is dropped")
+	assert.NotContains(t, out, "Part 2")
+	assert.Contains(t, out, "Part 1")
+	assert.Contains(t, out, "(2 done hidden)", "dropped child's items count on the parent")
+	assert.Contains(t, out, "Chapter 2")
+	assert.Contains(t, out, "(1 done hidden)")
+	assert.Contains(t, out, "Read two")
+	assert.NotContains(t, out, "Notes two")
+}
+
+func TestBuildProjectTree_MaxDepthSummarizesNested(t *testing.T) {
+	nodes := []*domain.PlanNode{
+		{ID: "n1", Title: "Part 1", Seq: 1, OrderIndex: 0},
+		{ID: "n4", Title: "Part 2", Seq: 6, OrderIndex: 1},
+	}
+	childMap := map[string][]*domain.PlanNode{
+		"n1": {
+			{ID: "n2", Title: "Chapter 1", Seq: 2, OrderIndex: 0},
+			{ID: "n3", Title: "Chapter 2", Seq: 3, OrderIndex: 1},
+		},
+	}
+	workItems := map[string][]*domain.WorkItem{
+		"n1": {{Title: "Overview", Seq: 4, Status: domain.WorkItemTodo, PlannedMin: 30}},
+		"n2": {{Title: "Read", Seq: 5, Status: domain.WorkItemTodo, PlannedMin: 60}},
+		"n4": {{Title: "Essay", Seq: 7, Status: domain.WorkItemTodo, PlannedMin: 90}},
+	}
+
+	items := buildProjectTree(nodes, childMap, workItems, nil, nil, nil, 0, 0)
+	require.Len(t, items, 2, "depth 0 draws root nodes only")
+	assert.Equal(t, "Part 1", items[0].Title)
+	assert.Equal(t, "(+3 nested items)", items[0].Detail, "two chapters and one work item")
+	assert.False(t, items[0].IsLast)
+	assert.Equal(t, "Part 2", items[1].Title, "single-item root still collapses")
+	assert.Equal(t, string(domain.WorkItemTodo), items[1].Status)
+
+	items = buildProjectTree(nodes, childMap, workItems, nil, nil, nil, 1, 0)
+	titles := make([]string, len(items))
+	for i, it := range items {
+		titles[i] = it.Title
+	}
+	assert.Equal(t, []string{"Part 1", "Chapter 1", "Chapter 2", "Overview", "Part 2"}, titles)
+}
+
+func TestBuildProjectTree_SessionActivityNotes(t *testing.T) {
+	nodes := []*domain.PlanNode{
+		{ID: "n1", Title: "Week 1", Seq: 1, OrderIndex: 0},
+		{ID: "n2", Title: "Week 2", Seq: 2, OrderIndex: 1},
+	}
+	workItems := map[string][]*domain.WorkItem{
+		"n1": {
+			{ID: "w1", Title: "Reading", Seq: 3, Status: domain.WorkItemInProgress},
+			{ID: "w2", Title: "Notes", Seq: 4, Status: domain.WorkItemTodo},
+			{ID: "w3", Title: "Intro", Seq: 5, Status: domain.WorkItemDone},
+		},
+		"n2": {
+			{ID: "w4", Title: "Essay", Seq: 6, Status: domain.WorkItemTodo},
+			{ID: "w5", Title: "Outline", Seq: 7, Status: domain.WorkItemTodo},
+		},
+	}
+	activity := &treeActivity{days: 14, byItem: map[string]ItemActivity{
+		"w1": {LastAt: time.Now(), Minutes: 90, Sessions: 2},
+	}}
+
+	items := buildProjectTree(nodes, nil, workItems, nil, activity, nil, -1, 0)
+	notes := make(map[string]string, len(items))
+	for _, it := range items {
+		notes[it.Title] = it.Note
+	}
+	assert.Contains(t, notes["Reading"], "1h 30m in 2 sessions · last today")
+	assert.Contains(t, notes["Notes"], "no sessions in 14d")
+	assert.Empty(t, notes["Intro"], "finished work without sessions is not flagged")
+	assert.Empty(t, notes["Week 1"], "a branch with recent sessions is not flagged")
+	assert.Contains(t, notes["Week 2"], "no sessions in 14d", "a neglected branch is flagged")
+
+	out := RenderTree(items)
+	assert.Contains(t, out, "no sessions in 14d")
+	for _, it := range buildProjectTree(nodes, nil, workItems, nil, nil, nil, -1, 0) {
+		assert.Empty(t, it.Note, "no notes without --sessions")
+	}
+}
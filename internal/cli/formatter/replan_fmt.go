@@ -0,0 +1,58 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+)
+
+// FormatReplanItemChanges lists each re-estimated work item under its
+// project: old → new planned minutes, and the unit pace that drove the
+// change. It returns "" when replan changed no estimates.
+func FormatReplanItemChanges(deltas []app.ProjectReplanDelta) string {
+	var b strings.Builder
+	for _, d := range deltas {
+		if len(d.ItemChanges) == 0 {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("\n  " + Bold("Estimate changes") + "  " +
+				Dim("new = 70% current estimate + 30% at the observed pace") + "\n")
+		}
+		b.WriteString(fmt.Sprintf("\n  %s\n", Bold(d.ProjectName)))
+		for _, ch := range d.ItemChanges {
+			b.WriteString(fmt.Sprintf("    %s  %s → %s  %s\n", ch.Title,
+				Dim(FormatMinutes(ch.PlannedMinBefore)),
+				FormatMinutes(ch.PlannedMinAfter),
+				formatMinutesDelta(ch.PlannedMinAfter-ch.PlannedMinBefore)))
+			kind := ch.UnitsKind
+			if kind == "" {
+				kind = "units"
+			}
+			b.WriteString("      " + Dim(fmt.Sprintf("%s per unit (%s over %d/%d %s) → %s at that pace",
+				formatPace(ch.MinPerUnit), FormatMinutes(ch.LoggedMin), ch.UnitsDone, ch.UnitsTotal, kind,
+				FormatMinutes(ch.ImpliedTotalMin))) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// formatMinutesDelta renders a signed change in minutes: red when an
+// estimate grew, green when it shrank.
+func formatMinutesDelta(delta int) string {
+	if delta < 0 {
+		return StyleGreen.Render("-" + FormatMinutes(-delta))
+	}
+	return StyleRed.Render("+" + FormatMinutes(delta))
+}
+
+// formatPace prints minutes per unit, keeping one decimal for short
+// fractional paces (7.5m) and rounding otherwise.
+func formatPace(min float64) string {
+	if min < 10 && min != math.Trunc(min) {
+		return fmt.Sprintf("%.1fm", min)
+	}
+	return FormatMinutes(int(math.Round(min)))
+}
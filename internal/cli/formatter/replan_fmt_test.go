@@ -0,0 +1,30 @@
+package formatter
+
+import (
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReplanItemChanges(t *testing.T) {
+	out := FormatReplanItemChanges([]app.ProjectReplanDelta{
+		{ProjectName: "Unchanged"},
+		{ProjectName: "Study", ItemChanges: []app.ReplanItemChange{
+			{Title: "Read Chapters", PlannedMinBefore: 100, PlannedMinAfter: 130,
+				LoggedMin: 60, UnitsDone: 3, UnitsTotal: 10, UnitsKind: "chapters",
+				MinPerUnit: 20, ImpliedTotalMin: 200},
+			{Title: "Drills", PlannedMinBefore: 120, PlannedMinAfter: 96,
+				LoggedMin: 30, UnitsDone: 4, UnitsTotal: 8,
+				MinPerUnit: 7.5, ImpliedTotalMin: 60},
+		}},
+	})
+	assert.Contains(t, out, "Estimate changes")
+	assert.NotContains(t, out, "Unchanged")
+	assert.Contains(t, out, "Read Chapters  1h 40m → 2h 10m  +30m")
+	assert.Contains(t, out, "20m per unit (1h over 3/10 chapters) → 3h 20m at that pace")
+	assert.Contains(t, out, "Drills  2h → 1h 36m  -24m")
+	assert.Contains(t, out, "7.5m per unit (30m over 4/8 units) → 1h at that pace")
+
+	assert.Empty(t, FormatReplanItemChanges([]app.ProjectReplanDelta{{ProjectName: "Unchanged"}}))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 )
 
@@ -57,3 +58,90 @@ func FormatZettelBacklog(data ZettelBacklogData) string {
 
 	return RenderBox("Zettelkasten Backlog", b.String())
 }
+
+// FormatWeeklyReviewPlain renders a weekly review as plain text with no ANSI
+// styling, for pasting into a journal or an email: the summary line, what
+// got done, the risks that worsened and the numbered next-week plan.
+func FormatWeeklyReviewPlain(r *app.WeeklyReview, summary string) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Weekly review, %s - %s\n", r.From.Format("Jan 2"), r.To.Format("Jan 2, 2006")))
+	if summary != "" {
+		b.WriteString(summary + "\n")
+	}
+
+	b.WriteString("\nAccomplished\n")
+	if r.LoggedMin == 0 && len(r.Completed) == 0 {
+		b.WriteString("- Nothing logged this week\n")
+	}
+	if r.LoggedMin > 0 {
+		b.WriteString(fmt.Sprintf("- %s logged over %s\n", FormatMinutes(r.LoggedMin), pluralSessions(r.SessionCount)))
+	}
+	for _, p := range r.Projects {
+		if p.LoggedMin > 0 {
+			b.WriteString(fmt.Sprintf("- %s: %s in %s\n", p.ProjectName, FormatMinutes(p.LoggedMin), pluralSessions(p.Sessions)))
+		}
+	}
+	for _, it := range r.Completed {
+		b.WriteString(fmt.Sprintf("- Finished #%d %s (%s)\n", it.WorkItemSeq, it.Title, it.ProjectName))
+	}
+
+	b.WriteString("\nRisks that worsened\n")
+	if len(r.Worsened) == 0 {
+		b.WriteString("- None\n")
+	}
+	for _, w := range r.Worsened {
+		if w.RiskBefore != "" && w.RiskBefore != w.RiskLevel {
+			line := fmt.Sprintf("- %s: %s -> %s", w.ProjectName, riskShortLabel(w.RiskBefore), riskShortLabel(w.RiskLevel))
+			if w.DueDate != nil {
+				line += ", due " + *w.DueDate
+			}
+			b.WriteString(line + "\n")
+		}
+		if w.Infeasible {
+			b.WriteString("- " + infeasibleMessage(w.ProjectName, w.DueDate, w.ShortfallMin) + "\n")
+		}
+	}
+
+	b.WriteString("\nNext week\n")
+	if len(r.NextWeek) == 0 {
+		b.WriteString("- Nothing left to schedule\n")
+	}
+	for i, a := range r.NextWeek {
+		b.WriteString(fmt.Sprintf("%d. %s\n", i+1, reviewActionLine(a)))
+	}
+	return b.String()
+}
+
+// FormatWeeklyReviewActions renders the next-week plan as the closing box of
+// the styled weekly review. The lines match the plain format's.
+func FormatWeeklyReviewActions(actions []app.WeeklyReviewAction) string {
+	var b strings.Builder
+	if len(actions) == 0 {
+		b.WriteString(Dim("Nothing left to schedule.") + "\n")
+	}
+	for i, a := range actions {
+		b.WriteString(fmt.Sprintf("%s %s\n", Bold(fmt.Sprintf("%d.", i+1)), reviewActionLine(a)))
+	}
+	b.WriteString("\n" + Dim("Plain copy: review weekly --plain; full week: weekly plan"))
+	return RenderBox("Next Week", b.String())
+}
+
+// reviewActionLine is "Mon, Wed: #4 Title (Project), 2h".
+func reviewActionLine(a app.WeeklyReviewAction) string {
+	days := make([]string, len(a.Days))
+	for i, d := range a.Days {
+		days[i] = d.Format("Mon")
+	}
+	title := a.Title
+	if a.WorkItemSeq > 0 {
+		title = fmt.Sprintf("#%d %s", a.WorkItemSeq, title)
+	}
+	return fmt.Sprintf("%s: %s (%s), %s", strings.Join(days, ", "), title, a.ProjectName, FormatMinutes(a.Minutes))
+}
+
+func pluralSessions(n int) string {
+	if n == 1 {
+		return "1 session"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
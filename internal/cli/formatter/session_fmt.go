@@ -0,0 +1,80 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatSessionTagReport renders minutes per session tag for the days from
+// through last (both inclusive, local dates). Untagged time is listed last.
+func FormatSessionTagReport(summaries []domain.SessionTagSummary, from, last time.Time, termWidth int) string {
+	title := fmt.Sprintf("Time by Tag · %s – %s", from.Format("Jan 2"), last.Format("Jan 2"))
+	if len(summaries) == 0 {
+		return RenderBox(title, Dim("No sessions logged in this range."))
+	}
+
+	headers := []string{"TAG", "SESSIONS", "TIME"}
+	rows := make([][]string, 0, len(summaries))
+	var untagged []string
+	tagged := 0
+	for _, s := range summaries {
+		row := []string{
+			Bold(s.Tag),
+			fmt.Sprintf("%d", s.SessionCount),
+			FormatMinutes(s.TotalMinutes),
+		}
+		if s.Tag == "" {
+			row[0] = Dim("(untagged)")
+			untagged = row
+			continue
+		}
+		tagged++
+		rows = append(rows, row)
+	}
+	if untagged != nil {
+		rows = append(rows, untagged)
+	}
+
+	var b strings.Builder
+	b.WriteString(RenderTable(headers, rows, BoxContentWidth(termWidth)))
+	if tagged > 1 {
+		b.WriteString("\n" + Dim("Sessions with several tags count toward each of them."))
+	}
+	return RenderBox(title, b.String())
+}
+
+// FormatSessionList renders sessions as a table in the order given. With
+// history set the rows are one work item's trail: the WORK ITEM column is
+// dropped and TOTAL carries the running sum of minutes, so the sessions
+// should be oldest first.
+func FormatSessionList(sessions []*domain.WorkSessionLog, history bool, termWidth int) string {
+	title := "Sessions"
+	headers := []string{"ID", "WORK ITEM", "STARTED", "DURATION", "UNITS", "TAGS", "NOTE"}
+	if history {
+		title = "Session History"
+		headers = []string{"ID", "STARTED", "DURATION", "TOTAL", "UNITS", "TAGS", "NOTE"}
+	}
+	rows := make([][]string, 0, len(sessions))
+	cumulative := 0
+	for _, s := range sessions {
+		cumulative += s.Minutes
+		row := []string{TruncID(s.ID)}
+		if !history {
+			row = append(row, TruncID(s.WorkItemID))
+		}
+		row = append(row, HumanTimestamp(s.StartedAt), FormatMinutes(s.Minutes))
+		if history {
+			row = append(row, FormatMinutes(cumulative))
+		}
+		row = append(row,
+			fmt.Sprintf("%d", s.UnitsDoneDelta),
+			strings.Join(s.Tags, ","),
+			Dim(Truncate(s.Note, 40)),
+		)
+		rows = append(rows, row)
+	}
+	return RenderBox(title, RenderTable(headers, rows, BoxContentWidth(termWidth)))
+}
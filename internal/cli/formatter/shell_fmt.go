@@ -54,16 +54,50 @@ func FormatShellHelp() string {
 			title: "Navigation",
 			commands: [][]string{
 				{"projects", "List all active projects"},
+				{"project list --risk-summary", "Project list headed by counts per risk tier"},
 				{"use <id>", "Set active project (no args to clear)"},
 				{"inspect [id]", "Show project details and plan tree"},
+				{"project inspect <id> --progress", "Plan tree with per-node logged/planned and % done"},
+				{"node inspect <id> --tree", "Plan tree under a single node"},
+				{"project inspect <id> --hide-done", "Plan tree without finished work (counts per node)"},
+				{"project inspect <id> --depth N", "Plan tree limited to N node levels below the roots"},
+				{"project inspect <id> --sessions", "Plan tree with each item's recent sessions; flags untouched work"},
+				{"project progress --chart", "Time elapsed vs work done bars for every project"},
 			},
 		},
 		{
 			title: "Planning",
 			commands: [][]string{
 				{"what-now [min]", "Get session recommendations (default: 60 min)"},
-				{"status", "Show progress overview"},
-				{"replan", "Rebalance project schedules"},
+				{"today", "Daily goal, time logged, today's plan and what's due"},
+				{"what-now --continue", "Keep the current item first (no spacing penalty)"},
+				{"what-now --avoid <id>", "Skip a project for this query (repeatable)"},
+				{"what-now --context office", "Only items tagged @office (set with work add/update --tag)"},
+				{"what-now --min-block 25", "Only suggest slices of at least 25 minutes"},
+				{"what-now 120 --max-projects 2", "Keep the session to the top two projects"},
+				{"what-now 120 --budget-per-project even", "Split the time evenly across projects"},
+				{"what-now --strategy warmup", "Start with a short item, then the top deep one"},
+				{"what-now --oneline", "Just the next action on one line (for prompts)"},
+				{"what-now --group-by project", "Recommendations under per-project headers and subtotals"},
+				{"what-now --snooze-critical <id>", "Set a stuck critical project aside until tomorrow (--until)"},
+				{"what-now --save-plan", "Keep today's recommendations as the day plan"},
+				{"what-now --respect-hours", "Nothing outside active-hours (--force overrides)"},
+				{"what-now --force-early", "Include projects that haven't started yet"},
+				{"what-now --until 15:00", "Plan the time left before a set time today"},
+				{"plan [status] [--date D]", "Show the saved day plan (status: planned vs logged)"},
+				{"weekly plan", "Spread remaining work over the next 7 days; flags deadlines that won't fit"},
+				{"status [--compare DATE]", "Show progress overview (change since DATE)"},
+				{"status --risk critical", "Only projects at that risk tier (repeatable)"},
+				{"status --export md", "Status as a plain markdown report to paste"},
+				{"status --watch", "Live status, refreshed every 30s (--interval)"},
+				{"timeline [--days 30]", "Upcoming deadlines across all projects, by date"},
+				{"replan [--dry-run]", "Rebalance project schedules (preview with --dry-run)"},
+				{"project shift <id> --by +14d", "Move all plan dates (or --from a new start date)"},
+				{"focus [add|remove <id>]", "Pin items to rank first in what-now (no args to list)"},
+				{"profile set auto-replan=true", "Replan a project after each logged session"},
+				{"profile export --out FILE", "Save settings and presets to carry to another machine (import FILE)"},
+				{"config [get|set <key> <value>]", "Scoring weights and other settings (no args to list)"},
+				{"stats accuracy", "Estimation accuracy per work type"},
 			},
 		},
 		{
@@ -71,6 +105,7 @@ func FormatShellHelp() string {
 			commands: [][]string{
 				{"add [#node] <title> [dur]", "Quick-add a work item (e.g. add #1 \"Review\" 2h)"},
 				{"log [min]", "Log a work session (wizard for missing args)"},
+				{"log #5 30 done", "Log a session and mark the item done ('!' works too)"},
 				{"start [id]", "Start a work item (mark in-progress)"},
 				{"finish [id]", "Finish a work item (mark done)"},
 				{"context", "Show/set active project, item, and duration"},
@@ -80,18 +115,49 @@ func FormatShellHelp() string {
 			title: "Tracking",
 			commands: [][]string{
 				{"session log", "Log a work session (wizard if flags omitted)"},
+				{"session log --pomodoro N", "Log N focus blocks, spaced by breaks"},
+				{"session log --finish", "Log a session and mark the item done"},
+				{"session log --continue-timer", "Log the timer started with start, from when it started"},
+				{"session log --yesterday", "Backdate to this time yesterday (or --days-ago N)"},
+				{"session log --tag billable", "Tag a session (comma-separated, lowercase)"},
+				{"session list --format json", "Sessions as JSON with item and project (for spreadsheets)"},
+				{"work inspect <id> --history", "An item's sessions oldest first, with a running total"},
+				{"session report --group-by tag", "Minutes per session tag (--from/--to or --days)"},
+				{"session undo-last", "Remove the session just logged (--force if older than 10m)"},
 				{"work done <id>", "Mark a work item as done"},
+				{"work done <id> --log 25", "Log the last 25 min and mark done in one step"},
+				{"work list --status S", "Flat list of the project's items (--type T, --project ID)"},
 				{"work update <id>", "Update a work item"},
+				{"work clone <id> [--node N]", "Copy an item fresh (Read Ch1 → Read Ch2)"},
+				{"work move-up <id>", "Reorder an item within its node (move-down too)"},
+				{"work bump <id> +30", "Adjust an estimate up or down (-15, +1h)"},
+				{"work check <id> ...", "Checklist steps: add <text>, toggle <n>, remove <n>"},
+				{"work priority <id> top", "Force an item first in what-now (high, none)"},
+				{"history <id>", "Show a work item's change log (created, updated, logged...)"},
+				{"project archive <id> --with-done", "Archive a project's done items (--all for every project)"},
+				{"archive list", "Archived projects and work items with their archive dates"},
+				{"archive purge --older-than 90d", "Permanently delete old archived entries (--dry-run first)"},
 			},
 		},
 		{
 			title: "Creation",
 			commands: [][]string{
 				{"draft [desc]", "Create a new project (wizard or AI draft)"},
+				{"draft --resume", "Pick up the draft you left unfinished (--discard drops it)"},
 				{"project add", "Add a project manually"},
 				{"project import <file>", "Import project from JSON"},
+				{"import <file> --dry-run", "Check a plan file and show what would be created"},
+				{"template validate <file>", "Check a custom template for errors"},
+				{"import <file> --merge", "Re-sync an existing project by short_id/ref"},
+				{"export [--since ts]", "Export changes as JSON (with tombstones)"},
+				{"project export --format dot", "Export the project tree as Graphviz DOT"},
 				{"node add", "Add a plan node (wizard if flags omitted)"},
+				{"node add --count 10 --title \"Week {n}\"", "Add Week 1..10 at once (--days-per 7 for due dates)"},
+				{"node update <id> --due D", "Set a node due date (--propagate copies it to its items)"},
 				{"work add", "Add a work item (wizard if flags omitted)"},
+				{"work preset save <name>", "Save a work item shape for work add --preset"},
+				{"project update <id> --default-type reading", "Defaults for new items in a project (--default-*)"},
+				{"work estimate --type T", "Suggest planned minutes from past items (--units N --unit-label L)"},
 			},
 		},
 		{
@@ -99,8 +165,10 @@ func FormatShellHelp() string {
 			commands: [][]string{
 				{"ask <question>", "Natural language command (requires LLM)"},
 				{"explain now", "Explain current recommendations"},
+				{"explain now --verbose", "Add the full ranked candidate table with scores"},
 				{"explain why-not", "Explain why an item was excluded"},
-				{"review weekly", "Weekly progress review"},
+				{"review weekly [--plain]", "Weekly progress review and next-week actions"},
+				{"llm status", "Check the model server and configured model"},
 			},
 		},
 		{
@@ -108,6 +176,9 @@ func FormatShellHelp() string {
 			commands: [][]string{
 				{"help", "Show this command reference"},
 				{"help chat [question]", "Interactive help (LLM or fuzzy match)"},
+				{"help commands --search T", "Offline command reference with flags and examples"},
+				{"debug timings", "Use-case call counts and latency percentiles"},
+				{"<command> --quiet | --verbose", "One-line confirmations, or add timing and IDs"},
 				{"clear", "Clear the screen"},
 				{"exit / quit", "Quit kairos"},
 			},
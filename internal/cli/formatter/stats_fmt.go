@@ -0,0 +1,94 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+)
+
+// FormatEstimateAccuracy renders logged/estimated ratios per work item type.
+func FormatEstimateAccuracy(resp *app.EstimateAccuracyResponse, termWidth int) string {
+	if resp.Overall.Count == 0 {
+		return RenderBox("Estimate Accuracy",
+			Dim("No completed work items with logged sessions yet."))
+	}
+
+	var b strings.Builder
+	headers := []string{"TYPE", "ITEMS", "MEAN", "SPREAD", "RANGE", "TENDENCY"}
+	rows := make([][]string, 0, len(resp.ByType)+1)
+	for _, acc := range resp.ByType {
+		typ := acc.Type
+		if typ == "" {
+			typ = "(untyped)"
+		}
+		rows = append(rows, estimateAccuracyRow(Bold(typ), acc))
+	}
+	rows = append(rows, estimateAccuracyRow(Dim("all"), resp.Overall))
+	b.WriteString(RenderTable(headers, rows, BoxContentWidth(termWidth)))
+
+	b.WriteString("\n")
+	b.WriteString(Dim("Ratio = logged ÷ original estimate; above 1.00× means it took longer.") + "\n")
+	if resp.SkippedNoEstimate > 0 {
+		b.WriteString(Dim(fmt.Sprintf("%d done item(s) skipped: no original estimate.", resp.SkippedNoEstimate)) + "\n")
+	}
+
+	return RenderBox("Estimate Accuracy", b.String())
+}
+
+func estimateAccuracyRow(label string, acc app.EstimateAccuracy) []string {
+	return []string{
+		label,
+		fmt.Sprintf("%d", acc.Count),
+		fmt.Sprintf("%.2f×", acc.MeanRatio),
+		Dim(fmt.Sprintf("±%.2f", acc.StdDevRatio)),
+		Dim(fmt.Sprintf("%.2f–%.2f", acc.MinRatio, acc.MaxRatio)),
+		estimateTendency(acc.MeanRatio),
+	}
+}
+
+// estimateTendency labels a mean ratio, treating ±10% as accurate.
+func estimateTendency(mean float64) string {
+	switch {
+	case mean > 1.1:
+		return StyleRed.Render(fmt.Sprintf("under by %.0f%%", (mean-1)*100))
+	case mean < 0.9:
+		return StyleYellow.Render(fmt.Sprintf("over by %.0f%%", (1-mean)*100))
+	default:
+		return StyleGreen.Render("accurate")
+	}
+}
+
+// FormatEstimateSuggestion renders a suggested planned-min with the history
+// it came from and the work add flag to use it.
+func FormatEstimateSuggestion(s *app.EstimateSuggestion) string {
+	var b strings.Builder
+	subject := s.Type
+	if s.Units > 0 {
+		label := s.UnitLabel
+		if label == "" {
+			label = "units"
+		}
+		subject = fmt.Sprintf("%s, %d %s", s.Type, s.Units, label)
+	}
+	b.WriteString(fmt.Sprintf("Suggested estimate for %s: %s\n",
+		Bold(subject), StyleGreen.Render(FormatMinutes(s.SuggestedMin))))
+
+	switch s.Basis {
+	case app.EstimateBasisPerUnit:
+		b.WriteString(Dim(fmt.Sprintf("Based on %.1f min per unit across %d completed %s item(s).",
+			s.MinPerUnit, s.SampleCount, s.Type)) + "\n")
+	case app.EstimateBasisAverage:
+		b.WriteString(Dim(fmt.Sprintf("Based on the average of %d completed %s item(s) (%s each).",
+			s.SampleCount, s.Type, FormatMinutes(int(math.Round(s.MeanMin))))) + "\n")
+		if s.Units > 0 {
+			b.WriteString(Dim("None of them recorded matching units, so the unit count is not factored in.") + "\n")
+		}
+	default:
+		b.WriteString(StyleYellow.Render(fmt.Sprintf("No completed %s items with logged time yet;", s.Type)) + " " +
+			Dim("this is a default starting point, not a prediction.") + "\n")
+	}
+	b.WriteString(Dim(fmt.Sprintf("Use it with: work add ... --type %s --planned-min %d", s.Type, s.SuggestedMin)))
+	return b.String()
+}
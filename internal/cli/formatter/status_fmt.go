@@ -3,19 +3,24 @@ package formatter
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
 )
 
 const statusProgressBarWidth = 10
 
-// FormatStatus formats a StatusResponse into a styled CLI dashboard string.
-func FormatStatus(resp *contract.StatusResponse) string {
+// FormatStatus formats a StatusResponse into a styled CLI dashboard string,
+// stacking rows as cards when the table doesn't fit termWidth.
+func FormatStatus(resp *contract.StatusResponse, termWidth int) string {
 	var b strings.Builder
 
 	// Build the table.
 	headers := []string{"NAME", "STATUS", "PROGRESS", "RISK", "DUE"}
+	showChange := hasStatusDeltas(resp.Projects)
+	if showChange {
+		headers = append(headers, "CHANGE")
+	}
 	rows := make([][]string, 0, len(resp.Projects))
 
 	for _, p := range resp.Projects {
@@ -25,29 +30,41 @@ func FormatStatus(resp *contract.StatusResponse) string {
 		// Risk indicator.
 		risk := RiskIndicator(p.RiskLevel)
 
+		// A project that hasn't started has no pace to judge yet.
+		if p.Upcoming {
+			risk = StyleBlue.Render("● UPCOMING")
+			if p.StartsOn != nil {
+				progress = Dim("starts " + *p.StartsOn)
+			}
+		}
+
 		// Status pill.
 		status := StatusPill(p.Status)
 
 		// Due date with relative styling.
 		due := Dim("--")
 		if p.DueDate != nil {
-			if parsed, err := time.Parse("2006-01-02", *p.DueDate); err == nil {
-				due = RelativeDateStyled(parsed)
+			if s, ok := DeadlineStyled(*p.DueDate); ok {
+				due = s
 			} else {
 				due = StyleFg.Render(*p.DueDate)
 			}
 		}
 
-		rows = append(rows, []string{
+		row := []string{
 			Bold(p.ProjectName),
 			status,
 			progress,
 			risk,
 			due,
-		})
+		}
+		if showChange {
+			row = append(row, formatStatusDelta(p.Delta))
+		}
+		rows = append(rows, row)
 	}
 
-	b.WriteString(RenderTable(headers, rows))
+	b.WriteString(RenderTable(headers, rows, BoxContentWidth(termWidth)))
 
 	// Summary line.
 	summary := resp.Summary
@@ -58,6 +75,9 @@ func FormatStatus(resp *contract.StatusResponse) string {
 	onTrackPart := StyleGreen.Render(fmt.Sprintf("%d On Track", summary.CountsOnTrack))
 
 	summaryLine := fmt.Sprintf("%s, %s, %s", criticalPart, atRiskPart, onTrackPart)
+	if summary.CountsUpcoming > 0 {
+		summaryLine += ", " + StyleBlue.Render(fmt.Sprintf("%d Upcoming", summary.CountsUpcoming))
+	}
 	b.WriteString(summaryLine + "\n")
 
 	// Policy message.
@@ -66,6 +86,18 @@ func FormatStatus(resp *contract.StatusResponse) string {
 		b.WriteString(Dim(summary.PolicyMessage) + "\n")
 	}
 
+	// Deadlines that can't be met at the profile's max daily minutes.
+	var infeasible []string
+	for _, p := range resp.Projects {
+		if p.Infeasible {
+			infeasible = append(infeasible, infeasibleMessage(p.ProjectName, p.DueDate, p.ShortfallMin))
+		}
+	}
+	writeInfeasible(&b, infeasible)
+
+	// Each project's nearest open assessment, which overall progress hides.
+	writeMilestones(&b, resp.Projects)
+
 	// Warnings.
 	if len(resp.Warnings) > 0 {
 		b.WriteString("\n")
@@ -77,3 +109,117 @@ func FormatStatus(resp *contract.StatusResponse) string {
 	return RenderBox("Status", b.String())
 }
 
+// infeasibleMessage says a project's deadline is out of reach and by how
+// much, e.g. "Thesis can't be finished by 2026-10-21 even at max-daily: 3h
+// short. Cut scope or move the date".
+func infeasibleMessage(projectName string, dueDate *string, shortfallMin int) string {
+	due := "its deadline"
+	if dueDate != nil {
+		due = *dueDate
+	}
+	return fmt.Sprintf("%s can't be finished by %s even at max-daily: %s short. Cut scope or move the date",
+		projectName, due, FormatMinutes(shortfallMin))
+}
+
+// writeInfeasible writes one red INFEASIBLE line per message.
+func writeInfeasible(b *strings.Builder, messages []string) {
+	if len(messages) == 0 {
+		return
+	}
+	b.WriteString("\n")
+	for _, msg := range messages {
+		b.WriteString(StyleRed.Render(fmt.Sprintf("  INFEASIBLE: %s", msg)) + "\n")
+	}
+}
+
+// milestoneSoonDays is how close an unstarted milestone must be for its
+// line to be highlighted.
+const milestoneSoonDays = 14
+
+// writeMilestones writes a NEXT MILESTONES block with one line per project
+// that has an open assessment node.
+func writeMilestones(b *strings.Builder, projects []contract.ProjectStatusView) {
+	var lines []string
+	for _, p := range projects {
+		if p.NextMilestone != nil {
+			lines = append(lines, fmt.Sprintf("  %s  %s", Bold(p.ProjectName), FormatMilestone(p.NextMilestone)))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	b.WriteString("\n" + Dim("NEXT MILESTONES") + "\n")
+	for _, line := range lines {
+		b.WriteString(line + "\n")
+	}
+}
+
+// FormatMilestone renders a milestone as "TMA 02 · 2026-10-30 (12d left) ·
+// not started". The days are red once overdue, and yellow when it is near
+// and nothing has been done on it yet.
+func FormatMilestone(m *contract.ProjectMilestone) string {
+	var days string
+	switch {
+	case m.DaysLeft < 0:
+		days = StyleRed.Render(fmt.Sprintf("(%dd overdue)", -m.DaysLeft))
+	case m.DaysLeft == 0:
+		days = StyleRed.Render("(today)")
+	case !m.Started && m.DaysLeft <= milestoneSoonDays:
+		days = StyleYellow.Render(fmt.Sprintf("(%dd left)", m.DaysLeft))
+	default:
+		days = Dim(fmt.Sprintf("(%dd left)", m.DaysLeft))
+	}
+	progress := Dim("not started")
+	if m.Started {
+		progress = StyleGreen.Render("started")
+	}
+	return fmt.Sprintf("%s · %s %s · %s", m.Title, m.DueDate, days, progress)
+}
+
+// hasStatusDeltas reports whether any project carries comparison data.
+func hasStatusDeltas(projects []contract.ProjectStatusView) bool {
+	for _, p := range projects {
+		if p.Delta != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// formatStatusDelta renders the CHANGE column: "new", "no history", or the
+// progress delta followed by the risk transition when it changed.
+func formatStatusDelta(d *contract.ProjectStatusDelta) string {
+	switch {
+	case d == nil:
+		return Dim("--")
+	case d.IsNew:
+		return StyleBlue.Render("new")
+	case !d.HasSnapshot:
+		return Dim("no history")
+	}
+
+	pct := fmt.Sprintf("%+.1f%%", d.ProgressTimePctDelta)
+	switch {
+	case d.ProgressTimePctDelta > 0:
+		pct = StyleGreen.Render(pct)
+	case d.ProgressTimePctDelta < 0:
+		pct = StyleRed.Render(pct)
+	default:
+		pct = Dim(pct)
+	}
+	return pct + " " + Dim("from "+riskShortLabel(d.RiskBefore))
+}
+
+// riskShortLabel returns a compact lowercase label for a risk level.
+func riskShortLabel(risk domain.RiskLevel) string {
+	switch risk {
+	case domain.RiskCritical:
+		return "critical"
+	case domain.RiskAtRisk:
+		return "at risk"
+	case domain.RiskOnTrack:
+		return "on track"
+	default:
+		return "unknown"
+	}
+}
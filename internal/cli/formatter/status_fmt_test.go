@@ -1,10 +1,12 @@
 package formatter
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/alexanderramin/kairos/internal/contract"
 	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,10 +31,100 @@ func TestFormatStatus_IncludesPolicyWarningsAndFallbackDueDate(t *testing.T) {
 		Warnings: []string{"Projected overload this week"},
 	}
 
-	out := FormatStatus(resp)
+	out := FormatStatus(resp, 0)
 	assert.Contains(t, out, "Chemistry Prep")
 	assert.Contains(t, out, "not-a-date")
 	assert.Contains(t, out, "Critical work requires attention")
 	assert.Contains(t, out, "Projected overload this week")
 }
 
+func TestFormatStatus_InfeasibleProjectsShowShortfall(t *testing.T) {
+	due := "2026-10-21"
+	resp := &contract.StatusResponse{
+		Projects: []contract.ProjectStatusView{
+			{ProjectName: "Essay", Status: domain.ProjectActive, RiskLevel: domain.RiskCritical, DueDate: &due, Infeasible: true, ShortfallMin: 180},
+			{ProjectName: "Thesis", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack},
+		},
+	}
+
+	out := FormatStatus(resp, 0)
+	assert.Contains(t, out, "INFEASIBLE: Essay can't be finished by 2026-10-21")
+	assert.Contains(t, out, "3h short")
+	assert.NotContains(t, out, "Thesis can't")
+
+	md := FormatStatusMarkdown(resp, nil)
+	assert.Contains(t, md, "- Infeasible: Essay can't be finished by 2026-10-21 even at max-daily: 3h short")
+}
+
+func TestFormatStatus_UpcomingProjectShowsStartInsteadOfPace(t *testing.T) {
+	starts := "2026-11-02"
+	resp := &contract.StatusResponse{
+		Summary: contract.GlobalStatusSummary{CountsOnTrack: 1, CountsUpcoming: 1},
+		Projects: []contract.ProjectStatusView{
+			{ProjectName: "Thesis", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack},
+			{ProjectName: "Next Term", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack, Upcoming: true, StartsOn: &starts},
+		},
+	}
+
+	out := FormatStatus(resp, 0)
+	assert.Contains(t, out, "UPCOMING")
+	assert.Contains(t, out, "starts 2026-11-02")
+	assert.Equal(t, 1, strings.Count(out, "ON TRACK"))
+	assert.Contains(t, out, "1 Upcoming")
+}
+
+func TestFormatStatus_ChangeColumnOnlyWithDeltas(t *testing.T) {
+	resp := &contract.StatusResponse{
+		Projects: []contract.ProjectStatusView{
+			{ProjectName: "Alpha", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack, ProgressTimePct: 40},
+		},
+	}
+	assert.NotContains(t, FormatStatus(resp, 0), "CHANGE")
+
+	resp.Projects = append(resp.Projects, contract.ProjectStatusView{
+		ProjectName: "Beta", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack,
+	})
+	resp.Projects[0].Delta = &contract.ProjectStatusDelta{
+		HasSnapshot: true, RiskBefore: domain.RiskAtRisk, ProgressTimePctDelta: 12.5,
+	}
+	resp.Projects[1].Delta = &contract.ProjectStatusDelta{IsNew: true}
+
+	out := FormatStatus(resp, 0)
+	assert.Contains(t, out, "CHANGE")
+	assert.Contains(t, out, "+12.5%")
+	assert.Contains(t, out, "from at risk")
+	assert.Contains(t, out, "new")
+}
+
+func TestFormatStatus_NarrowTerminalStacksRows(t *testing.T) {
+	resp := &contract.StatusResponse{
+		Projects: []contract.ProjectStatusView{
+			{ProjectName: "A Very Long Project Name That Keeps Going", Status: domain.ProjectActive, RiskLevel: domain.RiskAtRisk, ProgressTimePct: 40},
+		},
+	}
+
+	out := FormatStatus(resp, 40)
+	assert.Contains(t, out, "PROGRESS")
+	assert.Contains(t, out, "…")
+	for _, line := range strings.Split(out, "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 40, "line %q", line)
+	}
+}
+
+func TestFormatStatus_NextMilestones(t *testing.T) {
+	resp := &contract.StatusResponse{
+		Projects: []contract.ProjectStatusView{
+			{ProjectName: "Physics", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack,
+				NextMilestone: &contract.ProjectMilestone{Title: "Course test", DueDate: "2026-11-02", DaysLeft: 9}},
+			{ProjectName: "Thesis", Status: domain.ProjectActive, RiskLevel: domain.RiskOnTrack},
+		},
+	}
+
+	out := FormatStatus(resp, 0)
+	assert.Contains(t, out, "NEXT MILESTONES")
+	assert.Contains(t, out, "Course test · 2026-11-02 (9d left) · not started")
+	assert.Equal(t, 1, strings.Count(out, "not started"), "projects without a milestone get no line")
+
+	started := FormatMilestone(&contract.ProjectMilestone{Title: "TMA 01", DueDate: "2026-10-10", DaysLeft: -3, Started: true})
+	assert.Equal(t, "TMA 01 · 2026-10-10 (3d overdue) · started", started)
+}
@@ -5,12 +5,16 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // RenderTable renders a simple aligned table with a header separator line.
 // Headers are rendered with the Header style. Columns are padded to the
 // maximum width found in each column across both headers and rows.
-func RenderTable(headers []string, rows [][]string) string {
+//
+// width is the space available for the table (0 means unlimited). When the
+// aligned table would be wider, rows are rendered as stacked cards instead.
+func RenderTable(headers []string, rows [][]string, width int) string {
 	if len(headers) == 0 {
 		return ""
 	}
@@ -38,6 +42,14 @@ func RenderTable(headers []string, rows [][]string) string {
 	// Add padding between columns.
 	const colGap = 2
 
+	total := colGap * (cols - 1)
+	for _, w := range widths {
+		total += w
+	}
+	if width > 0 && total > width && cols > 1 {
+		return renderTableCards(headers, rows, width)
+	}
+
 	var b strings.Builder
 
 	// Render header row.
@@ -85,3 +97,54 @@ func RenderTable(headers []string, rows [][]string) string {
 
 	return fmt.Sprint(b.String())
 }
+
+// renderTableCards renders each row as a card: the first cell on its own
+// line, then one "HEADER  value" line per remaining column. Lines longer
+// than width are truncated with an ellipsis.
+func renderTableCards(headers []string, rows [][]string, width int) string {
+	labelWidth := 0
+	for _, h := range headers[1:] {
+		labelWidth = max(labelWidth, lipgloss.Width(h))
+	}
+
+	var b strings.Builder
+	for r, row := range rows {
+		if r > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(Truncate(tableCell(row, 0), width) + "\n")
+		for i := 1; i < len(headers); i++ {
+			label := headers[i] + strings.Repeat(" ", labelWidth-lipgloss.Width(headers[i]))
+			line := "  " + StyleDim.Render(label) + "  " + tableCell(row, i)
+			b.WriteString(Truncate(line, width) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func tableCell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// BoxContentWidth returns the width left for content inside RenderBox on a
+// terminal termWidth columns wide, or 0 when the width is unknown.
+func BoxContentWidth(termWidth int) int {
+	const boxChrome = 6 // border and padding on both sides
+	if termWidth <= 0 {
+		return 0
+	}
+	return max(termWidth-boxChrome, 1)
+}
+
+// Truncate shortens s to at most width visible columns, ending it with an
+// ellipsis when cut. Styling escape codes are preserved and don't count
+// toward the width. A width of 0 or less leaves s unchanged.
+func Truncate(s string, width int) string {
+	if width <= 0 || lipgloss.Width(s) <= width {
+		return s
+	}
+	return ansi.Truncate(s, width, "…")
+}
@@ -0,0 +1,43 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTable_StacksCardsWhenTooWide(t *testing.T) {
+	headers := []string{"NAME", "STATUS", "DUE"}
+	rows := [][]string{
+		{"Organic Chemistry Revision", "active", "In 3d"},
+		{"Marathon", "paused", "--"},
+	}
+
+	wide := RenderTable(headers, rows, 0)
+	assert.Contains(t, wide, "─", "unlimited width keeps the aligned table")
+
+	out := RenderTable(headers, rows, 24)
+	assert.NotContains(t, out, "─", "cards have no separator line")
+	assert.Contains(t, out, "Marathon\n")
+	assert.Contains(t, out, "STATUS  paused")
+	assert.Contains(t, out, "Organic Chemistry Revis…")
+	for _, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 24, "line %q", line)
+	}
+
+	assert.Equal(t, RenderTable(headers, rows, 0), RenderTable(headers, rows, 200),
+		"a table that fits renders the same as unlimited width")
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "Chemistry", Truncate("Chemistry", 9))
+	assert.Equal(t, "Chemi…", Truncate("Chemistry", 6))
+	assert.Equal(t, "Chemistry", Truncate("Chemistry", 0))
+	assert.Equal(t, "Über…", Truncate("Übersetzung", 5), "cuts runes, not bytes")
+
+	styled := Truncate(Bold("Chemistry"), 6)
+	assert.Equal(t, 6, lipgloss.Width(styled))
+	assert.True(t, strings.HasSuffix(stripANSI(styled), "…"))
+}
@@ -6,11 +6,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 )
 
 // FormatTemplateList renders a styled template list inside a bordered box.
-func FormatTemplateList(templates []domain.Template) string {
+func FormatTemplateList(templates []domain.Template, termWidth int) string {
 	headers := []string{"ID", "NAME", "DOMAIN", "VERSION"}
 	rows := make([][]string, 0, len(templates))
 
@@ -23,7 +24,7 @@ func FormatTemplateList(templates []domain.Template) string {
 		})
 	}
 
-	table := RenderTable(headers, rows)
+	table := RenderTable(headers, rows, BoxContentWidth(termWidth))
 	return RenderBox("Templates", table)
 }
 
@@ -52,3 +53,28 @@ func FormatTemplateShow(t *domain.Template) string {
 
 	return RenderBox("", b.String())
 }
+
+// FormatTemplateValidation renders the result of `template validate`, listing
+// every error and warning found in the file.
+func FormatTemplateValidation(v *app.TemplateValidation) string {
+	var b strings.Builder
+
+	name := v.Name
+	if name == "" {
+		name = v.Path
+	}
+	if v.Valid() {
+		b.WriteString(fmt.Sprintf("%s %s is valid", StyleGreen.Render("✔"), StyleBold.Render(name)))
+	} else {
+		b.WriteString(fmt.Sprintf("%s %s has %d error(s)", StyleRed.Render("✘"), StyleBold.Render(name), len(v.Errors)))
+	}
+	b.WriteString("  " + Dim(v.Path) + "\n")
+
+	for _, e := range v.Errors {
+		b.WriteString("  " + StyleRed.Render("error  ") + " " + e + "\n")
+	}
+	for _, w := range v.Warnings {
+		b.WriteString("  " + StyleYellow.Render("warning") + " " + w + "\n")
+	}
+	return b.String()
+}
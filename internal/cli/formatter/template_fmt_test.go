@@ -3,6 +3,7 @@ package formatter
 import (
 	"testing"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/stretchr/testify/assert"
 )
@@ -11,7 +12,7 @@ func TestFormatTemplateList(t *testing.T) {
 	out := FormatTemplateList([]domain.Template{
 		{NumericID: 1, Name: "OU Weekly", Domain: "education", Version: "1.0.0"},
 		{NumericID: 2, Name: "Marathon", Domain: "fitness", Version: "2.1.0"},
-	})
+	}, 0)
 
 	assert.Contains(t, out, "TEMPLATES")
 	assert.Contains(t, out, "OU Weekly")
@@ -44,3 +45,14 @@ func TestFormatTemplateShow_PrettyAndRawJSON(t *testing.T) {
 	})
 	assert.Contains(t, raw, "{invalid-json")
 }
+
+func TestFormatTemplateValidation(t *testing.T) {
+	ok := FormatTemplateValidation(&app.TemplateValidation{Path: "t.json", Name: "Plan", Warnings: []string{"odd type"}})
+	assert.Contains(t, ok, "Plan is valid")
+	assert.Contains(t, ok, "warning")
+	assert.Contains(t, ok, "odd type")
+
+	bad := FormatTemplateValidation(&app.TemplateValidation{Path: "t.json", Errors: []string{"a", "b"}})
+	assert.Contains(t, bad, "t.json has 2 error(s)")
+	assert.Contains(t, bad, "error")
+}
@@ -0,0 +1,117 @@
+package formatter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// TimelineKind names what a timeline entry's date belongs to.
+type TimelineKind string
+
+const (
+	TimelineProject TimelineKind = "project"
+	TimelineNode    TimelineKind = "node"
+	TimelineItem    TimelineKind = "item"
+)
+
+// TimelineEntry is one deadline on the cross-project timeline: a project
+// target date, a node due date or a work item due date.
+type TimelineEntry struct {
+	Date        time.Time
+	Kind        TimelineKind
+	Title       string
+	Seq         int // node or work item seq; 0 for projects
+	ProjectName string
+	Risk        domain.RiskLevel // the owning project's risk; empty when unknown
+}
+
+// TimelineDaysAway returns the number of calendar days from now until a
+// deadline, negative once it has passed. A date-only deadline is due for the
+// whole of its day, so it only goes negative the day after; a timed one as
+// soon as the time passes.
+func TimelineDaysAway(due, now time.Time) int {
+	if domain.DeadlineHasTime(due) && due.Before(now) {
+		days := calendarDays(due.Local(), now.Local())
+		return min(days, -1)
+	}
+	dueDay := due.UTC()
+	if domain.DeadlineHasTime(due) {
+		dueDay = due.Local()
+	}
+	return calendarDays(dueDay, now.Local())
+}
+
+// calendarDays counts the day boundaries between from and to's dates,
+// ignoring their times and zones.
+func calendarDays(to, from time.Time) int {
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(f).Hours() / 24)
+}
+
+// FormatTimeline renders upcoming deadlines across all projects as a
+// chronological table. entries must already be sorted; overdue ones (which
+// sort first) are shown in red.
+func FormatTimeline(entries []TimelineEntry, days int, now time.Time, termWidth int) string {
+	title := fmt.Sprintf("Timeline · next %d days", days)
+	if len(entries) == 0 {
+		return RenderBox(title, Dim("No deadlines coming up."))
+	}
+
+	headers := []string{"DATE", "WHEN", "KIND", "TITLE", "PROJECT", "RISK"}
+	rows := make([][]string, 0, len(entries))
+	overdue := 0
+	for _, e := range entries {
+		date := e.Date.UTC().Format("Mon Jan 2")
+		if domain.DeadlineHasTime(e.Date) {
+			date = e.Date.Local().Format("Mon Jan 2 15:04")
+		}
+
+		away := TimelineDaysAway(e.Date, now)
+		var when string
+		switch {
+		case away < 0:
+			when = StyleRed.Render(fmt.Sprintf("%dd overdue", -away))
+			overdue++
+		case away == 0:
+			when = StyleRed.Render("today")
+		case away <= 7:
+			when = StyleYellow.Render(fmt.Sprintf("in %dd", away))
+		default:
+			when = StyleFg.Render(fmt.Sprintf("in %dd", away))
+		}
+
+		title := Truncate(e.Title, 40)
+		if e.Seq > 0 {
+			title = fmt.Sprintf("#%d %s", e.Seq, title)
+		}
+		switch {
+		case away < 0:
+			title = StyleRed.Render(title)
+		case e.Kind == TimelineProject:
+			title = Bold(title)
+		}
+
+		risk := Dim("--")
+		if e.Risk != "" {
+			risk = RiskIndicator(e.Risk)
+		}
+
+		rows = append(rows, []string{
+			Dim(date),
+			when,
+			Dim(string(e.Kind)),
+			title,
+			Truncate(e.ProjectName, 24),
+			risk,
+		})
+	}
+
+	summary := Dim(fmt.Sprintf("%d deadlines", len(entries)))
+	if overdue > 0 {
+		summary += Dim(" · ") + StyleRed.Render(fmt.Sprintf("%d overdue", overdue))
+	}
+	return RenderBox(title, RenderTable(headers, rows, BoxContentWidth(termWidth))+"\n"+summary)
+}
@@ -0,0 +1,42 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineDaysAway(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, 0, TimelineDaysAway(day(10), now), "a date-only deadline is due all day")
+	assert.Equal(t, 3, TimelineDaysAway(day(13), now))
+	assert.Equal(t, -2, TimelineDaysAway(day(8), now))
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 10, h, 0, 0, 0, time.Local).UTC() }
+	assert.Equal(t, 0, TimelineDaysAway(at(17), now))
+	assert.Equal(t, -1, TimelineDaysAway(at(9), now), "a timed deadline is overdue once it passes")
+}
+
+func TestFormatTimeline(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.Local)
+	out := FormatTimeline([]TimelineEntry{
+		{Date: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), Kind: TimelineItem, Title: "Essay draft", Seq: 4,
+			ProjectName: "Philosophy", Risk: domain.RiskCritical},
+		{Date: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), Kind: TimelineProject, Title: "Philosophy",
+			ProjectName: "Philosophy", Risk: domain.RiskCritical},
+	}, 30, now, 120)
+
+	assert.Contains(t, out, "TIMELINE · NEXT 30 DAYS")
+	assert.Contains(t, out, "2d overdue")
+	assert.Contains(t, out, "#4 Essay draft")
+	assert.Contains(t, out, "in 10d")
+	assert.Contains(t, out, "CRITICAL")
+	assert.Contains(t, out, "2 deadlines")
+	assert.Contains(t, out, "1 overdue")
+
+	assert.Contains(t, FormatTimeline(nil, 7, now, 120), "No deadlines coming up.")
+}
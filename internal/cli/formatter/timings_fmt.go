@@ -0,0 +1,44 @@
+package formatter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/service"
+)
+
+// FormatUseCaseTimings renders per-use-case call counts and latency
+// percentiles collected since the shell started.
+func FormatUseCaseTimings(timings []service.UseCaseTiming, termWidth int) string {
+	if len(timings) == 0 {
+		return RenderBox("Use Case Timings", Dim("No use cases have run yet."))
+	}
+
+	headers := []string{"USE CASE", "CALLS", "ERRORS", "P50", "P95", "MAX"}
+	rows := make([][]string, 0, len(timings))
+	for _, t := range timings {
+		errs := Dim("0")
+		if t.Errors > 0 {
+			errs = StyleRed.Render(fmt.Sprintf("%d", t.Errors))
+		}
+		rows = append(rows, []string{
+			Bold(t.Name),
+			fmt.Sprintf("%d", t.Calls),
+			errs,
+			formatLatency(t.P50),
+			formatLatency(t.P95),
+			Dim(formatLatency(t.Max)),
+		})
+	}
+	return RenderBox("Use Case Timings", RenderTable(headers, rows, BoxContentWidth(termWidth))+"\n"+
+		Dim("Since shell start; percentiles cover the most recent calls."))
+}
+
+// formatLatency prints sub-second durations in milliseconds and longer ones
+// in seconds.
+func formatLatency(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%.1fms", float64(d.Microseconds())/1000)
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
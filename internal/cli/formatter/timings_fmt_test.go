@@ -0,0 +1,21 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUseCaseTimings(t *testing.T) {
+	out := FormatUseCaseTimings([]service.UseCaseTiming{
+		{Name: "what-now", Calls: 12, Errors: 1, P50: 40 * time.Millisecond, P95: 1500 * time.Millisecond, Max: 2 * time.Second},
+	}, 0)
+	assert.Contains(t, out, "USE CASE TIMINGS")
+	assert.Contains(t, out, "what-now")
+	assert.Contains(t, out, "40.0ms")
+	assert.Contains(t, out, "1.50s")
+
+	assert.Contains(t, FormatUseCaseTimings(nil, 0), "No use cases have run yet.")
+}
@@ -0,0 +1,110 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// TodaySummary is what the today command shows: progress toward the daily
+// goal, the time logged so far, the day's plan and what falls due.
+type TodaySummary struct {
+	GoalMin   int // the profile's availability for today; 0 when none is set
+	LoggedMin int
+	Logged    []TodayLogged
+	// SavedPlan is today's saved plan with its adherence, nil when none was
+	// saved. Without one, Suggested holds a what-now plan for SuggestedMin,
+	// or PlanNote says why there is none.
+	SavedPlan    *app.DayPlanAdherence
+	Suggested    []app.WorkSlice
+	SuggestedMin int
+	PlanNote     string
+	Due          []TimelineEntry // open work items due today
+}
+
+// TodayLogged is the time logged today on one work item.
+type TodayLogged struct {
+	Title       string
+	Seq         int
+	ProjectName string
+	Minutes     int
+	Sessions    int
+}
+
+// FormatToday renders the today summary as one box with a section each for
+// the goal, the time logged, the plan and the items due.
+func FormatToday(s *TodaySummary, now time.Time) string {
+	var b strings.Builder
+
+	b.WriteString(Bold("Goal") + "\n")
+	if s.GoalMin > 0 {
+		pct := float64(s.LoggedMin) / float64(s.GoalMin)
+		b.WriteString(fmt.Sprintf("  %s %s %s\n", RenderCompactBar(pct, 20, false),
+			Bold(fmt.Sprintf("%.0f%%", pct*100)),
+			Dim(fmt.Sprintf("%s of %s", FormatMinutes(s.LoggedMin), FormatMinutes(s.GoalMin)))))
+	} else {
+		b.WriteString("  " + Dim(fmt.Sprintf("No time set aside for %s. Set one with: config set availability",
+			now.Local().Weekday())) + "\n")
+	}
+
+	b.WriteString("\n" + Bold("Logged") + "\n")
+	if len(s.Logged) == 0 {
+		b.WriteString("  " + Dim("Nothing logged yet today.") + "\n")
+	}
+	for _, l := range s.Logged {
+		b.WriteString(fmt.Sprintf("  %s  %s %s\n", todayItemLabel(l.Seq, l.Title, l.ProjectName),
+			FormatMinutes(l.Minutes), Dim("· "+pluralSessions(l.Sessions))))
+	}
+
+	b.WriteString("\n" + Bold("Plan") + "\n")
+	switch {
+	case s.SavedPlan != nil:
+		for i, it := range s.SavedPlan.Items {
+			mark := Dim("·")
+			if it.Done {
+				mark = StyleGreen.Render("✔")
+			}
+			b.WriteString(fmt.Sprintf("  %d. %s %s  %s\n", i+1, mark, dayPlanItemLabel(it.DayPlanItem),
+				Dim(fmt.Sprintf("%s / %s", FormatMinutes(it.LoggedMin), FormatMinutes(it.AllocatedMin)))))
+		}
+		b.WriteString("  " + Dim(fmt.Sprintf("Saved plan, %.0f%% done. Details: plan status", s.SavedPlan.AdherencePct())) + "\n")
+	case len(s.Suggested) > 0:
+		for i, sl := range s.Suggested {
+			b.WriteString(fmt.Sprintf("  %d. %s  %s\n", i+1, todayItemLabel(sl.WorkItemSeq, sl.Title, ""),
+				Dim(FormatMinutes(sl.AllocatedMin))))
+		}
+		b.WriteString("  " + Dim(fmt.Sprintf("Suggested for %s. Keep it with: what-now %d --save-plan",
+			FormatMinutes(s.SuggestedMin), s.SuggestedMin)) + "\n")
+	default:
+		b.WriteString("  " + Dim(s.PlanNote) + "\n")
+	}
+
+	b.WriteString("\n" + Bold("Due today") + "\n")
+	if len(s.Due) == 0 {
+		b.WriteString("  " + Dim("Nothing due today.") + "\n")
+	}
+	for _, e := range s.Due {
+		line := "  " + todayItemLabel(e.Seq, e.Title, e.ProjectName)
+		if domain.DeadlineHasTime(e.Date) {
+			line += "  " + StyleYellow.Render(e.Date.Local().Format("15:04"))
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return RenderBox("Today · "+now.Local().Format("Mon Jan 2"), strings.TrimRight(b.String(), "\n"))
+}
+
+// todayItemLabel renders "#seq Title  Project" with the parts it has.
+func todayItemLabel(seq int, title, project string) string {
+	label := Bold(title)
+	if seq > 0 {
+		label = Dim(fmt.Sprintf("#%d ", seq)) + label
+	}
+	if project != "" {
+		label += "  " + Dim(project)
+	}
+	return label
+}
@@ -15,6 +15,7 @@ type TreeItem struct {
 	IsLast bool
 	Status string
 	Detail string
+	Note   string // already styled; drawn after the badge, aligned across lines
 }
 
 const (
@@ -34,10 +35,12 @@ func RenderTree(items []TreeItem) string {
 	type lineInfo struct {
 		content string // prefix + statusPrefix + title (styled)
 		badge   string // styled badge or ""
+		note    string
 	}
 
 	lines := make([]lineInfo, len(items))
 	maxContentWidth := 0
+	maxBadgeWidth := 0
 
 	// Pass 1: build each line's content and track max visible width.
 	for idx, item := range items {
@@ -77,6 +80,10 @@ func RenderTree(items []TreeItem) string {
 		if item.Detail != "" {
 			lines[idx].badge = StyleBlue.Render(fmt.Sprintf("[ %s ]", item.Detail))
 		}
+		lines[idx].note = item.Note
+		if w := lipgloss.Width(lines[idx].badge); w > maxBadgeWidth {
+			maxBadgeWidth = w
+		}
 
 		if w := lipgloss.Width(content); w > maxContentWidth {
 			maxContentWidth = w
@@ -86,15 +93,18 @@ func RenderTree(items []TreeItem) string {
 	// Pass 2: render with right-aligned badges.
 	var b strings.Builder
 	for _, li := range lines {
-		if li.badge != "" {
+		line := li.content
+		if li.badge != "" || li.note != "" {
 			pad := maxContentWidth - lipgloss.Width(li.content)
 			if pad < 0 {
 				pad = 0
 			}
-			b.WriteString(li.content + strings.Repeat(" ", pad) + "  " + li.badge + "\n")
-		} else {
-			b.WriteString(li.content + "\n")
+			line += strings.Repeat(" ", pad) + "  " + li.badge
+		}
+		if li.note != "" {
+			line += strings.Repeat(" ", maxBadgeWidth-lipgloss.Width(li.badge)) + "  " + li.note
 		}
+		b.WriteString(line + "\n")
 	}
 
 	return b.String()
@@ -0,0 +1,65 @@
+package formatter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Verbosity controls how much a command prints. The zero value is the
+// default, friendly output.
+type Verbosity int
+
+const (
+	VerbosityNormal Verbosity = iota
+	// VerbosityQuiet cuts success confirmations to one plain line.
+	VerbosityQuiet
+	// VerbosityVerbose adds the active IDs and the command's timing.
+	VerbosityVerbose
+)
+
+// ParseVerbosity reads "quiet", "normal" or "verbose" (case-insensitive);
+// an empty string is normal.
+func ParseVerbosity(s string) (Verbosity, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "normal":
+		return VerbosityNormal, true
+	case "quiet":
+		return VerbosityQuiet, true
+	case "verbose":
+		return VerbosityVerbose, true
+	}
+	return VerbosityNormal, false
+}
+
+func (v Verbosity) String() string {
+	switch v {
+	case VerbosityQuiet:
+		return "quiet"
+	case VerbosityVerbose:
+		return "verbose"
+	}
+	return "normal"
+}
+
+// QuietOutput reduces a success confirmation (output starting with ✔) to its
+// first line without styling. Anything else, including errors and the data
+// a command was asked for, is returned unchanged.
+func QuietOutput(output string) string {
+	plain := strings.TrimSpace(ansi.Strip(output))
+	if !strings.HasPrefix(plain, "✔") {
+		return output
+	}
+	line, _, _ := strings.Cut(plain, "\n")
+	return strings.TrimSpace(line)
+}
+
+// VerboseFooter renders the extra line --verbose appends: how long the
+// command took and the full IDs it ran against, e.g.
+// "took 12.431ms · project 3f2a8c1e-…".
+func VerboseFooter(elapsed time.Duration, ids []string) string {
+	parts := []string{"took " + elapsed.Round(time.Microsecond).String()}
+	parts = append(parts, ids...)
+	return Dim(strings.Join(parts, " · "))
+}
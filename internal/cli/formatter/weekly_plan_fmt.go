@@ -0,0 +1,51 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatWeeklyPlan renders a weekly plan as a day-by-day agenda, followed by
+// the projects that won't finish before their deadline.
+func FormatWeeklyPlan(p *app.WeeklyPlan) string {
+	var b strings.Builder
+	for i, d := range p.Days {
+		label := d.Date.Format("Mon Jan 2")
+		if i == 0 {
+			label += " (today)"
+		}
+		switch {
+		case d.AvailableMin <= 0:
+			b.WriteString(fmt.Sprintf("%s  %s\n", Bold(label), Dim("no time available")))
+		case len(d.Slices) == 0:
+			b.WriteString(fmt.Sprintf("%s  %s\n", Bold(label), Dim("nothing left to schedule")))
+		default:
+			b.WriteString(fmt.Sprintf("%s  %s\n", Bold(label),
+				Dim(fmt.Sprintf("%s of %s", FormatMinutes(d.AllocatedMin()), FormatMinutes(d.AvailableMin)))))
+		}
+		for _, sl := range d.Slices {
+			title := sl.Title
+			if sl.WorkItemSeq > 0 {
+				title = Dim(fmt.Sprintf("#%d ", sl.WorkItemSeq)) + title
+			}
+			b.WriteString(fmt.Sprintf("  %s  %s  %s\n", title, Dim(p.ProjectNames[sl.ProjectID]), FormatMinutes(sl.AllocatedMin)))
+		}
+	}
+	b.WriteString(fmt.Sprintf("\n%s planned of %s available\n",
+		FormatMinutes(p.AllocatedMin()), FormatMinutes(p.AvailableMin())))
+
+	if len(p.Infeasible) > 0 {
+		b.WriteString("\n" + StyleRed.Render("Won't finish before the deadline:") + "\n")
+		for _, inf := range p.Infeasible {
+			b.WriteString(fmt.Sprintf("  %s  %s  %s %s\n", Bold(inf.ProjectName),
+				Dim("due "+domain.FormatDeadline(inf.DueDate)),
+				StyleRed.Render(FormatMinutes(inf.ShortfallMin())+" short"),
+				Dim(fmt.Sprintf("(%s of %s fits)", FormatMinutes(inf.ScheduledMin), FormatMinutes(inf.RemainingMin)))))
+		}
+	}
+	b.WriteString("\n" + Dim("Availability per weekday: profile set availability=2h,2h,2h,2h,2h,1h,0"))
+	return RenderBox("Weekly Plan", b.String())
+}
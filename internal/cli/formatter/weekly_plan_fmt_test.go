@@ -0,0 +1,37 @@
+package formatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatWeeklyPlan(t *testing.T) {
+	mon := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	plan := &app.WeeklyPlan{
+		Days: []app.WeeklyPlanDay{
+			{Date: mon, AvailableMin: 90, Slices: []app.WorkSlice{
+				{WorkItemSeq: 4, ProjectID: "p1", Title: "Write", AllocatedMin: 60},
+				{ProjectID: "p2", Title: "Read", AllocatedMin: 30},
+			}},
+			{Date: mon.AddDate(0, 0, 1), AvailableMin: 60},
+			{Date: mon.AddDate(0, 0, 5)},
+		},
+		Infeasible: []app.InfeasibleProject{
+			{ProjectName: "Essay", DueDate: mon.AddDate(0, 0, 2), RemainingMin: 240, ScheduledMin: 180},
+		},
+		ProjectNames: map[string]string{"p1": "Essay", "p2": "Reading"},
+	}
+
+	out := FormatWeeklyPlan(plan)
+	assert.Contains(t, out, "Mon Oct 19 (today)  1h 30m of 1h 30m")
+	assert.Contains(t, out, "#4 Write  Essay  1h")
+	assert.Contains(t, out, "Read  Reading  30m")
+	assert.Contains(t, out, "Tue Oct 20  nothing left to schedule")
+	assert.Contains(t, out, "Sat Oct 24  no time available")
+	assert.Contains(t, out, "1h 30m planned of 2h 30m available")
+	assert.Contains(t, out, "Won't finish before the deadline")
+	assert.Contains(t, out, "Essay  due 2026-10-21  1h short (3h of 4h fits)")
+}
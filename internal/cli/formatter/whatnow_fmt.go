@@ -2,8 +2,9 @@ package formatter
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/alexanderramin/kairos/internal/contract"
 )
@@ -16,6 +17,18 @@ func FormatWhatNow(resp *contract.WhatNowResponse) string {
 // FormatWhatNowWithProjectIDs formats WhatNow output and replaces internal project IDs
 // with user-facing IDs when a map entry is available.
 func FormatWhatNowWithProjectIDs(resp *contract.WhatNowResponse, projectIDs map[string]string) string {
+	return formatWhatNow(resp, projectIDs, false)
+}
+
+// FormatWhatNowGrouped formats WhatNow output with the recommendations
+// clustered under a header per project, giving that project's allocated
+// minutes. Projects appear in the order of their best-ranked item and items
+// keep their overall rank numbers, so the plan itself is unchanged.
+func FormatWhatNowGrouped(resp *contract.WhatNowResponse, projectIDs map[string]string) string {
+	return formatWhatNow(resp, projectIDs, true)
+}
+
+func formatWhatNow(resp *contract.WhatNowResponse, projectIDs map[string]string, groupByProject bool) string {
 	var b strings.Builder
 
 	// Mode indicator.
@@ -29,52 +42,15 @@ func FormatWhatNowWithProjectIDs(resp *contract.WhatNowResponse, projectIDs map[
 	b.WriteString("\n\n")
 
 	// Recommendations.
-	if len(resp.Recommendations) == 0 {
+	switch {
+	case len(resp.Recommendations) == 0:
 		b.WriteString(Dim("No recommendations available."))
 		b.WriteString("\n")
-	} else {
+	case groupByProject:
+		writeRecommendationGroups(&b, resp, projectIDs)
+	default:
 		for i, rec := range resp.Recommendations {
-			num := fmt.Sprintf("%d.", i+1)
-			riskBadge := RiskIndicator(rec.RiskLevel)
-
-			// Title line: "1. #5 Title  (25m)  ● ON TRACK"
-			seqLabel := ""
-			if rec.WorkItemSeq > 0 {
-				seqLabel = StyleDim.Render(fmt.Sprintf("#%d ", rec.WorkItemSeq))
-			}
-			titleLine := fmt.Sprintf(
-				"%s %s%s  %s  %s",
-				Bold(num),
-				seqLabel,
-				StyleFg.Render(rec.Title),
-				StyleBlue.Render(fmt.Sprintf("(%s)", FormatMinutes(rec.AllocatedMin))),
-				riskBadge,
-			)
-			b.WriteString(titleLine + "\n")
-
-			// Project info with user-facing short ID when available.
-			if rec.ProjectID != "" {
-				b.WriteString(fmt.Sprintf("   %s %s\n", Dim("Project:"), renderProjectID(rec.ProjectID, projectIDs)))
-			}
-
-			// Due date with relative styling.
-			if rec.DueDate != nil {
-				if parsed, err := time.Parse(time.RFC3339, *rec.DueDate); err == nil {
-					b.WriteString(fmt.Sprintf("   %s %s\n", Dim("Due:"), RelativeDateStyled(parsed)))
-				} else if parsed, err := time.Parse("2006-01-02", *rec.DueDate); err == nil {
-					b.WriteString(fmt.Sprintf("   %s %s\n", Dim("Due:"), RelativeDateStyled(parsed)))
-				} else {
-					b.WriteString(fmt.Sprintf("   %s\n", Dim(fmt.Sprintf("Due: %s", *rec.DueDate))))
-				}
-			}
-
-			// Reason lines.
-			for _, reason := range rec.Reasons {
-				b.WriteString(fmt.Sprintf("   %s %s\n",
-					StyleYellow.Render("REASON:"),
-					Dim(reason.Message),
-				))
-			}
+			writeRecommendation(&b, i+1, rec, projectIDs, true)
 
 			// Blank line between recommendations.
 			if i < len(resp.Recommendations)-1 {
@@ -101,6 +77,45 @@ func FormatWhatNowWithProjectIDs(resp *contract.WhatNowResponse, projectIDs map[
 		}
 	}
 
+	// Projects skipped via --avoid, items too short for --min-block, and
+	// items outside the --context (counted, not listed).
+	var avoided, tooShort []string
+	otherContext := 0
+	for _, bl := range resp.Blockers {
+		switch bl.Code {
+		case contract.BlockerUserExcluded:
+			avoided = append(avoided, bl.Message)
+		case contract.BlockerInsufficientTime:
+			tooShort = append(tooShort, bl.Message)
+		case contract.BlockerWrongContext:
+			otherContext++
+		}
+	}
+	if len(avoided) > 0 {
+		b.WriteString("\n")
+		for _, msg := range avoided {
+			b.WriteString(Dim(fmt.Sprintf("  AVOIDED: %s", msg)) + "\n")
+		}
+	}
+	if len(tooShort) > 0 {
+		b.WriteString("\n")
+		for _, msg := range tooShort {
+			b.WriteString(Dim(fmt.Sprintf("  TOO SHORT: %s", msg)) + "\n")
+		}
+	}
+	if otherContext > 0 {
+		b.WriteString("\n" + Dim(fmt.Sprintf("  OTHER CONTEXT: %d items not tagged for this context", otherContext)) + "\n")
+	}
+
+	var infeasible []string
+	for _, rs := range resp.TopRiskProjects {
+		if rs.Infeasible {
+			infeasible = append(infeasible, infeasibleMessage(rs.ProjectName, rs.DueDate, rs.ShortfallMin))
+		}
+	}
+	sort.Strings(infeasible)
+	writeInfeasible(&b, infeasible)
+
 	// Warnings.
 	if len(resp.Warnings) > 0 {
 		b.WriteString("\n")
@@ -112,6 +127,166 @@ func FormatWhatNowWithProjectIDs(resp *contract.WhatNowResponse, projectIDs map[
 	return RenderBox("Session Plan", b.String())
 }
 
+// writeRecommendation writes one numbered recommendation with its due date
+// and reasons. The project line is left out under a project group header.
+func writeRecommendation(b *strings.Builder, num int, rec contract.WorkSlice, projectIDs map[string]string, showProject bool) {
+	riskBadge := RiskIndicator(rec.RiskLevel)
+
+	// Title line: "1. #5 Title  (25m)  ● ON TRACK"
+	seqLabel := ""
+	if rec.WorkItemSeq > 0 {
+		seqLabel = StyleDim.Render(fmt.Sprintf("#%d ", rec.WorkItemSeq))
+	}
+	titleLine := fmt.Sprintf(
+		"%s %s%s  %s  %s",
+		Bold(fmt.Sprintf("%d.", num)),
+		seqLabel,
+		StyleFg.Render(rec.Title),
+		StyleBlue.Render(fmt.Sprintf("(%s)", FormatMinutes(rec.AllocatedMin))),
+		riskBadge,
+	)
+	b.WriteString(titleLine + "\n")
+
+	// Project info with user-facing short ID when available.
+	if showProject && rec.ProjectID != "" {
+		b.WriteString(fmt.Sprintf("   %s %s\n", Dim("Project:"), renderProjectID(rec.ProjectID, projectIDs)))
+	}
+
+	// Due date with relative styling.
+	if rec.DueDate != nil {
+		if s, ok := DeadlineStyled(*rec.DueDate); ok {
+			b.WriteString(fmt.Sprintf("   %s %s\n", Dim("Due:"), s))
+		} else {
+			b.WriteString(fmt.Sprintf("   %s\n", Dim(fmt.Sprintf("Due: %s", *rec.DueDate))))
+		}
+	}
+
+	// Reason lines.
+	for _, reason := range rec.Reasons {
+		b.WriteString(fmt.Sprintf("   %s %s\n",
+			StyleYellow.Render("REASON:"),
+			Dim(reason.Message),
+		))
+	}
+}
+
+// writeRecommendationGroups writes the recommendations under one header per
+// project, e.g. "Thesis  PHI01  1h 15m · 2 items", in order of each
+// project's first recommendation.
+func writeRecommendationGroups(b *strings.Builder, resp *contract.WhatNowResponse, projectIDs map[string]string) {
+	names := make(map[string]string, len(resp.TopRiskProjects))
+	for _, rs := range resp.TopRiskProjects {
+		names[rs.ProjectID] = rs.ProjectName
+	}
+
+	var order []string
+	members := make(map[string][]int)
+	for i, rec := range resp.Recommendations {
+		if _, seen := members[rec.ProjectID]; !seen {
+			order = append(order, rec.ProjectID)
+		}
+		members[rec.ProjectID] = append(members[rec.ProjectID], i)
+	}
+
+	for g, pid := range order {
+		idx := members[pid]
+		total := 0
+		for _, i := range idx {
+			total += resp.Recommendations[i].AllocatedMin
+		}
+		header := renderProjectID(pid, projectIDs)
+		if name := names[pid]; name != "" {
+			header = StylePurple.Render(name) + "  " + header
+		}
+		items := "items"
+		if len(idx) == 1 {
+			items = "item"
+		}
+		b.WriteString(fmt.Sprintf("%s  %s\n", header,
+			StyleBlue.Render(fmt.Sprintf("%s · %d %s", FormatMinutes(total), len(idx), items))))
+		for j, i := range idx {
+			writeRecommendation(b, i+1, resp.Recommendations[i], projectIDs, false)
+			if j < len(idx)-1 {
+				b.WriteString("\n")
+			}
+		}
+		if g < len(order)-1 {
+			b.WriteString("\n")
+		}
+	}
+}
+
+// FormatWhatNowOneline renders the top recommendation as one unstyled line,
+// e.g. "NEXT: Reading (45m) · PHI01", for prompts and status bars. The
+// project shows its short ID when projectIDs has one. resp must have at
+// least one recommendation.
+func FormatWhatNowOneline(resp *contract.WhatNowResponse, projectIDs map[string]string) string {
+	rec := resp.Recommendations[0]
+	project := rec.ProjectID
+	if len(project) > 8 {
+		project = project[:8]
+	}
+	if displayID := strings.TrimSpace(projectIDs[rec.ProjectID]); displayID != "" {
+		project = displayID
+	}
+	return fmt.Sprintf("NEXT: %s (%s) · %s", rec.Title, FormatMinutes(rec.AllocatedMin), project)
+}
+
+// FormatCandidateRanking renders every scored what-now candidate as a table
+// sorted by final score, with its strongest scoring factors and, for
+// candidates that got no slice, the reason they lost.
+func FormatCandidateRanking(resp *contract.WhatNowResponse, width int) string {
+	title := fmt.Sprintf("Candidate Ranking (%s, as of %s)",
+		FormatMinutes(resp.RequestedMin), resp.GeneratedAt.Local().Format("2006-01-02 15:04"))
+	if len(resp.Ranking) == 0 {
+		return RenderBox(title, Dim("No scored candidates."))
+	}
+
+	headers := []string{"#", "ITEM", "PROJECT", "RISK", "SCORE", "TOP FACTORS", "OUTCOME"}
+	rows := make([][]string, 0, len(resp.Ranking))
+	for i, rc := range resp.Ranking {
+		outcome := StyleGreen.Render("selected")
+		if !rc.Selected {
+			outcome = Dim(rc.LostReason)
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", i+1),
+			rc.Title,
+			rc.ProjectName,
+			RiskIndicator(rc.RiskLevel),
+			fmt.Sprintf("%.1f", rc.Score),
+			topFactors(rc.Reasons, 2),
+			outcome,
+		})
+	}
+	return RenderBox(title, RenderTable(headers, rows, width))
+}
+
+// topFactors lists up to n reasons with the largest score impact, e.g.
+// "DEADLINE_PRESSURE +30.0, SPACING_OK +5.0".
+func topFactors(reasons []contract.RecommendationReason, n int) string {
+	weighted := make([]contract.RecommendationReason, 0, len(reasons))
+	for _, r := range reasons {
+		if r.WeightDelta != nil && *r.WeightDelta != 0 {
+			weighted = append(weighted, r)
+		}
+	}
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return math.Abs(*weighted[i].WeightDelta) > math.Abs(*weighted[j].WeightDelta)
+	})
+	if len(weighted) > n {
+		weighted = weighted[:n]
+	}
+	parts := make([]string, 0, len(weighted))
+	for _, r := range weighted {
+		parts = append(parts, fmt.Sprintf("%s %+.1f", r.Code, *r.WeightDelta))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
 func renderProjectID(projectID string, projectIDs map[string]string) string {
 	if projectIDs != nil {
 		if displayID := strings.TrimSpace(projectIDs[projectID]); displayID != "" {
@@ -1,6 +1,7 @@
 package formatter
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/alexanderramin/kairos/internal/contract"
@@ -68,6 +69,22 @@ func TestFormatWhatNowWithProjectIDs_NoRecommendations_ShowsFallbackAndWarnings(
 	assert.Contains(t, out, "On-track projects may include secondary work.")
 }
 
+func TestFormatWhatNowWithProjectIDs_ShowsAvoidedProjects(t *testing.T) {
+	resp := &contract.WhatNowResponse{
+		Mode:         domain.ModeBalanced,
+		RequestedMin: 60,
+		Blockers: []contract.ConstraintBlocker{
+			{EntityType: "project", EntityID: "p1", Code: contract.BlockerUserExcluded, Message: "Project 'Thesis' avoided for this query (4 items)"},
+			{EntityType: "work_item", EntityID: "w1", Code: contract.BlockerNotBefore, Message: "not yet"},
+		},
+	}
+
+	out := FormatWhatNowWithProjectIDs(resp, nil)
+
+	assert.Contains(t, out, "AVOIDED: Project 'Thesis' avoided for this query (4 items)")
+	assert.NotContains(t, out, "AVOIDED: not yet")
+}
+
 func TestFormatWhatNowWithProjectIDs_InvalidDueDateFallsBackToRawValue(t *testing.T) {
 	badDate := "tomorrow-ish"
 	resp := &contract.WhatNowResponse{
@@ -87,3 +104,69 @@ func TestFormatWhatNowWithProjectIDs_InvalidDueDateFallsBackToRawValue(t *testin
 	out := FormatWhatNowWithProjectIDs(resp, nil)
 	assert.Contains(t, out, "Due: tomorrow-ish")
 }
+
+func TestFormatWhatNowOneline(t *testing.T) {
+	resp := &contract.WhatNowResponse{
+		Recommendations: []contract.WorkSlice{
+			{Title: "Reading", AllocatedMin: 45, ProjectID: "39f351b6-2b6e-4f0e-a1d2-b8e3a40b1f07"},
+			{Title: "Exercises", AllocatedMin: 15, ProjectID: "39f351b6-2b6e-4f0e-a1d2-b8e3a40b1f07"},
+		},
+	}
+
+	assert.Equal(t, "NEXT: Reading (45m) · PHI01", FormatWhatNowOneline(resp, map[string]string{
+		"39f351b6-2b6e-4f0e-a1d2-b8e3a40b1f07": "PHI01",
+	}))
+	assert.Equal(t, "NEXT: Reading (45m) · 39f351b6", FormatWhatNowOneline(resp, nil))
+}
+
+func TestFormatWhatNowGrouped_ClustersByProjectWithSubtotals(t *testing.T) {
+	resp := &contract.WhatNowResponse{
+		Mode:         domain.ModeBalanced,
+		RequestedMin: 120,
+		AllocatedMin: 105,
+		Recommendations: []contract.WorkSlice{
+			{Title: "Reading", AllocatedMin: 45, ProjectID: "p-thesis"},
+			{Title: "Drills", AllocatedMin: 15, ProjectID: "p-lang"},
+			{Title: "Notes", AllocatedMin: 30, ProjectID: "p-thesis"},
+			{Title: "Listening", AllocatedMin: 15, ProjectID: "p-lang"},
+		},
+		TopRiskProjects: []contract.RiskSummary{
+			{ProjectID: "p-lang", ProjectName: "Spanish"},
+			{ProjectID: "p-thesis", ProjectName: "Thesis"},
+		},
+	}
+
+	out := FormatWhatNowGrouped(resp, map[string]string{"p-thesis": "PHI01"})
+
+	assert.Contains(t, out, "1h 15m · 2 items")
+	assert.Contains(t, out, "30m · 2 items")
+	assert.NotContains(t, out, "Project:", "the group header names the project")
+	thesis, spanish := strings.Index(out, "Thesis"), strings.Index(out, "Spanish")
+	assert.Less(t, thesis, spanish, "groups follow their best-ranked item")
+	reading, notes, drills := strings.Index(out, "Reading"), strings.Index(out, "Notes"), strings.Index(out, "Drills")
+	assert.Less(t, reading, notes)
+	assert.Less(t, notes, drills, "items are clustered under their project")
+	assert.Contains(t, out, "3. ", "items keep their overall rank")
+	assert.Contains(t, out, "Allocated: 1h 45m")
+}
+
+func TestFormatCandidateRanking_ShowsTopFactorsAndLostReason(t *testing.T) {
+	big, small, tiny := 30.0, -5.0, 1.0
+	resp := &contract.WhatNowResponse{
+		RequestedMin: 60,
+		Ranking: []contract.RankedCandidate{
+			{Title: "Reading", ProjectName: "Philosophy", Score: 56, Selected: true, Reasons: []contract.RecommendationReason{
+				{Code: contract.ReasonSpacingOK, WeightDelta: &tiny},
+				{Code: contract.ReasonDeadlinePressure, WeightDelta: &big},
+				{Code: contract.ReasonVariationPenalty, WeightDelta: &small},
+			}},
+			{Title: "Exercises", ProjectName: "Philosophy", Score: 40, LostReason: "Project already has a slice (variation)"},
+		},
+	}
+
+	out := FormatCandidateRanking(resp, 0)
+	assert.Contains(t, out, "DEADLINE_PRESSURE +30.0, VARIATION_PENALTY -5.0")
+	assert.NotContains(t, out, "SPACING_OK")
+	assert.Contains(t, out, "selected")
+	assert.Contains(t, out, "Project already has a slice (variation)")
+}
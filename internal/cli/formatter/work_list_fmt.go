@@ -0,0 +1,39 @@
+package formatter
+
+import (
+	"fmt"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatWorkItemList renders a project's work items as a flat table with
+// their node, status and planned/logged minutes.
+func FormatWorkItemList(projectName string, items []*domain.WorkItem, nodeTitles map[string]string, termWidth int) string {
+	title := "Work Items · " + projectName
+	if len(items) == 0 {
+		return RenderBox(title, Dim("No matching work items."))
+	}
+
+	headers := []string{"#", "TITLE", "NODE", "STATUS", "PLANNED", "LOGGED"}
+	rows := make([][]string, 0, len(items))
+	plannedTotal, loggedTotal := 0, 0
+	for _, w := range items {
+		seq := Dim("-")
+		if w.Seq > 0 {
+			seq = Dim(fmt.Sprintf("#%d", w.Seq))
+		}
+		rows = append(rows, []string{
+			seq,
+			Truncate(w.Title, 40),
+			Dim(Truncate(nodeTitles[w.NodeID], 24)),
+			WorkItemStatusPill(w.Status),
+			FormatMinutes(w.PlannedMin),
+			FormatMinutes(w.LoggedMin),
+		})
+		plannedTotal += w.PlannedMin
+		loggedTotal += w.LoggedMin
+	}
+	summary := Dim(fmt.Sprintf("%d items · %s planned · %s logged",
+		len(items), FormatMinutes(plannedTotal), FormatMinutes(loggedTotal)))
+	return RenderBox(title, RenderTable(headers, rows, BoxContentWidth(termWidth))+"\n"+summary)
+}
@@ -0,0 +1,57 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// FormatWorkPresets renders the saved work item presets.
+func FormatWorkPresets(presets []*domain.WorkPreset) string {
+	if len(presets) == 0 {
+		return RenderBox("Work Presets",
+			Dim("No presets yet. Save one with: work preset save reading45 --type reading --planned-min 45 --bounds 15/60/30"))
+	}
+	var b strings.Builder
+	for _, p := range presets {
+		b.WriteString(fmt.Sprintf("%-14s %s\n", StyleGreen.Render(p.Name), WorkPresetSummary(p)))
+	}
+	b.WriteString("\n" + Dim("Use with: work add --preset <name> (explicit flags still win)"))
+	return RenderBox("Work Presets", b.String())
+}
+
+// WorkPresetSummary describes a preset's fields on one line, e.g.
+// "reading · 45m · sessions 15m–1h (30m)".
+func WorkPresetSummary(p *domain.WorkPreset) string {
+	return workShapeSummary(p.Type, p.PlannedMin, p.MinSessionMin, p.MaxSessionMin, p.DefaultSessionMin)
+}
+
+// WorkDefaultsSummary describes a project's work defaults in the same form
+// as WorkPresetSummary, or "none" when no default is set.
+func WorkDefaultsSummary(d domain.WorkDefaults) string {
+	if d.IsZero() {
+		return "none"
+	}
+	return workShapeSummary(d.Type, d.PlannedMin, d.MinSessionMin, d.MaxSessionMin, d.DefaultSessionMin)
+}
+
+func workShapeSummary(typ string, plannedMin, minSession, maxSession, defSession int) string {
+	var parts []string
+	if typ != "" {
+		parts = append(parts, typ)
+	}
+	if plannedMin > 0 {
+		parts = append(parts, FormatMinutes(plannedMin))
+	}
+	if minSession > 0 || maxSession > 0 {
+		bounds := fmt.Sprintf("sessions %s–%s", FormatMinutes(minSession), FormatMinutes(maxSession))
+		if defSession > 0 {
+			bounds += fmt.Sprintf(" (%s)", FormatMinutes(defSession))
+		}
+		parts = append(parts, bounds)
+	} else if defSession > 0 {
+		parts = append(parts, fmt.Sprintf("sessions %s", FormatMinutes(defSession)))
+	}
+	return strings.Join(parts, " · ")
+}
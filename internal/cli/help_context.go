@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/intelligence"
+)
+
+// viewHelp names a view for help answers and lists the shell commands that
+// act on what it shows, most relevant first.
+type viewHelp struct {
+	name     string
+	commands []string
+}
+
+// viewHelpByID covers the views a user can ask for help from. Others fall
+// back to shellViewHelp.
+var viewHelpByID = map[ViewID]viewHelp{
+	ViewDashboard:      {"dashboard", []string{"what-now", "today", "status", "use", "projects", "draft", "plan"}},
+	ViewProjectList:    {"project list", []string{"use", "project inspect", "project add", "project archive"}},
+	ViewTaskList:       {"task list", []string{"start", "log", "work done", "work add", "node add", "project progress"}},
+	ViewActionMenu:     {"action menu", []string{"start", "log", "finish", "work done", "work update", "work archive", "history"}},
+	ViewRecommendation: {"what-now recommendations", []string{"start", "log", "explain now", "explain why-not", "what-now"}},
+	ViewStatusWatch:    {"status watch", []string{"status", "what-now", "log"}},
+	ViewOnboarding:     {"welcome screen", []string{"project init", "draft", "template list"}},
+}
+
+var shellViewHelp = viewHelp{"shell", []string{"what-now", "status", "projects", "help commands"}}
+
+// Commands that act on the active project or work item, added after the
+// view's own when one is set.
+var (
+	activeProjectHelp = []string{"inspect", "work add", "project progress"}
+	activeItemHelp    = []string{"log", "start", "finish", "work inspect"}
+)
+
+// buildHelpContext describes where the user is for help: the current view,
+// the active project and item, and the commands for them, without
+// duplicates.
+func buildHelpContext(state *SharedState) *intelligence.HelpContext {
+	vh, ok := viewHelpByID[state.CurrentView]
+	if !ok {
+		vh = shellViewHelp
+	}
+	hctx := &intelligence.HelpContext{View: vh.name}
+
+	commands := slices.Clone(vh.commands)
+	if state.ActiveProjectID != "" {
+		hctx.Project = strings.TrimSpace(state.ActiveShortID + " " + state.ActiveProjectName)
+		commands = append(commands, activeProjectHelp...)
+	}
+	if state.ActiveItemID != "" {
+		hctx.Item = state.ActiveItemTitle
+		if state.ActiveItemSeq > 0 {
+			hctx.Item = fmt.Sprintf("#%d %s", state.ActiveItemSeq, state.ActiveItemTitle)
+		}
+		commands = append(commands, activeItemHelp...)
+	}
+	for _, c := range commands {
+		if !slices.Contains(hctx.Commands, c) {
+			hctx.Commands = append(hctx.Commands, c)
+		}
+	}
+	return hctx
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildHelpContext_ActionMenuWithActiveItem(t *testing.T) {
+	state := &SharedState{
+		CurrentView:       ViewActionMenu,
+		ActiveProjectID:   "p1",
+		ActiveShortID:     "PHI01",
+		ActiveProjectName: "Philosophy",
+		ActiveItemID:      "w1",
+		ActiveItemTitle:   "Reading",
+		ActiveItemSeq:     3,
+	}
+	hctx := buildHelpContext(state)
+	assert.Equal(t, "action menu", hctx.View)
+	assert.Equal(t, "PHI01 Philosophy", hctx.Project)
+	assert.Equal(t, "#3 Reading", hctx.Item)
+	assert.Equal(t, []string{"start", "log", "finish", "work done", "work update", "work archive", "history",
+		"inspect", "work add", "project progress", "work inspect"}, hctx.Commands, "view commands first, no duplicates")
+
+	hctx = buildHelpContext(&SharedState{CurrentView: ViewForm})
+	assert.Equal(t, "shell", hctx.View)
+	assert.Empty(t, hctx.Project)
+}
+
+func TestHelpContextCommandsAreInSpec(t *testing.T) {
+	spec := ShellCommandSpec()
+	all := []viewHelp{shellViewHelp, {commands: activeProjectHelp}, {commands: activeItemHelp}}
+	for _, vh := range viewHelpByID {
+		all = append(all, vh)
+	}
+	for _, vh := range all {
+		for _, path := range vh.commands {
+			assert.True(t, spec.ValidateCommandPath(path), "help context command %q is not in the shell spec", path)
+		}
+	}
+}
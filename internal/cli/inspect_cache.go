@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// inspectKey identifies one rendering of a project's inspect tree.
+type inspectKey struct {
+	projectID   string
+	progress    bool
+	hideDone    bool
+	maxDepth    int
+	sessionDays int // 0 unless inspect --sessions
+}
+
+type inspectEntry struct {
+	fingerprint uint64
+	tree        string
+}
+
+// shellInspectCache keeps the last rendered plan tree of project inspect per
+// project and options, so "inspect, act, inspect again" skips the
+// node-by-node walk when nothing changed. An entry is served only while the
+// project's fingerprint matches; edits made anywhere (commands, forms,
+// another process) change it, so a stale tree is never shown. The metadata
+// panel is always redrawn from the current project row.
+type shellInspectCache struct {
+	mu      sync.Mutex
+	entries map[inspectKey]inspectEntry
+}
+
+func newShellInspectCache() *shellInspectCache {
+	return &shellInspectCache{entries: make(map[inspectKey]inspectEntry)}
+}
+
+// render returns the inspect card for key, reusing the cached tree panel
+// when the project's fingerprint is unchanged. A nil cache always rebuilds.
+func (c *shellInspectCache) render(ctx context.Context, app *App, key inspectKey) (string, error) {
+	if c == nil {
+		return buildInspectTree(app, ctx, key)
+	}
+	p, err := app.Projects.GetByID(ctx, key.projectID)
+	if err != nil {
+		return "", err
+	}
+	fp, err := inspectFingerprint(ctx, app, p, key.sessionDays, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && e.fingerprint == fp {
+		return formatter.FormatProjectInspectCard(p, e.tree), nil
+	}
+
+	data, err := loadInspectView(app, ctx, key)
+	if err != nil {
+		return "", err
+	}
+	tree := formatter.FormatProjectInspectTree(data)
+	c.mu.Lock()
+	c.entries[key] = inspectEntry{fingerprint: fp, tree: tree}
+	c.mu.Unlock()
+	return formatter.FormatProjectInspectCard(data.Project, tree), nil
+}
+
+// inspectFingerprint hashes everything the plan tree renders: the project's
+// UpdatedAt and each of its nodes and work items. UpdatedAt is stored to the
+// second, so the rendered fields are hashed too to catch two edits within one
+// second. The local date is included because node due dates render relative
+// to today. With sessionDays set, the sessions in that window are hashed
+// as well. It costs two queries (three with sessions) however deep the tree
+// is.
+func inspectFingerprint(ctx context.Context, app *App, p *domain.Project, sessionDays int, now time.Time) (uint64, error) {
+	nodes, err := app.Nodes.ListByProject(ctx, p.ID)
+	if err != nil {
+		return 0, fmt.Errorf("listing nodes: %w", err)
+	}
+	items, err := app.WorkItems.ListByProject(ctx, p.ID)
+	if err != nil {
+		return 0, fmt.Errorf("listing work items: %w", err)
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%s|%d|%d|", now.Local().Format("2006-01-02"), p.UpdatedAt.Format(time.RFC3339), len(nodes), len(items))
+	for _, n := range nodes {
+		parent := ""
+		if n.ParentID != nil {
+			parent = *n.ParentID
+		}
+		fmt.Fprintf(h, "n|%s|%s|%d|%s|%s|%t|%d|%s|", n.ID, parent, n.Seq, n.Title, n.Kind,
+			n.IsDefault, n.OrderIndex, n.UpdatedAt.Format(time.RFC3339))
+		writeOptTime(h, n.DueDate)
+		if n.PlannedMinBudget != nil {
+			fmt.Fprintf(h, "%d|", *n.PlannedMinBudget)
+		}
+	}
+	for _, w := range items {
+		fmt.Fprintf(h, "w|%s|%s|%d|%s|%s|%s|%d|%d|%d|%d|%s|", w.ID, w.NodeID, w.Seq, w.Title, w.Type,
+			w.Status, w.PlannedMin, w.LoggedMin, w.UnitsDone, w.UnitsTotal, w.UpdatedAt.Format(time.RFC3339))
+		writeOptTime(h, w.ArchivedAt)
+		writeOptTime(h, w.DueDate)
+	}
+	if sessionDays > 0 {
+		sessions, err := app.Sessions.ListRecentByProject(ctx, p.ID, sessionDays)
+		if err != nil {
+			return 0, fmt.Errorf("listing recent sessions: %w", err)
+		}
+		for _, s := range sessions {
+			fmt.Fprintf(h, "s|%s|%s|%d|", s.ID, s.StartedAt.Format(time.RFC3339), s.Minutes)
+		}
+	}
+	return h.Sum64(), nil
+}
+
+func writeOptTime(w io.Writer, t *time.Time) {
+	if t == nil {
+		fmt.Fprint(w, "-|")
+		return
+	}
+	fmt.Fprintf(w, "%s|", t.Format(time.RFC3339))
+}
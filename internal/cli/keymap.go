@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// KeyAction names a TUI action that can be bound to keys in keys.toml.
+type KeyAction string
+
+const (
+	KeyCommand    KeyAction = "command"
+	KeyQuit       KeyAction = "quit"
+	KeyWhatNow    KeyAction = "what-now"
+	KeyUp         KeyAction = "up"
+	KeyDown       KeyAction = "down"
+	KeyProjects   KeyAction = "projects"
+	KeyDraft      KeyAction = "draft"
+	KeyHelp       KeyAction = "help"
+	KeyRefresh    KeyAction = "refresh"
+	KeyFilter     KeyAction = "filter"
+	KeyToggleDone KeyAction = "toggle-done"
+	KeyAddItem    KeyAction = "add-item"
+	KeyDelete     KeyAction = "delete"
+	KeyPalette    KeyAction = "palette"
+	KeyMoveUp     KeyAction = "move-up"
+	KeyMoveDown   KeyAction = "move-down"
+)
+
+// keyActionSpec describes an action's default keys and the views it is
+// handled in. Global actions are checked before any view sees the key.
+type keyActionSpec struct {
+	defaults []string
+	fixed    []string // always bound, whatever the config says (arrow keys)
+	global   bool
+	views    []ViewID
+}
+
+var keyActionSpecs = map[KeyAction]keyActionSpec{
+	KeyCommand:    {defaults: []string{":"}, global: true},
+	KeyQuit:       {defaults: []string{"q"}, global: true},
+	KeyWhatNow:    {defaults: []string{"?"}, global: true},
+	KeyUp:         {defaults: []string{"k"}, fixed: []string{"up"}, global: true},
+	KeyDown:       {defaults: []string{"j"}, fixed: []string{"down"}, global: true},
+	KeyProjects:   {defaults: []string{"p"}, views: []ViewID{ViewDashboard}},
+	KeyDraft:      {defaults: []string{"d"}, views: []ViewID{ViewDashboard, ViewOnboarding}},
+	KeyHelp:       {defaults: []string{"h"}, views: []ViewID{ViewDashboard}},
+	KeyRefresh:    {defaults: []string{"r"}, views: []ViewID{ViewDashboard, ViewTaskList, ViewRecommendation, ViewStatusWatch}},
+	KeyFilter:     {defaults: []string{"/"}, views: []ViewID{ViewProjectList}},
+	KeyToggleDone: {defaults: []string{"space"}, views: []ViewID{ViewTaskList}},
+	KeyAddItem:    {defaults: []string{"a"}, views: []ViewID{ViewTaskList}},
+	KeyDelete:     {defaults: []string{"x"}, views: []ViewID{ViewTaskList}},
+	KeyPalette:    {defaults: []string{"ctrl+p"}, global: true},
+	KeyMoveUp:     {defaults: []string{"K"}, views: []ViewID{ViewTaskList}},
+	KeyMoveDown:   {defaults: []string{"J"}, views: []ViewID{ViewTaskList}},
+}
+
+// Keymap maps TUI actions to the keys that trigger them. Views consult it
+// instead of matching key strings directly.
+type Keymap struct {
+	keys map[KeyAction][]string
+
+	// Warnings lists problems found while loading the config (unknown
+	// actions, malformed lines, conflicting bindings). They are shown once
+	// when the TUI starts.
+	Warnings []string
+}
+
+// DefaultKeymap returns the built-in bindings.
+func DefaultKeymap() *Keymap {
+	k := &Keymap{keys: make(map[KeyAction][]string, len(keyActionSpecs))}
+	for a, spec := range keyActionSpecs {
+		k.keys[a] = append(append([]string{}, spec.defaults...), spec.fixed...)
+	}
+	return k
+}
+
+// Matches reports whether msg is bound to action a.
+func (k *Keymap) Matches(msg tea.KeyMsg, a KeyAction) bool {
+	s := msg.String()
+	for _, bound := range k.keys[a] {
+		if s == bound {
+			return true
+		}
+	}
+	return false
+}
+
+// Key returns the first key bound to a, for hints.
+func (k *Keymap) Key(a KeyAction) string {
+	if keys := k.keys[a]; len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// Binding returns a help binding for a, labelled with its first key.
+func (k *Keymap) Binding(a KeyAction, desc string) key.Binding {
+	return key.NewBinding(key.WithKeys(k.keys[a]...), key.WithHelp(k.Key(a), desc))
+}
+
+// LoadKeymap reads key bindings from path on top of the defaults. The file
+// holds `action = "key"` or `action = ["key", "key"]` lines (a TOML subset);
+// a missing file yields the defaults. Problems in the file become Warnings
+// rather than errors so a typo never blocks startup.
+func LoadKeymap(path string) (*Keymap, error) {
+	k := DefaultKeymap()
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return k, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening key bindings: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || line == "[keys]" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			k.warnf("line %d: expected action = \"key\"", lineNo)
+			continue
+		}
+		action := KeyAction(strings.TrimSpace(name))
+		spec, known := keyActionSpecs[action]
+		if !known {
+			k.warnf("line %d: unknown action %q", lineNo, action)
+			continue
+		}
+		keys, err := parseKeyList(value)
+		if err != nil {
+			k.warnf("line %d: %s: %v", lineNo, action, err)
+			continue
+		}
+		k.keys[action] = append(keys, spec.fixed...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading key bindings: %w", err)
+	}
+
+	k.warnConflicts()
+	return k, nil
+}
+
+// parseKeyList parses a quoted key or an array of quoted keys, dropping a
+// trailing comment.
+func parseKeyList(value string) ([]string, error) {
+	value = strings.TrimSpace(value)
+	if i := strings.LastIndex(value, "#"); i > 0 && strings.ContainsAny(value[i-1:i], " \t") {
+		value = strings.TrimSpace(value[:i])
+	}
+	var parts []string
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		for _, p := range strings.Split(value[1:len(value)-1], ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				parts = append(parts, p)
+			}
+		}
+	} else {
+		parts = []string{value}
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no keys given")
+	}
+	keys := make([]string, 0, len(parts))
+	for _, p := range parts {
+		s, err := strconv.Unquote(p)
+		if err != nil || s == "" {
+			return nil, fmt.Errorf("expected a quoted key, got %s", p)
+		}
+		keys = append(keys, s)
+	}
+	return keys, nil
+}
+
+// warnConflicts adds a warning for every key bound to two actions that can
+// fire in the same view.
+func (k *Keymap) warnConflicts() {
+	actions := make([]KeyAction, 0, len(k.keys))
+	for a := range k.keys {
+		actions = append(actions, a)
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+
+	for i, a := range actions {
+		for _, b := range actions[i+1:] {
+			if !actionsShareView(keyActionSpecs[a], keyActionSpecs[b]) {
+				continue
+			}
+			for _, ka := range k.keys[a] {
+				for _, kb := range k.keys[b] {
+					if ka == kb {
+						k.warnf("%q is bound to both %s and %s", ka, a, b)
+					}
+				}
+			}
+		}
+	}
+}
+
+func actionsShareView(a, b keyActionSpec) bool {
+	if a.global || b.global {
+		return true
+	}
+	for _, va := range a.views {
+		for _, vb := range b.views {
+			if va == vb {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (k *Keymap) warnf(format string, args ...any) {
+	k.Warnings = append(k.Warnings, fmt.Sprintf(format, args...))
+}
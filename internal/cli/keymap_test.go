@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeysFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.toml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func runeKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestLoadKeymap_MissingFileUsesDefaults(t *testing.T) {
+	k, err := LoadKeymap(filepath.Join(t.TempDir(), "keys.toml"))
+	require.NoError(t, err)
+	assert.Empty(t, k.Warnings)
+	assert.True(t, k.Matches(runeKey('d'), KeyDraft))
+	assert.True(t, k.Matches(runeKey('k'), KeyUp))
+	assert.True(t, k.Matches(tea.KeyMsg{Type: tea.KeyUp}, KeyUp))
+}
+
+func TestLoadKeymap_RemapsActions(t *testing.T) {
+	path := writeKeysFile(t, `# dvorak-ish
+[keys]
+up = "c"
+down = ["t", "ctrl+n"]  # arrows keep working
+draft = "n"
+`)
+	k, err := LoadKeymap(path)
+	require.NoError(t, err)
+	assert.Empty(t, k.Warnings)
+
+	assert.True(t, k.Matches(runeKey('c'), KeyUp))
+	assert.False(t, k.Matches(runeKey('k'), KeyUp), "configured keys replace the default")
+	assert.True(t, k.Matches(tea.KeyMsg{Type: tea.KeyUp}, KeyUp), "arrow keys stay bound")
+	assert.True(t, k.Matches(tea.KeyMsg{Type: tea.KeyCtrlN}, KeyDown))
+	assert.True(t, k.Matches(runeKey('n'), KeyDraft))
+	assert.Equal(t, "n", k.Key(KeyDraft))
+}
+
+func TestLoadKeymap_WarnsOnUnknownMalformedAndConflicting(t *testing.T) {
+	path := writeKeysFile(t, `teleport = "t"
+draft = n
+help = "q"
+add-item = "p"
+`)
+	k, err := LoadKeymap(path)
+	require.NoError(t, err)
+
+	require.Len(t, k.Warnings, 3)
+	assert.Contains(t, k.Warnings[0], `unknown action "teleport"`)
+	assert.Contains(t, k.Warnings[1], "line 2: draft")
+	assert.Contains(t, k.Warnings[2], `"q" is bound to both help and quit`)
+	// add-item (task list) and projects (dashboard) never share a view.
+	assert.True(t, k.Matches(runeKey('d'), KeyDraft), "a bad line keeps the default")
+}
+
+func TestTUI_CustomKeymapRoutesViewActions(t *testing.T) {
+	app := testApp(t)
+	k, err := LoadKeymap(writeKeysFile(t, "draft = \"n\"\nbogus = \"z\"\n"))
+	require.NoError(t, err)
+	app.Keys = k
+
+	d := NewTestDriver(t, app)
+	assert.Contains(t, d.LastOutput(), `unknown action "bogus"`)
+	d.PressEsc()
+
+	d.PressKey('d')
+	assert.Equal(t, ViewDashboard, d.ActiveViewID(), "old binding no longer opens draft")
+	d.PressKey('n')
+	assert.Equal(t, ViewDraft, d.ActiveViewID())
+}
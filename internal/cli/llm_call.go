@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// llmCall tracks a single in-flight LLM request started from a view.
+// It owns the spinner shown while waiting and the cancel func bound to Esc.
+// Each call gets an id; the view's result message echoes it back so replies
+// that arrive after a cancel (or a newer call) can be dropped.
+type llmCall struct {
+	spinner spinner.Model
+	label   string
+	cancel  context.CancelFunc
+	id      int
+}
+
+func newLLMCall() llmCall {
+	return llmCall{
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(formatter.StylePurple),
+		),
+	}
+}
+
+// start launches run in a bubbletea command with a cancellable context and
+// starts the spinner. run must return the message to deliver on completion,
+// tagged with the given id.
+func (c *llmCall) start(label string, run func(ctx context.Context, id int) tea.Msg) tea.Cmd {
+	c.abort()
+	ctx, cancel := context.WithCancel(context.Background())
+	c.id++
+	c.cancel = cancel
+	c.label = label
+	id := c.id
+	return tea.Batch(c.spinner.Tick, func() tea.Msg { return run(ctx, id) })
+}
+
+// pending reports whether a call is in flight.
+func (c *llmCall) pending() bool { return c.cancel != nil }
+
+// finish marks call id as complete. It returns false for stale results
+// (the call was cancelled or superseded), which the view should ignore.
+func (c *llmCall) finish(id int) bool {
+	if c.cancel == nil || id != c.id {
+		return false
+	}
+	c.cancel()
+	c.cancel = nil
+	return true
+}
+
+// abort cancels the in-flight call, if any, and reports whether one was running.
+func (c *llmCall) abort() bool {
+	if c.cancel == nil {
+		return false
+	}
+	c.cancel()
+	c.cancel = nil
+	return true
+}
+
+// tick advances the spinner while a call is pending.
+func (c *llmCall) tick(msg spinner.TickMsg) tea.Cmd {
+	if c.cancel == nil {
+		return nil
+	}
+	var cmd tea.Cmd
+	c.spinner, cmd = c.spinner.Update(msg)
+	return cmd
+}
+
+// View renders the spinner line shown in place of the input prompt.
+func (c *llmCall) View() string {
+	return "  " + c.spinner.View() + " " + formatter.Dim(c.label+"  (esc to cancel)")
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingHelp answers immediately unless block is set, in which case it
+// waits for cancellation and records that it saw it.
+type blockingHelp struct {
+	block     bool
+	cancelled chan struct{}
+}
+
+func (h *blockingHelp) wait(ctx context.Context) error {
+	if !h.block {
+		return nil
+	}
+	<-ctx.Done()
+	close(h.cancelled)
+	return ctx.Err()
+}
+
+func (h *blockingHelp) Ask(ctx context.Context, q, _ string, _ *intelligence.HelpContext) (*intelligence.HelpAnswer, error) {
+	if err := h.wait(ctx); err != nil {
+		return nil, err
+	}
+	return &intelligence.HelpAnswer{Answer: "LLM says: " + q, Source: "llm"}, nil
+}
+
+func (h *blockingHelp) StartChat(ctx context.Context, q, spec string, hctx *intelligence.HelpContext) (*intelligence.HelpConversation, *intelligence.HelpAnswer, error) {
+	answer, err := h.Ask(ctx, q, spec, hctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &intelligence.HelpConversation{CommandSpec: spec, Context: hctx}, answer, nil
+}
+
+func (h *blockingHelp) NextTurn(ctx context.Context, conv *intelligence.HelpConversation, q string) (*intelligence.HelpAnswer, error) {
+	answer, err := h.Ask(ctx, q, conv.CommandSpec, conv.Context)
+	if err != nil {
+		return nil, err
+	}
+	conv.Turns = append(conv.Turns, intelligence.ConversationTurn{Role: "User", Content: q})
+	return answer, nil
+}
+
+type blockingDraft struct {
+	cancelled chan struct{}
+}
+
+func (d *blockingDraft) Start(ctx context.Context, _ string) (*intelligence.DraftConversation, error) {
+	<-ctx.Done()
+	close(d.cancelled)
+	return nil, ctx.Err()
+}
+
+func (d *blockingDraft) StartWithDraft(ctx context.Context, desc string, _ *importer.ImportSchema) (*intelligence.DraftConversation, error) {
+	return d.Start(ctx, desc)
+}
+
+func (d *blockingDraft) NextTurn(ctx context.Context, _ *intelligence.DraftConversation, _ string) (*intelligence.DraftConversation, error) {
+	return d.Start(ctx, "")
+}
+
+func TestHelpChat_AnswersAsynchronously(t *testing.T) {
+	app := testApp(t)
+	app.Help = &blockingHelp{}
+	d := NewTestDriver(t, app)
+
+	d.Command("help chat how do I log")
+	require.Equal(t, ViewHelpChat, d.ActiveViewID())
+	assert.Contains(t, d.View(), "LLM says: how do I log")
+	assert.NotContains(t, d.View(), "esc to cancel")
+}
+
+func TestHelpChat_EscCancelsPendingAnswer(t *testing.T) {
+	app := testApp(t)
+	help := &blockingHelp{}
+	app.Help = help
+	d := NewTestDriver(t, app)
+
+	d.Command("help chat")
+	d.Type("first")
+	d.PressEnter()
+	m := d.appModel()
+	hv := m.activeView().(*helpChatView)
+	turns := len(hv.conv.Turns)
+
+	help.block = true
+	help.cancelled = make(chan struct{})
+	d.Type("slow question")
+	d.PressEnter()
+	assert.Contains(t, d.View(), "esc to cancel")
+
+	d.PressEsc()
+	<-help.cancelled
+	assert.Equal(t, ViewHelpChat, d.ActiveViewID(), "esc while pending cancels the call, not the view")
+	assert.Contains(t, d.View(), "Cancelled.")
+	assert.Equal(t, turns, len(hv.conv.Turns), "cancelled turn must not touch history")
+
+	d.PressEsc()
+	assert.Equal(t, ViewDashboard, d.ActiveViewID())
+}
+
+func TestDraftView_EscCancelsOpeningTurnAndFallsBackToWizard(t *testing.T) {
+	app := testApp(t)
+	draft := &blockingDraft{cancelled: make(chan struct{})}
+	app.ProjectDraft = draft
+	d := NewTestDriver(t, app)
+
+	d.Command("draft learn Go in 6 weeks")
+	require.Equal(t, ViewDraft, d.ActiveViewID())
+	assert.Contains(t, d.View(), "Drafting project...")
+
+	d.PressEsc()
+	<-draft.cancelled
+	assert.Equal(t, ViewDraft, d.ActiveViewID())
+	assert.Contains(t, d.View(), "Cancelled.")
+	assert.Contains(t, d.View(), "Describe your project:")
+}
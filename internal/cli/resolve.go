@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
 )
 
 // resolveNodeID resolves a node identifier which can be:
@@ -13,7 +15,7 @@ import (
 func resolveNodeID(ctx context.Context, app *App, input string, projectID string) (string, error) {
 	if seq, err := strconv.Atoi(input); err == nil && seq > 0 {
 		if projectID == "" {
-			return "", fmt.Errorf("numeric ID #%d requires project context (use --project flag or shell 'use' command)", seq)
+			return "", domain.Errorf(domain.CodeInvalidInput, "numeric ID #%d requires project context (use --project flag or shell 'use' command)", seq)
 		}
 		node, err := app.Nodes.GetBySeq(ctx, projectID, seq)
 		if err != nil {
@@ -30,7 +32,7 @@ func resolveNodeID(ctx context.Context, app *App, input string, projectID string
 func resolveWorkItemID(ctx context.Context, app *App, input string, projectID string) (string, error) {
 	if seq, err := strconv.Atoi(input); err == nil && seq > 0 {
 		if projectID == "" {
-			return "", fmt.Errorf("numeric ID #%d requires project context (use --project flag or shell 'use' command)", seq)
+			return "", domain.Errorf(domain.CodeInvalidInput, "numeric ID #%d requires project context (use --project flag or shell 'use' command)", seq)
 		}
 		wi, err := app.WorkItems.GetBySeq(ctx, projectID, seq)
 		if err != nil {
@@ -54,7 +56,7 @@ func resolveWorkItemID(ctx context.Context, app *App, input string, projectID st
 //   - A UUID prefix (must be unambiguous)
 func resolveProjectID(ctx context.Context, app *App, input string) (string, error) {
 	if input == "" {
-		return "", fmt.Errorf("project ID is required")
+		return "", domain.Errorf(domain.CodeInvalidInput, "project ID is required")
 	}
 
 	projects, err := app.Projects.List(ctx, true)
@@ -86,11 +88,11 @@ func resolveProjectID(ctx context.Context, app *App, input string) (string, erro
 
 	switch len(matches) {
 	case 0:
-		return "", fmt.Errorf("project not found: %q", input)
+		return "", domain.Errorf(domain.CodeNotFound, "project not found: %q", input)
 	case 1:
 		return matches[0], nil
 	default:
-		return "", fmt.Errorf("project ID prefix %q is ambiguous (%d matches)", input, len(matches))
+		return "", domain.Errorf(domain.CodeInvalidInput, "project ID prefix %q is ambiguous (%d matches)", input, len(matches))
 	}
 }
 
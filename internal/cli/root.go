@@ -4,7 +4,9 @@ import (
 	"sync"
 
 	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	"github.com/alexanderramin/kairos/internal/service"
 )
 
@@ -20,6 +22,20 @@ type App struct {
 	Replan    app.ReplanUseCase
 	Templates service.TemplateService
 	Import    service.ImportService
+	Export    app.ExportUseCase
+	Stats     app.StatsUseCase
+	Focus     service.FocusService
+	Audit     service.AuditService
+	Presets   service.WorkPresetService
+	Plans     service.DayPlanService
+	Weekly    service.WeeklyPlanService
+	Review    service.WeeklyReviewService
+	Profile   service.ProfileService
+	Archive   service.ArchiveService
+
+	// Timings aggregates use-case latencies for `debug timings` (nil when
+	// not wired).
+	Timings *service.TimingUseCaseObserver
 
 	// Phase 1 app ports with CLI-level fallback to legacy service fields.
 	LogSession    app.LogSessionUseCase
@@ -33,6 +49,29 @@ type App struct {
 	ProjectDraft  intelligence.ProjectDraftService
 	Help          intelligence.HelpService
 
+	// LLMConfig is the loaded LLM configuration, set even when disabled so
+	// `llm status` can report on the server.
+	LLMConfig llm.LLMConfig
+
+	// DBPath, TemplateDir and KeysPath are the paths main resolved from
+	// KAIROS_DB, KAIROS_TEMPLATES and KAIROS_KEYS or their defaults, shown
+	// by `config list`.
+	DBPath      string
+	TemplateDir string
+	KeysPath    string
+
+	// DraftPath is where the draft view saves an unfinished draft for
+	// `draft --resume`; empty disables saving.
+	DraftPath string
+
+	// Keys holds the TUI key bindings loaded from keys.toml; nil means the
+	// defaults.
+	Keys *Keymap
+
+	// Verbosity is the shell's default output verbosity; commands can
+	// override it with --quiet or --verbose.
+	Verbosity formatter.Verbosity
+
 	// IsInteractive reports whether stdin is a terminal.
 	// Set by main; tests override to return false.
 	IsInteractive func() bool
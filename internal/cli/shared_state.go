@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"time"
 
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/domain"
 )
 
@@ -20,16 +22,29 @@ type SharedState struct {
 	ActiveItemTitle string
 	ActiveItemSeq   int
 
+	// Running timer, set by start and logged by session log --continue-timer
+	TimerItemID    string
+	TimerItemTitle string
+	TimerStartedAt time.Time
+
 	// Session defaults
 	LastDuration int
+	Verbosity    formatter.Verbosity // from KAIROS_VERBOSITY or --quiet/--verbose at startup
 
 	// Terminal dimensions
 	Width  int
 	Height int
 
+	// View on top of the stack at the last key press; help uses it as the
+	// context for "what can I do here?"
+	CurrentView ViewID
+
 	// Project cache for suggestions
 	Cache *shellProjectCache
 
+	// Rendered project inspect trees, served while the project is unchanged
+	Inspect *shellInspectCache
+
 	// Transient recommendation context
 	LastRecommendedItemID    string
 	LastRecommendedItemTitle string
@@ -76,6 +91,31 @@ func (s *SharedState) SetActiveItem(id, title string, seq int) {
 	s.ActiveItemSeq = seq
 }
 
+// StartTimer starts (or restarts) the timer on a work item.
+func (s *SharedState) StartTimer(id, title string, now time.Time) {
+	s.TimerItemID = id
+	s.TimerItemTitle = title
+	s.TimerStartedAt = now
+}
+
+// StopTimer clears the running timer.
+func (s *SharedState) StopTimer() {
+	s.TimerItemID = ""
+	s.TimerItemTitle = ""
+	s.TimerStartedAt = time.Time{}
+}
+
+// TimerRunning reports whether a timer has been started and not yet logged.
+func (s *SharedState) TimerRunning() bool {
+	return s.TimerItemID != ""
+}
+
+// TimerElapsedMin is the timer's running time in whole minutes, at least 1
+// so that a timer stopped right away still logs a session.
+func (s *SharedState) TimerElapsedMin(now time.Time) int {
+	return max(1, int(now.Sub(s.TimerStartedAt).Minutes()))
+}
+
 // ContentHeight returns the available height for view content,
 // accounting for header (2 lines: title + separator),
 // status bar (2 lines: separator + hints), and command bar (1 line).
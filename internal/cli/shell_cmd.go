@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -19,14 +18,53 @@ var destructiveCommands = map[string]map[string]bool{
 
 func RunShell(app *App) error {
 	m := newAppModel(app)
+	if needsOnboarding(app) {
+		m.viewStack = append(m.viewStack, newOnboardingView(m.state))
+	}
 	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	_, err := p.Run()
 	return err
 }
 
-// shellError formats an error for display in the shell.
-func shellError(err error) string {
-	return formatter.StyleRed.Render(fmt.Sprintf("Error: %v", err))
+// parseSignedDuration parses a relative duration like "+30", "-15" or "+1h"
+// into signed minutes. A missing sign means an increase.
+func parseSignedDuration(s string) (int, bool) {
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		sign = -1
+		s = s[1:]
+	}
+	m, ok := parseDurationArg(s)
+	return sign * m, ok
+}
+
+// parseDayOffset parses a calendar offset like "+14d", "-7d", "2w" or "10"
+// (days) into signed days. A missing sign means later.
+func parseDayOffset(s string) (int, bool) {
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		sign = -1
+		s = s[1:]
+	}
+	unit := 1
+	switch {
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	case strings.HasSuffix(s, "w"):
+		s = strings.TrimSuffix(s, "w")
+		unit = 7
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return sign * n * unit, true
 }
 
 // parseDurationArg parses a duration string into minutes.
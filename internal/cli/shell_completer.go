@@ -41,11 +41,11 @@ func (c *shellProjectCache) get(app *App) []*domain.Project {
 func allCommandNames() []string {
 	return []string{
 		"projects", "use", "inspect",
-		"status", "what-now", "replan",
+		"status", "timeline", "today", "what-now", "plan", "weekly", "replan", "focus", "profile", "config", "history",
 		"log", "start", "finish", "add", "context",
 		"project", "node", "work", "session",
-		"draft", "import", "template",
-		"ask", "explain", "review",
+		"draft", "import", "export", "template", "archive",
+		"ask", "explain", "review", "stats", "debug", "llm",
 		"clear", "help", "exit", "quit",
 	}
 }
@@ -53,13 +53,23 @@ func allCommandNames() []string {
 // subcommandNames returns subcommand lists by parent command.
 func subcommandNames() map[string][]string {
 	return map[string][]string{
-		"project":  {"add", "list", "inspect", "update", "archive", "unarchive", "remove", "init", "import", "draft"},
+		"project":  {"add", "list", "inspect", "progress", "update", "shift", "archive", "unarchive", "remove", "init", "import", "export", "draft"},
 		"node":     {"add", "inspect", "update", "remove"},
-		"work":     {"add", "inspect", "update", "done", "archive", "remove"},
-		"session":  {"log", "list", "remove"},
-		"template": {"list", "show", "draft"},
+		"work":     {"add", "inspect", "list", "update", "clone", "move-up", "move-down", "bump", "estimate", "check", "priority", "preset", "done", "archive", "remove"},
+		"session":  {"log", "list", "report", "undo-last", "remove"},
+		"template": {"list", "show", "validate", "draft"},
 		"explain":  {"now", "why-not"},
 		"review":   {"weekly"},
+		"stats":    {"accuracy"},
+		"debug":    {"timings"},
+		"llm":      {"status"},
+		"help":     {"chat", "commands"},
+		"focus":    {"list", "add", "remove"},
+		"plan":     {"show", "status"},
+		"weekly":   {"plan"},
+		"profile":  {"show", "set", "export", "import"},
+		"config":   {"list", "get", "set"},
+		"archive":  {"list", "purge"},
 	}
 }
 
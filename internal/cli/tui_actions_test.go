@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -301,6 +302,41 @@ func TestTUI_TaskList_EnterPushesActionMenu(t *testing.T) {
 	assert.Contains(t, view, "Task List Item")
 }
 
+func TestTUI_TaskList_ShiftJKReordersWithinNode(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, nodeID, _ := seedProjectCore(t, app, seedOpts{shortID: "ORD01"})
+	require.NoError(t, app.WorkItems.Create(ctx, testutil.NewTestWorkItem(nodeID, "Essay")))
+
+	order := func() []string {
+		t.Helper()
+		items, err := app.WorkItems.ListByNode(ctx, nodeID)
+		require.NoError(t, err)
+		titles := make([]string, len(items))
+		for i, w := range items {
+			titles[i] = w.Title
+		}
+		return titles
+	}
+
+	d := NewTestDriver(t, app)
+	d.Command("inspect ORD01")
+	require.Equal(t, ViewTaskList, d.ActiveViewID())
+
+	d.PressKey('j') // Reading
+	d.PressKey('J')
+	assert.Equal(t, []string{"Essay", "Reading"}, order())
+	assert.Less(t, strings.Index(d.View(), "Essay"), strings.Index(d.View(), "Reading"))
+
+	// The cursor followed Reading, which is now last: J again is a no-op.
+	d.PressKey('J')
+	assert.Equal(t, []string{"Essay", "Reading"}, order())
+
+	d.PressKey('K')
+	assert.Equal(t, []string{"Reading", "Essay"}, order())
+	assert.Equal(t, ViewTaskList, d.ActiveViewID())
+}
+
 // =============================================================================
 // C. Work Actions — work_actions.go
 // =============================================================================
@@ -389,6 +425,7 @@ func TestExecMarkDone_SetsCompleted(t *testing.T) {
 	assert.Contains(t, msg, "Done")
 	assert.Contains(t, msg, "Reading")
 	assert.Empty(t, state.ActiveItemID, "should clear item context when marking active item done")
+	assert.NotContains(t, msg, "estimated", "nothing logged, nothing to compare")
 
 	// Verify DB state.
 	wi, err := app.WorkItems.GetByID(ctx, wiID)
@@ -396,6 +433,21 @@ func TestExecMarkDone_SetsCompleted(t *testing.T) {
 	assert.Equal(t, domain.WorkItemDone, wi.Status)
 }
 
+func TestExecMarkDone_ShowsEstimatedVsActual(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+
+	_, wiID := seedProjectWithWork(t, app)
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 50)))
+	require.NoError(t, app.Sessions.LogSession(ctx, testutil.NewTestSession(wiID, 41)))
+
+	state := &SharedState{App: app}
+	msg, err := execMarkDone(ctx, app, state, wiID, "Reading")
+
+	require.NoError(t, err)
+	assert.Contains(t, msg, "estimated 1h, actually took 1h 31m (+52%)")
+}
+
 func TestExecMarkDone_DoesNotClearOtherItem(t *testing.T) {
 	app := testApp(t)
 	ctx := context.Background()
@@ -735,6 +735,25 @@ func TestTUI_Context_PreservedAfterHelpChat(t *testing.T) {
 	assert.Equal(t, "Help Context", d.State().ActiveProjectName)
 }
 
+func TestTUI_HelpChat_AnswersForCurrentView(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Help Context", testutil.WithShortID("CTX01"))
+	require.NoError(t, app.Projects.Create(ctx, proj))
+
+	d := NewTestDriver(t, app)
+	d.Command("use CTX01")
+	d.PressKey('h')
+	require.Equal(t, ViewHelpChat, d.ActiveViewID())
+
+	draftType(d, "what can I do here?")
+	view := d.View()
+	assert.Contains(t, view, "You're on the dashboard in CTX01 Help Context")
+	assert.Contains(t, view, "what-now")
+	assert.Contains(t, view, "project progress")
+}
+
 func TestTUI_Context_ClearUseNoArgs(t *testing.T) {
 	app := testApp(t)
 	ctx := context.Background()
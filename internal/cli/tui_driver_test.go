@@ -18,8 +18,14 @@ type TestDriver struct {
 // (which loads dashboard data synchronously via in-memory SQLite).
 func NewTestDriver(t *testing.T, app *App) *TestDriver {
 	t.Helper()
+	return newTestDriverFromModel(t, newAppModel(app))
+}
+
+// newTestDriverFromModel is NewTestDriver for a model the test has already
+// adjusted, e.g. with an extra view pushed as RunShell would.
+func newTestDriverFromModel(t *testing.T, m appModel) *TestDriver {
+	t.Helper()
 
-	m := newAppModel(app)
 	d := teatest.New(t, m, teatest.WithSize(120, 40))
 	d.DrainInit()
 
@@ -120,6 +120,24 @@ func TestE2E_LogSession_CommandBar(t *testing.T) {
 	assert.Equal(t, 45, d.State().LastDuration)
 }
 
+func TestE2E_LogSession_TrailingDoneFinishesItem(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithShortIDAndWork(t, app, "LOG02", "Log Done Test")
+	ctx := context.Background()
+
+	d := NewTestDriver(t, app)
+	d.Command("use LOG02")
+
+	d.Command("log #1 45 done")
+	assert.Contains(t, d.LastOutput(), "marked it done")
+
+	wi, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	assert.Equal(t, 45, wi.LoggedMin)
+	assert.Equal(t, domain.WorkItemDone, wi.Status)
+	assert.Empty(t, d.State().ActiveItemID, "finished item should leave the context")
+}
+
 // =============================================================================
 // 3. Start → Log → Mark Done (lifecycle)
 // =============================================================================
@@ -583,3 +601,39 @@ func TestE2E_LogShiftsRecommendation(t *testing.T) {
 		}
 	}
 }
+
+func TestE2E_Onboarding_SampleProjectFromTemplate(t *testing.T) {
+	app := testAppFull(t)
+	ctx := context.Background()
+	require.True(t, needsOnboarding(app))
+
+	m := newAppModel(app)
+	m.viewStack = append(m.viewStack, newOnboardingView(m.state))
+	d := newTestDriverFromModel(t, m)
+
+	assert.Equal(t, ViewOnboarding, d.ActiveViewID())
+	assert.Contains(t, d.View(), "WELCOME TO KAIROS")
+
+	d.PressKey('s')
+	assert.Contains(t, d.View(), "Choose a template")
+	d.PressEnter()
+
+	assert.Equal(t, ViewDashboard, d.ActiveViewID())
+	projects, err := app.Projects.List(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, sampleProjectShortID, projects[0].ShortID)
+	assert.False(t, needsOnboarding(app), "onboarding is not offered once a project exists")
+}
+
+func TestE2E_Onboarding_EscSkipsToLoadedDashboard(t *testing.T) {
+	app := testApp(t)
+
+	m := newAppModel(app)
+	m.viewStack = append(m.viewStack, newOnboardingView(m.state))
+	d := newTestDriverFromModel(t, m)
+
+	d.PressEsc()
+	assert.Equal(t, ViewDashboard, d.ActiveViewID())
+	assert.Contains(t, d.View(), "No projects yet")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/testutil"
 	tea "github.com/charmbracelet/bubbletea"
@@ -34,6 +35,73 @@ func TestTUI_DashboardShowsProjects(t *testing.T) {
 	assert.Contains(t, view, "CLI Test Project")
 }
 
+func TestTUI_DashboardShowsFocusList(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithWork(t, app)
+	require.NoError(t, app.Focus.Add(context.Background(), wiID))
+
+	d := NewTestDriver(t, app)
+
+	view := d.View()
+	assert.Contains(t, view, "FOCUS")
+	assert.Contains(t, view, "Reading")
+}
+
+func TestTUI_DashboardShowsTodayLine(t *testing.T) {
+	app := testApp(t)
+	_, wiID := seedProjectWithShortIDAndWork(t, app, "DAY01", "Today Test")
+	require.NoError(t, app.Sessions.LogSession(context.Background(), testutil.NewTestSession(wiID, 30)))
+
+	d := NewTestDriver(t, app)
+
+	view := d.View()
+	assert.Contains(t, view, "30m logged today")
+	assert.Contains(t, view, "next: #2 Reading")
+
+	// Logging from the command bar refreshes the line without pressing r.
+	d.Command("use DAY01")
+	d.Command("log #2 15")
+	assert.Contains(t, d.LastOutput(), "Logged 15m")
+	d.PressEsc()
+	assert.Contains(t, d.View(), "45m logged today")
+}
+
+// countingStatus counts GetStatus calls on the wrapped use case.
+type countingStatus struct {
+	app.StatusUseCase
+	calls int
+}
+
+func (c *countingStatus) GetStatus(ctx context.Context, req app.StatusRequest) (*app.StatusResponse, error) {
+	c.calls++
+	return c.StatusUseCase.GetStatus(ctx, req)
+}
+
+func TestDashboard_CursorReadsPrefetchedDetail(t *testing.T) {
+	a := testApp(t)
+	seedProjectWithShortIDAndWork(t, a, "NAV01", "First Project")
+	seedProjectWithShortIDAndWork(t, a, "NAV02", "Second Project")
+	status := &countingStatus{StatusUseCase: a.Status}
+	a.Status = status
+
+	v := newDashboardView(&SharedState{App: a, Width: 120, Height: 40})
+	_, cmd := v.Update(v.Init()())
+	require.NotNil(t, cmd, "first project's task tree loads lazily")
+	v.Update(cmd())
+	assert.Equal(t, 1, status.calls, "one global status query per load")
+
+	_, cmd = v.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.NotContains(t, v.View(), "Loading details", "detail renders from the prefetch")
+	require.NotNil(t, cmd)
+	v.Update(cmd())
+	assert.Len(t, v.taskRows, 2)
+	assert.NotContains(t, v.View(), "Loading tasks")
+
+	_, cmd = v.Update(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Nil(t, cmd, "revisited task trees come from the cache")
+	assert.Equal(t, 1, status.calls, "navigation never re-queries status")
+}
+
 func TestTUI_QuitWithQ(t *testing.T) {
 	app := testApp(t)
 	d := NewTestDriver(t, app)
@@ -284,3 +352,81 @@ func TestTUI_QDoesNotQuitWhenCmdBarFocused(t *testing.T) {
 	d.PressKey('q')
 	assert.True(t, d.IsQuitting())
 }
+
+func TestTUI_StatusWatchRefreshesUntilQ(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	seedProjectWithWork(t, app)
+
+	d := NewTestDriver(t, app)
+	d.Command("status --watch --interval 10s")
+
+	require.Equal(t, ViewStatusWatch, d.ActiveViewID())
+	m := d.appModel()
+	watch := m.activeView().(*statusWatchView)
+	assert.Contains(t, d.View(), "every 10s")
+
+	// A tick reloads status, picking up changes made since the last frame.
+	require.NoError(t, app.Projects.Create(ctx, testutil.NewTestProject("Thesis", testutil.WithShortID("THS01"))))
+	d.Send(statusWatchTickMsg{view: watch, seq: watch.seq})
+	assert.Contains(t, d.View(), "Thesis")
+
+	// A tick from a superseded load starts nothing.
+	seq := watch.seq
+	d.Send(statusWatchTickMsg{view: watch, seq: seq - 1})
+	assert.Equal(t, seq, watch.seq)
+
+	// q stops watching without leaving the shell, and the popped view's
+	// pending tick is dropped rather than re-arming its ticker.
+	d.PressKey('q')
+	assert.False(t, d.IsQuitting())
+	assert.Equal(t, ViewDashboard, d.ActiveViewID())
+	d.Send(statusWatchTickMsg{view: watch, seq: watch.seq})
+	assert.Equal(t, seq, watch.seq)
+}
+
+func TestTUI_StatusWatchRejectsBadInterval(t *testing.T) {
+	app := testApp(t)
+	d := NewTestDriver(t, app)
+
+	d.Command("status --watch --interval soon")
+
+	assert.Equal(t, ViewDashboard, d.ActiveViewID())
+	assert.Contains(t, d.LastOutput(), "--interval must be")
+}
+
+func TestTUI_PaletteJumpsToProjectAndRunsActions(t *testing.T) {
+	app := testApp(t)
+	projID, _ := seedProjectWithShortIDAndWork(t, app, "PHI01", "Philosophy")
+	seedProjectWithShortIDAndWork(t, app, "MTH01", "Mathematics")
+
+	d := NewTestDriver(t, app)
+	ctrlP := tea.KeyMsg{Type: tea.KeyCtrlP}
+
+	// Open, filter to one project, and jump: the palette is replaced by the
+	// task list, so the stack grows by exactly one view.
+	d.SendKey(ctrlP)
+	require.Equal(t, ViewPalette, d.ActiveViewID())
+	assert.Contains(t, d.View(), "Mathematics")
+	d.Type("philo")
+	view := d.View()
+	assert.Contains(t, view, "Philosophy")
+	assert.NotContains(t, view, "Mathematics")
+	d.PressEnter()
+	assert.Equal(t, []ViewID{ViewDashboard, ViewTaskList}, d.ViewStackIDs())
+	assert.Equal(t, projID, d.State().ActiveProjectID)
+
+	// q typed into the palette filters rather than quitting; esc closes it.
+	d.SendKey(ctrlP)
+	d.PressKey('q')
+	assert.False(t, d.IsQuitting())
+	d.PressEsc()
+	assert.Equal(t, []ViewID{ViewDashboard, ViewTaskList}, d.ViewStackIDs())
+
+	// Enter on an action pops the palette and runs the command.
+	d.SendKey(ctrlP)
+	d.Type("timeline")
+	d.PressEnter()
+	assert.Equal(t, []ViewID{ViewDashboard, ViewTaskList}, d.ViewStackIDs())
+	assert.Contains(t, d.LastOutput(), "TIMELINE")
+}
@@ -17,6 +17,9 @@ const (
 	ViewForm
 	ViewDraft
 	ViewHelpChat
+	ViewOnboarding
+	ViewStatusWatch
+	ViewPalette
 )
 
 // View is the interface that all TUI views must implement.
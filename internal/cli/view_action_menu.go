@@ -85,16 +85,17 @@ func (v *actionMenuView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return v, nil
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		keys := v.state.App.keymap()
+		switch {
+		case keys.Matches(msg, KeyUp):
 			if v.cursor > 0 {
 				v.cursor--
 			}
-		case "down", "j":
+		case keys.Matches(msg, KeyDown):
 			if v.cursor < len(v.actions)-1 {
 				v.cursor++
 			}
-		case "enter":
+		case msg.String() == "enter":
 			if v.cursor < len(v.actions) {
 				return v, v.actions[v.cursor].fn()
 			}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -20,14 +21,28 @@ import (
 type dashboardData struct {
 	projects []*domain.Project
 	status   *contract.StatusResponse
+	focus    []*domain.WorkItem // pinned focus list, nil when unavailable
+	today    dashboardToday
+
+	// details holds the right-pane summary for every active project, keyed
+	// by project ID, so cursor movement never waits on the database.
+	details map[string]*dashboardDetailData
+}
+
+// dashboardToday summarizes how the day is going for the line under the badge.
+type dashboardToday struct {
+	loggedMin int                 // minutes from sessions started today
+	targetMin int                 // sum of required daily pace; 0 when no deadlines
+	next      *contract.WorkSlice // top what-now recommendation, nil when none
 }
 
-// dashboardDetailData holds per-project detail for the right pane.
+// dashboardDetailData holds per-project detail for the right pane. It is
+// prefetched for all active projects; the task tree preview is loaded lazily
+// (see dashboardView.taskRows).
 type dashboardDetailData struct {
 	project    *domain.Project
 	statusView *contract.ProjectStatusView
 	itemCounts struct{ total, done, inProgress, todo int }
-	taskRows   []taskRow // flattened task tree for preview in detail pane
 }
 
 // ── messages ─────────────────────────────────────────────────────────────────
@@ -38,10 +53,10 @@ type dashboardLoadedMsg struct {
 	err  error
 }
 
-// dashboardDetailLoadedMsg signals that per-project detail has been loaded.
-type dashboardDetailLoadedMsg struct {
-	data *dashboardDetailData
-	err  error
+// dashboardTasksLoadedMsg delivers the task tree preview for one project.
+type dashboardTasksLoadedMsg struct {
+	projectID string
+	rows      []taskRow
 }
 
 // ── view ─────────────────────────────────────────────────────────────────────
@@ -56,10 +71,12 @@ type dashboardView struct {
 	err     error
 
 	// Project selection
-	cursor        int
-	cachedActive  []*domain.Project // recomputed on data load
-	detailData    *dashboardDetailData
-	detailLoading bool
+	cursor       int
+	cachedActive []*domain.Project // recomputed on data load
+
+	// taskRows caches each project's flattened task tree once loaded; it is
+	// reset whenever dashboard data reloads.
+	taskRows map[string][]taskRow
 }
 
 func newDashboardView(state *SharedState) *dashboardView {
@@ -73,13 +90,14 @@ func (v *dashboardView) ID() ViewID    { return ViewDashboard }
 func (v *dashboardView) Title() string { return "Dashboard" }
 
 func (v *dashboardView) ShortHelp() []key.Binding {
+	keys := v.state.App.keymap()
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
-		key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "what now")),
-		key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "draft")),
-		key.NewBinding(key.WithKeys("h"), key.WithHelp("h", "help")),
-		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
-		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit")),
+		keys.Binding(KeyWhatNow, "what now"),
+		keys.Binding(KeyDraft, "draft"),
+		keys.Binding(KeyHelp, "help"),
+		keys.Binding(KeyRefresh, "refresh"),
+		keys.Binding(KeyQuit, "quit"),
 	}
 }
 
@@ -105,70 +123,118 @@ func (v *dashboardView) loadData() tea.Cmd {
 			return dashboardLoadedMsg{err: err}
 		}
 
+		var focus []*domain.WorkItem
+		if app.Focus != nil {
+			focus, _ = app.Focus.List(ctx)
+		}
+
 		return dashboardLoadedMsg{
 			data: dashboardData{
 				projects: projects,
 				status:   status,
+				focus:    focus,
+				today:    loadDashboardToday(ctx, app, status, time.Now()),
+				details:  loadDashboardDetails(ctx, app, projects, status),
 			},
 		}
 	}
 }
 
-func (v *dashboardView) loadSelectedDetail() tea.Cmd {
-	active := v.activeProjects()
-	if v.cursor >= len(active) {
-		return nil
-	}
-	projectID := active[v.cursor].ID
-	app := v.state.App
-
-	return func() tea.Msg {
-		ctx := context.Background()
-
-		project, err := app.Projects.GetByID(ctx, projectID)
-		if err != nil {
-			return dashboardDetailLoadedMsg{err: err}
-		}
-
-		// Project-scoped status
-		req := contract.NewStatusRequest()
-		req.ProjectScope = []string{projectID}
-		statusResp, err := app.Status.GetStatus(ctx, req)
-		if err != nil {
-			return dashboardDetailLoadedMsg{err: err}
+// loadDashboardDetails builds the right-pane summary for every active
+// project in one pass, reusing the global status response for risk and pace
+// instead of issuing a project-scoped status query per selection.
+func loadDashboardDetails(ctx context.Context, app *App, projects []*domain.Project, status *contract.StatusResponse) map[string]*dashboardDetailData {
+	views := make(map[string]*contract.ProjectStatusView)
+	if status != nil {
+		for i := range status.Projects {
+			views[status.Projects[i].ProjectID] = &status.Projects[i]
 		}
+	}
 
-		var sv *contract.ProjectStatusView
-		if len(statusResp.Projects) > 0 {
-			sv = &statusResp.Projects[0]
+	details := make(map[string]*dashboardDetailData)
+	for _, p := range projects {
+		if p.Status != domain.ProjectActive {
+			continue
 		}
-
-		// Item counts
-		items, _ := app.WorkItems.ListByProject(ctx, projectID)
-		var counts struct{ total, done, inProgress, todo int }
-		counts.total = len(items)
+		d := &dashboardDetailData{project: p, statusView: views[p.ID]}
+		items, _ := app.WorkItems.ListByProject(ctx, p.ID)
+		d.itemCounts.total = len(items)
 		for _, item := range items {
 			switch item.Status {
 			case domain.WorkItemDone:
-				counts.done++
+				d.itemCounts.done++
 			case domain.WorkItemInProgress:
-				counts.inProgress++
+				d.itemCounts.inProgress++
 			case domain.WorkItemTodo:
-				counts.todo++
+				d.itemCounts.todo++
 			}
 		}
+		details[p.ID] = d
+	}
+	return details
+}
 
-		// Build flattened task tree for the detail pane preview.
-		taskRows, _ := buildTaskRows(ctx, app, projectID)
+// loadDashboardToday gathers today's logged minutes, the combined daily pace
+// target from status, and the single top recommendation. Each part is
+// best-effort: a failure just leaves that part of the line out.
+func loadDashboardToday(ctx context.Context, app *App, status *contract.StatusResponse, now time.Time) dashboardToday {
+	var today dashboardToday
+
+	if sessions, err := app.Sessions.ListRecent(ctx, 1); err == nil {
+		y, m, d := now.Local().Date()
+		for _, s := range sessions {
+			sy, sm, sd := s.StartedAt.Local().Date()
+			if sy == y && sm == m && sd == d {
+				today.loggedMin += s.Minutes
+			}
+		}
+	}
 
-		return dashboardDetailLoadedMsg{
-			data: &dashboardDetailData{
-				project:    project,
-				statusView: sv,
-				itemCounts: counts,
-				taskRows:   taskRows,
-			},
+	if status != nil {
+		var required float64
+		for _, p := range status.Projects {
+			required += p.RequiredDailyMin
 		}
+		today.targetMin = int(math.Ceil(required))
+	}
+
+	if app.WhatNow != nil {
+		req := contract.NewWhatNowRequest(60)
+		req.MaxSlices = 1
+		req.DryRun = true
+		if resp, err := app.WhatNow.Recommend(ctx, req); err == nil && len(resp.Recommendations) > 0 {
+			today.next = &resp.Recommendations[0]
+		}
+	}
+	return today
+}
+
+// selectedDetail returns the prefetched detail for the project under the
+// cursor, or nil when there is none.
+func (v *dashboardView) selectedDetail() *dashboardDetailData {
+	active := v.activeProjects()
+	if v.data == nil || v.cursor >= len(active) {
+		return nil
+	}
+	return v.data.details[active[v.cursor].ID]
+}
+
+// loadSelectedTasks fetches the task tree preview for the selected project
+// unless it is already cached.
+func (v *dashboardView) loadSelectedTasks() tea.Cmd {
+	active := v.activeProjects()
+	if v.cursor >= len(active) {
+		return nil
+	}
+	projectID := active[v.cursor].ID
+	if _, ok := v.taskRows[projectID]; ok {
+		return nil
+	}
+	app := v.state.App
+
+	return func() tea.Msg {
+		rows, _ := buildTaskRows(context.Background(), app, projectID)
+		return dashboardTasksLoadedMsg{projectID: projectID, rows: rows}
 	}
 }
 
@@ -203,25 +269,21 @@ func (v *dashboardView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		}
 		v.data = &msg.data
+		v.taskRows = make(map[string][]taskRow)
 		v.recomputeActive()
-		// Clamp cursor and load detail for the first project.
+		// Clamp cursor and load the task tree for the selected project.
 		active := v.activeProjects()
 		if v.cursor >= len(active) {
 			v.cursor = max(0, len(active)-1)
 		}
-		if len(active) > 0 {
-			v.detailLoading = true
-			return v, v.loadSelectedDetail()
-		}
-		return v, nil
+		return v, v.loadSelectedTasks()
 
-	case dashboardDetailLoadedMsg:
-		v.detailLoading = false
-		if msg.err != nil {
-			v.detailData = nil
-			return v, nil
+	case dashboardTasksLoadedMsg:
+		// A failed load is cached as an empty tree so navigation doesn't
+		// retry on every keypress; 'r' reloads.
+		if v.taskRows != nil {
+			v.taskRows[msg.projectID] = msg.rows
 		}
-		v.detailData = msg.data
 		return v, nil
 
 	case refreshViewMsg:
@@ -231,33 +293,32 @@ func (v *dashboardView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		active := v.activeProjects()
-		switch msg.String() {
-		case "up", "k":
+		keys := v.state.App.keymap()
+		switch {
+		case keys.Matches(msg, KeyUp):
 			if v.cursor > 0 {
 				v.cursor--
-				v.detailLoading = true
-				return v, v.loadSelectedDetail()
+				return v, v.loadSelectedTasks()
 			}
-		case "down", "j":
+		case keys.Matches(msg, KeyDown):
 			if v.cursor < len(active)-1 {
 				v.cursor++
-				v.detailLoading = true
-				return v, v.loadSelectedDetail()
+				return v, v.loadSelectedTasks()
 			}
-		case "enter":
+		case msg.String() == "enter":
 			if v.cursor < len(active) {
 				p := active[v.cursor]
 				v.state.SetActiveProjectFrom(p)
 				v.state.ClearItemContext()
 				return v, pushView(newTaskListView(v.state))
 			}
-		case "p":
+		case keys.Matches(msg, KeyProjects):
 			return v, pushView(newProjectListView(v.state))
-		case "d":
+		case keys.Matches(msg, KeyDraft):
 			return v, pushView(newDraftView(v.state, ""))
-		case "h":
+		case keys.Matches(msg, KeyHelp):
 			return v, pushView(newHelpChatView(v.state))
-		case "r":
+		case keys.Matches(msg, KeyRefresh):
 			v.loading = true
 			v.err = nil
 			return v, v.loadData()
@@ -285,7 +346,7 @@ func (v *dashboardView) View() string {
 		return "\n  " + formatter.Dim("Loading...")
 	}
 	if v.err != nil {
-		return "\n  " + formatter.StyleRed.Render("Error: "+v.err.Error())
+		return "\n  " + shellError(v.err)
 	}
 	if v.data == nil {
 		return ""
@@ -296,12 +357,14 @@ func (v *dashboardView) View() string {
 	// Mode badge
 	if v.data.status != nil {
 		b.WriteString("\n  " + formatter.ModeBadge(v.data.status.Summary.GlobalModeIfNow))
-		b.WriteString("\n\n")
+		b.WriteString("\n")
+		b.WriteString(v.renderTodayLine(time.Now()))
+		b.WriteString("\n")
 	}
 
 	active := v.activeProjects()
 	if len(active) == 0 {
-		b.WriteString("  " + formatter.Dim("No projects yet. Press 'd' to create one."))
+		b.WriteString("  " + formatter.Dim("No projects yet. Press '"+v.state.App.keymap().Key(KeyDraft)+"' to create one."))
 		b.WriteString("\n")
 		return b.String()
 	}
@@ -346,6 +409,38 @@ func (v *dashboardView) View() string {
 	return b.String()
 }
 
+// renderTodayLine renders today's logged time against the daily target, an
+// ETA for reaching the target if work starts now, and the next action.
+func (v *dashboardView) renderTodayLine(now time.Time) string {
+	t := v.data.today
+	parts := []string{formatter.Bold(formatter.FormatMinutes(t.loggedMin)) + formatter.Dim(" logged today")}
+
+	if t.targetMin > 0 {
+		if remaining := t.targetMin - t.loggedMin; remaining > 0 {
+			eta := now.Add(time.Duration(remaining) * time.Minute).Local().Format("15:04")
+			parts = append(parts, formatter.Dim("target ")+formatter.FormatMinutes(t.targetMin)+
+				formatter.Dim(fmt.Sprintf(" (%s to go, ETA %s)", formatter.FormatMinutes(remaining), eta)))
+		} else {
+			parts = append(parts, formatter.StyleGreen.Render("target "+formatter.FormatMinutes(t.targetMin)+" met"))
+		}
+	}
+
+	if t.next != nil {
+		seq := ""
+		if t.next.WorkItemSeq > 0 {
+			seq = fmt.Sprintf("#%d ", t.next.WorkItemSeq)
+		}
+		parts = append(parts, formatter.Dim("next: ")+seq+t.next.Title+
+			formatter.Dim(" ("+formatter.FormatMinutes(t.next.AllocatedMin)+")"))
+	}
+
+	line := "  " + strings.Join(parts, formatter.Dim("  ·  "))
+	if v.state.Width > 0 {
+		line = lipgloss.NewStyle().MaxWidth(v.state.Width).Render(line)
+	}
+	return line + "\n"
+}
+
 // ── left pane: selectable project list ───────────────────────────────────────
 
 func (v *dashboardView) renderLeftPane(projects []*domain.Project) string {
@@ -366,6 +461,26 @@ func (v *dashboardView) renderLeftPane(projects []*domain.Project) string {
 		b.WriteString(row + "\n")
 	}
 
+	b.WriteString(v.renderFocusSection())
+
+	return b.String()
+}
+
+// renderFocusSection lists the pinned focus items below the project list.
+func (v *dashboardView) renderFocusSection() string {
+	if len(v.data.focus) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n" + formatter.StyleHeader.Render("FOCUS") + "\n\n")
+	for _, w := range v.data.focus {
+		seq := ""
+		if w.Seq > 0 {
+			seq = formatter.Dim(fmt.Sprintf("#%d ", w.Seq))
+		}
+		line := formatter.StyleYellow.Render("★ ") + seq + w.Title
+		b.WriteString(lipgloss.NewStyle().MaxWidth(dashLeftPaneWidth).Render(line) + "\n")
+	}
 	return b.String()
 }
 
@@ -396,10 +511,7 @@ func (v *dashboardView) renderProjectRow(
 	shortIDCol := lipgloss.NewStyle().Foreground(formatter.ColorDim).Width(colShortIDW).Render(shortID)
 
 	// Name (15 chars, truncated with ellipsis, bold when selected).
-	name := p.Name
-	if len(name) > colNameW {
-		name = name[:colNameW-1] + "…"
-	}
+	name := formatter.Truncate(p.Name, colNameW)
 	nameStyle := lipgloss.NewStyle().Foreground(formatter.ColorFg).Width(colNameW)
 	if selected {
 		nameStyle = nameStyle.Bold(true)
@@ -436,14 +548,10 @@ func (v *dashboardView) renderProjectRow(
 // ── right pane: project detail ───────────────────────────────────────────────
 
 func (v *dashboardView) renderRightPane(contentHeight, rightWidth int) string {
-	if v.detailLoading {
-		return formatter.Dim("Loading details...")
-	}
-	if v.detailData == nil {
+	d := v.selectedDetail()
+	if d == nil {
 		return formatter.Dim("Select a project to see details.")
 	}
-
-	d := v.detailData
 	var b strings.Builder
 
 	// Project name + status
@@ -467,8 +575,8 @@ func (v *dashboardView) renderRightPane(contentHeight, rightWidth int) string {
 		// Due date
 		if d.statusView.DueDate != nil {
 			b.WriteString(formatter.Dim("Due       "))
-			if parsed, err := time.Parse("2006-01-02", *d.statusView.DueDate); err == nil {
-				b.WriteString(formatter.RelativeDateStyled(parsed))
+			if s, ok := formatter.DeadlineStyled(*d.statusView.DueDate); ok {
+				b.WriteString(s)
 			}
 			if d.statusView.DaysLeft != nil {
 				b.WriteString(formatter.Dim(fmt.Sprintf(" (%dd left)", *d.statusView.DaysLeft)))
@@ -476,6 +584,12 @@ func (v *dashboardView) renderRightPane(contentHeight, rightWidth int) string {
 			b.WriteString("\n")
 		}
 
+		// Next assessment
+		if m := d.statusView.NextMilestone; m != nil {
+			b.WriteString(formatter.Dim("Next      "))
+			b.WriteString(formatter.FormatMilestone(m) + "\n")
+		}
+
 		// Pace
 		if d.statusView.RequiredDailyMin > 0 {
 			b.WriteString(fmt.Sprintf("\n%s %s/day needed\n",
@@ -503,7 +617,7 @@ func (v *dashboardView) renderRightPane(contentHeight, rightWidth int) string {
 	statsLines := strings.Count(b.String(), "\n")
 	availForTasks := contentHeight - statsLines - 4 // header + truncation hint + padding
 	if availForTasks > 0 {
-		b.WriteString(v.renderTaskPreview(availForTasks, rightWidth))
+		b.WriteString(v.renderTaskPreview(d.project.ID, availForTasks, rightWidth))
 	}
 
 	return b.String()
@@ -511,14 +625,18 @@ func (v *dashboardView) renderRightPane(contentHeight, rightWidth int) string {
 
 // ── task tree preview (read-only) ────────────────────────────────────────────
 
-func (v *dashboardView) renderTaskPreview(maxLines, maxWidth int) string {
-	if v.detailData == nil || len(v.detailData.taskRows) == 0 {
+func (v *dashboardView) renderTaskPreview(projectID string, maxLines, maxWidth int) string {
+	rows, loaded := v.taskRows[projectID]
+	if !loaded {
+		return "\n" + formatter.Dim("Loading tasks...") + "\n"
+	}
+	if len(rows) == 0 {
 		return ""
 	}
 
 	// Filter out default node rows (same as taskListView.visibleRows).
 	var visible []taskRow
-	for _, r := range v.detailData.taskRows {
+	for _, r := range rows {
 		if r.isNode && r.isDefault {
 			continue
 		}
@@ -543,7 +661,7 @@ func (v *dashboardView) renderTaskPreview(maxLines, maxWidth int) string {
 	}
 
 	if truncated {
-		total := len(v.detailData.taskRows)
+		total := len(rows)
 		b.WriteString(formatter.Dim(fmt.Sprintf("  ... %d more (enter to view all)", total-maxLines)))
 		b.WriteByte('\n')
 	}
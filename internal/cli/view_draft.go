@@ -2,15 +2,19 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/importer"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -61,6 +65,7 @@ type draftWizardState struct {
 	currentSpecialWI wizardWorkItem
 	wizard           *wizardResult
 	schema           *importer.ImportSchema
+	presets          []*domain.WorkPreset // offered at work item type prompts
 }
 
 // draftView is a dedicated view for the project draft/creation flow.
@@ -76,6 +81,21 @@ type draftView struct {
 	transcript []string
 	// currentPrompt is the prompt for the current phase.
 	currentPrompt string
+
+	// In-flight LLM turn; initCmd starts the first turn from Init when the
+	// view is created with a description.
+	call    llmCall
+	initCmd tea.Cmd
+}
+
+// draftTurnMsg delivers the result of an async project-draft LLM call.
+// starting is set for the opening turn (Start/StartWithDraft), whose failure
+// falls back to the wizard rather than staying in the conversation.
+type draftTurnMsg struct {
+	callID   int
+	starting bool
+	conv     *intelligence.DraftConversation
+	err      error
 }
 
 func newDraftView(state *SharedState, description string) *draftView {
@@ -88,12 +108,20 @@ func newDraftView(state *SharedState, description string) *draftView {
 		state: state,
 		input: ti,
 		draft: &draftWizardState{},
+		call:  newLLMCall(),
+	}
+
+	if saved, _ := loadSavedDraft(state.App.DraftPath); saved != nil {
+		v.transcript = append(v.transcript, formatter.StyleYellow.Render(fmt.Sprintf(
+			"An unfinished draft for %q (last saved: %s) will be replaced. Resume it instead with: draft --resume",
+			saved.Description, formatter.HumanTimestamp(saved.SavedAt))))
 	}
 
 	if description != "" && state.App.ProjectDraft != nil {
 		// LLM conversational flow: start with the description.
+		v.draft.description = description
 		description += "\nStart date: " + time.Now().Format("2006-01-02")
-		v.startLLMConversation(description, nil)
+		v.initCmd = v.startLLMConversation(description, nil)
 	} else if description != "" && state.App.ProjectDraft == nil {
 		// LLM disabled but description provided.
 		v.transcript = append(v.transcript, formatter.StyleRed.Render(
@@ -111,27 +139,81 @@ func (v *draftView) startWizardFlow() {
 	v.transcript = append(v.transcript, formatter.FormatDraftWelcome())
 	v.draft.phase = draftPhaseDescription
 	v.currentPrompt = "  Describe your project:"
+	if v.state.App.Presets != nil {
+		v.draft.presets, _ = v.state.App.Presets.List(context.Background())
+	}
 }
 
-func (v *draftView) startLLMConversation(description string, preDraft *importer.ImportSchema) {
-	ctx := context.Background()
+// workItemTypePrompt asks for a work item type, offering saved presets as
+// an alternative when there are any.
+func (v *draftView) workItemTypePrompt(indent string) string {
+	prompt := indent + "Type [reading/practice/review/assignment/task/quiz/study]"
+	if len(v.draft.presets) > 0 {
+		names := make([]string, len(v.draft.presets))
+		for i, p := range v.draft.presets {
+			names[i] = p.Name
+		}
+		prompt += " or preset [" + strings.Join(names, "/") + "]"
+	}
+	return prompt + ":"
+}
+
+// applyDraftPreset fills wi from the saved preset named input and reports
+// whether one matched.
+func (v *draftView) applyDraftPreset(input string, wi *wizardWorkItem) bool {
+	for _, p := range v.draft.presets {
+		if strings.EqualFold(p.Name, input) {
+			wi.applyPreset(p)
+			return true
+		}
+	}
+	return false
+}
 
-	var conv *intelligence.DraftConversation
-	var err error
+// startLLMConversation kicks off the opening draft turn asynchronously.
+// The result arrives as a draftTurnMsg handled by applyDraftTurn.
+func (v *draftView) startLLMConversation(description string, preDraft *importer.ImportSchema) tea.Cmd {
+	drafts := v.state.App.ProjectDraft
+	v.currentPrompt = ""
+	return v.call.start("Drafting project...", func(ctx context.Context, id int) tea.Msg {
+		var conv *intelligence.DraftConversation
+		var err error
+		if preDraft != nil {
+			conv, err = drafts.StartWithDraft(ctx, description, preDraft)
+		} else {
+			conv, err = drafts.Start(ctx, description)
+		}
+		return draftTurnMsg{callID: id, starting: true, conv: conv, err: err}
+	})
+}
 
-	if preDraft != nil {
-		conv, err = v.state.App.ProjectDraft.StartWithDraft(ctx, description, preDraft)
-	} else {
-		conv, err = v.state.App.ProjectDraft.Start(ctx, description)
+// applyDraftTurn records a completed LLM turn and moves to review once the
+// draft is ready.
+func (v *draftView) applyDraftTurn(msg draftTurnMsg) {
+	if errors.Is(msg.err, llm.ErrLLMUnavailable) {
+		// Keep an ongoing conversation so the user can retry once the
+		// server is back; an opening turn drops to the guided wizard.
+		v.transcript = append(v.transcript, formatter.FormatLLMUnavailable())
+		if msg.starting {
+			v.resumeWithoutLLM()
+		}
+		return
 	}
-	if err != nil {
-		v.transcript = append(v.transcript,
-			formatter.StyleRed.Render(fmt.Sprintf("Failed to start project draft: %v", err)))
-		v.startWizardFlow()
+	if msg.err != nil {
+		if msg.starting {
+			v.transcript = append(v.transcript,
+				formatter.StyleRed.Render(fmt.Sprintf("Failed to start project draft: %v", msg.err)))
+			v.resumeWithoutLLM()
+			return
+		}
+		v.transcript = append(v.transcript, shellError(msg.err))
 		return
 	}
+
+	conv := msg.conv
 	v.draft.conv = conv
 	v.transcript = append(v.transcript, formatter.FormatDraftTurn(conv))
+	defer v.saveDraft()
 
 	if conv.Status == intelligence.DraftStatusReady {
 		v.draft.phase = draftPhaseReview
@@ -143,20 +225,56 @@ func (v *draftView) startLLMConversation(description string, preDraft *importer.
 	}
 }
 
+// cancelLLMTurn handles Esc while an LLM call is pending. A cancelled opening
+// turn falls back to where the user came from; a cancelled follow-up keeps
+// the conversation so the user can rephrase.
+func (v *draftView) cancelLLMTurn() {
+	v.transcript = append(v.transcript, formatter.Dim("Cancelled."))
+	if v.draft.conv == nil {
+		v.resumeWithoutLLM()
+	}
+}
+
+// resumeWithoutLLM returns to the wizard review when the LLM was refining a
+// wizard draft, or starts the wizard from scratch otherwise.
+func (v *draftView) resumeWithoutLLM() {
+	if v.draft.schema != nil {
+		v.draft.phase = draftPhaseWizardReview
+		v.currentPrompt = "[a]ccept  [r]efine with AI  [c]ancel:"
+		return
+	}
+	v.startWizardFlow()
+}
+
 // ── tea.Model interface ──────────────────────────────────────────────────────
 
 func (v *draftView) Init() tea.Cmd {
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, v.initCmd)
 }
 
 func (v *draftView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case draftTurnMsg:
+		if v.call.finish(msg.callID) {
+			v.applyDraftTurn(msg)
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		return v, v.call.tick(msg)
+
 	case tea.KeyMsg:
+		if v.call.pending() {
+			if msg.Type == tea.KeyEsc {
+				v.call.abort()
+				v.cancelLLMTurn()
+			}
+			return v, nil
+		}
+
 		if msg.Type == tea.KeyEsc {
 			v.transcript = append(v.transcript, formatter.Dim("Draft cancelled."))
-			return v, func() tea.Msg {
-				return wizardCompleteMsg{nextCmd: outputCmd(formatter.Dim("Draft cancelled."))}
-			}
+			return v, v.cancel()
 		}
 
 		if msg.Type == tea.KeyEnter {
@@ -184,6 +302,11 @@ func (v *draftView) View() string {
 		b.WriteString("\n")
 	}
 
+	if v.call.pending() {
+		b.WriteString(v.call.View())
+		return b.String()
+	}
+
 	// Show current prompt.
 	if v.currentPrompt != "" {
 		b.WriteString(v.currentPrompt)
@@ -203,6 +326,9 @@ func (v *draftView) View() string {
 func (v *draftView) ID() ViewID   { return ViewDraft }
 func (v *draftView) Title() string { return "Draft" }
 func (v *draftView) ShortHelp() []key.Binding {
+	if v.call.pending() {
+		return []key.Binding{key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel"))}
+	}
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
 		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
@@ -211,11 +337,27 @@ func (v *draftView) ShortHelp() []key.Binding {
 
 // ── input handling ───────────────────────────────────────────────────────────
 
+// cancel leaves the draft view. A draft that got past its description stays
+// saved, and the message says how to pick it up again.
+func (v *draftView) cancel() tea.Cmd {
+	msg := formatter.Dim("Draft cancelled.")
+	if v.state.App.DraftPath != "" && v.draft.description != "" {
+		msg = formatter.Dim("Draft saved. Resume it with: draft --resume (or drop it: draft --discard)")
+	}
+	return func() tea.Msg {
+		return wizardCompleteMsg{nextCmd: outputCmd(msg)}
+	}
+}
+
 func (v *draftView) handleInput(input string) (tea.Model, tea.Cmd) {
 	lower := strings.ToLower(strings.TrimSpace(input))
 	if lower == "/quit" || lower == "/cancel" || lower == "/q" {
+		return v, v.cancel()
+	}
+	if lower == "/discard" {
+		clearSavedDraft(v.state.App.DraftPath)
 		return v, func() tea.Msg {
-			return wizardCompleteMsg{nextCmd: outputCmd(formatter.Dim("Draft cancelled."))}
+			return wizardCompleteMsg{nextCmd: outputCmd(formatter.Dim("Draft discarded."))}
 		}
 	}
 
@@ -231,11 +373,12 @@ func (v *draftView) handleInput(input string) (tea.Model, tea.Cmd) {
 	case v.draft.phase == draftPhaseWizardReview:
 		return v.handleWizardReview(input)
 	case v.draft.phase == draftPhaseConversation:
-		v.handleConversation(input)
+		return v, v.handleConversation(input)
 	case v.draft.phase == draftPhaseReview:
 		return v.handleLLMReview(input)
 	}
 
+	v.saveDraft()
 	return v, nil
 }
 
@@ -366,9 +509,13 @@ func (v *draftView) handleWorkItem(input string) {
 		}
 		v.draft.currentWI = wizardWorkItem{Title: input, Type: "task"}
 		v.draft.phase = draftPhaseWorkItemType
-		v.currentPrompt = "    Type [reading/practice/review/assignment/task/quiz/study]:"
+		v.currentPrompt = v.workItemTypePrompt("    ")
 
 	case draftPhaseWorkItemType:
+		if v.applyDraftPreset(input, &v.draft.currentWI) && v.draft.currentWI.PlannedMin > 0 {
+			v.addDraftWorkItem()
+			return
+		}
 		if input != "" {
 			t := strings.ToLower(input)
 			if validWorkItemTypes[t] {
@@ -387,15 +534,21 @@ func (v *draftView) handleWorkItem(input string) {
 			}
 		}
 		v.draft.currentWI.PlannedMin = mins
-		v.draft.workItems = append(v.draft.workItems, v.draft.currentWI)
-		v.transcript = append(v.transcript,
-			formatter.Dim(fmt.Sprintf("  + %s (%s, %dm)",
-				v.draft.currentWI.Title, v.draft.currentWI.Type, v.draft.currentWI.PlannedMin)))
-		v.draft.phase = draftPhaseWorkItemTitle
-		v.currentPrompt = "  Title (Enter when done):"
+		v.addDraftWorkItem()
 	}
 }
 
+// addDraftWorkItem appends the finished current work item and returns to
+// the title prompt.
+func (v *draftView) addDraftWorkItem() {
+	v.draft.workItems = append(v.draft.workItems, v.draft.currentWI)
+	v.transcript = append(v.transcript,
+		formatter.Dim(fmt.Sprintf("  + %s (%s, %dm)",
+			v.draft.currentWI.Title, v.draft.currentWI.Type, v.draft.currentWI.PlannedMin)))
+	v.draft.phase = draftPhaseWorkItemTitle
+	v.currentPrompt = "  Title (Enter when done):"
+}
+
 func (v *draftView) handleSpecialNode(input string) {
 	switch v.draft.phase {
 	case draftPhaseSpecialTitle:
@@ -437,9 +590,13 @@ func (v *draftView) handleSpecialNode(input string) {
 		}
 		v.draft.currentSpecialWI = wizardWorkItem{Title: input, Type: "task"}
 		v.draft.phase = draftPhaseSpecialWIType
-		v.currentPrompt = "      Type [reading/practice/review/assignment/task/quiz/study]:"
+		v.currentPrompt = v.workItemTypePrompt("      ")
 
 	case draftPhaseSpecialWIType:
+		if v.applyDraftPreset(input, &v.draft.currentSpecialWI) && v.draft.currentSpecialWI.PlannedMin > 0 {
+			v.addDraftSpecialWorkItem()
+			return
+		}
 		if input != "" {
 			t := strings.ToLower(input)
 			if validWorkItemTypes[t] {
@@ -458,12 +615,18 @@ func (v *draftView) handleSpecialNode(input string) {
 			}
 		}
 		v.draft.currentSpecialWI.PlannedMin = mins
-		v.draft.currentSpecial.WorkItems = append(v.draft.currentSpecial.WorkItems, v.draft.currentSpecialWI)
-		v.draft.phase = draftPhaseSpecialWITitle
-		v.currentPrompt = "    Work item title (Enter when done):"
+		v.addDraftSpecialWorkItem()
 	}
 }
 
+// addDraftSpecialWorkItem appends the finished work item to the current
+// special node and returns to its work item title prompt.
+func (v *draftView) addDraftSpecialWorkItem() {
+	v.draft.currentSpecial.WorkItems = append(v.draft.currentSpecial.WorkItems, v.draft.currentSpecialWI)
+	v.draft.phase = draftPhaseSpecialWITitle
+	v.currentPrompt = "    Work item title (Enter when done):"
+}
+
 func (v *draftView) buildAndShowWizardDraft() {
 	wizard := &wizardResult{
 		Description:  v.draft.description,
@@ -495,17 +658,14 @@ func (v *draftView) handleWizardReview(input string) (tea.Model, tea.Cmd) {
 	case "a", "accept":
 		return v.acceptWizardSchema()
 	case "c", "cancel":
-		return v, func() tea.Msg {
-			return wizardCompleteMsg{nextCmd: outputCmd(formatter.Dim("Draft cancelled."))}
-		}
+		return v, v.cancel()
 	case "r", "refine":
 		if v.state.App.ProjectDraft == nil {
 			v.currentPrompt = "LLM features are disabled. Accept the draft or cancel."
 			return v, nil
 		}
 		desc := buildLLMDescription(v.draft.wizard)
-		v.startLLMConversation(desc, v.draft.schema)
-		return v, nil
+		return v, v.startLLMConversation(desc, v.draft.schema)
 	default:
 		v.currentPrompt = "Invalid option. [a]ccept  [c]ancel:"
 		return v, nil
@@ -528,6 +688,7 @@ func (v *draftView) acceptWizardSchema() (tea.Model, tea.Cmd) {
 		return v, nil
 	}
 
+	clearSavedDraft(v.state.App.DraftPath)
 	msg := formatter.FormatDraftAccepted(result)
 	return v, func() tea.Msg {
 		return wizardCompleteMsg{nextCmd: outputCmd(msg)}
@@ -536,43 +697,37 @@ func (v *draftView) acceptWizardSchema() (tea.Model, tea.Cmd) {
 
 // ── LLM conversation handlers ───────────────────────────────────────────────
 
-func (v *draftView) handleConversation(input string) {
+func (v *draftView) handleConversation(input string) tea.Cmd {
 	if input == "" {
-		return
+		return nil
 	}
 
 	lower := strings.ToLower(input)
 	switch lower {
 	case "/show", "/draft":
 		v.transcript = append(v.transcript, formatter.FormatDraftPreview(v.draft.conv))
-		return
+		return nil
 	case "/accept":
 		if v.draft.conv.Draft != nil {
 			// Will be handled by handleLLMReview.
 			v.draft.phase = draftPhaseReview
 			v.currentPrompt = "[a]ccept  [e]dit  [c]ancel:"
-			return
+			return nil
 		}
 		v.transcript = append(v.transcript, "No draft to accept yet.")
-		return
+		return nil
 	}
 
 	v.transcript = append(v.transcript, formatter.Dim("You: ")+input)
 
-	ctx := context.Background()
-	conv, err := v.state.App.ProjectDraft.NextTurn(ctx, v.draft.conv, input)
-	if err != nil {
-		v.transcript = append(v.transcript, shellError(err))
-		return
-	}
-	v.draft.conv = conv
-	v.transcript = append(v.transcript, formatter.FormatDraftTurn(conv))
-
-	if conv.Status == intelligence.DraftStatusReady {
-		v.draft.phase = draftPhaseReview
-		v.transcript = append(v.transcript, formatter.FormatDraftReview(conv))
-		v.currentPrompt = "[a]ccept  [e]dit  [c]ancel:"
-	}
+	// NextTurn returns a new conversation and leaves conv untouched, so a
+	// cancelled turn needs no rollback.
+	drafts := v.state.App.ProjectDraft
+	conv := v.draft.conv
+	return v.call.start("Thinking...", func(ctx context.Context, id int) tea.Msg {
+		next, err := drafts.NextTurn(ctx, conv, input)
+		return draftTurnMsg{callID: id, conv: next, err: err}
+	})
 }
 
 func (v *draftView) handleLLMReview(input string) (tea.Model, tea.Cmd) {
@@ -580,9 +735,7 @@ func (v *draftView) handleLLMReview(input string) (tea.Model, tea.Cmd) {
 	case "a", "accept":
 		return v.acceptLLMDraft()
 	case "c", "cancel":
-		return v, func() tea.Msg {
-			return wizardCompleteMsg{nextCmd: outputCmd(formatter.Dim("Draft cancelled."))}
-		}
+		return v, v.cancel()
 	case "e", "edit":
 		v.draft.conv.Status = intelligence.DraftStatusGathering
 		v.draft.phase = draftPhaseConversation
@@ -593,8 +746,7 @@ func (v *draftView) handleLLMReview(input string) (tea.Model, tea.Cmd) {
 		// Treat as a refinement message.
 		v.draft.conv.Status = intelligence.DraftStatusGathering
 		v.draft.phase = draftPhaseConversation
-		v.handleConversation(input)
-		return v, nil
+		return v, v.handleConversation(input)
 	}
 }
 
@@ -617,6 +769,7 @@ func (v *draftView) acceptLLMDraft() (tea.Model, tea.Cmd) {
 		return v, nil
 	}
 
+	clearSavedDraft(v.state.App.DraftPath)
 	msg := formatter.FormatDraftAccepted(result)
 	return v, func() tea.Msg {
 		return wizardCompleteMsg{nextCmd: outputCmd(msg)}
@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/intelligence"
+	"github.com/alexanderramin/kairos/internal/llm"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -22,9 +25,16 @@ type helpChatView struct {
 	conv     *intelligence.HelpConversation
 	messages []string
 
-	// Pre-computed help context.
+	// In-flight LLM answer; initCmd carries a one-shot question asked at
+	// construction so it runs from Init rather than blocking the caller.
+	call    llmCall
+	initCmd tea.Cmd
+
+	// Pre-computed help context; hctx is the view and active project/item
+	// the chat was opened from.
 	specJSON string
 	cmdInfos []intelligence.HelpCommandInfo
+	hctx     *intelligence.HelpContext
 }
 
 func newHelpChatView(state *SharedState) *helpChatView {
@@ -43,6 +53,8 @@ func newHelpChatView(state *SharedState) *helpChatView {
 		input:    ti,
 		specJSON: specJSON,
 		cmdInfos: cmdInfos,
+		hctx:     buildHelpContext(state),
+		call:     newLLMCall(),
 	}
 
 	v.messages = append(v.messages, formatter.FormatHelpChatWelcome())
@@ -50,36 +62,66 @@ func newHelpChatView(state *SharedState) *helpChatView {
 	return v
 }
 
-// newHelpChatViewWithQuestion creates a help chat view and immediately
-// answers a one-shot question, then displays the chat interface.
+// newHelpChatViewWithQuestion creates a help chat view that answers a
+// one-shot question as soon as it is shown, then displays the chat interface.
 func newHelpChatViewWithQuestion(state *SharedState, question string) *helpChatView {
 	v := newHelpChatView(state)
-
-	answer := resolveHelpAnswer(state.App, question, v.specJSON, v.cmdInfos)
-	v.messages = append(v.messages,
-		formatter.Dim("You: ")+question,
-		formatter.FormatHelpAnswer(answer),
-	)
-
+	v.messages = append(v.messages, formatter.Dim("You: ")+question)
+	v.initCmd = v.ask(question)
 	return v
 }
 
+// helpAnswerMsg delivers the result of an async help question.
+type helpAnswerMsg struct {
+	callID   int
+	question string
+	conv     *intelligence.HelpConversation
+	answer   *intelligence.HelpAnswer
+	err      error
+}
+
 // ── tea.Model interface ──────────────────────────────────────────────────────
 
 func (v *helpChatView) Init() tea.Cmd {
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, v.initCmd)
 }
 
 func (v *helpChatView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case helpAnswerMsg:
+		if !v.call.finish(msg.callID) {
+			return v, nil // cancelled or superseded
+		}
+		answer := msg.answer
+		if errors.Is(msg.err, llm.ErrLLMUnavailable) {
+			v.messages = append(v.messages, formatter.FormatLLMUnavailable())
+		}
+		if msg.err != nil || answer == nil {
+			answer = intelligence.DeterministicHelp(msg.question, v.cmdInfos, v.hctx)
+		} else {
+			v.conv = msg.conv
+		}
+		v.messages = append(v.messages, formatter.FormatHelpAnswer(answer))
+		return v, nil
+
+	case spinner.TickMsg:
+		return v, v.call.tick(msg)
+
 	case tea.KeyMsg:
 		if msg.Type == tea.KeyEsc {
+			if v.call.abort() {
+				v.messages = append(v.messages, formatter.Dim("Cancelled."))
+				return v, nil
+			}
 			return v, func() tea.Msg {
 				return wizardCompleteMsg{nextCmd: nil}
 			}
 		}
 
 		if msg.Type == tea.KeyEnter {
+			if v.call.pending() {
+				return v, nil
+			}
 			input := strings.TrimSpace(v.input.Value())
 			v.input.Reset()
 			if input == "" {
@@ -106,6 +148,11 @@ func (v *helpChatView) View() string {
 		b.WriteString("\n")
 	}
 
+	if v.call.pending() {
+		b.WriteString(v.call.View())
+		return b.String()
+	}
+
 	prompt := formatter.StylePurple.Render("help") + formatter.Dim("> ")
 	b.WriteString(prompt)
 	b.WriteString(v.input.View())
@@ -118,6 +165,9 @@ func (v *helpChatView) View() string {
 func (v *helpChatView) ID() ViewID   { return ViewHelpChat }
 func (v *helpChatView) Title() string { return "Help" }
 func (v *helpChatView) ShortHelp() []key.Binding {
+	if v.call.pending() {
+		return []key.Binding{key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel"))}
+	}
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "ask")),
 		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
@@ -139,42 +189,38 @@ func (v *helpChatView) handleInput(input string) (tea.Model, tea.Cmd) {
 	}
 
 	v.messages = append(v.messages, formatter.Dim("You: ")+input)
-
-	if v.state.App.Help != nil {
-		var answer *intelligence.HelpAnswer
-		var err error
-		if v.conv == nil {
-			v.conv, answer, err = v.state.App.Help.StartChat(context.Background(), input, v.specJSON)
-		} else {
-			answer, err = v.state.App.Help.NextTurn(context.Background(), v.conv, input)
-		}
-		if err != nil {
-			answer = intelligence.DeterministicHelp(input, v.cmdInfos)
-		}
-		v.messages = append(v.messages, formatter.FormatHelpAnswer(answer))
-	} else {
-		answer := intelligence.DeterministicHelp(input, v.cmdInfos)
-		v.messages = append(v.messages, formatter.FormatHelpAnswer(answer))
-	}
-
-	return v, nil
+	return v, v.ask(input)
 }
 
-// resolveHelpAnswer gets a help answer using LLM with fallback to deterministic.
-func resolveHelpAnswer(app *App, question, specJSON string, cmdInfos []intelligence.HelpCommandInfo) *intelligence.HelpAnswer {
-	if app.Help == nil {
-		return intelligence.DeterministicHelp(question, cmdInfos)
+// ask answers question deterministically when the LLM is disabled, or
+// starts an async LLM turn. NextTurn appends to the conversation it is
+// given, so the LLM works on a copy that only replaces v.conv on success;
+// a cancelled turn leaves the history untouched.
+func (v *helpChatView) ask(question string) tea.Cmd {
+	if v.state.App.Help == nil {
+		answer := intelligence.DeterministicHelp(question, v.cmdInfos, v.hctx)
+		v.messages = append(v.messages, formatter.FormatHelpAnswer(answer))
+		return nil
 	}
 
-	ctx := context.Background()
-	stopSpinner := formatter.StartSpinner("Thinking...")
-	answer, err := app.Help.Ask(ctx, question, specJSON)
-	stopSpinner()
-	if err != nil {
-		return intelligence.DeterministicHelp(question, cmdInfos)
+	help := v.state.App.Help
+	specJSON := v.specJSON
+	hctx := v.hctx
+	var conv *intelligence.HelpConversation
+	if v.conv != nil {
+		clone := *v.conv
+		clone.Turns = append([]intelligence.ConversationTurn(nil), v.conv.Turns...)
+		conv = &clone
 	}
 
-	return answer
+	return v.call.start("Thinking...", func(ctx context.Context, id int) tea.Msg {
+		if conv == nil {
+			started, answer, err := help.StartChat(ctx, question, specJSON, hctx)
+			return helpAnswerMsg{callID: id, question: question, conv: started, answer: answer, err: err}
+		}
+		answer, err := help.NextTurn(ctx, conv, question)
+		return helpAnswerMsg{callID: id, question: question, conv: conv, answer: answer, err: err}
+	})
 }
 
 // buildHelpCommandInfos converts CommandSpec entries into HelpCommandInfo
@@ -182,10 +228,22 @@ func resolveHelpAnswer(app *App, question, specJSON string, cmdInfos []intellige
 func buildHelpCommandInfos(spec *CommandSpec) []intelligence.HelpCommandInfo {
 	infos := make([]intelligence.HelpCommandInfo, len(spec.Commands))
 	for i, cmd := range spec.Commands {
-		infos[i] = intelligence.HelpCommandInfo{
+		info := intelligence.HelpCommandInfo{
 			FullPath: cmd.FullPath,
 			Short:    cmd.Short,
 		}
+		for _, f := range cmd.Flags {
+			info.Flags = append(info.Flags, intelligence.HelpFlagInfo{
+				Name:        f.Name,
+				Type:        f.Type,
+				Description: f.Description,
+				Required:    f.Required,
+			})
+		}
+		if cmd.Examples != "" {
+			info.Examples = strings.Split(cmd.Examples, "\n")
+		}
+		infos[i] = info
 	}
 	return infos
 }
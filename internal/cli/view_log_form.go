@@ -163,7 +163,7 @@ func applyEditWorkItem(app *App, itemID string, f *editWorkItemFields) tea.Msg {
 
 	if f.dueDate == "" {
 		current.DueDate = nil
-	} else if t, err := time.Parse("2006-01-02", f.dueDate); err == nil {
+	} else if t, err := domain.ParseDeadline(f.dueDate); err == nil {
 		current.DueDate = &t
 	}
 
@@ -213,7 +213,7 @@ func newEditWorkItemView(state *SharedState, itemID, title string) View {
 		f.itemType = "task"
 	}
 	if item.DueDate != nil {
-		f.dueDate = item.DueDate.Format("2006-01-02")
+		f.dueDate = domain.FormatDeadline(*item.DueDate)
 	}
 	if item.NotBefore != nil {
 		f.notBefore = item.NotBefore.Format("2006-01-02")
@@ -265,7 +265,7 @@ func newEditWorkItemView(state *SharedState, itemID, title string) View {
 				Value(&f.itemType),
 		),
 		huh.NewGroup(
-			dateInput("Due Date (YYYY-MM-DD, blank to clear)", "", &f.dueDate),
+			deadlineInput("Due Date (YYYY-MM-DD [HH:MM], blank to clear)", "", &f.dueDate),
 			dateInput("Not Before (YYYY-MM-DD, blank to clear)", "2025-01-15", &f.notBefore),
 			huh.NewInput().
 				Title("Min Session Minutes (blank for default)").
@@ -342,6 +342,7 @@ func newAddWorkItemView(state *SharedState, nodeID string) View {
 			Title:      newTitle,
 			Type:       itemType,
 			PlannedMin: dur,
+			Splittable: true,
 		}
 		if dueDate != "" {
 			if t, err := time.Parse("2006-01-02", dueDate); err == nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +46,29 @@ func TestApplyEditWorkItem_ReturnsOutputMessageAndUpdatesItem(t *testing.T) {
 	}
 }
 
+func TestApplyEditWorkItem_AcceptsDeadlineTimeOfDay(t *testing.T) {
+	app := testApp(t)
+	ctx := context.Background()
+	_, wiID := seedProjectWithWork(t, app)
+
+	fields := &editWorkItemFields{
+		title:      "Renamed",
+		plannedMin: "60",
+		itemType:   "task",
+		dueDate:    "2026-03-15 17:30",
+	}
+	msg := applyEditWorkItem(app, wiID, fields)
+	_, ok := msg.(cmdOutputMsg)
+	require.True(t, ok, "expected cmdOutputMsg, got %T", msg)
+
+	updated, err := app.WorkItems.GetByID(ctx, wiID)
+	require.NoError(t, err)
+	if assert.NotNil(t, updated.DueDate) {
+		// The form prefills the same text on the next edit.
+		assert.Equal(t, "2026-03-15 17:30", domain.FormatDeadline(*updated.DueDate))
+	}
+}
+
 func TestApplyEditWorkItem_ErrorReturnsOutputMessage(t *testing.T) {
 	app := testApp(t)
 	fields := &editWorkItemFields{
@@ -58,4 +82,3 @@ func TestApplyEditWorkItem_ErrorReturnsOutputMessage(t *testing.T) {
 	require.True(t, ok, "expected cmdOutputMsg, got %T", msg)
 	assert.Contains(t, out.output, "Error:")
 }
-
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sampleProjectShortID is the short ID given to a project created from the
+// onboarding view's template picker.
+const sampleProjectShortID = "SAMPLE"
+
+// templatesLoadedMsg signals that the onboarding template list has loaded.
+type templatesLoadedMsg struct {
+	templates []domain.Template
+	err       error
+}
+
+// onboardingView greets a first run with no projects. It offers a sample
+// project from a template or a draft, and esc dismisses it to the
+// dashboard. RunShell only pushes it when the database has no projects, so
+// it never comes back once one exists.
+type onboardingView struct {
+	state     *SharedState
+	templates []domain.Template
+	picking   bool // choosing a template rather than a start option
+	cursor    int
+	err       error
+}
+
+func newOnboardingView(state *SharedState) *onboardingView {
+	return &onboardingView{state: state}
+}
+
+// needsOnboarding reports whether the database has no projects at all,
+// archived ones included.
+func needsOnboarding(app *App) bool {
+	if app == nil || app.Projects == nil {
+		return false
+	}
+	projects, err := app.Projects.List(context.Background(), true)
+	return err == nil && len(projects) == 0
+}
+
+func (v *onboardingView) ID() ViewID    { return ViewOnboarding }
+func (v *onboardingView) Title() string { return "Welcome" }
+
+func (v *onboardingView) ShortHelp() []key.Binding {
+	keys := v.state.App.keymap()
+	if v.picking {
+		return []key.Binding{
+			key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "create")),
+			key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "skip")),
+		}
+	}
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sample project")),
+		keys.Binding(KeyDraft, "draft"),
+		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "skip")),
+	}
+}
+
+func (v *onboardingView) Init() tea.Cmd {
+	app := v.state.App
+	if app.Templates == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		templates, err := app.Templates.List(context.Background())
+		return templatesLoadedMsg{templates: templates, err: err}
+	}
+}
+
+func (v *onboardingView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case templatesLoadedMsg:
+		v.templates = msg.templates
+		if msg.err != nil {
+			v.err = msg.err
+		}
+		return v, nil
+
+	case tea.KeyMsg:
+		keys := v.state.App.keymap()
+		if v.picking {
+			switch {
+			case keys.Matches(msg, KeyUp):
+				if v.cursor > 0 {
+					v.cursor--
+				}
+			case keys.Matches(msg, KeyDown):
+				if v.cursor < len(v.templates)-1 {
+					v.cursor++
+				}
+			case msg.String() == "enter":
+				if v.cursor < len(v.templates) {
+					return v, v.createSample(v.templates[v.cursor])
+				}
+			}
+			return v, nil
+		}
+		switch {
+		case msg.String() == "s":
+			if len(v.templates) > 0 {
+				v.picking = true
+				v.cursor = 0
+			}
+		case keys.Matches(msg, KeyDraft):
+			return v, replaceView(newDraftView(v.state, ""))
+		}
+	}
+	return v, nil
+}
+
+// createSample initializes a project from tmpl starting today, due in three
+// months, then returns to the dashboard with it active.
+func (v *onboardingView) createSample(tmpl domain.Template) tea.Cmd {
+	ctx := context.Background()
+	initProject := v.state.App.initProjectUseCase()
+	if initProject == nil {
+		v.err = fmt.Errorf("templates are not configured")
+		return nil
+	}
+	today := time.Now()
+	due := today.AddDate(0, 3, 0).Format("2006-01-02")
+	p, err := initProject.InitProject(ctx, tmpl.ID, "Sample: "+tmpl.Name, sampleProjectShortID,
+		today.Format("2006-01-02"), &due, nil)
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	v.state.SetActiveProjectFrom(p)
+	out := fmt.Sprintf("%s Created sample project %s [%s]. Try 'what-now', or 'project remove %s' when you're done with it.",
+		formatter.StyleGreen.Render("✔"), p.Name, p.ShortID, p.ShortID)
+	return func() tea.Msg { return wizardCompleteMsg{nextCmd: outputCmd(out)} }
+}
+
+func (v *onboardingView) View() string {
+	var b strings.Builder
+	b.WriteString("\n")
+	b.WriteString("  " + formatter.StyleHeader.Render("WELCOME TO KAIROS") + "\n")
+	b.WriteString("  " + formatter.Dim("You don't have any projects yet. Pick a way to start:") + "\n\n")
+
+	if v.picking {
+		b.WriteString("  " + formatter.Bold("Choose a template for the sample project") + "\n\n")
+		for i, t := range v.templates {
+			cursor := "  "
+			if i == v.cursor {
+				cursor = formatter.StyleGreen.Render("▸ ")
+			}
+			b.WriteString(fmt.Sprintf("  %s%s  %s\n", cursor, formatter.StyleFg.Render(t.Name), formatter.Dim(t.Domain)))
+		}
+	} else {
+		sample := "Create a sample project from a template"
+		if len(v.templates) == 0 {
+			sample = formatter.Dim(sample + " (no templates found)")
+		}
+		b.WriteString(fmt.Sprintf("  %s  %s\n", formatter.Dim("[s]"), sample))
+		b.WriteString(fmt.Sprintf("  %s  %s\n", formatter.Dim("["+v.state.App.keymap().Key(KeyDraft)+"]"),
+			"Describe your own project and draft a plan"))
+		b.WriteString(fmt.Sprintf("  %s  %s\n", formatter.Dim("[esc]"), "Skip to the dashboard"))
+		b.WriteString("\n  " + formatter.Dim("You can also press '"+v.state.App.keymap().Key(KeyCommand)+
+			"' and run 'project add' or 'project init'.") + "\n")
+	}
+
+	if v.err != nil {
+		b.WriteString("\n  " + shellError(v.err) + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paletteRunMsg asks the app to close the palette and run a shell command
+// through the command bar, as if it had been typed there.
+type paletteRunMsg struct {
+	input string
+}
+
+// paletteAction is a common command offered by the quick-jump palette.
+type paletteAction struct {
+	command string
+	desc    string
+}
+
+// paletteActions are the actions listed below the projects in the palette.
+var paletteActions = []paletteAction{
+	{"what-now", "Recommend what to work on now"},
+	{"today", "Goal, time logged, plan and what's due today"},
+	{"status", "Progress and risk across projects"},
+	{"timeline", "Upcoming deadlines by date"},
+	{"weekly plan", "Spread remaining work over the next 7 days"},
+	{"plan status", "Today's saved plan against logged time"},
+	{"review weekly", "Last week's time, completions and risk changes"},
+	{"session list", "Sessions logged in the last 7 days"},
+	{"focus", "Items pinned to rank first"},
+	{"projects", "All projects as a table"},
+	{"draft", "Draft a new project"},
+	{"help", "Command reference"},
+}
+
+// paletteEntry is one selectable row: a project or an action.
+type paletteEntry struct {
+	project *domain.Project // nil for actions
+	action  paletteAction
+	search  string // lowercased text the query is matched against
+}
+
+// paletteView is the quick-jump overlay (ctrl+p): projects from the shell's
+// project cache and common actions, filtered as you type. Enter on a project
+// makes it the active project and replaces the palette with its task list;
+// enter on an action pops the palette and runs the command. esc pops it, so
+// the stack underneath is left as it was.
+type paletteView struct {
+	state   *SharedState
+	entries []paletteEntry
+	query   string
+	cursor  int
+}
+
+func newPaletteView(state *SharedState) *paletteView {
+	v := &paletteView{state: state}
+	for _, p := range state.Cache.get(state.App) {
+		v.entries = append(v.entries, paletteEntry{
+			project: p,
+			search:  strings.ToLower(p.DisplayID() + " " + p.Name + " " + p.Domain),
+		})
+	}
+	for _, a := range paletteActions {
+		v.entries = append(v.entries, paletteEntry{
+			action: a,
+			search: strings.ToLower(a.command + " " + a.desc),
+		})
+	}
+	return v
+}
+
+func (v *paletteView) ID() ViewID    { return ViewPalette }
+func (v *paletteView) Title() string { return "Jump to" }
+
+func (v *paletteView) ShortHelp() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open/run")),
+		key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑↓", "move")),
+		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "close")),
+	}
+}
+
+func (v *paletteView) Init() tea.Cmd { return nil }
+
+// visible returns the entries matching the query: every entry when it is
+// empty, else those containing any query term, most terms first and
+// otherwise in list order (projects before actions).
+func (v *paletteView) visible() []paletteEntry {
+	terms := strings.Fields(strings.ToLower(v.query))
+	if len(terms) == 0 {
+		return v.entries
+	}
+	type scored struct {
+		entry paletteEntry
+		hits  int
+	}
+	var matches []scored
+	for _, e := range v.entries {
+		if hits := termHits(e.search, terms); hits > 0 {
+			matches = append(matches, scored{entry: e, hits: hits})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].hits > matches[j].hits })
+	out := make([]paletteEntry, len(matches))
+	for i, m := range matches {
+		out[i] = m.entry
+	}
+	return out
+}
+
+func (v *paletteView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return v, nil
+	}
+	visible := v.visible()
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		return v, popView()
+	case tea.KeyEnter:
+		if v.cursor >= len(visible) {
+			return v, nil
+		}
+		return v, v.choose(visible[v.cursor])
+	case tea.KeyUp, tea.KeyCtrlP:
+		if v.cursor > 0 {
+			v.cursor--
+		}
+	case tea.KeyDown, tea.KeyCtrlN:
+		if v.cursor < len(visible)-1 {
+			v.cursor++
+		}
+	case tea.KeyBackspace:
+		if r := []rune(v.query); len(r) > 0 {
+			v.query = string(r[:len(r)-1])
+			v.cursor = 0
+		}
+	case tea.KeyRunes:
+		v.query += string(keyMsg.Runes)
+		v.cursor = 0
+	case tea.KeySpace:
+		v.query += " "
+		v.cursor = 0
+	}
+	return v, nil
+}
+
+// choose opens a project's task list in place of the palette, or closes the
+// palette and runs an action.
+func (v *paletteView) choose(e paletteEntry) tea.Cmd {
+	if e.project != nil {
+		v.state.SetActiveProjectFrom(e.project)
+		v.state.ClearItemContext()
+		return replaceView(newTaskListView(v.state))
+	}
+	input := e.action.command
+	return func() tea.Msg { return paletteRunMsg{input: input} }
+}
+
+func (v *paletteView) View() string {
+	var b strings.Builder
+	b.WriteString("\n  " + formatter.StyleYellow.Render("›") + " " + v.query + "█\n\n")
+
+	visible := v.visible()
+	if len(visible) == 0 {
+		b.WriteString("  " + formatter.Dim("No matching projects or actions.") + "\n")
+		return b.String()
+	}
+
+	// Keep the cursor row on screen below the query line.
+	limit := max(v.state.ContentHeight()-3, 1)
+	start := 0
+	if v.cursor >= limit {
+		start = v.cursor - limit + 1
+	}
+	for i := start; i < len(visible) && i < start+limit; i++ {
+		e := visible[i]
+		cursor := "  "
+		style := formatter.StyleFg
+		if i == v.cursor {
+			cursor = formatter.StyleGreen.Render("▸ ")
+			style = formatter.StyleBold
+		}
+		if e.project != nil {
+			b.WriteString(fmt.Sprintf("%s%-7s %s  %s\n", cursor,
+				formatter.StyleGreen.Render(e.project.DisplayID()),
+				style.Render(padRight(e.project.Name, 28)), formatter.Dim("project")))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%s%-7s %s  %s\n", cursor,
+			formatter.StyleBlue.Render(":"),
+			style.Render(padRight(e.action.command, 28)), formatter.Dim(e.action.desc)))
+	}
+	return b.String()
+}
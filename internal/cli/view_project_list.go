@@ -9,6 +9,7 @@ import (
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // projectsLoadedMsg signals that project list data has been loaded.
@@ -43,7 +44,7 @@ func (v *projectListView) Title() string { return "Projects" }
 func (v *projectListView) ShortHelp() []key.Binding {
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
-		key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		v.state.App.keymap().Binding(KeyFilter, "filter"),
 		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
 	}
 }
@@ -83,24 +84,25 @@ func (v *projectListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (v *projectListView) updateNormal(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	visible := v.visibleProjects()
+	keys := v.state.App.keymap()
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case keys.Matches(msg, KeyUp):
 		if v.cursor > 0 {
 			v.cursor--
 		}
-	case "down", "j":
+	case keys.Matches(msg, KeyDown):
 		if v.cursor < len(visible)-1 {
 			v.cursor++
 		}
-	case "enter":
+	case msg.String() == "enter":
 		if v.cursor < len(visible) {
 			p := visible[v.cursor]
 			v.state.SetActiveProjectFrom(p)
 			v.state.ClearItemContext()
 			return v, pushView(newTaskListView(v.state))
 		}
-	case "/":
+	case keys.Matches(msg, KeyFilter):
 		v.filtering = true
 		v.filter = ""
 	}
@@ -151,7 +153,7 @@ func (v *projectListView) View() string {
 		return "\n  " + formatter.Dim("Loading projects...")
 	}
 	if v.err != nil {
-		return "\n  " + formatter.StyleRed.Render("Error: "+v.err.Error())
+		return "\n  " + shellError(v.err)
 	}
 
 	visible := v.visibleProjects()
@@ -201,8 +203,6 @@ func (v *projectListView) View() string {
 
 // padRight pads a string to a minimum width, truncating if needed.
 func padRight(s string, width int) string {
-	if len(s) > width {
-		return s[:width-1] + "…"
-	}
-	return s + strings.Repeat(" ", width-len(s))
+	s = formatter.Truncate(s, width)
+	return s + strings.Repeat(" ", width-lipgloss.Width(s))
 }
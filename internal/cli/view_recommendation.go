@@ -43,7 +43,7 @@ func (v *recommendationView) Title() string {
 func (v *recommendationView) ShortHelp() []key.Binding {
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "actions")),
-		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		v.state.App.keymap().Binding(KeyRefresh, "refresh"),
 		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
 	}
 }
@@ -80,21 +80,22 @@ func (v *recommendationView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return v, v.loadRecommendations()
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		keys := v.state.App.keymap()
+		switch {
+		case keys.Matches(msg, KeyUp):
 			if v.cursor > 0 {
 				v.cursor--
 			}
-		case "down", "j":
+		case keys.Matches(msg, KeyDown):
 			if v.cursor < v.recCount()-1 {
 				v.cursor++
 			}
-		case "enter":
+		case msg.String() == "enter":
 			if v.resp != nil && v.cursor < len(v.resp.Recommendations) {
 				rec := v.resp.Recommendations[v.cursor]
 				return v, pushView(newActionMenuView(v.state, rec.WorkItemID, rec.Title, rec.WorkItemSeq))
 			}
-		case "r":
+		case keys.Matches(msg, KeyRefresh):
 			v.loading = true
 			v.err = nil
 			return v, v.loadRecommendations()
@@ -115,7 +116,7 @@ func (v *recommendationView) View() string {
 		return "\n  " + formatter.Dim("Computing recommendations...")
 	}
 	if v.err != nil {
-		return "\n  " + formatter.StyleRed.Render("Error: "+v.err.Error())
+		return "\n  " + shellError(v.err)
 	}
 	if v.resp == nil {
 		return ""
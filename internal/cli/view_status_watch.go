@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/cli/formatter"
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultWatchInterval is how often status --watch refreshes without --interval.
+const defaultWatchInterval = 30 * time.Second
+
+// statusWatchLoadedMsg delivers one refresh of status --watch. seq ties it
+// to the load that produced it, so results from a superseded load are dropped.
+type statusWatchLoadedMsg struct {
+	view *statusWatchView
+	seq  int
+	resp *contract.StatusResponse
+	err  error
+	at   time.Time
+}
+
+// statusWatchTickMsg asks the watch view to reload once its interval is up.
+type statusWatchTickMsg struct {
+	view *statusWatchView
+	seq  int
+}
+
+// statusWatchView re-runs the status query on a ticker and redraws it
+// (status --watch). Only one load→tick chain is live at a time: each load
+// bumps seq and messages carrying an older seq, or addressed to another
+// view, are ignored. Once the view is popped nothing re-arms the ticker, so
+// no timers outlive it. q and esc stop watching; the command bar stays
+// usable, so sessions logged meanwhile show up on the next refresh.
+type statusWatchView struct {
+	state    *SharedState
+	req      contract.StatusRequest
+	risks    map[domain.RiskLevel]bool
+	interval time.Duration
+
+	seq       int
+	resp      *contract.StatusResponse
+	updatedAt time.Time
+	loading   bool
+	err       error
+}
+
+func newStatusWatchView(state *SharedState, req contract.StatusRequest, risks map[domain.RiskLevel]bool, interval time.Duration) *statusWatchView {
+	return &statusWatchView{
+		state:    state,
+		req:      req,
+		risks:    risks,
+		interval: interval,
+		loading:  true,
+	}
+}
+
+func (v *statusWatchView) ID() ViewID    { return ViewStatusWatch }
+func (v *statusWatchView) Title() string { return "Status (watching)" }
+
+func (v *statusWatchView) ShortHelp() []key.Binding {
+	keys := v.state.App.keymap()
+	return []key.Binding{
+		keys.Binding(KeyRefresh, "refresh now"),
+		keys.Binding(KeyCommand, "command"),
+		key.NewBinding(key.WithKeys(keys.Key(KeyQuit), "esc"), key.WithHelp(keys.Key(KeyQuit)+"/esc", "stop")),
+	}
+}
+
+func (v *statusWatchView) Init() tea.Cmd {
+	return v.load()
+}
+
+// load starts a new refresh, superseding any pending tick or load.
+func (v *statusWatchView) load() tea.Cmd {
+	v.seq++
+	seq := v.seq
+	app := v.state.App
+	req := v.req
+	risks := v.risks
+	return func() tea.Msg {
+		resp, err := app.Status.GetStatus(context.Background(), req)
+		if err == nil {
+			filterStatusByRisk(resp, risks)
+		}
+		return statusWatchLoadedMsg{view: v, seq: seq, resp: resp, err: err, at: time.Now()}
+	}
+}
+
+func (v *statusWatchView) tick() tea.Cmd {
+	seq := v.seq
+	return tea.Tick(v.interval, func(time.Time) tea.Msg {
+		return statusWatchTickMsg{view: v, seq: seq}
+	})
+}
+
+func (v *statusWatchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case statusWatchLoadedMsg:
+		if msg.view != v || msg.seq != v.seq {
+			return v, nil
+		}
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.resp = msg.resp
+			v.updatedAt = msg.at
+		}
+		return v, v.tick()
+
+	case statusWatchTickMsg:
+		if msg.view != v || msg.seq != v.seq {
+			return v, nil
+		}
+		return v, v.load()
+
+	case refreshViewMsg:
+		return v, v.load()
+
+	case tea.KeyMsg:
+		if v.state.App.keymap().Matches(msg, KeyRefresh) {
+			return v, v.load()
+		}
+	}
+	return v, nil
+}
+
+func (v *statusWatchView) View() string {
+	if v.loading {
+		return "\n  " + formatter.Dim("Loading status...")
+	}
+
+	var b strings.Builder
+	b.WriteString("\n  " + formatter.Dim(fmt.Sprintf("Watching status · every %s · updated %s",
+		v.interval, v.updatedAt.Format("15:04:05"))))
+	if v.err != nil {
+		b.WriteString("  " + formatter.StyleRed.Render("refresh failed: "+v.err.Error()))
+	}
+	b.WriteString("\n")
+	switch {
+	case v.resp == nil:
+	case len(v.resp.Projects) == 0 && len(v.risks) > 0:
+		b.WriteString(formatter.Dim("No projects at that risk level.") + "\n" + formatter.FormatStatus(v.resp, v.state.Width))
+	default:
+		b.WriteString(formatter.FormatStatus(v.resp, v.state.Width))
+	}
+
+	// Keep the frame within the content area so a resize never scrolls the
+	// header off screen.
+	lines := strings.Split(b.String(), "\n")
+	if h := v.state.ContentHeight(); v.state.Height > 0 && len(lines) > h {
+		lines = lines[:h]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -37,6 +37,9 @@ type taskRow struct {
 type taskListLoadedMsg struct {
 	rows []taskRow
 	err  error
+	// selectItemID, when set, moves the cursor to that item's row (after a
+	// reorder, so the cursor follows the moved item).
+	selectItemID string
 }
 
 // jumpTimeoutMsg clears the digit-jump buffer after a pause.
@@ -71,13 +74,16 @@ func (v *taskListView) Title() string {
 }
 
 func (v *taskListView) ShortHelp() []key.Binding {
+	keys := v.state.App.keymap()
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open/collapse")),
-		key.NewBinding(key.WithKeys("space"), key.WithHelp("space", "toggle done")),
+		keys.Binding(KeyToggleDone, "toggle done"),
 		key.NewBinding(key.WithKeys("1"), key.WithHelp("#", "jump to item")),
-		key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add item")),
-		key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "delete")),
-		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		keys.Binding(KeyAddItem, "add item"),
+		keys.Binding(KeyDelete, "delete"),
+		keys.Binding(KeyMoveDown, "move down"),
+		keys.Binding(KeyMoveUp, "move up"),
+		keys.Binding(KeyRefresh, "refresh"),
 		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
 	}
 }
@@ -105,6 +111,14 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return v, nil
 		}
 		v.rows = msg.rows
+		if msg.selectItemID != "" {
+			for i, row := range v.visibleRows() {
+				if row.itemID == msg.selectItemID {
+					v.cursor = i
+					break
+				}
+			}
+		}
 		return v, nil
 
 	case refreshViewMsg:
@@ -141,16 +155,17 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Any non-digit key clears the jump buffer.
 		v.jumpBuf = ""
 
-		switch msg.String() {
-		case "up", "k":
+		keys := v.state.App.keymap()
+		switch {
+		case keys.Matches(msg, KeyUp):
 			if v.cursor > 0 {
 				v.cursor--
 			}
-		case "down", "j":
+		case keys.Matches(msg, KeyDown):
 			if v.cursor < len(visible)-1 {
 				v.cursor++
 			}
-		case "enter":
+		case msg.String() == "enter":
 			if v.cursor < len(visible) {
 				row := visible[v.cursor]
 				if row.isNode {
@@ -160,7 +175,7 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return v, pushView(newActionMenuView(v.state, row.itemID, row.title, row.seq))
 				}
 			}
-		case "space":
+		case keys.Matches(msg, KeyToggleDone):
 			// Toggle done/todo for work items
 			if v.cursor < len(visible) {
 				row := visible[v.cursor]
@@ -168,7 +183,7 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return v, v.toggleDone(row)
 				}
 			}
-		case "a":
+		case keys.Matches(msg, KeyAddItem):
 			// Add work item: infer nodeID from cursor position.
 			if v.cursor < len(visible) {
 				nodeID := visible[v.cursor].nodeID
@@ -176,7 +191,7 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return v, pushView(newAddWorkItemView(v.state, nodeID))
 				}
 			}
-		case "x":
+		case keys.Matches(msg, KeyDelete):
 			// Delete: on item row → open action menu (which has delete);
 			// on node row → confirm and delete node.
 			if v.cursor < len(visible) {
@@ -187,7 +202,18 @@ func (v *taskListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return v, v.deleteItem(row)
 				}
 			}
-		case "r":
+		case keys.Matches(msg, KeyMoveUp), keys.Matches(msg, KeyMoveDown):
+			if v.cursor < len(visible) {
+				row := visible[v.cursor]
+				if !row.isNode && row.itemID != "" {
+					offset := 1
+					if keys.Matches(msg, KeyMoveUp) {
+						offset = -1
+					}
+					return v, v.moveItem(row, offset)
+				}
+			}
+		case keys.Matches(msg, KeyRefresh):
 			v.loading = true
 			return v, v.loadTasks()
 		}
@@ -222,6 +248,25 @@ func (v *taskListView) toggleDone(row taskRow) tea.Cmd {
 	}
 }
 
+// moveItem shifts an item among its node's items and reloads the rows with
+// the cursor still on it. At either end of the node nothing changes.
+func (v *taskListView) moveItem(row taskRow, offset int) tea.Cmd {
+	app := v.state.App
+	projectID := v.state.ActiveProjectID
+	return func() tea.Msg {
+		ctx := context.Background()
+		moved, err := app.WorkItems.Move(ctx, row.itemID, offset)
+		if err != nil {
+			return taskListLoadedMsg{err: err}
+		}
+		if !moved {
+			return nil
+		}
+		rows, err := buildTaskRows(ctx, app, projectID)
+		return taskListLoadedMsg{rows: rows, err: err, selectItemID: row.itemID}
+	}
+}
+
 func (v *taskListView) deleteItem(row taskRow) tea.Cmd {
 	return execDeleteItem(v.state, row.itemID, row.title)
 }
@@ -280,7 +325,7 @@ func (v *taskListView) View() string {
 		return "\n  " + formatter.Dim("Loading tasks...")
 	}
 	if v.err != nil {
-		return "\n  " + formatter.StyleRed.Render("Error: "+v.err.Error())
+		return "\n  " + shellError(v.err)
 	}
 
 	visible := v.visibleRows()
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/alexanderramin/kairos/internal/cli/formatter"
 	"github.com/alexanderramin/kairos/internal/contract"
@@ -30,3 +34,50 @@ func loadProjectDisplayIDs(ctx context.Context, app *App) map[string]string {
 	}
 	return projectIDs
 }
+
+var errNothingToDo = errors.New("nothing to work on right now")
+
+// whatNowOneline renders the top recommendation as a single plain line for
+// `what-now --oneline`. An empty plan (or no candidates at all) is an error,
+// so scripts can tell "nothing to do" from a suggestion.
+func whatNowOneline(ctx context.Context, app *App, resp *contract.WhatNowResponse, err error) (string, error) {
+	var wnErr *contract.WhatNowError
+	if errors.As(err, &wnErr) && wnErr.Code == contract.ErrNoCandidates {
+		return "", errNothingToDo
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Recommendations) == 0 {
+		return "", errNothingToDo
+	}
+	return formatter.FormatWhatNowOneline(resp, loadProjectDisplayIDs(ctx, app)), nil
+}
+
+// RunWhatNowOneline answers `kairos what-now [min] --oneline` outside the
+// shell: it writes the next action to w as one uncoloured line, for prompts
+// and status bars, and returns an error when there is nothing to suggest.
+func RunWhatNowOneline(app *App, args []string, w io.Writer) error {
+	ctx := context.Background()
+	opts, err := parseWhatNowArgs(args)
+	if err != nil {
+		return err
+	}
+	if !opts.oneline {
+		return fmt.Errorf("outside the shell, what-now needs --oneline")
+	}
+	if _, err := applyCriticalSnooze(ctx, app, opts, time.Now()); err != nil {
+		return err
+	}
+	req, err := buildWhatNowRequest(ctx, app, opts, "")
+	if err != nil {
+		return err
+	}
+	resp, err := app.WhatNow.Recommend(ctx, req)
+	line, err := whatNowOneline(ctx, app, resp, err)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, line)
+	return err
+}
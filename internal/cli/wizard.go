@@ -261,6 +261,18 @@ func validateOptionalDate(s string) error {
 	return nil
 }
 
+// validateOptionalDeadline accepts empty, a YYYY-MM-DD date, or a
+// "YYYY-MM-DD HH:MM" deadline.
+func validateOptionalDeadline(s string) error {
+	if s == "" {
+		return nil
+	}
+	if _, err := domain.ParseDeadline(s); err != nil {
+		return fmt.Errorf("use YYYY-MM-DD or \"YYYY-MM-DD HH:MM\" format")
+	}
+	return nil
+}
+
 // wizardConfirm creates a huh form for a yes/no confirmation.
 func wizardConfirm(title string, result *bool) *huh.Form {
 	return huh.NewForm(
@@ -273,3 +285,18 @@ func wizardConfirm(title string, result *bool) *huh.Form {
 		),
 	).WithTheme(kairosHuhTheme()).WithShowHelp(false)
 }
+
+// wizardConfirmPreview is wizardConfirm with a multi-line description shown
+// under the title, used to preview what a confirmation will run.
+func wizardConfirmPreview(title, description string, result *bool) *huh.Form {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(title).
+				Description(description).
+				Affirmative("Yes").
+				Negative("No").
+				Value(result),
+		),
+	).WithTheme(kairosHuhTheme()).WithShowHelp(false)
+}
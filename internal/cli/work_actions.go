@@ -18,31 +18,56 @@ type LogSessionInput struct {
 	Minutes    int
 	UnitsDelta int
 	Note       string
+	Tags       []string
+	StartedAt  time.Time // zero means now
+	DayOnly    bool      // StartedAt names only a day; see WorkSessionLog.DayOnly
+	Finish     bool      // also mark the item done, in the same transaction
+	FromTimer  bool      // the session is the running timer's, which it stops
 }
 
-// execLogSession creates and persists a WorkSessionLog, updates shared state,
-// and returns a formatted success message.
-func execLogSession(ctx context.Context, app *App, state *SharedState, in LogSessionInput) (string, error) {
-
-	s := &domain.WorkSessionLog{
+// newWorkSessionLog builds the session to record for in. Every shell path
+// that logs a session (log, session log, the timer, each part of a split)
+// goes through it.
+func newWorkSessionLog(in LogSessionInput, now time.Time) *domain.WorkSessionLog {
+	startedAt := in.StartedAt
+	if startedAt.IsZero() {
+		startedAt = now
+	}
+	return &domain.WorkSessionLog{
 		ID:             uuid.New().String(),
 		WorkItemID:     in.ItemID,
-		StartedAt:      time.Now(),
+		StartedAt:      startedAt.UTC(),
 		Minutes:        in.Minutes,
 		UnitsDoneDelta: in.UnitsDelta,
 		Note:           in.Note,
-		CreatedAt:      time.Now(),
+		Tags:           in.Tags,
+		CreatedAt:      now,
+		DayOnly:        in.DayOnly,
 	}
+}
+
+// execLogSession creates and persists a WorkSessionLog, updates shared state,
+// and returns a formatted success message.
+func execLogSession(ctx context.Context, app *App, state *SharedState, in LogSessionInput) (string, error) {
+	now := time.Now()
+	s := newWorkSessionLog(in, now)
 	logSession := app.logSessionUseCase()
 	if logSession == nil {
 		return "", fmt.Errorf("log-session use case is not configured")
 	}
-	if err := logSession.LogSession(ctx, s); err != nil {
+	logFn := logSession.LogSession
+	if in.Finish {
+		logFn = logSession.LogSessionAndFinish
+	}
+	if err := logFn(ctx, s); err != nil {
 		return "", err
 	}
 
 	state.ActiveItemID = in.ItemID
 	state.LastDuration = in.Minutes
+	if in.Finish {
+		state.ClearItemContext()
+	}
 
 	msg := fmt.Sprintf("%s Logged %s to %s",
 		formatter.StyleGreen.Render("✔"),
@@ -51,7 +76,37 @@ func execLogSession(ctx context.Context, app *App, state *SharedState, in LogSes
 	if in.UnitsDelta > 0 {
 		msg += fmt.Sprintf(" (+%d units)", in.UnitsDelta)
 	}
-	return msg, nil
+	if in.Finish {
+		msg += " and marked it done"
+	}
+	return msg + settleTimer(state, in, now), nil
+}
+
+// settleTimer keeps a running timer from counting time that a session just
+// logged. The timer's own session stops it silently. Any other log while it
+// runs most likely covers time the timer has also been counting, so the
+// timer is restarted from now (or stopped, when its item was just finished)
+// and a warning says how much of its time was dropped.
+func settleTimer(state *SharedState, in LogSessionInput, now time.Time) string {
+	if !state.TimerRunning() {
+		return ""
+	}
+	if in.FromTimer {
+		state.StopTimer()
+		return ""
+	}
+	elapsed := formatter.FormatMinutes(state.TimerElapsedMin(now))
+	title := state.TimerItemTitle
+	action := "restarted it from now"
+	if in.Finish && state.TimerItemID == in.ItemID {
+		state.StopTimer()
+		action = "stopped it"
+	} else {
+		state.StartTimer(state.TimerItemID, title, now)
+	}
+	return "\n  " + formatter.StyleYellow.Render(fmt.Sprintf(
+		"The timer on %s had run %s; %s so that time isn't counted twice. Use session log --continue-timer to log the timer instead.",
+		title, elapsed, action))
 }
 
 // execStartItem marks a work item as in-progress and updates shared state.
@@ -61,10 +116,19 @@ func execStartItem(ctx context.Context, app *App, state *SharedState,
 	if err := app.WorkItems.MarkInProgress(ctx, itemID); err != nil {
 		return "", err
 	}
+	now := time.Now()
+	var replaced string
+	if state.TimerRunning() && state.TimerItemID != itemID {
+		replaced = "\n  " + formatter.StyleYellow.Render(fmt.Sprintf(
+			"The timer on %s (%s) was not logged and has been replaced.",
+			state.TimerItemTitle, formatter.FormatMinutes(state.TimerElapsedMin(now))))
+	}
 	state.SetActiveItem(itemID, title, seq)
-	return fmt.Sprintf("%s Started: %s",
+	state.StartTimer(itemID, title, now)
+	return fmt.Sprintf("%s Started: %s  %s",
 		formatter.StyleGreen.Render("▶"),
-		formatter.Bold(title)), nil
+		formatter.Bold(title),
+		formatter.Dim("timer running; log it with session log --continue-timer")) + replaced, nil
 }
 
 // execMarkDone marks a work item as done and clears context if it was active.
@@ -77,9 +141,31 @@ func execMarkDone(ctx context.Context, app *App, state *SharedState,
 	if state.ActiveItemID == itemID {
 		state.ClearItemContext()
 	}
+	var timer string
+	if state.TimerItemID == itemID {
+		timer = "\n  " + formatter.StyleYellow.Render(fmt.Sprintf(
+			"Stopped its timer after %s without logging it; record the time with session log if it counts.",
+			formatter.FormatMinutes(state.TimerElapsedMin(time.Now()))))
+		state.StopTimer()
+	}
 	return fmt.Sprintf("%s Done: %s",
 		formatter.StyleGreen.Render("✔"),
-		formatter.Bold(title)), nil
+		formatter.Bold(title)) + estimateOutcomeLine(ctx, app, itemID) + timer, nil
+}
+
+// estimateOutcomeLine reloads a just-finished item and returns its
+// estimated-vs-actual comparison as an extra output line, or "" when the item
+// has no original estimate or no logged time.
+func estimateOutcomeLine(ctx context.Context, app *App, itemID string) string {
+	wi, err := app.WorkItems.GetByID(ctx, itemID)
+	if err != nil {
+		return ""
+	}
+	outcome := formatter.FormatEstimateOutcome(wi.InitialPlannedMin, wi.LoggedMin)
+	if outcome == "" {
+		return ""
+	}
+	return "\n  " + formatter.Dim(outcome)
 }
 
 // wizardCompleteError returns a wizardCompleteMsg that displays a formatted error.
@@ -16,6 +16,11 @@ const (
 	ReasonOnTrackSafeMix    RecommendationReasonCode = app.ReasonOnTrackSafeMix
 	ReasonCriticalFocus     RecommendationReasonCode = app.ReasonCriticalFocus
 	ReasonMomentum          RecommendationReasonCode = app.ReasonMomentum
+	ReasonContinuePinned    RecommendationReasonCode = app.ReasonContinuePinned
+	ReasonFocusList         RecommendationReasonCode = app.ReasonFocusList
+	ReasonWarmup            RecommendationReasonCode = app.ReasonWarmup
+	ReasonProjectImportance RecommendationReasonCode = app.ReasonProjectImportance
+	ReasonManualPriority    RecommendationReasonCode = app.ReasonManualPriority
 )
 
 type RecommendationReason = app.RecommendationReason
@@ -33,6 +38,12 @@ const (
 	BlockerNotInCriticalScope     ConstraintBlockerCode = app.BlockerNotInCriticalScope
 	BlockerSessionMinExceedsAvail ConstraintBlockerCode = app.BlockerSessionMinExceedsAvail
 	BlockerWorkComplete           ConstraintBlockerCode = app.BlockerWorkComplete
+	BlockerUserExcluded           ConstraintBlockerCode = app.BlockerUserExcluded
+	BlockerNeedsLongerBlock       ConstraintBlockerCode = app.BlockerNeedsLongerBlock
+	BlockerInsufficientTime       ConstraintBlockerCode = app.BlockerInsufficientTime
+	BlockerWrongContext           ConstraintBlockerCode = app.BlockerWrongContext
+	BlockerCriticalSnoozed        ConstraintBlockerCode = app.BlockerCriticalSnoozed
+	BlockerNotStarted             ConstraintBlockerCode = app.BlockerNotStarted
 )
 
 type ConstraintBlocker = app.ConstraintBlocker
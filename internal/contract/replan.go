@@ -13,6 +13,8 @@ func NewReplanRequest(trigger domain.ReplanTrigger) ReplanRequest {
 
 type ProjectReplanDelta = app.ProjectReplanDelta
 
+type ReplanItemChange = app.ReplanItemChange
+
 type ReplanResponse = app.ReplanResponse
 
 type ReplanExplanation = app.ReplanExplanation
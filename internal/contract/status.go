@@ -10,6 +10,10 @@ func NewStatusRequest() StatusRequest {
 
 type ProjectStatusView = app.ProjectStatusView
 
+type ProjectStatusDelta = app.ProjectStatusDelta
+
+type ProjectMilestone = app.ProjectMilestone
+
 type GlobalStatusSummary = app.GlobalStatusSummary
 
 type StatusResponse = app.StatusResponse
@@ -10,13 +10,22 @@ func NewWhatNowRequest(availableMin int) WhatNowRequest {
 
 type WhatNowResponse = app.WhatNowResponse
 
+type RankedCandidate = app.RankedCandidate
+
 type WhatNowErrorCode = app.WhatNowErrorCode
 
 const (
 	ErrInvalidAvailableMin WhatNowErrorCode = app.ErrInvalidAvailableMin
 	ErrNoCandidates        WhatNowErrorCode = app.ErrNoCandidates
+	ErrInvalidStrategy     WhatNowErrorCode = app.ErrInvalidStrategy
+	ErrOutsideActiveHours  WhatNowErrorCode = app.ErrOutsideActiveHours
 	ErrDataIntegrity       WhatNowErrorCode = app.ErrDataIntegrity
 	ErrInternalError       WhatNowErrorCode = app.ErrInternalError
 )
 
+const (
+	WhatNowStrategyPriority = app.WhatNowStrategyPriority
+	WhatNowStrategyWarmup   = app.WhatNowStrategyWarmup
+)
+
 type WhatNowError = app.WhatNowError
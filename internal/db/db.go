@@ -5,13 +5,25 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	_ "modernc.org/sqlite"
 )
 
+// Connection settings. busyTimeoutMS is how long a connection waits for
+// another writer's lock before failing with SQLITE_BUSY; maxOpenConns caps the
+// pool so overlapping goroutines (async TUI loads, auto-replan after a log)
+// share a few connections instead of opening one each.
+const (
+	busyTimeoutMS = 5000
+	maxOpenConns  = 4
+)
+
 // OpenDB opens a SQLite database at the given path.
 // If path is ":memory:", uses an in-memory database.
-// Sets WAL mode and enables foreign keys.
+// Every pooled connection gets WAL mode, foreign keys and a busy timeout, and
+// transactions begin IMMEDIATE so a writer queues for the lock up front
+// instead of failing when it upgrades from a read.
 // Runs migrations automatically.
 func OpenDB(path string) (*sql.DB, error) {
 	if path != ":memory:" {
@@ -21,21 +33,22 @@ func OpenDB(path string) (*sql.DB, error) {
 		}
 	}
 
-	db, err := sql.Open("sqlite", path)
+	db, err := sql.Open("sqlite", dsn(path))
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
 	}
-
-	// Enable WAL mode for better concurrent read performance
-	if _, err := db.Exec("PRAGMA journal_mode = WAL"); err != nil {
-		db.Close()
-		return nil, fmt.Errorf("setting WAL mode: %w", err)
+	if path == ":memory:" {
+		// Each connection to :memory: is a separate, empty database.
+		db.SetMaxOpenConns(1)
+	} else {
+		db.SetMaxOpenConns(maxOpenConns)
+		db.SetMaxIdleConns(maxOpenConns)
 	}
 
-	// Enable foreign key enforcement
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+	// Open a connection now so a bad path or pragma fails here.
+	if err := db.Ping(); err != nil {
 		db.Close()
-		return nil, fmt.Errorf("enabling foreign keys: %w", err)
+		return nil, fmt.Errorf("opening database: %w", err)
 	}
 
 	if err := Migrate(db); err != nil {
@@ -45,3 +58,18 @@ func OpenDB(path string) (*sql.DB, error) {
 
 	return db, nil
 }
+
+// dsn adds the per-connection pragmas and transaction mode to path. The
+// pragmas run on every new pooled connection, unlike a one-off db.Exec.
+func dsn(path string) string {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + strings.Join([]string{
+		"_pragma=journal_mode(WAL)",
+		"_pragma=foreign_keys(1)",
+		fmt.Sprintf("_pragma=busy_timeout(%d)", busyTimeoutMS),
+		"_txlock=immediate",
+	}, "&")
+}
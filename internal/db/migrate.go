@@ -236,6 +236,190 @@ var migrations = []string{
 	`ALTER TABLE plan_nodes ADD COLUMN is_default INTEGER NOT NULL DEFAULT 0`,
 	`ALTER TABLE work_items ADD COLUMN description TEXT NOT NULL DEFAULT ''`,
 	`ALTER TABLE work_items ADD COLUMN completed_at TEXT`,
+
+	// Import refs on nodes and work items so merge-import can match entities
+	// across re-imports of the same plan file.
+	`ALTER TABLE plan_nodes ADD COLUMN ref TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE work_items ADD COLUMN ref TEXT NOT NULL DEFAULT ''`,
+
+	// Tombstones for hard-deleted entities, populated by triggers so that
+	// cascaded deletes are captured too. Used by incremental export.
+	`CREATE TABLE IF NOT EXISTS tombstones (
+		entity_type TEXT NOT NULL,
+		entity_id   TEXT NOT NULL,
+		deleted_at  TEXT NOT NULL,
+		PRIMARY KEY (entity_type, entity_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_tombstones_deleted ON tombstones(deleted_at)`,
+	`CREATE TRIGGER IF NOT EXISTS trg_projects_tombstone AFTER DELETE ON projects BEGIN
+		INSERT OR REPLACE INTO tombstones (entity_type, entity_id, deleted_at)
+		VALUES ('project', OLD.id, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS trg_plan_nodes_tombstone AFTER DELETE ON plan_nodes BEGIN
+		INSERT OR REPLACE INTO tombstones (entity_type, entity_id, deleted_at)
+		VALUES ('node', OLD.id, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS trg_work_items_tombstone AFTER DELETE ON work_items BEGIN
+		INSERT OR REPLACE INTO tombstones (entity_type, entity_id, deleted_at)
+		VALUES ('work_item', OLD.id, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS trg_sessions_tombstone AFTER DELETE ON work_session_logs BEGIN
+		INSERT OR REPLACE INTO tombstones (entity_type, entity_id, deleted_at)
+		VALUES ('session', OLD.id, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+	END`,
+
+	// Immutable original estimate on work items, used for estimation accuracy.
+	// Existing rows adopt their current planned_min as the baseline.
+	`ALTER TABLE work_items ADD COLUMN initial_planned_min INTEGER`,
+	`UPDATE work_items SET initial_planned_min = planned_min WHERE initial_planned_min IS NULL`,
+
+	// Daily per-project risk/progress history for status --compare.
+	`CREATE TABLE IF NOT EXISTS risk_snapshots (
+		project_id              TEXT NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+		snapshot_date           TEXT NOT NULL,
+		risk_level              TEXT NOT NULL,
+		progress_time_pct       REAL NOT NULL DEFAULT 0,
+		progress_structural_pct REAL NOT NULL DEFAULT 0,
+		planned_min             INTEGER NOT NULL DEFAULT 0,
+		logged_min              INTEGER NOT NULL DEFAULT 0,
+		created_at              TEXT NOT NULL,
+		PRIMARY KEY (project_id, snapshot_date)
+	)`,
+
+	// Per-item checklist steps, stored as a JSON array of {text, done}.
+	`ALTER TABLE work_items ADD COLUMN checklist TEXT NOT NULL DEFAULT '[]'`,
+
+	// Manually pinned focus list and the what-now boost it receives.
+	`CREATE TABLE IF NOT EXISTS focus_items (
+		work_item_id TEXT PRIMARY KEY REFERENCES work_items(id) ON DELETE CASCADE,
+		added_at     TEXT NOT NULL
+	)`,
+	`ALTER TABLE user_profile ADD COLUMN weight_focus REAL NOT NULL DEFAULT 1.0`,
+
+	// Pomodoro block and break lengths for session log --pomodoro.
+	`ALTER TABLE user_profile ADD COLUMN focus_block_min INTEGER NOT NULL DEFAULT 25`,
+	`ALTER TABLE user_profile ADD COLUMN break_min INTEGER NOT NULL DEFAULT 5`,
+
+	// Append-only audit log of service mutations (see history command).
+	`CREATE TABLE IF NOT EXISTS audit_events (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		entity_type TEXT NOT NULL,
+		entity_id   TEXT NOT NULL,
+		action      TEXT NOT NULL,
+		changes     TEXT NOT NULL DEFAULT '{}',
+		occurred_at TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events(entity_id, occurred_at)`,
+
+	// Named work item shapes for work add --preset.
+	`CREATE TABLE IF NOT EXISTS work_presets (
+		name                TEXT PRIMARY KEY,
+		type                TEXT NOT NULL DEFAULT '',
+		planned_min         INTEGER NOT NULL DEFAULT 0,
+		min_session_min     INTEGER NOT NULL DEFAULT 0,
+		max_session_min     INTEGER NOT NULL DEFAULT 0,
+		default_session_min INTEGER NOT NULL DEFAULT 0
+	)`,
+
+	// Items added from the shell used to be stored with 0/0/0 session bounds
+	// and splittable = 0. Splittable is now honored by the allocator, so give
+	// those rows the default policy instead of turning them atomic. The
+	// backfill is recorded in data_backfills and runs only once, so items
+	// later made atomic with --atomic are left alone.
+	`CREATE TABLE IF NOT EXISTS data_backfills (
+		name TEXT PRIMARY KEY
+	)`,
+	`UPDATE work_items
+		SET min_session_min = 15, max_session_min = 60, default_session_min = 30, splittable = 1
+		WHERE min_session_min = 0 AND max_session_min = 0 AND default_session_min = 0
+		  AND NOT EXISTS (SELECT 1 FROM data_backfills WHERE name = 'work_item_session_bounds')`,
+	`INSERT OR IGNORE INTO data_backfills (name) VALUES ('work_item_session_bounds')`,
+
+	// Opt-in project-scoped replan after each logged session.
+	`ALTER TABLE user_profile ADD COLUMN auto_replan INTEGER NOT NULL DEFAULT 0`,
+
+	// Deadline-independent project importance (1-5, 3 is neutral) and the
+	// what-now weight it gets.
+	`ALTER TABLE projects ADD COLUMN importance INTEGER NOT NULL DEFAULT 3`,
+	`ALTER TABLE user_profile ADD COLUMN weight_importance REAL NOT NULL DEFAULT 1.0`,
+
+	// Free-form session tags (e.g. billable), stored as a JSON array of
+	// lowercase strings for session report --group-by tag.
+	`ALTER TABLE work_session_logs ADD COLUMN tags TEXT NOT NULL DEFAULT '[]'`,
+
+	// Work item context tags (e.g. office, online) for what-now --context.
+	`ALTER TABLE work_items ADD COLUMN tags TEXT NOT NULL DEFAULT '[]'`,
+
+	// Shell typo correction (on by default; profile set autocorrect=false).
+	`ALTER TABLE user_profile ADD COLUMN autocorrect INTEGER NOT NULL DEFAULT 1`,
+
+	// Saved what-now agendas (what-now --save-plan), one per local day. Items
+	// keep a copy of the title so the plan still reads after edits or
+	// deletes; there is deliberately no foreign key to work_items.
+	`CREATE TABLE IF NOT EXISTS day_plans (
+		plan_date     TEXT PRIMARY KEY,
+		available_min INTEGER NOT NULL DEFAULT 0,
+		created_at    TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS day_plan_items (
+		plan_date     TEXT NOT NULL REFERENCES day_plans(plan_date) ON DELETE CASCADE,
+		position      INTEGER NOT NULL,
+		work_item_id  TEXT NOT NULL,
+		work_item_seq INTEGER NOT NULL DEFAULT 0,
+		project_id    TEXT NOT NULL DEFAULT '',
+		title         TEXT NOT NULL DEFAULT '',
+		allocated_min INTEGER NOT NULL DEFAULT 0,
+		PRIMARY KEY (plan_date, position)
+	)`,
+
+	// Per-weekday availability for weekly plan: seven comma-separated minute
+	// counts, Monday first; empty falls back to baseline_daily_min.
+	`ALTER TABLE user_profile ADD COLUMN weekday_min TEXT NOT NULL DEFAULT ''`,
+
+	// Most minutes a day can hold; remaining work beyond it before a deadline
+	// is flagged infeasible.
+	`ALTER TABLE user_profile ADD COLUMN max_daily_min INTEGER NOT NULL DEFAULT 480`,
+
+	// Active hours window for what-now, in minutes after local midnight;
+	// equal start and end mean no window.
+	`ALTER TABLE user_profile ADD COLUMN active_hours_start INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE user_profile ADD COLUMN active_hours_end INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE user_profile ADD COLUMN respect_active_hours INTEGER NOT NULL DEFAULT 0`,
+
+	// Manual what-now ranking override (work priority): '', 'high' or 'top'.
+	`ALTER TABLE work_items ADD COLUMN manual_priority TEXT NOT NULL DEFAULT ''`,
+
+	// Until when what-now ignores the project's critical risk (RFC3339 UTC).
+	`ALTER TABLE projects ADD COLUMN critical_snoozed_until TEXT`,
+
+	// Per-project defaults for new work items (project update --default-*);
+	// '' and 0 mean no default.
+	`ALTER TABLE projects ADD COLUMN default_work_type TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE projects ADD COLUMN default_planned_min INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE projects ADD COLUMN default_min_session_min INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE projects ADD COLUMN default_max_session_min INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE projects ADD COLUMN default_session_min INTEGER NOT NULL DEFAULT 0`,
+
+	// Manual order of work items within their node (task list J/K, work
+	// move-up/move-down). Existing rows start at -1 and are numbered once in
+	// creation order; new rows are always appended with an index >= 0, so
+	// re-running the backfill leaves reordered items alone.
+	`ALTER TABLE work_items ADD COLUMN order_index INTEGER NOT NULL DEFAULT -1`,
+	`UPDATE work_items SET order_index = (
+		SELECT COUNT(*) FROM work_items w2
+		WHERE w2.node_id = work_items.node_id
+		  AND (w2.created_at < work_items.created_at
+		    OR (w2.created_at = work_items.created_at AND w2.rowid < work_items.rowid)))
+	WHERE order_index < 0`,
+
+	// Opt-in check that a backdated session fits between its start and now
+	// (profile set validate-session-time=true).
+	`ALTER TABLE user_profile ADD COLUMN validate_session_time INTEGER NOT NULL DEFAULT 0`,
+
+	// Days of sessions behind the recent daily pace and the spacing
+	// look-back; 0 keeps the 7-day defaults.
+	`ALTER TABLE user_profile ADD COLUMN pace_window_days INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE user_profile ADD COLUMN spacing_lookback_days INTEGER NOT NULL DEFAULT 0`,
 }
 
 // migrateBackfillSeq assigns sequential IDs to existing nodes and work items
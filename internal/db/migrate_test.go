@@ -319,3 +319,65 @@ func TestMigratePlanNodesAssessmentKind_UpgradesLegacySchema(t *testing.T) {
 	assert.Equal(t, "Legacy Node", title)
 	assert.Equal(t, 1, orderIndex)
 }
+
+func TestMigrate_BackfillsWorkItemOrderInCreationOrder(t *testing.T) {
+	db := openTestDB(t)
+
+	_, err := db.Exec(`INSERT INTO projects (id, name, domain, start_date, status, created_at, updated_at, short_id)
+		VALUES ('p1', 'Project 1', 'test', '2025-01-01', 'active', '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z', 'POR01')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO plan_nodes (id, project_id, title, kind, seq, created_at, updated_at)
+		VALUES ('n1', 'p1', 'Node 1', 'generic', 1, '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+	// Rows from before the column existed carry the -1 default.
+	_, err = db.Exec(`INSERT INTO work_items (id, node_id, title, status, seq, created_at, updated_at) VALUES
+		('w2', 'n1', 'Second', 'todo', 3, '2025-01-02T00:00:00Z', '2025-01-02T00:00:00Z'),
+		('w1', 'n1', 'First', 'todo', 2, '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+
+	require.NoError(t, Migrate(db))
+	var first, second int
+	require.NoError(t, db.QueryRow(`SELECT order_index FROM work_items WHERE id = 'w1'`).Scan(&first))
+	require.NoError(t, db.QueryRow(`SELECT order_index FROM work_items WHERE id = 'w2'`).Scan(&second))
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 1, second)
+
+	// A manual reorder survives the next startup.
+	_, err = db.Exec(`UPDATE work_items SET order_index = CASE id WHEN 'w1' THEN 1 ELSE 0 END`)
+	require.NoError(t, err)
+	require.NoError(t, Migrate(db))
+	require.NoError(t, db.QueryRow(`SELECT order_index FROM work_items WHERE id = 'w1'`).Scan(&first))
+	assert.Equal(t, 1, first)
+}
+
+func TestMigrate_BackfillsZeroSessionBoundsOnce(t *testing.T) {
+	db := openTestDB(t)
+
+	_, err := db.Exec(`INSERT INTO projects (id, name, domain, start_date, status, created_at, updated_at, short_id)
+		VALUES ('p1', 'Project 1', 'test', '2025-01-01', 'active', '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z', 'POR01')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO plan_nodes (id, project_id, title, kind, seq, created_at, updated_at)
+		VALUES ('n1', 'p1', 'Node 1', 'generic', 1, '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+	// Simulate a database from before the backfill was recorded.
+	_, err = db.Exec(`DELETE FROM data_backfills WHERE name = 'work_item_session_bounds'`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO work_items (id, node_id, title, status, seq, min_session_min, max_session_min, default_session_min, splittable, created_at, updated_at)
+		VALUES ('w1', 'n1', 'Legacy', 'todo', 1, 0, 0, 0, 0, '2025-01-01T00:00:00Z', '2025-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+
+	require.NoError(t, Migrate(db))
+	var lo, hi, def, splittable int
+	require.NoError(t, db.QueryRow(`SELECT min_session_min, max_session_min, default_session_min, splittable FROM work_items WHERE id = 'w1'`).
+		Scan(&lo, &hi, &def, &splittable))
+	assert.Equal(t, []int{15, 60, 30, 1}, []int{lo, hi, def, splittable})
+
+	// Rows written after the backfill keep their bounds and atomic flag.
+	_, err = db.Exec(`INSERT INTO work_items (id, node_id, title, status, seq, min_session_min, max_session_min, default_session_min, splittable, created_at, updated_at)
+		VALUES ('w2', 'n1', 'Atomic', 'todo', 2, 0, 0, 0, 0, '2025-01-02T00:00:00Z', '2025-01-02T00:00:00Z')`)
+	require.NoError(t, err)
+	require.NoError(t, Migrate(db))
+	require.NoError(t, db.QueryRow(`SELECT min_session_min, max_session_min, default_session_min, splittable FROM work_items WHERE id = 'w2'`).
+		Scan(&lo, &hi, &def, &splittable))
+	assert.Equal(t, []int{0, 0, 0, 0}, []int{lo, hi, def, splittable})
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// ArchivedEntity is an archived project or work item as listed by archive
+// list and removed by archive purge. EntityType is TombstoneEntityProject or
+// TombstoneEntityWorkItem; for a project, Title is its name and the Project*
+// fields describe itself.
+type ArchivedEntity struct {
+	EntityType     string
+	ID             string
+	Title          string
+	Seq            int // work items only
+	ProjectID      string
+	ProjectShortID string
+	ProjectName    string
+	ArchivedAt     time.Time
+	// SessionCount is the number of logged sessions deleted along with the
+	// entity (for a project, across all its work items).
+	SessionCount int
+}
+
+// ArchivedBefore reports whether the entity was archived before cutoff.
+func (e ArchivedEntity) ArchivedBefore(cutoff time.Time) bool {
+	return e.ArchivedAt.Before(cutoff)
+}
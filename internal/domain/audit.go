@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// AuditEvent is one entry in the append-only mutation log. Changes holds only
+// the fields that differ, as display strings (empty means unset).
+type AuditEvent struct {
+	ID         int64
+	EntityType string
+	EntityID   string
+	Action     string
+	Changes    map[string]FieldChange
+	OccurredAt time.Time
+}
+
+// FieldChange records a single field's value before and after a mutation.
+type FieldChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
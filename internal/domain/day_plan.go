@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// DayPlan is a what-now agenda saved as the commitment for one day (see
+// what-now --save-plan). Items are in the order they were recommended.
+type DayPlan struct {
+	Date         time.Time // local calendar date (YYYY-MM-DD)
+	AvailableMin int
+	Items        []DayPlanItem
+	CreatedAt    time.Time
+}
+
+// DayPlanItem is one planned slice. WorkItemSeq and Title are copied from
+// the work item when the plan is saved.
+type DayPlanItem struct {
+	WorkItemID   string
+	WorkItemSeq  int
+	ProjectID    string
+	Title        string
+	AllocatedMin int
+}
+
+// PlannedMin returns the minutes allocated across all items.
+func (p *DayPlan) PlannedMin() int {
+	total := 0
+	for _, it := range p.Items {
+		total += it.AllocatedMin
+	}
+	return total
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Deadlines (project target dates, node and work item due dates) are usually
+// whole dates, held as midnight UTC. A deadline may instead carry a time of
+// day ("due today 5pm"), entered and held in local time. The location is what
+// marks it: a local time such as 17:00 PDT is midnight in UTC, so the clock
+// alone can't tell the two apart.
+const (
+	DeadlineDateLayout = "2006-01-02"
+	DeadlineTimeLayout = "2006-01-02 15:04"
+)
+
+// ParseDeadline parses a user-supplied deadline: YYYY-MM-DD for a whole day,
+// or "YYYY-MM-DD HH:MM" (a T separator also works) for a local time of day,
+// returned in time.Local.
+func ParseDeadline(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(DeadlineDateLayout, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{DeadlineTimeLayout, "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, Errorf(CodeInvalidInput, "invalid deadline %q (expected YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")", value)
+}
+
+// DeadlineHasTime reports whether t carries a time of day rather than being
+// a date-only deadline: it is held in local time, or is off midnight UTC.
+func DeadlineHasTime(t time.Time) bool {
+	if t.Location() == time.Local {
+		return true
+	}
+	u := t.UTC()
+	return u.Hour() != 0 || u.Minute() != 0 || u.Second() != 0
+}
+
+// FormatDeadline renders t the way ParseDeadline reads it: YYYY-MM-DD for a
+// date-only deadline, "YYYY-MM-DD HH:MM" in local time otherwise.
+func FormatDeadline(t time.Time) string {
+	if DeadlineHasTime(t) {
+		return t.Local().Format(DeadlineTimeLayout)
+	}
+	return t.Format(DeadlineDateLayout)
+}
+
+// ShiftDeadline moves t by days calendar days. A deadline with a time of day
+// keeps its local wall-clock time across daylight-saving changes.
+func ShiftDeadline(t time.Time, days int) time.Time {
+	if DeadlineHasTime(t) {
+		return t.Local().AddDate(0, 0, days)
+	}
+	return t.AddDate(0, 0, days)
+}
+
+// shiftOptionalDeadline returns t shifted by days, or nil for no deadline.
+func shiftOptionalDeadline(t *time.Time, days int) *time.Time {
+	if t == nil {
+		return nil
+	}
+	shifted := ShiftDeadline(*t, days)
+	return &shifted
+}
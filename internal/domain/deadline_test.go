@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeadline_DateOnlyStaysMidnightUTC(t *testing.T) {
+	got, err := ParseDeadline("2026-03-13")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC), got)
+	assert.False(t, DeadlineHasTime(got))
+	assert.Equal(t, "2026-03-13", FormatDeadline(got))
+}
+
+func TestParseDeadline_WithTimeIsLocal(t *testing.T) {
+	for _, in := range []string{"2026-03-13 17:00", "2026-03-13T17:00"} {
+		got, err := ParseDeadline(in)
+		require.NoError(t, err, in)
+		assert.True(t, got.Equal(time.Date(2026, 3, 13, 17, 0, 0, 0, time.Local)), in)
+		assert.Equal(t, "2026-03-13 17:00", FormatDeadline(got))
+	}
+
+	_, err := ParseDeadline("13/03/2026")
+	assert.Error(t, err)
+}
+
+// useLocal runs the rest of the test with time.Local set to loc.
+func useLocal(t *testing.T, loc *time.Location) {
+	t.Helper()
+	prev := time.Local
+	time.Local = loc
+	t.Cleanup(func() { time.Local = prev })
+}
+
+func TestParseDeadline_LocalTimeOnUTCMidnightKeepsTime(t *testing.T) {
+	for _, tc := range []struct {
+		zone *time.Location
+		in   string
+	}{
+		{time.FixedZone("PDT", -7*60*60), "2026-03-10 17:00"},
+		{time.FixedZone("MSK", 3*60*60), "2026-03-10 03:00"},
+	} {
+		useLocal(t, tc.zone)
+		got, err := ParseDeadline(tc.in)
+		require.NoError(t, err, tc.in)
+		require.Zero(t, got.UTC().Hour(), "the local time should land on midnight UTC")
+
+		assert.True(t, DeadlineHasTime(got), tc.in)
+		assert.Equal(t, tc.in, FormatDeadline(got))
+		assert.Equal(t, "2026-03-11"+tc.in[10:], FormatDeadline(ShiftDeadline(got, 1)))
+	}
+}
@@ -1,5 +1,9 @@
 package domain
 
+import (
+	"strings"
+)
+
 type RiskLevel string
 
 const (
@@ -78,3 +82,35 @@ const (
 	SourceManual   DurationSource = "manual"
 	SourceTemplate DurationSource = "template"
 )
+
+// ManualPriority is the user's override of what-now ranking (work priority).
+// High ranks an item ahead of the rest of its risk tier; top ranks it ahead
+// of everything the user may work on. The empty value means no override.
+type ManualPriority string
+
+const (
+	PriorityNone ManualPriority = ""
+	PriorityHigh ManualPriority = "high"
+	PriorityTop  ManualPriority = "top"
+)
+
+// ParseManualPriority accepts none, high or top, in any case.
+func ParseManualPriority(s string) (ManualPriority, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "none":
+		return PriorityNone, nil
+	case "high":
+		return PriorityHigh, nil
+	case "top":
+		return PriorityTop, nil
+	}
+	return PriorityNone, Errorf(CodeInvalidInput, "invalid priority %q (expected none, high or top)", s)
+}
+
+// String returns the priority as typed on the command line, "none" when unset.
+func (p ManualPriority) String() string {
+	if p == PriorityNone {
+		return "none"
+	}
+	return string(p)
+}
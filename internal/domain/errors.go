@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is a stable, machine-readable name for a kind of failure. Codes
+// are part of the CLI's output (error.code in JSON), so they never change
+// once published; messages may.
+type ErrorCode string
+
+const (
+	CodeNotFound      ErrorCode = "NOT_FOUND"
+	CodeInvalidInput  ErrorCode = "INVALID_INPUT"
+	CodeInvalidState  ErrorCode = "INVALID_STATE" // e.g. finishing an archived item
+	CodeSessionTooOld ErrorCode = "SESSION_TOO_OLD"
+	CodeInternal      ErrorCode = "INTERNAL_ERROR"
+	// CodeUnknown is reported for errors nothing has classified yet.
+	CodeUnknown ErrorCode = "UNKNOWN"
+)
+
+// ErrNotFound is returned when a queried entity does not exist. Wrap it
+// with context (fmt.Errorf("project: %w", ErrNotFound)); the code survives.
+var ErrNotFound = &Error{Code: CodeNotFound, Message: "not found"}
+
+// Error is an error with a stable Code. Message is the whole text shown to
+// the user; Err, when set, is the cause, reachable through errors.Is/As.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// ErrorCode reports e's code, so CodeOf finds it anywhere in a chain.
+func (e *Error) ErrorCode() ErrorCode { return e.Code }
+
+// Errorf builds a coded error with a formatted message. A %w verb wraps its
+// argument as the cause, as with fmt.Errorf.
+func Errorf(code ErrorCode, format string, args ...any) *Error {
+	wrapped := fmt.Errorf(format, args...)
+	return &Error{Code: code, Message: wrapped.Error(), Err: errors.Unwrap(wrapped)}
+}
+
+// CodeOf returns the code of the first error in err's chain that carries
+// one (any type with an ErrorCode() ErrorCode method), CodeUnknown when
+// none does, and "" for a nil err.
+func CodeOf(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	var coded interface{ ErrorCode() ErrorCode }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return CodeUnknown
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOf(t *testing.T) {
+	assert.Equal(t, ErrorCode(""), CodeOf(nil))
+	assert.Equal(t, CodeUnknown, CodeOf(errors.New("boom")))
+	assert.Equal(t, CodeNotFound, CodeOf(ErrNotFound))
+	assert.Equal(t, CodeNotFound, CodeOf(fmt.Errorf("project %s: %w", "p1", ErrNotFound)),
+		"the code survives wrapping")
+
+	_, err := ParseDeadline("soonish")
+	assert.Equal(t, CodeInvalidInput, CodeOf(err))
+
+	w := &WorkItem{Status: WorkItemArchived}
+	assert.Equal(t, CodeInvalidState, CodeOf(w.MarkDone(time.Now())))
+}
+
+func TestErrorf_WrapsCause(t *testing.T) {
+	err := Errorf(CodeInvalidInput, "bad deadline: %w", ErrNotFound)
+	assert.Equal(t, "bad deadline: not found", err.Error())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, CodeInvalidInput, CodeOf(err), "the outermost code wins")
+
+	plain := Errorf(CodeInvalidInput, "title is required")
+	assert.Nil(t, errors.Unwrap(plain))
+}
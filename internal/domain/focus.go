@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// FocusItem pins a work item to the user's focus list. Focused items get a
+// ranking boost in what-now until they are finished or removed.
+type FocusItem struct {
+	WorkItemID string
+	AddedAt    time.Time
+}
@@ -15,6 +15,20 @@ type PlanNode struct {
 	NotBefore        *time.Time
 	NotAfter         *time.Time
 	PlannedMinBudget *int
+	Ref              string // import ref, used to match nodes on merge-import
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
+
+// ShiftDates moves the node's due date and not-before/not-after window by
+// days calendar days. Reports whether the node had any date to move.
+func (n *PlanNode) ShiftDates(days int, now time.Time) bool {
+	if n.DueDate == nil && n.NotBefore == nil && n.NotAfter == nil {
+		return false
+	}
+	n.DueDate = shiftOptionalDeadline(n.DueDate, days)
+	n.NotBefore = shiftOptionalDeadline(n.NotBefore, days)
+	n.NotAfter = shiftOptionalDeadline(n.NotAfter, days)
+	n.UpdatedAt = now
+	return true
+}
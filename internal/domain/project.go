@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"fmt"
 	"regexp"
 	"time"
 )
@@ -15,20 +14,126 @@ type Project struct {
 	Domain     string
 	StartDate  time.Time
 	TargetDate *time.Time
+	Importance int // 1-5 regardless of deadline; 0 means DefaultImportance
 	Status     ProjectStatus
 	ArchivedAt *time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	// CriticalSnoozedUntil, while in the future, stops the project's
+	// critical risk from forcing what-now into critical mode.
+	CriticalSnoozedUntil *time.Time
+	// WorkDefaults fill in the shape of new work items that don't set it.
+	WorkDefaults WorkDefaults
+}
+
+// WorkDefaults is a project's default shape for new work items — type,
+// estimate and session bounds — set with project update --default-*.
+// Unlike a preset it only fills fields the item leaves unset, so explicit
+// values always win. Zero fields mean no default.
+type WorkDefaults struct {
+	Type              string
+	PlannedMin        int
+	MinSessionMin     int
+	MaxSessionMin     int
+	DefaultSessionMin int
+}
+
+// IsZero reports whether no default is set.
+func (d WorkDefaults) IsZero() bool {
+	return d == WorkDefaults{}
+}
+
+// Validate checks the type and session bounds.
+func (d WorkDefaults) Validate() error {
+	if d.Type != "" && !ValidWorkItemTypes[d.Type] {
+		return Errorf(CodeInvalidInput, "invalid default work item type %q", d.Type)
+	}
+	if d.PlannedMin < 0 || d.MinSessionMin < 0 || d.MaxSessionMin < 0 || d.DefaultSessionMin < 0 {
+		return Errorf(CodeInvalidInput, "default minutes must not be negative")
+	}
+	if d.MinSessionMin > 0 && d.MaxSessionMin > 0 && d.MinSessionMin > d.MaxSessionMin {
+		return Errorf(CodeInvalidInput, "default min session (%dm) exceeds max session (%dm)", d.MinSessionMin, d.MaxSessionMin)
+	}
+	if d.DefaultSessionMin > 0 &&
+		((d.MinSessionMin > 0 && d.DefaultSessionMin < d.MinSessionMin) ||
+			(d.MaxSessionMin > 0 && d.DefaultSessionMin > d.MaxSessionMin)) {
+		return Errorf(CodeInvalidInput, "default session (%dm) must lie within the default session bounds", d.DefaultSessionMin)
+	}
+	return nil
+}
+
+// ApplyTo fills w's unset type, estimate and session bounds from the
+// defaults. A default bound that would conflict with a bound w sets
+// explicitly is skipped, so the explicit value stands and the remaining
+// bounds are left for ApplySessionDefaults.
+func (d WorkDefaults) ApplyTo(w *WorkItem) {
+	if w.Type == "" {
+		w.Type = d.Type
+	}
+	if w.PlannedMin == 0 {
+		w.PlannedMin = d.PlannedMin
+	}
+	explicitMin, explicitMax, explicitDef := w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin
+	if explicitMin == 0 && d.MinSessionMin > 0 &&
+		(explicitMax == 0 || d.MinSessionMin <= explicitMax) &&
+		(explicitDef == 0 || d.MinSessionMin <= explicitDef) {
+		w.MinSessionMin = d.MinSessionMin
+	}
+	if explicitMax == 0 && d.MaxSessionMin > 0 &&
+		d.MaxSessionMin >= explicitMin && d.MaxSessionMin >= explicitDef {
+		w.MaxSessionMin = d.MaxSessionMin
+	}
+	if explicitDef == 0 && d.DefaultSessionMin > 0 &&
+		(w.MinSessionMin == 0 || d.DefaultSessionMin >= w.MinSessionMin) &&
+		(w.MaxSessionMin == 0 || d.DefaultSessionMin <= w.MaxSessionMin) {
+		w.DefaultSessionMin = d.DefaultSessionMin
+	}
+}
+
+// CriticalSnoozed reports whether the project's critical snooze is still
+// in effect at now.
+func (p *Project) CriticalSnoozed(now time.Time) bool {
+	return p.CriticalSnoozedUntil != nil && p.CriticalSnoozedUntil.After(now)
+}
+
+// NotStarted reports whether the project's start date is still ahead of
+// now, i.e. it is upcoming and its work shouldn't be scheduled yet.
+func (p *Project) NotStarted(now time.Time) bool {
+	return now.Before(p.StartDate)
 }
 
 // ValidateShortID checks that ShortID is non-empty and matches the required
 // format: 3-6 uppercase letters followed by 2-4 digits (e.g. PHI01, MATH0234).
 func (p *Project) ValidateShortID() error {
 	if p.ShortID == "" {
-		return fmt.Errorf("short ID is required (use --id flag)")
+		return Errorf(CodeInvalidInput, "short ID is required (use --id flag)")
 	}
 	if !shortIDPattern.MatchString(p.ShortID) {
-		return fmt.Errorf("short ID %q must be 3-6 uppercase letters followed by 2-4 digits (e.g. PHI01)", p.ShortID)
+		return Errorf(CodeInvalidInput, "short ID %q must be 3-6 uppercase letters followed by 2-4 digits (e.g. PHI01)", p.ShortID)
+	}
+	return nil
+}
+
+// Project importance bounds. DefaultImportance is neutral: it neither
+// raises nor lowers a project's what-now score.
+const (
+	MinImportance     = 1
+	MaxImportance     = 5
+	DefaultImportance = 3
+)
+
+// ImportanceOrDefault returns Importance, or DefaultImportance when unset.
+func (p *Project) ImportanceOrDefault() int {
+	if p.Importance == 0 {
+		return DefaultImportance
+	}
+	return p.Importance
+}
+
+// ValidateImportance checks that Importance is unset or within 1-5.
+func (p *Project) ValidateImportance() error {
+	if p.Importance != 0 && (p.Importance < MinImportance || p.Importance > MaxImportance) {
+		return Errorf(CodeInvalidInput, "importance must be between %d and %d, got %d", MinImportance, MaxImportance, p.Importance)
 	}
 	return nil
 }
@@ -44,3 +149,10 @@ func (p *Project) DisplayID() string {
 	}
 	return p.ID
 }
+
+// ShiftDates moves the start and target dates by days calendar days.
+func (p *Project) ShiftDates(days int, now time.Time) {
+	p.StartDate = ShiftDeadline(p.StartDate, days)
+	p.TargetDate = shiftOptionalDeadline(p.TargetDate, days)
+	p.UpdatedAt = now
+}
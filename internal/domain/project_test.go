@@ -55,3 +55,38 @@ func TestDisplayID_ShortUUID(t *testing.T) {
 	p := &Project{ID: "abc", ShortID: ""}
 	assert.Equal(t, "abc", p.DisplayID())
 }
+
+func TestWorkDefaults_ApplyTo_FillsOnlyUnsetFields(t *testing.T) {
+	d := WorkDefaults{Type: "reading", PlannedMin: 45, MinSessionMin: 20, MaxSessionMin: 90, DefaultSessionMin: 45}
+
+	w := &WorkItem{}
+	d.ApplyTo(w)
+	assert.Equal(t, "reading", w.Type)
+	assert.Equal(t, 45, w.PlannedMin)
+	assert.Equal(t, []int{20, 90, 45}, []int{w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin})
+
+	w = &WorkItem{Type: "task", PlannedMin: 30, DefaultSessionMin: 60}
+	d.ApplyTo(w)
+	assert.Equal(t, "task", w.Type, "explicit values win")
+	assert.Equal(t, 30, w.PlannedMin)
+	assert.Equal(t, []int{20, 90, 60}, []int{w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin})
+
+	w = &WorkItem{MaxSessionMin: 15}
+	d.ApplyTo(w)
+	assert.Equal(t, 0, w.MinSessionMin, "a default min above the explicit max is skipped")
+	assert.Equal(t, 0, w.DefaultSessionMin, "a default session above the explicit max is skipped")
+	assert.Equal(t, 15, w.MaxSessionMin)
+}
+
+func TestWorkDefaults_Validate(t *testing.T) {
+	assert.NoError(t, WorkDefaults{}.Validate())
+	assert.NoError(t, WorkDefaults{Type: "reading", MinSessionMin: 20, MaxSessionMin: 90, DefaultSessionMin: 45}.Validate())
+	for name, d := range map[string]WorkDefaults{
+		"unknown type":    {Type: "novel"},
+		"negative":        {PlannedMin: -1},
+		"min above max":   {MinSessionMin: 60, MaxSessionMin: 15},
+		"default outside": {MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 90},
+	} {
+		assert.Error(t, d.Validate(), name)
+	}
+}
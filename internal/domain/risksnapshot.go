@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// RiskSnapshot is a per-project, per-day record of risk and progress, captured
+// whenever status is recomputed. The latest capture of a day wins.
+type RiskSnapshot struct {
+	ProjectID             string
+	SnapshotDate          time.Time // calendar date (YYYY-MM-DD)
+	RiskLevel             RiskLevel
+	ProgressTimePct       float64
+	ProgressStructuralPct float64
+	PlannedMin            int
+	LoggedMin             int
+	CreatedAt             time.Time
+}
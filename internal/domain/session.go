@@ -1,6 +1,8 @@
 package domain
 
-import "time"
+import (
+	"time"
+)
 
 type WorkSessionLog struct {
 	ID             string
@@ -9,7 +11,40 @@ type WorkSessionLog struct {
 	Minutes        int
 	UnitsDoneDelta int
 	Note           string
+	Tags           []string // lowercase, deduplicated; see NormalizeTags
 	CreatedAt      time.Time
+	// DayOnly marks a StartedAt known only to the day (session log --at
+	// YYYY-MM-DD), which CheckElapsed lets through. Not stored.
+	DayOnly bool
+}
+
+// SessionClockSlackMin is how many minutes a session may overrun the time
+// since its start before CheckElapsed rejects it, for clock drift and
+// rounding.
+const SessionClockSlackMin = 5
+
+// CheckElapsed rejects a session that claims more minutes than have passed
+// between StartedAt and now, plus SessionClockSlackMin. A session stamped
+// within the slack of now was logged as just finished: it ends now, so it
+// must have started today (local time). A DayOnly session is not checked.
+func (s *WorkSessionLog) CheckElapsed(now time.Time) error {
+	if s.DayOnly {
+		return nil
+	}
+	elapsed := int(now.Sub(s.StartedAt).Minutes())
+	if elapsed <= SessionClockSlackMin {
+		local := now.In(time.Local)
+		midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+		if s.Minutes > int(now.Sub(midnight).Minutes())+SessionClockSlackMin {
+			return Errorf(CodeInvalidInput, "can't log %dm ending now: it would have started before today (use --at to set its start)", s.Minutes)
+		}
+		return nil
+	}
+	if s.Minutes > elapsed+SessionClockSlackMin {
+		return Errorf(CodeInvalidInput, "can't log %dm from %s: only %dm have passed",
+			s.Minutes, s.StartedAt.Local().Format("2006-01-02 15:04"), elapsed)
+	}
+	return nil
 }
 
 // SessionSummaryByType aggregates session minutes per work item, including type info.
@@ -18,3 +53,11 @@ type SessionSummaryByType struct {
 	WorkItemType  string
 	TotalMinutes  int
 }
+
+// SessionTagSummary aggregates session minutes per tag. Untagged sessions are
+// grouped under an empty Tag.
+type SessionTagSummary struct {
+	Tag          string
+	TotalMinutes int
+	SessionCount int
+}
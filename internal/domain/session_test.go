@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkSessionLog_CheckElapsed(t *testing.T) {
+	useLocal(t, time.UTC)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	at := func(minAgo int) time.Time { return now.Add(-time.Duration(minAgo) * time.Minute) }
+
+	assert.NoError(t, (&WorkSessionLog{StartedAt: at(60), Minutes: 60}).CheckElapsed(now))
+	assert.NoError(t, (&WorkSessionLog{StartedAt: at(60), Minutes: 65}).CheckElapsed(now), "within the slack")
+	assert.ErrorContains(t, (&WorkSessionLog{StartedAt: at(60), Minutes: 66}).CheckElapsed(now), "only 60m have passed")
+	assert.NoError(t, (&WorkSessionLog{StartedAt: now, Minutes: 600}).CheckElapsed(now), "stamped now: ends now, started at 02:00")
+	assert.ErrorContains(t, (&WorkSessionLog{StartedAt: now, Minutes: 730}).CheckElapsed(now), "can't log 730m ending now")
+	assert.NoError(t, (&WorkSessionLog{StartedAt: at(60), Minutes: 600, DayOnly: true}).CheckElapsed(now))
+}
+
+func TestWorkSessionLog_CheckElapsed_StampedNowStartsToday(t *testing.T) {
+	// 09:00 in Berlin is 07:00 UTC: the bound is local midnight, 540m back.
+	useLocal(t, time.FixedZone("CEST", 2*60*60))
+	now := time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, (&WorkSessionLog{StartedAt: now, Minutes: 540}).CheckElapsed(now))
+	assert.NoError(t, (&WorkSessionLog{StartedAt: now.Add(-2 * time.Minute), Minutes: 545}).CheckElapsed(now), "within the slack")
+	err := (&WorkSessionLog{StartedAt: now, Minutes: 600}).CheckElapsed(now)
+	assert.ErrorContains(t, err, "would have started before today")
+	assert.Equal(t, CodeInvalidInput, CodeOf(err))
+	assert.NoError(t, (&WorkSessionLog{StartedAt: now, Minutes: 600, DayOnly: true}).CheckElapsed(now))
+}
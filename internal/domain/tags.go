@@ -0,0 +1,31 @@
+package domain
+
+import "strings"
+
+// ParseTags splits a comma-separated tag list such as "billable, Research"
+// and normalizes the result.
+func ParseTags(s string) []string {
+	return NormalizeTags(strings.Split(s, ","))
+}
+
+// NormalizeTags lowercases and trims tags, dropping blanks and duplicates
+// while keeping first-seen order. It returns nil when no tags remain.
+func NormalizeTags(tags []string) []string {
+	var out []string
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// ContextTag normalizes a GTD-style context such as "@Office" to the bare
+// lowercase tag "office".
+func ContextTag(s string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "@")
+}
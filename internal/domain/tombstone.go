@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Tombstone entity types, matching the export envelope section names.
+const (
+	TombstoneEntityProject  = "project"
+	TombstoneEntityNode     = "node"
+	TombstoneEntityWorkItem = "work_item"
+	TombstoneEntitySession  = "session"
+)
+
+// TombstoneReason describes why an entity left the live data set.
+type TombstoneReason string
+
+const (
+	TombstoneDeleted  TombstoneReason = "deleted"
+	TombstoneArchived TombstoneReason = "archived"
+)
+
+// Tombstone records that an entity was removed or archived, so consumers of
+// an incremental export can reconcile their copy.
+type Tombstone struct {
+	EntityType string
+	EntityID   string
+	Reason     TombstoneReason
+	At         time.Time
+}
@@ -1,12 +1,168 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+)
+
+// Default pomodoro lengths used when the profile leaves them unset.
+const (
+	DefaultFocusBlockMin = 25
+	DefaultBreakMin      = 5
+	// DefaultMaxDailyMin is the most work a day can physically hold when the
+	// profile leaves it unset.
+	DefaultMaxDailyMin = 8 * 60
+	// DefaultPaceWindowDays is how many days of sessions the recent daily
+	// pace averages over when the profile leaves it unset.
+	DefaultPaceWindowDays = 7
+	// DefaultSpacingLookbackDays is how far back what-now looks for an
+	// item's last session when the profile leaves it unset.
+	DefaultSpacingLookbackDays = 7
+	// MaxRecentWindowDays bounds both windows.
+	MaxRecentWindowDays = 90
+)
+
+// MaxScoringWeight bounds each what-now scoring weight. Weights default to
+// about 1, so 10 already lets one factor drown out the rest.
+const MaxScoringWeight = 10.0
+
+// UserProfile holds the user's scheduling settings. BufferPct is the
+// deadline safety margin added to remaining work before required daily
+// minutes are computed; status, what-now and replan all read it, so risk
+// and recommendations stay consistent.
 type UserProfile struct {
 	ID                     string
-	BufferPct              float64
+	BufferPct              float64 // e.g. 0.1 = plan for 10% more than remains
 	WeightDeadlinePressure float64
 	WeightBehindPace       float64
 	WeightSpacing          float64
 	WeightVariation        float64
+	WeightFocus            float64
+	WeightImportance       float64
 	DefaultMaxSlices       int
 	BaselineDailyMin       int
+	MaxDailyMin            int  // the most a day can hold; deadlines needing more are infeasible
+	FocusBlockMin          int  // pomodoro focus block length
+	BreakMin               int  // pause between pomodoro blocks
+	AutoReplan             bool // replan the affected project after each logged session
+	Autocorrect            bool // run single-edit command typos with an "(assuming: ...)" note
+	ValidateSessionTime    bool // reject sessions longer than the time since they started (WorkSessionLog.CheckElapsed)
+	// WeekdayMin is the time available for planned work on each weekday,
+	// Monday first, as used by weekly plan. Nil means BaselineDailyMin
+	// every day.
+	WeekdayMin []int
+	// ActiveHoursStart and ActiveHoursEnd bound the local time of day, in
+	// minutes after midnight, when what-now may suggest work. Equal values
+	// mean no window; an end before the start wraps past midnight.
+	ActiveHoursStart int
+	ActiveHoursEnd   int
+	// RespectActiveHours applies the window to every what-now, not only to
+	// what-now --respect-hours.
+	RespectActiveHours bool
+	// PaceWindowDays is how many days of sessions the recent daily pace
+	// averages over: short windows react to a burst or a lull within days,
+	// long ones smooth them out. SpacingLookbackDays is how far back
+	// what-now looks for an item's last session when scoring spacing.
+	// Zero means the defaults.
+	PaceWindowDays      int
+	SpacingLookbackDays int
+}
+
+// HasActiveHours reports whether the profile sets an active-hours window.
+func (p *UserProfile) HasActiveHours() bool {
+	return p.ActiveHoursStart != p.ActiveHoursEnd
+}
+
+// InActiveHours reports whether t's local time of day falls in the active
+// hours window. It is always true without a window.
+func (p *UserProfile) InActiveHours(t time.Time) bool {
+	if !p.HasActiveHours() {
+		return true
+	}
+	m := t.Hour()*60 + t.Minute()
+	if p.ActiveHoursStart < p.ActiveHoursEnd {
+		return m >= p.ActiveHoursStart && m < p.ActiveHoursEnd
+	}
+	return m >= p.ActiveHoursStart || m < p.ActiveHoursEnd
+}
+
+// FormatClock renders minutes after midnight as HH:MM.
+func FormatClock(min int) string {
+	return fmt.Sprintf("%02d:%02d", min/60, min%60)
+}
+
+// NextActiveStart returns when the active hours window next opens after t,
+// in t's location.
+func (p *UserProfile) NextActiveStart(t time.Time) time.Time {
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, p.ActiveHoursStart, 0, 0, t.Location())
+	if !start.After(t) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
+}
+
+// AvailableMinOn returns the minutes available on day for weekly planning.
+func (p *UserProfile) AvailableMinOn(day time.Weekday) int {
+	if len(p.WeekdayMin) != 7 {
+		return p.BaselineDailyMin
+	}
+	return p.WeekdayMin[(int(day)+6)%7]
+}
+
+// MaxDailyCapacity returns MaxDailyMin, or DefaultMaxDailyMin when unset.
+func (p *UserProfile) MaxDailyCapacity() int {
+	if p.MaxDailyMin <= 0 {
+		return DefaultMaxDailyMin
+	}
+	return p.MaxDailyMin
+}
+
+// PaceWindow returns PaceWindowDays, or DefaultPaceWindowDays when unset.
+func (p *UserProfile) PaceWindow() int {
+	if p.PaceWindowDays <= 0 {
+		return DefaultPaceWindowDays
+	}
+	return p.PaceWindowDays
+}
+
+// SpacingLookback returns SpacingLookbackDays, or
+// DefaultSpacingLookbackDays when unset.
+func (p *UserProfile) SpacingLookback() int {
+	if p.SpacingLookbackDays <= 0 {
+		return DefaultSpacingLookbackDays
+	}
+	return p.SpacingLookbackDays
+}
+
+// PomodoroLengths returns the focus block and break lengths in minutes,
+// falling back to the defaults for unset values.
+func (p *UserProfile) PomodoroLengths() (blockMin, breakMin int) {
+	blockMin, breakMin = p.FocusBlockMin, p.BreakMin
+	if blockMin <= 0 {
+		blockMin = DefaultFocusBlockMin
+	}
+	if breakMin < 0 {
+		breakMin = DefaultBreakMin
+	}
+	return blockMin, breakMin
+}
+
+// ScoringWeight is one named what-now scoring weight of a profile; Value
+// points into the profile so callers can change it in place.
+type ScoringWeight struct {
+	Name  string
+	Value *float64
+}
+
+// ScoringWeights lists the profile's what-now scoring weights in display
+// order.
+func (p *UserProfile) ScoringWeights() []ScoringWeight {
+	return []ScoringWeight{
+		{"deadline-pressure", &p.WeightDeadlinePressure},
+		{"behind-pace", &p.WeightBehindPace},
+		{"spacing", &p.WeightSpacing},
+		{"variation", &p.WeightVariation},
+		{"focus", &p.WeightFocus},
+		{"importance", &p.WeightImportance},
+	}
 }
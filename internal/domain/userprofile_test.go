@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserProfile_AvailableMinOn(t *testing.T) {
+	p := &UserProfile{BaselineDailyMin: 45}
+	assert.Equal(t, 45, p.AvailableMinOn(time.Sunday), "baseline without weekly availability")
+
+	p.WeekdayMin = []int{10, 20, 30, 40, 50, 60, 70}
+	assert.Equal(t, 10, p.AvailableMinOn(time.Monday))
+	assert.Equal(t, 60, p.AvailableMinOn(time.Saturday))
+	assert.Equal(t, 70, p.AvailableMinOn(time.Sunday))
+}
+
+func TestUserProfile_ScoringWeightsPointIntoProfile(t *testing.T) {
+	p := &UserProfile{WeightSpacing: 0.5}
+	weights := p.ScoringWeights()
+	assert.Len(t, weights, 6)
+	for _, w := range weights {
+		if w.Name == "spacing" {
+			*w.Value = 3
+		}
+	}
+	assert.Equal(t, 3.0, p.WeightSpacing)
+}
+
+func TestUserProfile_ActiveHours(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2026, 10, 16, h, m, 0, 0, time.UTC) }
+
+	p := &UserProfile{}
+	assert.False(t, p.HasActiveHours())
+	assert.True(t, p.InActiveHours(at(2, 0)), "no window allows any time")
+
+	p.ActiveHoursStart, p.ActiveHoursEnd = 7*60, 22*60+30
+	assert.False(t, p.InActiveHours(at(6, 59)))
+	assert.True(t, p.InActiveHours(at(7, 0)))
+	assert.True(t, p.InActiveHours(at(22, 29)))
+	assert.False(t, p.InActiveHours(at(22, 30)))
+	assert.Equal(t, at(7, 0), p.NextActiveStart(at(2, 0)))
+	assert.Equal(t, at(7, 0).AddDate(0, 0, 1), p.NextActiveStart(at(23, 0)))
+
+	// A night-owl window wraps past midnight.
+	p.ActiveHoursStart, p.ActiveHoursEnd = 20*60, 2*60
+	assert.True(t, p.InActiveHours(at(23, 0)))
+	assert.True(t, p.InActiveHours(at(1, 59)))
+	assert.False(t, p.InActiveHours(at(12, 0)))
+	assert.Equal(t, at(20, 0), p.NextActiveStart(at(12, 0)))
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+)
+
+// WorkPreset is a named shape for a single work item — type, estimate and
+// session bounds — applied by `work add --preset`. Zero fields leave the
+// item's own value untouched.
+type WorkPreset struct {
+	Name              string
+	Type              string
+	PlannedMin        int
+	MinSessionMin     int
+	MaxSessionMin     int
+	DefaultSessionMin int
+}
+
+// Validate checks the preset name, type and session bounds.
+func (p *WorkPreset) Validate() error {
+	if p.Name == "" || strings.ContainsAny(p.Name, " \t") {
+		return Errorf(CodeInvalidInput, "preset name must be a single word")
+	}
+	if p.Type != "" && !ValidWorkItemTypes[p.Type] {
+		return Errorf(CodeInvalidInput, "invalid work item type %q", p.Type)
+	}
+	if p.PlannedMin < 0 || p.MinSessionMin < 0 || p.MaxSessionMin < 0 || p.DefaultSessionMin < 0 {
+		return Errorf(CodeInvalidInput, "preset minutes must not be negative")
+	}
+	if p.MinSessionMin > 0 && p.MaxSessionMin > 0 && p.MinSessionMin > p.MaxSessionMin {
+		return Errorf(CodeInvalidInput, "min session (%dm) exceeds max session (%dm)", p.MinSessionMin, p.MaxSessionMin)
+	}
+	if p.DefaultSessionMin > 0 &&
+		((p.MinSessionMin > 0 && p.DefaultSessionMin < p.MinSessionMin) ||
+			(p.MaxSessionMin > 0 && p.DefaultSessionMin > p.MaxSessionMin)) {
+		return Errorf(CodeInvalidInput, "default session (%dm) must lie within the session bounds", p.DefaultSessionMin)
+	}
+	return nil
+}
+
+// ApplyTo copies the preset's non-zero fields onto w.
+func (p *WorkPreset) ApplyTo(w *WorkItem) {
+	if p.Type != "" {
+		w.Type = p.Type
+	}
+	if p.PlannedMin > 0 {
+		w.PlannedMin = p.PlannedMin
+	}
+	if p.MinSessionMin > 0 {
+		w.MinSessionMin = p.MinSessionMin
+	}
+	if p.MaxSessionMin > 0 {
+		w.MaxSessionMin = p.MaxSessionMin
+	}
+	if p.DefaultSessionMin > 0 {
+		w.DefaultSessionMin = p.DefaultSessionMin
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkPreset_Validate(t *testing.T) {
+	ok := WorkPreset{Name: "reading45", Type: "reading", PlannedMin: 45, MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30}
+	assert.NoError(t, ok.Validate())
+
+	for name, p := range map[string]WorkPreset{
+		"empty name":       {Type: "reading"},
+		"spaced name":      {Name: "two words"},
+		"unknown type":     {Name: "x", Type: "novel"},
+		"negative minutes": {Name: "x", PlannedMin: -5},
+		"min above max":    {Name: "x", MinSessionMin: 60, MaxSessionMin: 15},
+		"default outside":  {Name: "x", MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 90},
+	} {
+		assert.Error(t, p.Validate(), name)
+	}
+}
+
+func TestWorkPreset_ApplyTo_OnlyNonZeroFields(t *testing.T) {
+	w := &WorkItem{Type: "task", PlannedMin: 30, MinSessionMin: 10, MaxSessionMin: 45, DefaultSessionMin: 20}
+	(&WorkPreset{Name: "reading45", Type: "reading", PlannedMin: 45}).ApplyTo(w)
+
+	assert.Equal(t, "reading", w.Type)
+	assert.Equal(t, 45, w.PlannedMin)
+	assert.Equal(t, 10, w.MinSessionMin, "unset preset bounds keep the item's own")
+	assert.Equal(t, 45, w.MaxSessionMin)
+	assert.Equal(t, 20, w.DefaultSessionMin)
+}
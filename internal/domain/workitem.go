@@ -2,6 +2,8 @@ package domain
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -9,6 +11,7 @@ type WorkItem struct {
 	ID          string
 	NodeID      string
 	Seq         int // project-scoped sequential ID (shared with plan nodes)
+	OrderIndex  int // position among the node's items; set by the repository
 	Title       string
 	Description string
 	Type        string
@@ -19,6 +22,7 @@ type WorkItem struct {
 	// Duration
 	DurationMode       DurationMode
 	PlannedMin         int
+	InitialPlannedMin  int // estimate at creation or last deliberate bump; unlike PlannedMin, never re-estimated
 	LoggedMin          int
 	DurationSource     DurationSource
 	EstimateConfidence float64
@@ -38,10 +42,38 @@ type WorkItem struct {
 	DueDate   *time.Time
 	NotBefore *time.Time
 
+	// Ref is the import ref, used to match items on merge-import.
+	Ref string
+
+	// Checklist holds lightweight steps within the item. It has no effect on
+	// scheduling or progress.
+	Checklist []ChecklistItem
+
+	// Tags are situational labels such as "office" or "online", normalized
+	// by NormalizeTags. what-now --context filters on them.
+	Tags []string
+
+	// ManualPriority overrides what-now ranking; see ManualPriority.
+	ManualPriority ManualPriority
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// ChecklistItem is a single step in a work item's checklist.
+type ChecklistItem struct {
+	Text string
+	Done bool
+}
+
+// Fallback session bounds for items created without a session policy. They
+// match the work_items column defaults and generation.ResolveWorkItemDefaults.
+const (
+	DefaultMinSessionMin    = 15
+	DefaultMaxSessionMin    = 60
+	DefaultSessionLengthMin = 30
+)
+
 // IsTerminal returns true for done, skipped, or archived statuses.
 func (w *WorkItem) IsTerminal() bool {
 	return w.Status == WorkItemDone || w.Status == WorkItemSkipped || w.Status == WorkItemArchived
@@ -54,7 +86,7 @@ func (w *WorkItem) MarkDone(now time.Time) error {
 		return nil
 	}
 	if w.Status == WorkItemArchived {
-		return fmt.Errorf("cannot mark done: work item in %s status", w.Status)
+		return Errorf(CodeInvalidState, "cannot mark done: work item in %s status", w.Status)
 	}
 	w.Status = WorkItemDone
 	w.CompletedAt = &now
@@ -69,7 +101,7 @@ func (w *WorkItem) MarkInProgress(now time.Time) error {
 		return nil
 	}
 	if w.Status == WorkItemArchived || w.Status == WorkItemDone {
-		return fmt.Errorf("cannot mark in-progress: work item in %s status", w.Status)
+		return Errorf(CodeInvalidState, "cannot mark in-progress: work item in %s status", w.Status)
 	}
 	w.Status = WorkItemInProgress
 	w.UpdatedAt = now
@@ -80,7 +112,7 @@ func (w *WorkItem) MarkInProgress(now time.Time) error {
 // Returns error if not currently done.
 func (w *WorkItem) Reopen(now time.Time) error {
 	if w.Status != WorkItemDone {
-		return fmt.Errorf("cannot reopen: work item in %s status", w.Status)
+		return Errorf(CodeInvalidState, "cannot reopen: work item in %s status", w.Status)
 	}
 	w.Status = WorkItemTodo
 	w.CompletedAt = nil
@@ -93,7 +125,7 @@ func (w *WorkItem) Reopen(now time.Time) error {
 // Does NOT handle re-estimation — caller is responsible for that.
 func (w *WorkItem) ApplySession(minutes, unitsDelta int, now time.Time) error {
 	if w.Status == WorkItemArchived {
-		return fmt.Errorf("cannot log session: work item in %s status", w.Status)
+		return Errorf(CodeInvalidState, "cannot log session: work item in %s status", w.Status)
 	}
 	w.LoggedMin += minutes
 	w.UnitsDone += unitsDelta
@@ -106,6 +138,27 @@ func (w *WorkItem) ApplySession(minutes, unitsDelta int, now time.Time) error {
 	return nil
 }
 
+// RevertSession undoes ApplySession for a removed session: logged minutes
+// and units go back down (never below zero), and an item left with no
+// sessions returns from in_progress to todo. An item finished by the same
+// log (completed within a minute of loggedAt) is reopened. Re-estimation
+// done at log time is not reverted.
+func (w *WorkItem) RevertSession(minutes, unitsDelta int, loggedAt time.Time, sessionsLeft int, now time.Time) {
+	w.LoggedMin = max(w.LoggedMin-minutes, 0)
+	w.UnitsDone = max(w.UnitsDone-unitsDelta, 0)
+
+	if w.Status == WorkItemDone && w.CompletedAt != nil {
+		if d := w.CompletedAt.Sub(loggedAt); d > -time.Minute && d < time.Minute {
+			w.Status = WorkItemInProgress
+			w.CompletedAt = nil
+		}
+	}
+	if w.Status == WorkItemInProgress && sessionsLeft == 0 {
+		w.Status = WorkItemTodo
+	}
+	w.UpdatedAt = now
+}
+
 // EligibleForReestimate returns true if this item qualifies for smooth
 // re-estimation: has unit tracking, is in estimate mode, and is not terminal.
 func (w *WorkItem) EligibleForReestimate() bool {
@@ -125,6 +178,111 @@ func (w *WorkItem) ApplyReestimate(newPlannedMin int, now time.Time) bool {
 	return true
 }
 
+// ResetEstimate restores PlannedMin to the estimate recorded at creation.
+// Returns error if no original estimate was recorded.
+func (w *WorkItem) ResetEstimate(now time.Time) error {
+	if w.InitialPlannedMin <= 0 {
+		return Errorf(CodeInvalidState, "cannot reset estimate: no original estimate recorded")
+	}
+	w.PlannedMin = w.InitialPlannedMin
+	w.UpdatedAt = now
+	return nil
+}
+
+// BumpEstimate adjusts PlannedMin by deltaMin, never going below the minutes
+// already logged. A bump is a deliberate re-estimate, so InitialPlannedMin
+// moves with it and the change doesn't count as estimation drift.
+// Returns the previous PlannedMin.
+func (w *WorkItem) BumpEstimate(deltaMin int, now time.Time) (int, error) {
+	if w.IsTerminal() {
+		return 0, Errorf(CodeInvalidState, "cannot bump estimate: work item in %s status", w.Status)
+	}
+	prev := w.PlannedMin
+	w.PlannedMin = max(prev+deltaMin, w.LoggedMin, 0)
+	w.InitialPlannedMin = w.PlannedMin
+	w.UpdatedAt = now
+	return prev, nil
+}
+
+// SetUnits sets the planned and completed unit counts. A zero total means
+// units aren't tracked; otherwise done may not exceed it.
+func (w *WorkItem) SetUnits(total, done int, now time.Time) error {
+	if total < 0 || done < 0 {
+		return Errorf(CodeInvalidInput, "units must not be negative (got %d/%d)", done, total)
+	}
+	if total > 0 && done > total {
+		return Errorf(CodeInvalidInput, "units done (%d) exceeds planned units (%d)", done, total)
+	}
+	w.UnitsTotal = total
+	w.UnitsDone = done
+	w.UpdatedAt = now
+	return nil
+}
+
+// InheritDueDate sets the item's due date from its node. Items with their
+// own due date keep it, unless it equals previous (the node's old due date,
+// i.e. it was inherited earlier). Finished items are left alone. Reports
+// whether the due date changed.
+func (w *WorkItem) InheritDueDate(due time.Time, previous *time.Time, now time.Time) bool {
+	if w.IsTerminal() {
+		return false
+	}
+	if w.DueDate != nil {
+		if previous == nil || !w.DueDate.Equal(*previous) || w.DueDate.Equal(due) {
+			return false
+		}
+	}
+	w.DueDate = &due
+	w.UpdatedAt = now
+	return true
+}
+
+// ShiftDates moves the item's due and not-before dates by days calendar
+// days. Reports whether the item had any date to move.
+func (w *WorkItem) ShiftDates(days int, now time.Time) bool {
+	if w.DueDate == nil && w.NotBefore == nil {
+		return false
+	}
+	w.DueDate = shiftOptionalDeadline(w.DueDate, days)
+	w.NotBefore = shiftOptionalDeadline(w.NotBefore, days)
+	w.UpdatedAt = now
+	return true
+}
+
+// ApplySessionDefaults fills unset (zero) session bounds with the fallback
+// values, widening them as needed to contain any bound that was set.
+func (w *WorkItem) ApplySessionDefaults() {
+	if w.MinSessionMin == 0 {
+		w.MinSessionMin = DefaultMinSessionMin
+		for _, v := range []int{w.MaxSessionMin, w.DefaultSessionMin} {
+			if v > 0 && v < w.MinSessionMin {
+				w.MinSessionMin = v
+			}
+		}
+	}
+	if w.MaxSessionMin == 0 {
+		w.MaxSessionMin = max(DefaultMaxSessionMin, w.MinSessionMin, w.DefaultSessionMin)
+	}
+	if w.DefaultSessionMin == 0 {
+		w.DefaultSessionMin = min(max(DefaultSessionLengthMin, w.MinSessionMin), w.MaxSessionMin)
+	}
+}
+
+// ValidateSessionBounds checks that 0 < min <= default <= max.
+func (w *WorkItem) ValidateSessionBounds() error {
+	if w.MinSessionMin <= 0 {
+		return Errorf(CodeInvalidInput, "min session must be positive (got %dm)", w.MinSessionMin)
+	}
+	if w.MaxSessionMin < w.MinSessionMin {
+		return Errorf(CodeInvalidInput, "max session (%dm) is below min session (%dm)", w.MaxSessionMin, w.MinSessionMin)
+	}
+	if w.DefaultSessionMin < w.MinSessionMin || w.DefaultSessionMin > w.MaxSessionMin {
+		return Errorf(CodeInvalidInput, "default session (%dm) must lie between min (%dm) and max (%dm)",
+			w.DefaultSessionMin, w.MinSessionMin, w.MaxSessionMin)
+	}
+	return nil
+}
+
 // EffectiveLoggedMin returns LoggedMin, but for done/skipped items
 // returns max(LoggedMin, PlannedMin) — completed work counts as at least planned.
 func (w *WorkItem) EffectiveLoggedMin() int {
@@ -133,3 +291,121 @@ func (w *WorkItem) EffectiveLoggedMin() int {
 	}
 	return w.LoggedMin
 }
+
+// AddChecklistItem appends an unchecked step to the checklist.
+func (w *WorkItem) AddChecklistItem(text string, now time.Time) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Errorf(CodeInvalidInput, "checklist item text is required")
+	}
+	w.Checklist = append(w.Checklist, ChecklistItem{Text: text})
+	w.UpdatedAt = now
+	return nil
+}
+
+// ToggleChecklistItem flips the done state of the step at the 1-based index n.
+func (w *WorkItem) ToggleChecklistItem(n int, now time.Time) error {
+	if err := w.checkChecklistIndex(n); err != nil {
+		return err
+	}
+	w.Checklist[n-1].Done = !w.Checklist[n-1].Done
+	w.UpdatedAt = now
+	return nil
+}
+
+// RemoveChecklistItem deletes the step at the 1-based index n.
+func (w *WorkItem) RemoveChecklistItem(n int, now time.Time) error {
+	if err := w.checkChecklistIndex(n); err != nil {
+		return err
+	}
+	w.Checklist = append(w.Checklist[:n-1], w.Checklist[n:]...)
+	w.UpdatedAt = now
+	return nil
+}
+
+// ChecklistProgress returns the number of checked steps and the total.
+func (w *WorkItem) ChecklistProgress() (done, total int) {
+	for _, c := range w.Checklist {
+		if c.Done {
+			done++
+		}
+	}
+	return done, len(w.Checklist)
+}
+
+func (w *WorkItem) checkChecklistIndex(n int) error {
+	if len(w.Checklist) == 0 {
+		return Errorf(CodeInvalidInput, "work item has no checklist items")
+	}
+	if n < 1 || n > len(w.Checklist) {
+		return Errorf(CodeInvalidInput, "checklist item %d out of range (1-%d)", n, len(w.Checklist))
+	}
+	return nil
+}
+
+// HasContext reports whether the item is tagged with the given context. A
+// leading "@" is ignored on both sides, so "@office" matches "office".
+func (w *WorkItem) HasContext(context string) bool {
+	want := ContextTag(context)
+	for _, t := range w.Tags {
+		if ContextTag(t) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// Clone returns a new, unsaved item into nodeID with w's type, estimate,
+// session policy, units, description, tags and checklist (unticked). Its
+// progress starts over: no ID or seq, todo, nothing logged, no due date.
+func (w *WorkItem) Clone(nodeID string) *WorkItem {
+	c := &WorkItem{
+		NodeID:             nodeID,
+		Title:              w.Title,
+		Description:        w.Description,
+		Type:               w.Type,
+		Status:             WorkItemTodo,
+		DurationMode:       w.DurationMode,
+		PlannedMin:         w.PlannedMin,
+		DurationSource:     w.DurationSource,
+		EstimateConfidence: w.EstimateConfidence,
+		MinSessionMin:      w.MinSessionMin,
+		MaxSessionMin:      w.MaxSessionMin,
+		DefaultSessionMin:  w.DefaultSessionMin,
+		Splittable:         w.Splittable,
+		UnitsKind:          w.UnitsKind,
+		UnitsTotal:         w.UnitsTotal,
+		Tags:               append([]string(nil), w.Tags...),
+	}
+	for _, step := range w.Checklist {
+		c.Checklist = append(c.Checklist, ChecklistItem{Text: step.Text})
+	}
+	return c
+}
+
+// NextCloneTitle bumps the number a title ends with ("Read Ch1" becomes
+// "Read Ch2", "Week 09" becomes "Week 10") until it is not among taken. A
+// title without a trailing number gets " 2", " 3" and so on.
+func NextCloneTitle(title string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, t := range taken {
+		used[t] = true
+	}
+	end := len(title)
+	start := end
+	for start > 0 && title[start-1] >= '0' && title[start-1] <= '9' {
+		start--
+	}
+	prefix, width, n := title+" ", 0, 1
+	if start < end {
+		prefix, width = title[:start], end-start
+		n, _ = strconv.Atoi(title[start:])
+	}
+	for {
+		n++
+		candidate := fmt.Sprintf("%s%0*d", prefix, width, n)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
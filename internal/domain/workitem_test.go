@@ -132,6 +132,29 @@ func TestApplySession_ErrorOnArchived(t *testing.T) {
 	assert.Contains(t, err.Error(), "archived")
 }
 
+func TestRevertSession_LastSessionReturnsToTodo(t *testing.T) {
+	w := &WorkItem{Status: WorkItemInProgress, LoggedMin: 20, UnitsDone: 1}
+	w.RevertSession(30, 2, testNow.Add(-time.Minute), 0, testNow)
+	assert.Equal(t, 0, w.LoggedMin, "never below zero")
+	assert.Equal(t, 0, w.UnitsDone)
+	assert.Equal(t, WorkItemTodo, w.Status)
+}
+
+func TestRevertSession_ReopensItemFinishedBySameLog(t *testing.T) {
+	loggedAt := testNow.Add(-2 * time.Minute)
+	completed := loggedAt
+	w := &WorkItem{Status: WorkItemDone, LoggedMin: 90, CompletedAt: &completed}
+	w.RevertSession(30, 0, loggedAt, 2, testNow)
+	assert.Equal(t, 60, w.LoggedMin)
+	assert.Equal(t, WorkItemInProgress, w.Status)
+	assert.Nil(t, w.CompletedAt)
+
+	earlier := loggedAt.Add(-time.Hour)
+	done := &WorkItem{Status: WorkItemDone, LoggedMin: 90, CompletedAt: &earlier}
+	done.RevertSession(30, 0, loggedAt, 2, testNow)
+	assert.Equal(t, WorkItemDone, done.Status, "finished separately, stays done")
+}
+
 func TestEligibleForReestimate(t *testing.T) {
 	w := &WorkItem{
 		Status:       WorkItemInProgress,
@@ -206,3 +229,202 @@ func TestEffectiveLoggedMin_SkippedWithLessLogged(t *testing.T) {
 	w := &WorkItem{Status: WorkItemSkipped, PlannedMin: 60, LoggedMin: 10}
 	assert.Equal(t, 60, w.EffectiveLoggedMin(), "skipped items count as at least planned")
 }
+
+func TestResetEstimate_RestoresInitial(t *testing.T) {
+	w := &WorkItem{PlannedMin: 91, InitialPlannedMin: 60}
+	require.NoError(t, w.ResetEstimate(testNow))
+	assert.Equal(t, 60, w.PlannedMin)
+	assert.Equal(t, 60, w.InitialPlannedMin)
+	assert.Equal(t, testNow, w.UpdatedAt)
+}
+
+func TestResetEstimate_NoInitial(t *testing.T) {
+	w := &WorkItem{PlannedMin: 30}
+	err := w.ResetEstimate(testNow)
+	require.Error(t, err)
+	assert.Equal(t, 30, w.PlannedMin)
+}
+
+func TestBumpEstimate(t *testing.T) {
+	w := &WorkItem{Status: WorkItemInProgress, PlannedMin: 60, InitialPlannedMin: 45, LoggedMin: 40}
+
+	prev, err := w.BumpEstimate(30, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, 60, prev)
+	assert.Equal(t, 90, w.PlannedMin)
+	assert.Equal(t, 90, w.InitialPlannedMin, "a bump is a deliberate re-estimate")
+	assert.Equal(t, testNow, w.UpdatedAt)
+
+	prev, err = w.BumpEstimate(-80, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, 90, prev)
+	assert.Equal(t, 40, w.PlannedMin, "clamped to logged minutes")
+
+	done := &WorkItem{Status: WorkItemDone, PlannedMin: 60}
+	_, err = done.BumpEstimate(15, testNow)
+	require.Error(t, err)
+	assert.Equal(t, 60, done.PlannedMin)
+}
+
+func TestSetUnits(t *testing.T) {
+	w := &WorkItem{UnitsTotal: 20, UnitsDone: 5}
+	require.NoError(t, w.SetUnits(30, 12, testNow))
+	assert.Equal(t, 30, w.UnitsTotal)
+	assert.Equal(t, 12, w.UnitsDone)
+	assert.Equal(t, testNow, w.UpdatedAt)
+
+	require.Error(t, w.SetUnits(10, 12, testNow))
+	assert.Equal(t, 30, w.UnitsTotal, "rejected update leaves units unchanged")
+
+	require.NoError(t, w.SetUnits(0, 12, testNow), "zero planned units means untracked")
+	require.Error(t, w.SetUnits(10, -1, testNow))
+}
+
+func TestInheritDueDate(t *testing.T) {
+	oldDue := time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)
+	newDue := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	own := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	unset := &WorkItem{Status: WorkItemTodo}
+	assert.True(t, unset.InheritDueDate(newDue, nil, testNow))
+	assert.Equal(t, newDue, *unset.DueDate)
+	assert.Equal(t, testNow, unset.UpdatedAt)
+
+	inherited := &WorkItem{Status: WorkItemTodo, DueDate: &oldDue}
+	assert.True(t, inherited.InheritDueDate(newDue, &oldDue, testNow), "follows the node when it carried the old node date")
+	assert.Equal(t, newDue, *inherited.DueDate)
+
+	overridden := &WorkItem{Status: WorkItemTodo, DueDate: &own}
+	assert.False(t, overridden.InheritDueDate(newDue, &oldDue, testNow))
+	assert.Equal(t, own, *overridden.DueDate)
+
+	done := &WorkItem{Status: WorkItemDone}
+	assert.False(t, done.InheritDueDate(newDue, nil, testNow))
+	assert.Nil(t, done.DueDate)
+}
+
+func TestChecklist_AddToggleRemove(t *testing.T) {
+	w := &WorkItem{}
+	require.NoError(t, w.AddChecklistItem("  outline ", testNow))
+	require.NoError(t, w.AddChecklistItem("draft", testNow))
+	assert.Equal(t, "outline", w.Checklist[0].Text)
+
+	require.NoError(t, w.ToggleChecklistItem(2, testNow))
+	done, total := w.ChecklistProgress()
+	assert.Equal(t, 1, done)
+	assert.Equal(t, 2, total)
+
+	require.NoError(t, w.RemoveChecklistItem(1, testNow))
+	require.Len(t, w.Checklist, 1)
+	assert.Equal(t, ChecklistItem{Text: "draft", Done: true}, w.Checklist[0])
+	assert.Equal(t, testNow, w.UpdatedAt)
+}
+
+func TestChecklist_InvalidInput(t *testing.T) {
+	w := &WorkItem{}
+	assert.Error(t, w.AddChecklistItem(" ", testNow))
+	assert.Error(t, w.ToggleChecklistItem(1, testNow))
+
+	require.NoError(t, w.AddChecklistItem("step", testNow))
+	assert.Error(t, w.ToggleChecklistItem(0, testNow))
+	assert.Error(t, w.RemoveChecklistItem(2, testNow))
+}
+
+func TestApplySessionDefaults(t *testing.T) {
+	tests := []struct {
+		name          string
+		min, max, def int
+		want          []int
+	}{
+		{"all unset", 0, 0, 0, []int{15, 60, 30}},
+		{"explicit kept", 20, 90, 45, []int{20, 90, 45}},
+		{"large min widens max and default", 90, 0, 0, []int{90, 90, 90}},
+		{"small max narrows min and default", 0, 10, 0, []int{10, 10, 10}},
+		{"default only", 0, 0, 75, []int{15, 75, 75}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &WorkItem{MinSessionMin: tt.min, MaxSessionMin: tt.max, DefaultSessionMin: tt.def}
+			w.ApplySessionDefaults()
+			assert.Equal(t, tt.want, []int{w.MinSessionMin, w.MaxSessionMin, w.DefaultSessionMin})
+			assert.NoError(t, w.ValidateSessionBounds())
+		})
+	}
+}
+
+func TestValidateSessionBounds(t *testing.T) {
+	assert.NoError(t, (&WorkItem{MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 15}).ValidateSessionBounds())
+	assert.ErrorContains(t, (&WorkItem{MinSessionMin: 0, MaxSessionMin: 60, DefaultSessionMin: 30}).ValidateSessionBounds(), "must be positive")
+	assert.ErrorContains(t, (&WorkItem{MinSessionMin: 30, MaxSessionMin: 20, DefaultSessionMin: 25}).ValidateSessionBounds(), "below min session")
+	assert.ErrorContains(t, (&WorkItem{MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 90}).ValidateSessionBounds(), "must lie between")
+}
+
+func TestHasContext(t *testing.T) {
+	w := &WorkItem{Tags: NormalizeTags([]string{" Office", "@online", "office"})}
+	assert.Equal(t, []string{"office", "@online"}, w.Tags)
+	assert.True(t, w.HasContext("office"))
+	assert.True(t, w.HasContext("@Office"))
+	assert.True(t, w.HasContext("online"))
+	assert.False(t, w.HasContext("home"))
+	assert.False(t, (&WorkItem{}).HasContext("office"))
+}
+
+func TestClone_CopiesShapeAndResetsProgress(t *testing.T) {
+	due := testNow.AddDate(0, 0, 3)
+	w := &WorkItem{
+		ID: "w1", NodeID: "n1", Seq: 4, Title: "Read Ch1", Type: "reading",
+		Status: WorkItemDone, CompletedAt: &testNow, DueDate: &due,
+		PlannedMin: 90, InitialPlannedMin: 60, LoggedMin: 75,
+		MinSessionMin: 20, MaxSessionMin: 45, DefaultSessionMin: 30,
+		UnitsKind: "pages", UnitsTotal: 30, UnitsDone: 30,
+		Tags:      []string{"home"},
+		Checklist: []ChecklistItem{{Text: "Skim", Done: true}},
+	}
+
+	c := w.Clone("n2")
+	assert.Equal(t, "n2", c.NodeID)
+	assert.Empty(t, c.ID)
+	assert.Zero(t, c.Seq)
+	assert.Equal(t, WorkItemTodo, c.Status)
+	assert.Nil(t, c.CompletedAt)
+	assert.Nil(t, c.DueDate)
+	assert.Equal(t, 90, c.PlannedMin)
+	assert.Zero(t, c.InitialPlannedMin)
+	assert.Zero(t, c.LoggedMin)
+	assert.Equal(t, [3]int{20, 45, 30}, [3]int{c.MinSessionMin, c.MaxSessionMin, c.DefaultSessionMin})
+	assert.Equal(t, 30, c.UnitsTotal)
+	assert.Zero(t, c.UnitsDone)
+	assert.Equal(t, []ChecklistItem{{Text: "Skim"}}, c.Checklist)
+
+	c.Tags[0] = "office"
+	assert.Equal(t, "home", w.Tags[0], "tags are not shared")
+}
+
+func TestNextCloneTitle(t *testing.T) {
+	cases := []struct {
+		title string
+		taken []string
+		want  string
+	}{
+		{"Read Ch1", nil, "Read Ch2"},
+		{"Read Ch1", []string{"Read Ch1", "Read Ch2"}, "Read Ch3"},
+		{"Week 09", nil, "Week 10"},
+		{"Week 99", nil, "Week 100"},
+		{"Essay", nil, "Essay 2"},
+		{"Essay", []string{"Essay 2"}, "Essay 3"},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, NextCloneTitle(tc.title, tc.taken), tc.title)
+	}
+}
+
+func TestParseManualPriority(t *testing.T) {
+	for in, want := range map[string]ManualPriority{"none": PriorityNone, "High": PriorityHigh, "TOP": PriorityTop} {
+		got, err := ParseManualPriority(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseManualPriority("urgent")
+	assert.Error(t, err)
+	assert.Equal(t, "none", PriorityNone.String())
+}
@@ -50,17 +50,17 @@ func ResolveWorkItemDefaults(item, defaults WorkItemDefaultsInput) ResolvedWorkI
 			item.EstimateConfidence,
 		),
 		MinSessionMin: domain.IntFromPtrWithDefault(
-			15,
+			domain.DefaultMinSessionMin,
 			minSession(item.SessionPolicy),
 			minSession(defaults.SessionPolicy),
 		),
 		MaxSessionMin: domain.IntFromPtrWithDefault(
-			60,
+			domain.DefaultMaxSessionMin,
 			maxSession(item.SessionPolicy),
 			maxSession(defaults.SessionPolicy),
 		),
 		DefaultSessionMin: domain.IntFromPtrWithDefault(
-			30,
+			domain.DefaultSessionLengthMin,
 			defaultSession(item.SessionPolicy),
 			defaultSession(defaults.SessionPolicy),
 		),
@@ -150,6 +150,19 @@ func ParseOptionalDate(value *string, field string) (*time.Time, error) {
 	return &t, nil
 }
 
+// ParseOptionalDeadline parses an optional deadline, YYYY-MM-DD or
+// "YYYY-MM-DD HH:MM", with field-aware errors.
+func ParseOptionalDeadline(value *string, field string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, err := domain.ParseDeadline(*value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid date format %q (expected YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")", field, *value)
+	}
+	return &t, nil
+}
+
 func minSession(policy SessionPolicy) *int {
 	if policy == nil {
 		return nil
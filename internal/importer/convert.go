@@ -54,7 +54,7 @@ func convertProject(schema *ImportSchema, now time.Time) (*domain.Project, error
 		return nil, err
 	}
 
-	targetDate, err := generation.ParseOptionalDate(schema.Project.TargetDate, "project.target_date")
+	targetDate, err := generation.ParseOptionalDeadline(schema.Project.TargetDate, "project.target_date")
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func convertNodes(schemaNodes []NodeImport, projectID string, refMap map[string]
 			kind = string(domain.NodeGeneric)
 		}
 
-		dueDate, err := generation.ParseOptionalDate(n.DueDate, fmt.Sprintf("nodes[%d].due_date", i))
+		dueDate, err := generation.ParseOptionalDeadline(n.DueDate, fmt.Sprintf("nodes[%d].due_date", i))
 		if err != nil {
 			return nil, err
 		}
@@ -115,6 +115,7 @@ func convertNodes(schemaNodes []NodeImport, projectID string, refMap map[string]
 			NotBefore:        notBefore,
 			NotAfter:         notAfter,
 			PlannedMinBudget: n.PlannedMinBudget,
+			Ref:              n.Ref,
 			CreatedAt:        now,
 			UpdatedAt:        now,
 		}
@@ -170,7 +171,7 @@ func convertWorkItems(schema *ImportSchema, refMap map[string]string, now time.T
 			loggedMin = resolved.PlannedMin
 		}
 
-		dueDate, err := generation.ParseOptionalDate(wi.DueDate, fmt.Sprintf("work_items[%d].due_date", i))
+		dueDate, err := generation.ParseOptionalDeadline(wi.DueDate, fmt.Sprintf("work_items[%d].due_date", i))
 		if err != nil {
 			return nil, err
 		}
@@ -183,6 +184,7 @@ func convertWorkItems(schema *ImportSchema, refMap map[string]string, now time.T
 			ID:                 realID,
 			NodeID:             nodeUUID,
 			Title:              wi.Title,
+			Description:        wi.Description,
 			Type:               wi.Type,
 			Status:             domain.WorkItemStatus(status),
 			DurationMode:       domain.DurationMode(resolved.DurationMode),
@@ -198,6 +200,7 @@ func convertWorkItems(schema *ImportSchema, refMap map[string]string, now time.T
 			UnitsTotal:         unitsTotal,
 			DueDate:            dueDate,
 			NotBefore:          notBefore,
+			Ref:                wi.Ref,
 			CreatedAt:          now,
 			UpdatedAt:          now,
 		}
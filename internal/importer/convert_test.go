@@ -272,6 +272,17 @@ func TestConvert_DateParsing(t *testing.T) {
 	assert.Equal(t, 4, int(gen.WorkItems[0].NotBefore.Month()))
 }
 
+func TestConvert_DeadlineTimeOfDay(t *testing.T) {
+	schema := validMinimalSchema()
+	schema.WorkItems[0].DueDate = ptrStr("2025-05-01 17:30")
+
+	gen, err := Convert(schema)
+	require.NoError(t, err)
+
+	require.NotNil(t, gen.WorkItems[0].DueDate)
+	assert.Equal(t, "2025-05-01 17:30", domain.FormatDeadline(*gen.WorkItems[0].DueDate))
+}
+
 func TestConvert_InvalidOptionalDateReturnsError(t *testing.T) {
 	schema := validMinimalSchema()
 	schema.Nodes[0].DueDate = ptrStr("invalid-date")
@@ -55,6 +55,7 @@ type WorkItemImport struct {
 	Ref                string               `json:"ref"`
 	NodeRef            string               `json:"node_ref"`
 	Title              string               `json:"title"`
+	Description        string               `json:"description,omitempty"`
 	Type               string               `json:"type"`
 	Status             string               `json:"status,omitempty"`
 	DurationMode       string               `json:"duration_mode,omitempty"`
@@ -50,11 +50,11 @@ func validateProject(p *ProjectImport) []error {
 		errs = append(errs, fmt.Errorf("project.start_date: invalid date format %q (expected YYYY-MM-DD)", p.StartDate))
 	}
 	if p.TargetDate != nil {
-		if _, err := time.Parse("2006-01-02", *p.TargetDate); err != nil {
-			errs = append(errs, fmt.Errorf("project.target_date: invalid date format %q (expected YYYY-MM-DD)", *p.TargetDate))
+		if dateErrs := validateOptionalDeadline("project.target_date", p.TargetDate); len(dateErrs) > 0 {
+			errs = append(errs, dateErrs...)
 		} else if p.StartDate != "" {
 			start, startErr := time.Parse("2006-01-02", p.StartDate)
-			target, targetErr := time.Parse("2006-01-02", *p.TargetDate)
+			target, targetErr := domain.ParseDeadline(*p.TargetDate)
 			if startErr == nil && targetErr == nil && !target.After(start) {
 				errs = append(errs, fmt.Errorf("project.target_date %q must be after start_date %q", *p.TargetDate, p.StartDate))
 			}
@@ -148,7 +148,7 @@ func validateNodes(nodes []NodeImport, nodeRefs map[string]bool) []error {
 			}
 		}
 
-		errs = append(errs, validateOptionalDate(prefix+".due_date", n.DueDate)...)
+		errs = append(errs, validateOptionalDeadline(prefix+".due_date", n.DueDate)...)
 		errs = append(errs, validateOptionalDate(prefix+".not_before", n.NotBefore)...)
 		errs = append(errs, validateOptionalDate(prefix+".not_after", n.NotAfter)...)
 	}
@@ -198,7 +198,7 @@ func validateWorkItems(items []WorkItemImport, nodeRefs map[string]bool, wiRefs
 			errs = append(errs, validateSessionPolicy(prefix+".session_policy", wi.SessionPolicy)...)
 		}
 
-		errs = append(errs, validateOptionalDate(prefix+".due_date", wi.DueDate)...)
+		errs = append(errs, validateOptionalDeadline(prefix+".due_date", wi.DueDate)...)
 		errs = append(errs, validateOptionalDate(prefix+".not_before", wi.NotBefore)...)
 	}
 
@@ -294,3 +294,15 @@ func validateOptionalDate(field string, dateStr *string) []error {
 	}
 	return nil
 }
+
+// validateOptionalDeadline is validateOptionalDate for deadlines, which may
+// also carry a time of day ("YYYY-MM-DD HH:MM").
+func validateOptionalDeadline(field string, dateStr *string) []error {
+	if dateStr == nil || *dateStr == "" {
+		return nil
+	}
+	if _, err := domain.ParseDeadline(*dateStr); err != nil {
+		return []error{fmt.Errorf("%s: invalid date format %q (expected YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")", field, *dateStr)}
+	}
+	return nil
+}
@@ -123,6 +123,14 @@ func TestValidateImportSchema_InvalidDates(t *testing.T) {
 	}
 }
 
+func TestValidateImportSchema_DeadlineTimeOfDay(t *testing.T) {
+	s := validMinimalSchema()
+	s.Project.TargetDate = ptrStr("2026-12-31 18:00")
+	s.Nodes[0].DueDate = ptrStr("2026-06-01 09:00")
+	s.WorkItems[0].DueDate = ptrStr("2026-05-01 17:30")
+	assert.Empty(t, ValidateImportSchema(s))
+}
+
 func TestValidateImportSchema_DuplicateNodeRef(t *testing.T) {
 	s := validMinimalSchema()
 	s.Nodes = append(s.Nodes, NodeImport{Ref: "n1", Title: "Dup", Kind: "module"})
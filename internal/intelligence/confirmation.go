@@ -1,5 +1,20 @@
 package intelligence
 
+// destructiveIntents are write intents that archive or delete data. The
+// shell always shows a confirmation preview for these before running them.
+var destructiveIntents = map[IntentName]bool{
+	IntentProjectArchive: true,
+	IntentProjectRemove:  true,
+	IntentNodeRemove:     true,
+	IntentWorkRemove:     true,
+	IntentSessionRemove:  true,
+}
+
+// IsDestructiveIntent returns true if the intent archives or deletes data.
+func IsDestructiveIntent(name IntentName) bool {
+	return destructiveIntents[name]
+}
+
 // EnforceWriteSafety ensures that any write intent has the correct
 // risk and requires_confirmation flags, regardless of what the LLM produced.
 // This is a hard safety boundary — LLM output cannot bypass it.
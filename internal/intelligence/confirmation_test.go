@@ -103,3 +103,16 @@ func TestIsWriteIntent(t *testing.T) {
 		assert.False(t, IsWriteIntent(intent), "expected %s to be read_only", intent)
 	}
 }
+
+func TestIsDestructiveIntent(t *testing.T) {
+	for _, intent := range []IntentName{
+		IntentProjectArchive, IntentProjectRemove, IntentNodeRemove,
+		IntentWorkRemove, IntentSessionRemove,
+	} {
+		assert.True(t, IsDestructiveIntent(intent), "expected %s to be destructive", intent)
+		assert.True(t, IsWriteIntent(intent), "destructive intent %s must also be write", intent)
+	}
+	for _, intent := range []IntentName{IntentProjectAdd, IntentWorkDone, IntentSessionLog, IntentStatus} {
+		assert.False(t, IsDestructiveIntent(intent), "expected %s not to be destructive", intent)
+	}
+}
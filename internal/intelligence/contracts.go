@@ -242,6 +242,10 @@ type LLMExplanation struct {
 	Counterfactuals []Counterfactual    `json:"counterfactuals,omitempty"`
 	Confidence      float64             `json:"confidence"`
 	Source          string              `json:"source"` // "llm" or "deterministic"
+
+	// Unavailable is set when the model server could not be reached, so the
+	// deterministic explanation stands in for the LLM one.
+	Unavailable bool `json:"-"`
 }
 
 // TemplateDraft is the result of LLM-assisted template generation.
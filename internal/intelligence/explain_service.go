@@ -3,6 +3,7 @@ package intelligence
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/alexanderramin/kairos/internal/llm"
 )
@@ -83,7 +84,9 @@ func (s *explainService) generateExplanation(
 		UserPrompt:   string(dataJSON),
 	})
 	if err != nil {
-		return useFallback()
+		result, _ := useFallback()
+		result.Unavailable = errors.Is(err, llm.ErrLLMUnavailable)
+		return result, nil
 	}
 
 	explanation, err := llm.ExtractJSON[LLMExplanation](resp.Text, nil)
@@ -44,7 +44,7 @@ func testTrace() RecommendationTrace {
 }
 
 func TestExplainNow_FallbackWhenLLMDown(t *testing.T) {
-	client := &mockLLMClient{err: llm.ErrOllamaUnavailable}
+	client := &mockLLMClient{err: llm.ErrLLMUnavailable}
 	svc := NewExplainService(client, llm.NoopObserver{})
 	trace := testTrace()
 
@@ -55,6 +55,7 @@ func TestExplainNow_FallbackWhenLLMDown(t *testing.T) {
 	assert.Equal(t, float64(1.0), explanation.Confidence)
 	assert.NotEmpty(t, explanation.SummaryShort)
 	assert.NotEmpty(t, explanation.Factors)
+	assert.True(t, explanation.Unavailable, "an unreachable server should be flagged")
 }
 
 func TestExplainNow_FallbackOnInvalidEvidence(t *testing.T) {
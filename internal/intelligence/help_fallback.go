@@ -5,18 +5,45 @@ import (
 	"strings"
 )
 
+// contextCues are question words that ask about the current view rather
+// than a command ("what can I do here?").
+var contextCues = map[string]bool{"here": true, "this": true, "current": true}
+
 // DeterministicHelp produces a help answer without LLM by fuzzy-matching
-// the question against the command spec and glossary.
-func DeterministicHelp(question string, commands []HelpCommandInfo) *HelpAnswer {
+// the question against the command spec and glossary. With a context
+// (which may be nil), an empty question or one about "here" lists the
+// commands for the current view, and otherwise commands for the view win
+// ties.
+func DeterministicHelp(question string, commands []HelpCommandInfo, hctx *HelpContext) *HelpAnswer {
 	terms := strings.Fields(strings.ToLower(question))
+	if hctx != nil && len(hctx.Commands) > 0 {
+		asksHere := len(terms) == 0
+		for _, term := range terms {
+			if contextCues[strings.Trim(term, "?!.,")] {
+				asksHere = true
+				break
+			}
+		}
+		if asksHere {
+			return contextHelpAnswer(hctx, commands)
+		}
+	}
 	if len(terms) == 0 {
 		return defaultHelpAnswer(commands)
 	}
 
+	inContext := make(map[string]bool)
+	if hctx != nil {
+		for _, path := range hctx.Commands {
+			inContext[path] = true
+		}
+	}
+
 	// Score each command by how many query terms match its path or description.
 	type scored struct {
-		cmd  HelpCommandInfo
-		hits int
+		cmd       HelpCommandInfo
+		hits      int
+		inContext bool
 	}
 	var matches []scored
 	for _, cmd := range commands {
@@ -29,14 +56,15 @@ func DeterministicHelp(question string, commands []HelpCommandInfo) *HelpAnswer
 			}
 		}
 		if hits > 0 {
-			matches = append(matches, scored{cmd: cmd, hits: hits})
+			matches = append(matches, scored{cmd: cmd, hits: hits, inContext: inContext[cmd.FullPath]})
 		}
 	}
 
-	// Sort by hits descending.
+	// Sort by hits descending, commands for the current view first on ties.
 	for i := 0; i < len(matches); i++ {
 		for j := i + 1; j < len(matches); j++ {
-			if matches[j].hits > matches[i].hits {
+			if matches[j].hits > matches[i].hits ||
+				(matches[j].hits == matches[i].hits && matches[j].inContext && !matches[i].inContext) {
 				matches[i], matches[j] = matches[j], matches[i]
 			}
 		}
@@ -126,3 +154,36 @@ func defaultHelpAnswer(commands []HelpCommandInfo) *HelpAnswer {
 		Source:       "deterministic",
 	}
 }
+
+// contextHelpAnswer lists the commands for the current view, in the
+// context's order, with their descriptions from the spec.
+func contextHelpAnswer(hctx *HelpContext, commands []HelpCommandInfo) *HelpAnswer {
+	byPath := make(map[string]HelpCommandInfo, len(commands))
+	for _, cmd := range commands {
+		byPath[cmd.FullPath] = cmd
+	}
+	var examples []ShellExample
+	for _, path := range hctx.Commands {
+		if cmd, ok := byPath[path]; ok {
+			examples = append(examples, ShellExample{Command: cmd.FullPath, Description: cmd.Short})
+		}
+	}
+
+	var answer strings.Builder
+	answer.WriteString("You're on the " + hctx.View)
+	if hctx.Item != "" {
+		answer.WriteString(" for " + hctx.Item)
+	}
+	if hctx.Project != "" {
+		answer.WriteString(" in " + hctx.Project)
+	}
+	answer.WriteString(". From here you can:")
+
+	return &HelpAnswer{
+		Answer:       answer.String(),
+		Examples:     examples,
+		NextCommands: []string{"kairos status", "kairos what-now", "kairos help"},
+		Confidence:   1.0,
+		Source:       "deterministic",
+	}
+}
@@ -17,7 +17,7 @@ func testHelpCommands() []HelpCommandInfo {
 }
 
 func TestDeterministicHelp_CommandMatch(t *testing.T) {
-	answer := DeterministicHelp("how do I log a session?", testHelpCommands())
+	answer := DeterministicHelp("how do I log a session?", testHelpCommands(), nil)
 
 	assert.Equal(t, "deterministic", answer.Source)
 	assert.Equal(t, 1.0, answer.Confidence)
@@ -33,17 +33,49 @@ func TestDeterministicHelp_CommandMatch(t *testing.T) {
 }
 
 func TestDeterministicHelp_GlossaryMatch(t *testing.T) {
-	answer := DeterministicHelp("what is session policy?", testHelpCommands())
+	answer := DeterministicHelp("what is session policy?", testHelpCommands(), nil)
 
 	assert.Contains(t, answer.Answer, "session_policy:")
 	assert.Equal(t, "deterministic", answer.Source)
 }
 
 func TestDeterministicHelp_DefaultForEmptyQuestion(t *testing.T) {
-	answer := DeterministicHelp("   ", testHelpCommands())
+	answer := DeterministicHelp("   ", testHelpCommands(), nil)
 
 	assert.Equal(t, "deterministic", answer.Source)
 	assert.Equal(t, 1.0, answer.Confidence)
 	assert.Contains(t, answer.Answer, "common commands")
 	require.NotEmpty(t, answer.NextCommands)
 }
+
+func TestDeterministicHelp_ContextListsCommandsForView(t *testing.T) {
+	hctx := &HelpContext{
+		View:     "action menu",
+		Project:  "PHI01 Philosophy",
+		Item:     "#3 Reading",
+		Commands: []string{"kairos session log", "kairos status", "kairos not-in-spec"},
+	}
+
+	answer := DeterministicHelp("what can I do here?", testHelpCommands(), hctx)
+	assert.Equal(t, "You're on the action menu for #3 Reading in PHI01 Philosophy. From here you can:", answer.Answer)
+	require.Len(t, answer.Examples, 2, "commands missing from the spec are dropped")
+	assert.Equal(t, "kairos session log", answer.Examples[0].Command)
+	assert.Equal(t, "Log a work session", answer.Examples[0].Description)
+
+	empty := DeterministicHelp("", testHelpCommands(), hctx)
+	assert.Equal(t, answer.Answer, empty.Answer, "an empty question describes the view")
+}
+
+func TestDeterministicHelp_ContextWinsTies(t *testing.T) {
+	commands := []HelpCommandInfo{
+		{FullPath: "review weekly", Short: "Show the week"},
+		{FullPath: "weekly plan", Short: "Plan the week"},
+	}
+	answer := DeterministicHelp("week", commands, nil)
+	require.NotEmpty(t, answer.Examples)
+	assert.Equal(t, "review weekly", answer.Examples[0].Command)
+
+	answer = DeterministicHelp("week", commands, &HelpContext{View: "dashboard", Commands: []string{"weekly plan"}})
+	require.NotEmpty(t, answer.Examples)
+	assert.Equal(t, "weekly plan", answer.Examples[0].Command)
+}
@@ -27,7 +27,8 @@ CRITICAL RULES:
 7. If the user asks about project status, suggest "kairos status".
 8. Provide 1-3 examples maximum. Quality over quantity.
 9. next_commands should be read-only/safe by default. Only suggest write commands if the user explicitly asks about creating or modifying data.
-10. Output ONLY the JSON object, no markdown fences, no text before or after.`
+10. Output ONLY the JSON object, no markdown fences, no text before or after.
+11. If a Current Context section is given, the user is on that view with that project and item active. For "what can I do here?" questions, answer from the commands listed for the view, and prefer them when several commands fit.`
 
 // buildHelpSystemPrompt substitutes the glossary into the system prompt template.
 func buildHelpSystemPrompt() string {
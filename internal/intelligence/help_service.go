@@ -29,21 +29,44 @@ type ShellExample struct {
 type HelpCommandInfo struct {
 	FullPath string
 	Short    string
+	Flags    []HelpFlagInfo
+	Examples []string
+}
+
+// HelpFlagInfo describes one flag of a HelpCommandInfo.
+type HelpFlagInfo struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// HelpContext is where the user is when asking for help: the view on
+// screen, the active project and work item, and the command paths that act
+// on them there, most relevant first. Help answers "what can I do here?"
+// from it and ranks those commands first.
+type HelpContext struct {
+	View     string
+	Project  string // e.g. "PHI01 Philosophy"; empty when none is active
+	Item     string // e.g. "#3 Reading"; empty when none is active
+	Commands []string
 }
 
 // HelpConversation holds multi-turn help chat state.
 type HelpConversation struct {
 	Turns       []ConversationTurn // reuse ConversationTurn from project_draft
 	CommandSpec string             // serialized spec, stored once
+	Context     *HelpContext       // where the chat was opened; nil if unknown
 }
 
 // HelpService answers user questions about using Kairos.
 type HelpService interface {
-	// Ask handles a one-shot help question.
-	Ask(ctx context.Context, question, commandSpec string) (*HelpAnswer, error)
+	// Ask handles a one-shot help question. hctx may be nil.
+	Ask(ctx context.Context, question, commandSpec string, hctx *HelpContext) (*HelpAnswer, error)
 
-	// StartChat begins an interactive help conversation.
-	StartChat(ctx context.Context, question, commandSpec string) (*HelpConversation, *HelpAnswer, error)
+	// StartChat begins an interactive help conversation, keeping hctx
+	// (which may be nil) for its later turns.
+	StartChat(ctx context.Context, question, commandSpec string, hctx *HelpContext) (*HelpConversation, *HelpAnswer, error)
 
 	// NextTurn continues an interactive help conversation.
 	NextTurn(ctx context.Context, conv *HelpConversation, question string) (*HelpAnswer, error)
@@ -67,16 +90,17 @@ type helpLLMResponse struct {
 	Confidence   float64        `json:"confidence"`
 }
 
-func (s *helpService) Ask(ctx context.Context, question, commandSpec string) (*HelpAnswer, error) {
-	return s.resolveWithFallback(ctx, nil, question, commandSpec), nil
+func (s *helpService) Ask(ctx context.Context, question, commandSpec string, hctx *HelpContext) (*HelpAnswer, error) {
+	return s.resolveWithFallback(ctx, nil, question, commandSpec, hctx), nil
 }
 
-func (s *helpService) StartChat(ctx context.Context, question, commandSpec string) (*HelpConversation, *HelpAnswer, error) {
+func (s *helpService) StartChat(ctx context.Context, question, commandSpec string, hctx *HelpContext) (*HelpConversation, *HelpAnswer, error) {
 	conv := &HelpConversation{
 		CommandSpec: commandSpec,
+		Context:     hctx,
 	}
 
-	answer := s.resolveWithFallback(ctx, conv, question, commandSpec)
+	answer := s.resolveWithFallback(ctx, conv, question, commandSpec, hctx)
 
 	// Record conversation turns.
 	conv.Turns = append(conv.Turns,
@@ -91,7 +115,7 @@ func (s *helpService) NextTurn(ctx context.Context, conv *HelpConversation, ques
 	if conv == nil {
 		return nil, fmt.Errorf("conversation is nil")
 	}
-	answer := s.resolveWithFallback(ctx, conv, question, conv.CommandSpec)
+	answer := s.resolveWithFallback(ctx, conv, question, conv.CommandSpec, conv.Context)
 
 	// Append turns.
 	conv.Turns = append(conv.Turns,
@@ -102,19 +126,19 @@ func (s *helpService) NextTurn(ctx context.Context, conv *HelpConversation, ques
 	return answer, nil
 }
 
-func (s *helpService) resolveWithFallback(ctx context.Context, conv *HelpConversation, question, commandSpec string) *HelpAnswer {
+func (s *helpService) resolveWithFallback(ctx context.Context, conv *HelpConversation, question, commandSpec string, hctx *HelpContext) *HelpAnswer {
 	commandInfos, validCmds, validFlags := parseHelpCommandSpec(commandSpec)
 
-	userPrompt := buildHelpUserPrompt(conv, question, commandSpec)
+	userPrompt := buildHelpUserPrompt(conv, question, commandSpec, hctx)
 	answer, err := s.generate(ctx, userPrompt)
 	if err != nil {
-		return DeterministicHelp(question, commandInfos)
+		return DeterministicHelp(question, commandInfos, hctx)
 	}
 
 	answer, groundingStripped := ValidateHelpGrounding(answer, validCmds, validFlags)
 	if groundingStripped && len(answer.Examples) == 0 && len(answer.NextCommands) == 0 {
 		// LLM produced only hallucinated commands; fall back to deterministic.
-		fallback := DeterministicHelp(question, commandInfos)
+		fallback := DeterministicHelp(question, commandInfos, hctx)
 		if strings.TrimSpace(answer.Answer) == "" {
 			answer.Answer = fallback.Answer
 		}
@@ -153,7 +177,7 @@ func (s *helpService) generate(ctx context.Context, userPrompt string) (*HelpAns
 	}, nil
 }
 
-func buildHelpUserPrompt(conv *HelpConversation, question, commandSpec string) string {
+func buildHelpUserPrompt(conv *HelpConversation, question, commandSpec string, hctx *HelpContext) string {
 	var b strings.Builder
 
 	// Include conversation history for multi-turn.
@@ -169,6 +193,19 @@ func buildHelpUserPrompt(conv *HelpConversation, question, commandSpec string) s
 
 	b.WriteString("## Command Specification\n")
 	b.WriteString(commandSpec)
+	if hctx != nil {
+		b.WriteString("\n\n## Current Context")
+		b.WriteString("\nView: " + hctx.View)
+		if hctx.Project != "" {
+			b.WriteString("\nActive project: " + hctx.Project)
+		}
+		if hctx.Item != "" {
+			b.WriteString("\nActive work item: " + hctx.Item)
+		}
+		if len(hctx.Commands) > 0 {
+			b.WriteString("\nCommands for this view: " + strings.Join(hctx.Commands, ", "))
+		}
+	}
 	b.WriteString("\n\n## User Question\n")
 	b.WriteString(question)
 
@@ -19,9 +19,9 @@ const testHelpCommandSpec = `{
 }`
 
 func TestHelpServiceAsk_FallbackWhenLLMUnavailable(t *testing.T) {
-	svc := NewHelpService(&mockLLMClient{err: llm.ErrOllamaUnavailable}, llm.NoopObserver{})
+	svc := NewHelpService(&mockLLMClient{err: llm.ErrLLMUnavailable}, llm.NoopObserver{})
 
-	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec)
+	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec, nil)
 
 	require.NoError(t, err)
 	assert.Equal(t, "deterministic", answer.Source)
@@ -39,7 +39,7 @@ func TestHelpServiceAsk_ValidLLMResponse(t *testing.T) {
 	}
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec)
+	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec, nil)
 
 	require.NoError(t, err)
 	assert.Equal(t, "llm", answer.Source)
@@ -58,7 +58,7 @@ func TestHelpServiceAsk_InvalidGroundingFallsBack(t *testing.T) {
 	}
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	answer, err := svc.Ask(context.Background(), "status", testHelpCommandSpec)
+	answer, err := svc.Ask(context.Background(), "status", testHelpCommandSpec, nil)
 
 	require.NoError(t, err)
 	assert.Equal(t, "deterministic", answer.Source)
@@ -76,7 +76,7 @@ func TestHelpServiceStartChatAndNextTurn(t *testing.T) {
 	}
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	conv, first, err := svc.StartChat(context.Background(), "what should I do now?", testHelpCommandSpec)
+	conv, first, err := svc.StartChat(context.Background(), "what should I do now?", testHelpCommandSpec, nil)
 	require.NoError(t, err)
 	require.NotNil(t, conv)
 	assert.Equal(t, "llm", first.Source)
@@ -86,3 +86,16 @@ func TestHelpServiceStartChatAndNextTurn(t *testing.T) {
 	assert.NotNil(t, next)
 	assert.GreaterOrEqual(t, len(conv.Turns), 4)
 }
+
+func TestBuildHelpUserPrompt_IncludesContext(t *testing.T) {
+	prompt := buildHelpUserPrompt(nil, "what can I do here?", testHelpCommandSpec, &HelpContext{
+		View:     "task list",
+		Project:  "PHI01 Philosophy",
+		Commands: []string{"kairos session log", "kairos status"},
+	})
+	assert.Contains(t, prompt, "## Current Context\nView: task list\nActive project: PHI01 Philosophy\n"+
+		"Commands for this view: kairos session log, kairos status\n\n## User Question\nwhat can I do here?")
+	assert.NotContains(t, prompt, "Active work item")
+
+	assert.NotContains(t, buildHelpUserPrompt(nil, "hi", testHelpCommandSpec, nil), "Current Context")
+}
@@ -51,7 +51,7 @@ func TestHelpService_Ask_WithHTTPTestServer(t *testing.T) {
 	client := llm.NewOllamaClient(cfg, llm.NoopObserver{})
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec)
+	answer, err := svc.Ask(context.Background(), "how do I check status?", testHelpCommandSpec, nil)
 	require.NoError(t, err)
 
 	assert.Equal(t, "llm", answer.Source)
@@ -96,7 +96,7 @@ func TestHelpService_Ask_HallucinatedCommand_WithHTTPTestServer(t *testing.T) {
 	client := llm.NewOllamaClient(cfg, llm.NoopObserver{})
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	answer, err := svc.Ask(context.Background(), "how do I deploy?", testHelpCommandSpec)
+	answer, err := svc.Ask(context.Background(), "how do I deploy?", testHelpCommandSpec, nil)
 	require.NoError(t, err)
 
 	// Grounding validation should reject the hallucinated "deploy" command
@@ -140,7 +140,7 @@ func TestHelpService_Chat_WithHTTPTestServer(t *testing.T) {
 	client := llm.NewOllamaClient(cfg, llm.NoopObserver{})
 	svc := NewHelpService(client, llm.NoopObserver{})
 
-	conv, first, err := svc.StartChat(context.Background(), "what should I do?", testHelpCommandSpec)
+	conv, first, err := svc.StartChat(context.Background(), "what should I do?", testHelpCommandSpec, nil)
 	require.NoError(t, err)
 	require.NotNil(t, conv)
 	assert.Equal(t, "llm", first.Source)
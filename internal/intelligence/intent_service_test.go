@@ -105,7 +105,7 @@ func TestIntentService_Parse_ArgSchemaRejection(t *testing.T) {
 }
 
 func TestIntentService_Parse_LLMUnavailable(t *testing.T) {
-	client := &mockLLMClient{err: llm.ErrOllamaUnavailable}
+	client := &mockLLMClient{err: llm.ErrLLMUnavailable}
 
 	svc := NewIntentService(client, llm.NoopObserver{}, DefaultConfirmationPolicy(0.85))
 	_, err := svc.Parse(context.Background(), "what now?")
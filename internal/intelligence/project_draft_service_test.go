@@ -178,7 +178,7 @@ func TestProjectDraftService_PreservesDraftOnNilReturn(t *testing.T) {
 }
 
 func TestProjectDraftService_LLMError(t *testing.T) {
-	client := &draftMockClient{err: llm.ErrOllamaUnavailable}
+	client := &draftMockClient{err: llm.ErrLLMUnavailable}
 	svc := NewProjectDraftService(client, llm.NoopObserver{})
 
 	_, err := svc.Start(context.Background(), "physics")
@@ -168,7 +168,7 @@ func TestTemplateDraftService_InvalidTemplate_NoNodes(t *testing.T) {
 }
 
 func TestTemplateDraftService_LLMError(t *testing.T) {
-	client := &templateDraftMockClient{err: llm.ErrOllamaUnavailable}
+	client := &templateDraftMockClient{err: llm.ErrLLMUnavailable}
 	svc := NewTemplateDraftService(client, llm.NoopObserver{})
 
 	_, err := svc.Draft(context.Background(), "Create a template")
@@ -110,20 +110,33 @@ func (c *ollamaClient) Generate(ctx context.Context, req GenerateRequest) (*Gene
 		},
 	}
 
+	c.observer.OnCallStart(LLMCallEvent{Task: req.Task, Model: c.cfg.Model})
+
 	var lastErr error
-	attempts := 1 + c.cfg.MaxRetries
+	maxAttempts := 1 + c.cfg.MaxRetries
+	attempt := 0
 
-	for i := 0; i < attempts; i++ {
+	for attempt < maxAttempts {
+		attempt++
+		attemptStart := time.Now()
 		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout)
 		resp, err := c.doRequest(attemptCtx, body)
 		cancel()
 		if err == nil {
+			c.observer.OnAttempt(LLMCallEvent{
+				Task:      req.Task,
+				Model:     c.cfg.Model,
+				LatencyMs: time.Since(attemptStart).Milliseconds(),
+				Success:   true,
+				Attempt:   attempt,
+			})
 			latency := time.Since(start).Milliseconds()
 			c.observer.OnCallComplete(LLMCallEvent{
 				Task:      req.Task,
 				Model:     c.cfg.Model,
 				LatencyMs: latency,
 				Success:   true,
+				Attempt:   attempt,
 			})
 			return &GenerateResponse{
 				Text:      resp.Response,
@@ -133,31 +146,79 @@ func (c *ollamaClient) Generate(ctx context.Context, req GenerateRequest) (*Gene
 		}
 		lastErr = err
 
-		// Parent context cancellation should stop retries immediately.
-		if ctx.Err() != nil {
+		// Parent context cancellation stops retries immediately, and a
+		// rejected request would only be rejected again.
+		retrying := attempt < maxAttempts && ctx.Err() == nil && isRetryable(err)
+		c.observer.OnAttempt(LLMCallEvent{
+			Task:      req.Task,
+			Model:     c.cfg.Model,
+			LatencyMs: time.Since(attemptStart).Milliseconds(),
+			ErrorCode: errorCode(err),
+			Attempt:   attempt,
+			Retrying:  retrying,
+		})
+		if !retrying || !sleepCtx(ctx, c.retryDelay(attempt)) {
 			break
 		}
 	}
 
 	latency := time.Since(start).Milliseconds()
 	errCode := errorCode(lastErr)
+	if errors.Is(ctx.Err(), context.Canceled) {
+		errCode = errCodeCancelled
+	}
 	c.observer.OnCallComplete(LLMCallEvent{
 		Task:      req.Task,
 		Model:     c.cfg.Model,
 		LatencyMs: latency,
 		Success:   false,
 		ErrorCode: errCode,
+		Attempt:   attempt,
 	})
 
+	if errCode == errCodeCancelled {
+		return nil, ErrCancelled
+	}
 	if ctx.Err() != nil || isTimeoutError(lastErr) {
 		return nil, ErrTimeout
 	}
 	if isConnectionError(lastErr) {
-		return nil, ErrOllamaUnavailable
+		return nil, ErrLLMUnavailable
+	}
+	if errors.Is(lastErr, ErrRequestRejected) {
+		return nil, lastErr
 	}
 	return nil, fmt.Errorf("%w: %v", ErrRetryExhausted, lastErr)
 }
 
+// maxRetryBackoff caps the exponential delay between attempts.
+const maxRetryBackoff = 4 * time.Second
+
+// retryDelay is the wait after the given failed attempt: RetryBackoffMs,
+// doubled for each attempt after the first, capped at maxRetryBackoff.
+func (c *ollamaClient) retryDelay(attempt int) time.Duration {
+	delay := time.Duration(c.cfg.RetryBackoffMs) * time.Millisecond
+	for i := 1; i < attempt && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryBackoff)
+}
+
+// sleepCtx waits for d, returning false if ctx ends first.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func (c *ollamaClient) doRequest(ctx context.Context, body ollamaRequest) (*ollamaResponse, error) {
 	data, err := json.Marshal(body)
 	if err != nil {
@@ -183,7 +244,11 @@ func (c *ollamaClient) doRequest(ctx context.Context, body ollamaRequest) (*olla
 	}
 
 	if httpResp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("ollama returned status %d: %s", httpResp.StatusCode, string(respBody))
+		err := fmt.Errorf("ollama returned status %d: %s", httpResp.StatusCode, strings.TrimSpace(string(respBody)))
+		if !retryableStatus(httpResp.StatusCode) {
+			err = fmt.Errorf("%w: %v", ErrRequestRejected, err)
+		}
+		return nil, err
 	}
 
 	var resp ollamaResponse
@@ -218,27 +283,47 @@ func (c *ollamaClient) Available(ctx context.Context) bool {
 	return resp.StatusCode == http.StatusOK
 }
 
+// retryableStatus reports whether an HTTP status from Ollama may succeed on
+// a later attempt: server errors, 408 and 429. Other 4xx responses, such as
+// 404 for an unknown model, mean the request itself is wrong.
+func retryableStatus(code int) bool {
+	return code >= 500 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+}
+
+// isRetryable reports whether a failed attempt is worth repeating. Timeouts,
+// connection errors, server errors and empty or partial responses are;
+// rejected requests are not.
+func isRetryable(err error) bool {
+	return !errors.Is(err, ErrRequestRejected)
+}
+
 func isConnectionError(err error) bool {
 	if err == nil {
 		return false
 	}
-	var netErr *net.OpError
-	if errors.As(err, &netErr) {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
 		return true
 	}
-	return false
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
 }
 
+// errCodeCancelled is the LLMCallEvent.ErrorCode for caller-cancelled calls.
+const errCodeCancelled = "CANCELLED"
+
 func errorCode(err error) string {
 	switch {
 	case err == nil:
 		return ""
 	case isTimeoutError(err) || errors.Is(err, ErrTimeout):
 		return "TIMEOUT"
-	case isConnectionError(err) || errors.Is(err, ErrOllamaUnavailable):
+	case isConnectionError(err) || errors.Is(err, ErrLLMUnavailable):
 		return "UNAVAILABLE"
 	case errors.Is(err, ErrInvalidOutput):
 		return "INVALID_OUTPUT"
+	case errors.Is(err, ErrRequestRejected):
+		return "REJECTED"
 	case errors.Is(err, ErrRetryExhausted):
 		return "RETRY_EXHAUSTED"
 	default:
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -103,7 +104,7 @@ func TestOllamaClient_Generate_Unavailable(t *testing.T) {
 		UserPrompt: "test",
 	})
 
-	assert.ErrorIs(t, err, ErrOllamaUnavailable)
+	assert.ErrorIs(t, err, ErrLLMUnavailable)
 }
 
 func TestOllamaClient_Generate_RetryOnTransientError(t *testing.T) {
@@ -165,8 +166,8 @@ func TestOllamaClient_Generate_RetryAfterTimeout(t *testing.T) {
 
 func TestOllamaClient_Generate_ServerError(t *testing.T) {
 	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte("bad request"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("loading model"))
 	}))
 	defer srv.Close()
 
@@ -183,6 +184,89 @@ func TestOllamaClient_Generate_ServerError(t *testing.T) {
 	assert.ErrorIs(t, err, ErrRetryExhausted)
 }
 
+func TestOllamaClient_Generate_RejectedRequestFailsFast(t *testing.T) {
+	var attempts atomic.Int32
+	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"model 'llama9' not found"}`))
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxRetries = 3
+
+	var completed LLMCallEvent
+	obs := &captureObserver{fn: func(e LLMCallEvent) { completed = e }}
+	client := NewOllamaClient(cfg, obs)
+	_, err := client.Generate(context.Background(), GenerateRequest{
+		Task:       TaskParse,
+		UserPrompt: "test",
+	})
+
+	assert.ErrorIs(t, err, ErrRequestRejected)
+	assert.Contains(t, err.Error(), "not found")
+	assert.Equal(t, int32(1), attempts.Load())
+	assert.Equal(t, "REJECTED", completed.ErrorCode)
+	assert.Equal(t, 1, completed.Attempt)
+}
+
+func TestOllamaClient_Generate_RetriesWithBackoffAndReportsAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(ollamaResponse{Model: "llama3.2", Response: "ok"})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxRetries = 2
+	cfg.RetryBackoffMs = 20
+
+	var perAttempt []LLMCallEvent
+	var completed LLMCallEvent
+	obs := &captureObserver{
+		onAttempt: func(e LLMCallEvent) { perAttempt = append(perAttempt, e) },
+		fn:        func(e LLMCallEvent) { completed = e },
+	}
+	client := NewOllamaClient(cfg, obs)
+
+	start := time.Now()
+	resp, err := client.Generate(context.Background(), GenerateRequest{
+		Task:       TaskParse,
+		UserPrompt: "test",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp.Text)
+	assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond, "waits 20ms then 40ms between attempts")
+
+	require.Len(t, perAttempt, 3)
+	for i, e := range perAttempt[:2] {
+		assert.Equal(t, i+1, e.Attempt)
+		assert.False(t, e.Success)
+		assert.True(t, e.Retrying)
+		assert.Equal(t, "UNKNOWN", e.ErrorCode)
+	}
+	assert.True(t, perAttempt[2].Success)
+	assert.True(t, completed.Success)
+	assert.Equal(t, 3, completed.Attempt)
+}
+
+func TestOllamaClient_RetryDelayDoublesUpToCap(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RetryBackoffMs = 1000
+	c := NewOllamaClient(cfg, nil).(*ollamaClient)
+
+	assert.Equal(t, time.Second, c.retryDelay(1))
+	assert.Equal(t, 2*time.Second, c.retryDelay(2))
+	assert.Equal(t, 4*time.Second, c.retryDelay(3))
+	assert.Equal(t, maxRetryBackoff, c.retryDelay(10))
+}
+
 func TestOllamaClient_Available_True(t *testing.T) {
 	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/api/tags", r.URL.Path)
@@ -298,8 +382,67 @@ func TestOllamaClient_Generate_MissingResponseField(t *testing.T) {
 	assert.ErrorIs(t, err, ErrRetryExhausted)
 }
 
+func TestOllamaClient_CancelledContext(t *testing.T) {
+	release := make(chan struct{})
+	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	var started int
+	var captured LLMCallEvent
+	obs := &captureObserver{
+		onStart: func(LLMCallEvent) { started++ },
+		fn:      func(e LLMCallEvent) { captured = e },
+	}
+	client := NewOllamaClient(testConfig(srv.URL), obs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	_, err := client.Generate(ctx, GenerateRequest{Task: TaskParse, UserPrompt: "test"})
+
+	assert.ErrorIs(t, err, ErrCancelled)
+	assert.Equal(t, 1, started)
+	assert.False(t, captured.Success)
+	assert.Equal(t, "CANCELLED", captured.ErrorCode)
+}
+
+func TestLogObserver_StartAndCancel(t *testing.T) {
+	var buf strings.Builder
+	obs := NewLogObserver(&buf)
+
+	obs.OnCallStart(LLMCallEvent{Task: TaskHelp, Model: "llama3.2"})
+	obs.OnAttempt(LLMCallEvent{Task: TaskHelp, Model: "llama3.2", Attempt: 1, ErrorCode: "TIMEOUT", Retrying: true})
+	obs.OnCallComplete(LLMCallEvent{Task: TaskHelp, Model: "llama3.2", ErrorCode: "CANCELLED"})
+
+	out := buf.String()
+	assert.Contains(t, out, "llm_call_start task=help model=llama3.2")
+	assert.Contains(t, out, "attempt=1 latency_ms=0 status=err:TIMEOUT retrying=true")
+	assert.Contains(t, out, "status=cancelled")
+}
+
 type captureObserver struct {
-	fn func(LLMCallEvent)
+	onStart   func(LLMCallEvent)
+	onAttempt func(LLMCallEvent)
+	fn        func(LLMCallEvent)
+}
+
+func (o *captureObserver) OnCallStart(e LLMCallEvent) {
+	if o.onStart != nil {
+		o.onStart(e)
+	}
 }
 
+func (o *captureObserver) OnAttempt(e LLMCallEvent) {
+	if o.onAttempt != nil {
+		o.onAttempt(e)
+	}
+}
 func (o *captureObserver) OnCallComplete(e LLMCallEvent) { o.fn(e) }
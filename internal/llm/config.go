@@ -29,8 +29,9 @@ type LLMConfig struct {
 	LogCalls            bool
 	Endpoint            string
 	Model               string
-	TimeoutMs           int
-	MaxRetries          int
+	TimeoutMs           int // per attempt; cancels the in-flight request
+	MaxRetries          int // extra attempts after a transient failure
+	RetryBackoffMs      int // delay before the first retry, doubled for each one after
 	ConfidenceThreshold float64
 	Tasks               map[TaskType]TaskConfig
 }
@@ -45,6 +46,7 @@ func DefaultConfig() LLMConfig {
 		Model:               "llama3.2",
 		TimeoutMs:           10000,
 		MaxRetries:          1,
+		RetryBackoffMs:      250,
 		ConfidenceThreshold: 0.85,
 		Tasks: map[TaskType]TaskConfig{
 			TaskParse:         {Temperature: 0.1, MaxTokens: 512, TimeoutMs: 10000},
@@ -83,6 +85,11 @@ func LoadConfig() LLMConfig {
 			cfg.MaxRetries = n
 		}
 	}
+	if v := os.Getenv("KAIROS_LLM_RETRY_BACKOFF_MS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.RetryBackoffMs = n
+		}
+	}
 	if v := os.Getenv("KAIROS_LLM_CONFIDENCE_THRESHOLD"); v != "" {
 		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 0 && f <= 1 {
 			cfg.ConfidenceThreshold = f
@@ -32,6 +32,21 @@ func TestLoadConfig_InvalidTaskTimeoutOverrideIgnored(t *testing.T) {
 	assert.Equal(t, 10000, cfg.TaskTimeout(TaskParse))
 }
 
+func TestLoadConfig_RetryOverrides(t *testing.T) {
+	cfg := LoadConfig()
+	assert.Equal(t, 1, cfg.MaxRetries)
+	assert.Equal(t, 250, cfg.RetryBackoffMs)
+
+	t.Setenv("KAIROS_LLM_MAX_RETRIES", "3")
+	t.Setenv("KAIROS_LLM_RETRY_BACKOFF_MS", "0")
+	cfg = LoadConfig()
+	assert.Equal(t, 3, cfg.MaxRetries)
+	assert.Equal(t, 0, cfg.RetryBackoffMs)
+
+	t.Setenv("KAIROS_LLM_RETRY_BACKOFF_MS", "-5")
+	assert.Equal(t, 250, LoadConfig().RetryBackoffMs)
+}
+
 func TestDefaultConfig_LogCallsDisabled(t *testing.T) {
 	cfg := DefaultConfig()
 	assert.False(t, cfg.LogCalls)
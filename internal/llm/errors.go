@@ -3,8 +3,9 @@ package llm
 import "errors"
 
 var (
-	// ErrOllamaUnavailable indicates the Ollama server is unreachable.
-	ErrOllamaUnavailable = errors.New("ollama server unavailable")
+	// ErrLLMUnavailable indicates the model server is unreachable (connection
+	// refused, unknown host). Callers fall back to their deterministic path.
+	ErrLLMUnavailable = errors.New("llm server unavailable")
 
 	// ErrTimeout indicates the LLM request exceeded the configured timeout.
 	ErrTimeout = errors.New("llm request timed out")
@@ -13,6 +14,14 @@ var (
 	// into the expected structured format.
 	ErrInvalidOutput = errors.New("invalid llm output format")
 
+	// ErrCancelled indicates the caller cancelled the request (e.g. the user
+	// pressed Esc while waiting). Callers should not fall back or retry.
+	ErrCancelled = errors.New("llm request cancelled")
+
+	// ErrRequestRejected indicates the server refused the request itself
+	// (e.g. an unknown model name). It is not retried.
+	ErrRequestRejected = errors.New("llm server rejected the request")
+
 	// ErrRetryExhausted indicates all retry attempts have been exhausted.
 	ErrRetryExhausted = errors.New("llm retry attempts exhausted")
 )
@@ -13,10 +13,20 @@ type LLMCallEvent struct {
 	LatencyMs int64
 	Success   bool
 	ErrorCode string
+	// Attempt is the 1-based attempt number for OnAttempt, and the number
+	// of attempts made for OnCallComplete.
+	Attempt int
+	// Retrying is set on a failed OnAttempt event that will be retried.
+	Retrying bool
 }
 
 // Observer receives events about LLM calls for logging and metrics.
+// OnCallStart fires before the first attempt (only Task and Model are set);
+// OnAttempt fires after every HTTP attempt, with that attempt's latency;
+// OnCallComplete fires once the call succeeds, fails, or is cancelled.
 type Observer interface {
+	OnCallStart(event LLMCallEvent)
+	OnAttempt(event LLMCallEvent)
 	OnCallComplete(event LLMCallEvent)
 }
 
@@ -30,17 +40,38 @@ func NewLogObserver(w io.Writer) *LogObserver {
 	return &LogObserver{w: w}
 }
 
+func (o *LogObserver) OnCallStart(event LLMCallEvent) {
+	ts := time.Now().UTC().Format(time.RFC3339)
+	fmt.Fprintf(o.w, "[%s] llm_call_start task=%s model=%s\n", ts, event.Task, event.Model)
+}
+
+// OnAttempt logs failed attempts only; a successful one is reported by the
+// llm_call line that follows.
+func (o *LogObserver) OnAttempt(event LLMCallEvent) {
+	if event.Success {
+		return
+	}
+	ts := time.Now().UTC().Format(time.RFC3339)
+	fmt.Fprintf(o.w, "[%s] llm_attempt task=%s model=%s attempt=%d latency_ms=%d status=err:%s retrying=%t\n",
+		ts, event.Task, event.Model, event.Attempt, event.LatencyMs, event.ErrorCode, event.Retrying)
+}
+
 func (o *LogObserver) OnCallComplete(event LLMCallEvent) {
 	ts := time.Now().UTC().Format(time.RFC3339)
 	status := "ok"
-	if !event.Success {
+	switch {
+	case event.ErrorCode == errCodeCancelled:
+		status = "cancelled"
+	case !event.Success:
 		status = "err:" + event.ErrorCode
 	}
-	fmt.Fprintf(o.w, "[%s] llm_call task=%s model=%s latency_ms=%d status=%s\n",
-		ts, event.Task, event.Model, event.LatencyMs, status)
+	fmt.Fprintf(o.w, "[%s] llm_call task=%s model=%s latency_ms=%d attempts=%d status=%s\n",
+		ts, event.Task, event.Model, event.LatencyMs, event.Attempt, status)
 }
 
 // NoopObserver discards all events. Useful for tests.
 type NoopObserver struct{}
 
+func (NoopObserver) OnCallStart(LLMCallEvent)    {}
+func (NoopObserver) OnAttempt(LLMCallEvent)      {}
 func (NoopObserver) OnCallComplete(LLMCallEvent) {}
@@ -0,0 +1,89 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ServerStatus describes what the configured model server reported when
+// pinged.
+type ServerStatus struct {
+	Endpoint       string
+	Model          string
+	Reachable      bool
+	ModelAvailable bool
+	Models         []string      // models the server has pulled
+	Latency        time.Duration // round trip of the ping
+	Err            error         // why the server was not usable; nil when reachable
+}
+
+// ollamaTagsResponse is the JSON body returned by GET /api/tags.
+type ollamaTagsResponse struct {
+	Models []struct {
+		Name string `json:"name"`
+	} `json:"models"`
+}
+
+// CheckServer pings the Ollama server at cfg.Endpoint and reports whether it
+// responds and has cfg.Model pulled. Connection failures set Err to
+// ErrLLMUnavailable.
+func CheckServer(ctx context.Context, cfg LLMConfig) ServerStatus {
+	status := ServerStatus{Endpoint: cfg.Endpoint, Model: cfg.Model}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.Endpoint+"/api/tags", nil)
+	if err != nil {
+		status.Err = fmt.Errorf("creating request: %w", err)
+		return status
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	status.Latency = time.Since(start)
+	if err != nil {
+		switch {
+		case isConnectionError(err):
+			status.Err = ErrLLMUnavailable
+		case isTimeoutError(err):
+			status.Err = ErrTimeout
+		default:
+			status.Err = err
+		}
+		return status
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		status.Err = fmt.Errorf("ollama returned status %d", resp.StatusCode)
+		return status
+	}
+	status.Reachable = true
+
+	var tags ollamaTagsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		status.Err = fmt.Errorf("decoding model list: %w", err)
+		return status
+	}
+	for _, m := range tags.Models {
+		status.Models = append(status.Models, m.Name)
+		if modelMatches(m.Name, cfg.Model) {
+			status.ModelAvailable = true
+		}
+	}
+	return status
+}
+
+// modelMatches reports whether a pulled model name satisfies the configured
+// model: "llama3.2" matches "llama3.2:latest" and any other tag.
+func modelMatches(pulled, configured string) bool {
+	if pulled == configured {
+		return true
+	}
+	return !strings.Contains(configured, ":") && strings.HasPrefix(pulled, configured+":")
+}
@@ -0,0 +1,36 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckServer_ReportsModelAvailability(t *testing.T) {
+	srv := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tags", r.URL.Path)
+		_, _ = w.Write([]byte(`{"models":[{"name":"llama3.2:latest"},{"name":"qwen2.5:7b"}]}`))
+	}))
+	defer srv.Close()
+
+	status := CheckServer(context.Background(), testConfig(srv.URL))
+	require.NoError(t, status.Err)
+	assert.True(t, status.Reachable)
+	assert.True(t, status.ModelAvailable, "llama3.2 should match llama3.2:latest")
+	assert.Equal(t, []string{"llama3.2:latest", "qwen2.5:7b"}, status.Models)
+
+	cfg := testConfig(srv.URL)
+	cfg.Model = "mistral"
+	status = CheckServer(context.Background(), cfg)
+	assert.True(t, status.Reachable)
+	assert.False(t, status.ModelAvailable)
+}
+
+func TestCheckServer_Unreachable(t *testing.T) {
+	status := CheckServer(context.Background(), testConfig("http://127.0.0.1:1"))
+	assert.False(t, status.Reachable)
+	assert.ErrorIs(t, status.Err, ErrLLMUnavailable)
+}
@@ -2,12 +2,16 @@ package repository
 
 import (
 	"database/sql"
-	"errors"
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
 )
 
-// ErrNotFound is returned when a queried entity does not exist.
-var ErrNotFound = errors.New("not found")
+// ErrNotFound is returned when a queried entity does not exist. It is
+// domain.ErrNotFound, so it carries the NOT_FOUND code.
+var ErrNotFound = domain.ErrNotFound
 
 // dateLayout is the standard date format for project/node/work-item dates in SQLite.
 const dateLayout = "2006-01-02"
@@ -34,6 +38,26 @@ func nullableTimeToString(t *time.Time, layout string) interface{} {
 	return t.Format(layout)
 }
 
+// parseNullableDeadline parses a stored deadline: a YYYY-MM-DD date, or an
+// RFC3339 UTC timestamp for deadlines with a time of day, which comes back in
+// local time so it stays marked as timed.
+func parseNullableDeadline(s sql.NullString) *time.Time {
+	if t := parseNullableTime(s, time.RFC3339); t != nil {
+		local := t.Local()
+		return &local
+	}
+	return parseNullableTime(s, dateLayout)
+}
+
+// nullableDeadlineToString stores date-only deadlines as YYYY-MM-DD, as
+// before, and deadlines with a time of day as RFC3339 UTC.
+func nullableDeadlineToString(t *time.Time) interface{} {
+	if t == nil || !domain.DeadlineHasTime(*t) {
+		return nullableTimeToString(t, dateLayout)
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 // nullableIntToValue converts a *int to a value suitable for SQLite storage.
 // Returns nil (SQL NULL) if the pointer is nil, otherwise returns the int value.
 func nullableIntToValue(v *int) interface{} {
@@ -60,3 +84,25 @@ func intToBool(i int) bool {
 func nowUTC() string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
+
+// tagsToJSON encodes a tag list for the JSON tags columns of work_items and
+// work_session_logs.
+func tagsToJSON(tags []string) string {
+	if len(tags) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(tags) // plain strings; cannot fail
+	return string(data)
+}
+
+// parseTags decodes a JSON tags column. An empty list decodes to nil.
+func parseTags(s string) ([]string, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	var tags []string
+	if err := json.Unmarshal([]byte(s), &tags); err != nil {
+		return nil, fmt.Errorf("parsing tags: %w", err)
+	}
+	return tags, nil
+}
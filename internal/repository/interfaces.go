@@ -18,6 +18,20 @@ type SchedulableCandidate struct {
 	NodeDueDate       *time.Time
 	ProjectTargetDate *time.Time
 	ProjectStartDate  *time.Time
+	ProjectImportance int
+	// ProjectCriticalSnoozedUntil is the project's critical snooze, if any.
+	ProjectCriticalSnoozedUntil *time.Time
+	// Focused is true when the item is on the user's focus list.
+	Focused bool
+}
+
+// CandidateWithAggregates is a SchedulableCandidate with its session
+// aggregates over the what-now windows: minutes logged in the pace window
+// and the latest session start in the spacing look-back.
+type CandidateWithAggregates struct {
+	SchedulableCandidate
+	RecentMin     int
+	LastSessionAt *time.Time
 }
 
 // CompletedWorkSummary holds per-project aggregates for completed (done/skipped) work items.
@@ -29,6 +43,18 @@ type CompletedWorkSummary struct {
 	TotalItemCount int
 }
 
+// EstimateSample pairs a completed work item's original estimate with the
+// minutes actually logged against it, plus its units for per-unit rates.
+type EstimateSample struct {
+	WorkItemID        string
+	Type              string
+	InitialPlannedMin int
+	LoggedMin         int
+	UnitsKind         string
+	UnitsTotal        int
+	UnitsDone         int
+}
+
 type ProjectRepo interface {
 	Create(ctx context.Context, p *domain.Project) error
 	GetByID(ctx context.Context, id string) (*domain.Project, error)
@@ -65,9 +91,24 @@ type WorkItemRepo interface {
 	ListByNode(ctx context.Context, nodeID string) ([]*domain.WorkItem, error)
 	ListByProject(ctx context.Context, projectID string) ([]*domain.WorkItem, error)
 	ListSchedulable(ctx context.Context, includeArchived bool) ([]SchedulableCandidate, error)
+	// ListCandidateWorkItemsWithAggregates returns the schedulable items
+	// with their session aggregates over the last paceDays and spacingDays
+	// days, in one statement.
+	ListCandidateWorkItemsWithAggregates(ctx context.Context, includeArchived bool, paceDays, spacingDays int) ([]CandidateWithAggregates, error)
 	ListCompletedSummaryByProject(ctx context.Context) ([]CompletedWorkSummary, error)
+	// ListEstimateSamples returns done items that have at least one logged session.
+	ListEstimateSamples(ctx context.Context) ([]EstimateSample, error)
 	Update(ctx context.Context, w *domain.WorkItem) error
+	// SetInitialPlannedMin rewrites the estimation baseline, which Update
+	// never touches. Only deliberate re-estimates (work bump) use it.
+	SetInitialPlannedMin(ctx context.Context, id string, minutes int) error
+	// SetOrderIndex sets an item's position among its node's items, which
+	// Create assigns and Update never changes within a node.
+	SetOrderIndex(ctx context.Context, id string, index int) error
 	Archive(ctx context.Context, id string) error
+	// ArchiveDone archives every done work item in the project (all projects
+	// when projectID is empty) and returns how many were archived.
+	ArchiveDone(ctx context.Context, projectID string) (int, error)
 	Delete(ctx context.Context, id string) error
 }
 
@@ -78,6 +119,7 @@ type DependencyRepo interface {
 	ListSuccessors(ctx context.Context, workItemID string) ([]domain.Dependency, error)
 	HasUnfinishedPredecessors(ctx context.Context, workItemID string) (bool, error)
 	ListBlockedWorkItemIDs(ctx context.Context, candidateIDs []string) (map[string]bool, error)
+	ListUnfinishedPredecessors(ctx context.Context, candidateIDs []string) (map[string][]string, error)
 }
 
 type SessionRepo interface {
@@ -87,9 +129,76 @@ type SessionRepo interface {
 	ListRecent(ctx context.Context, days int) ([]*domain.WorkSessionLog, error)
 	ListRecentByProject(ctx context.Context, projectID string, days int) ([]*domain.WorkSessionLog, error)
 	ListRecentSummaryByType(ctx context.Context, days int) ([]domain.SessionSummaryByType, error)
+	// SummaryByTag sums minutes per tag for sessions started in [from, to).
+	SummaryByTag(ctx context.Context, from, to time.Time) ([]domain.SessionTagSummary, error)
+	// LatestLogged returns the most recently logged session (by created_at,
+	// then insertion order; not started_at), limited to one project when projectID is set, or
+	// ErrNotFound if there is none.
+	LatestLogged(ctx context.Context, projectID string) (*domain.WorkSessionLog, error)
 	Delete(ctx context.Context, id string) error
 }
 
+// RiskSnapshotRepo stores the daily risk/progress history per project.
+type RiskSnapshotRepo interface {
+	Upsert(ctx context.Context, s *domain.RiskSnapshot) error
+	// GetLatestOnOrBefore returns the most recent snapshot dated on or before
+	// the given day, or ErrNotFound if none exists.
+	GetLatestOnOrBefore(ctx context.Context, projectID string, date time.Time) (*domain.RiskSnapshot, error)
+}
+
+// ArchiveRepo lists archived projects and work items across all projects.
+type ArchiveRepo interface {
+	// ListArchived returns archived projects and work items, oldest archive
+	// first.
+	ListArchived(ctx context.Context) ([]domain.ArchivedEntity, error)
+}
+
+// TombstoneRepo lists deletion records for incremental export.
+type TombstoneRepo interface {
+	ListSince(ctx context.Context, since time.Time) ([]domain.Tombstone, error)
+}
+
+// AuditRepo stores the append-only mutation log.
+type AuditRepo interface {
+	Append(ctx context.Context, e *domain.AuditEvent) error
+	// ListByEntity returns an entity's events, oldest first.
+	ListByEntity(ctx context.Context, entityID string) ([]*domain.AuditEvent, error)
+}
+
+// FocusRepo stores the user's pinned focus list.
+type FocusRepo interface {
+	// Add pins a work item; adding an already focused item is a no-op.
+	Add(ctx context.Context, f *domain.FocusItem) error
+	// Remove unpins a work item, or returns ErrNotFound if it isn't focused.
+	Remove(ctx context.Context, workItemID string) error
+	// List returns focused items, oldest first.
+	List(ctx context.Context) ([]domain.FocusItem, error)
+	// PruneFinished removes items that are done, skipped, or archived and
+	// returns how many were removed.
+	PruneFinished(ctx context.Context) (int, error)
+}
+
+// WorkPresetRepo stores named work item presets.
+type WorkPresetRepo interface {
+	// Upsert creates the preset or replaces the one with the same name.
+	Upsert(ctx context.Context, p *domain.WorkPreset) error
+	// GetByName returns the preset, or ErrNotFound.
+	GetByName(ctx context.Context, name string) (*domain.WorkPreset, error)
+	// List returns all presets ordered by name.
+	List(ctx context.Context) ([]*domain.WorkPreset, error)
+	// Delete removes the preset, or returns ErrNotFound.
+	Delete(ctx context.Context, name string) error
+}
+
+// DayPlanRepo stores saved day plans, one per calendar date.
+type DayPlanRepo interface {
+	// Replace stores the plan, removing any plan (and its items) saved
+	// earlier for the same date.
+	Replace(ctx context.Context, p *domain.DayPlan) error
+	// GetByDate returns the plan for the calendar date, or ErrNotFound.
+	GetByDate(ctx context.Context, date time.Time) (*domain.DayPlan, error)
+}
+
 type UserProfileRepo interface {
 	Get(ctx context.Context) (*domain.UserProfile, error)
 	Upsert(ctx context.Context, p *domain.UserProfile) error
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteArchiveRepo implements ArchiveRepo using a SQLite database.
+type SQLiteArchiveRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteArchiveRepo creates a new SQLiteArchiveRepo.
+func NewSQLiteArchiveRepo(conn db.DBTX) *SQLiteArchiveRepo {
+	return &SQLiteArchiveRepo{db: conn}
+}
+
+func (r *SQLiteArchiveRepo) ListArchived(ctx context.Context) ([]domain.ArchivedEntity, error) {
+	query := `SELECT 'project', p.id, p.name, 0, p.id, p.short_id, p.name, p.archived_at,
+			(SELECT COUNT(*) FROM work_session_logs s
+				JOIN work_items w ON w.id = s.work_item_id
+				JOIN plan_nodes n ON n.id = w.node_id
+				WHERE n.project_id = p.id)
+		FROM projects p
+		WHERE p.archived_at IS NOT NULL
+		UNION ALL
+		SELECT 'work_item', w.id, w.title, w.seq, p.id, p.short_id, p.name, w.archived_at,
+			(SELECT COUNT(*) FROM work_session_logs s WHERE s.work_item_id = w.id)
+		FROM work_items w
+		JOIN plan_nodes n ON n.id = w.node_id
+		JOIN projects p ON p.id = n.project_id
+		WHERE w.archived_at IS NOT NULL
+		ORDER BY 8, 1, 2`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("listing archived entities: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.ArchivedEntity
+	for rows.Next() {
+		var e domain.ArchivedEntity
+		var archivedAtStr string
+		if err := rows.Scan(&e.EntityType, &e.ID, &e.Title, &e.Seq,
+			&e.ProjectID, &e.ProjectShortID, &e.ProjectName, &archivedAtStr, &e.SessionCount); err != nil {
+			return nil, fmt.Errorf("scanning archived entity: %w", err)
+		}
+		if e.ArchivedAt, err = time.Parse(time.RFC3339, archivedAtStr); err != nil {
+			return nil, fmt.Errorf("parsing archived_at for %s %s: %w", e.EntityType, e.ID, err)
+		}
+		result = append(result, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating archived entities: %w", err)
+	}
+	return result, nil
+}
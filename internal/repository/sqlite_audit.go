@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteAuditRepo implements AuditRepo using a SQLite database.
+type SQLiteAuditRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteAuditRepo creates a new SQLiteAuditRepo.
+func NewSQLiteAuditRepo(conn db.DBTX) *SQLiteAuditRepo {
+	return &SQLiteAuditRepo{db: conn}
+}
+
+func (r *SQLiteAuditRepo) Append(ctx context.Context, e *domain.AuditEvent) error {
+	changes := e.Changes
+	if changes == nil {
+		changes = map[string]domain.FieldChange{}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("encoding audit changes: %w", err)
+	}
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO audit_events (entity_type, entity_id, action, changes, occurred_at)
+		VALUES (?, ?, ?, ?, ?)`,
+		e.EntityType, e.EntityID, e.Action, string(data), e.OccurredAt.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return fmt.Errorf("appending audit event: %w", err)
+	}
+	if id, err := res.LastInsertId(); err == nil {
+		e.ID = id
+	}
+	return nil
+}
+
+func (r *SQLiteAuditRepo) ListByEntity(ctx context.Context, entityID string) ([]*domain.AuditEvent, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, entity_type, entity_id, action, changes, occurred_at
+		FROM audit_events WHERE entity_id = ? ORDER BY occurred_at, id`, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("listing audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.AuditEvent
+	for rows.Next() {
+		var e domain.AuditEvent
+		var changesStr, occurredAtStr string
+		if err := rows.Scan(&e.ID, &e.EntityType, &e.EntityID, &e.Action, &changesStr, &occurredAtStr); err != nil {
+			return nil, fmt.Errorf("scanning audit event: %w", err)
+		}
+		if err := json.Unmarshal([]byte(changesStr), &e.Changes); err != nil {
+			return nil, fmt.Errorf("decoding audit changes: %w", err)
+		}
+		e.OccurredAt, err = time.Parse(time.RFC3339Nano, occurredAtStr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing audit timestamp: %w", err)
+		}
+		events = append(events, &e)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepo_AppendAndListByEntity(t *testing.T) {
+	repo := NewSQLiteAuditRepo(testutil.NewTestDB(t))
+	ctx := context.Background()
+	t0 := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	done := &domain.AuditEvent{
+		EntityType: "work_item", EntityID: "wi-1", Action: "mark-done", OccurredAt: t0.Add(time.Hour),
+		Changes: map[string]domain.FieldChange{"status": {From: "in_progress", To: "done"}},
+	}
+	created := &domain.AuditEvent{
+		EntityType: "work_item", EntityID: "wi-1", Action: "create-work-item", OccurredAt: t0,
+		Changes: map[string]domain.FieldChange{"title": {To: "Essay"}},
+	}
+	other := &domain.AuditEvent{EntityType: "work_item", EntityID: "wi-2", Action: "create-work-item", OccurredAt: t0}
+	for _, e := range []*domain.AuditEvent{done, created, other} {
+		require.NoError(t, repo.Append(ctx, e))
+		assert.NotZero(t, e.ID)
+	}
+
+	events, err := repo.ListByEntity(ctx, "wi-1")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "create-work-item", events[0].Action, "oldest first")
+	assert.Equal(t, t0, events[0].OccurredAt)
+	assert.Equal(t, done.Changes, events[1].Changes)
+
+	events, err = repo.ListByEntity(ctx, "wi-2")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Empty(t, events[0].Changes)
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteDayPlanRepo implements DayPlanRepo using a SQLite database.
+type SQLiteDayPlanRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteDayPlanRepo creates a new SQLiteDayPlanRepo.
+func NewSQLiteDayPlanRepo(conn db.DBTX) *SQLiteDayPlanRepo {
+	return &SQLiteDayPlanRepo{db: conn}
+}
+
+// Replace runs several statements; callers wanting it atomic pass a
+// transaction-scoped DBTX.
+func (r *SQLiteDayPlanRepo) Replace(ctx context.Context, p *domain.DayPlan) error {
+	date := p.Date.Format(dateLayout)
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM day_plan_items WHERE plan_date = ?`, date); err != nil {
+		return fmt.Errorf("clearing day plan items: %w", err)
+	}
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO day_plans (plan_date, available_min, created_at) VALUES (?, ?, ?)
+		ON CONFLICT(plan_date) DO UPDATE SET
+			available_min = excluded.available_min,
+			created_at = excluded.created_at`,
+		date, p.AvailableMin, p.CreatedAt.UTC().Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("saving day plan: %w", err)
+	}
+	for i, it := range p.Items {
+		_, err := r.db.ExecContext(ctx,
+			`INSERT INTO day_plan_items (plan_date, position, work_item_id, work_item_seq, project_id, title, allocated_min)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			date, i, it.WorkItemID, it.WorkItemSeq, it.ProjectID, it.Title, it.AllocatedMin)
+		if err != nil {
+			return fmt.Errorf("saving day plan item: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SQLiteDayPlanRepo) GetByDate(ctx context.Context, date time.Time) (*domain.DayPlan, error) {
+	day := date.Format(dateLayout)
+	var p domain.DayPlan
+	var createdAtStr string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT available_min, created_at FROM day_plans WHERE plan_date = ?`, day).
+		Scan(&p.AvailableMin, &createdAtStr)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("day plan for %s: %w", day, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting day plan: %w", err)
+	}
+	p.Date, _ = time.Parse(dateLayout, day)
+	p.CreatedAt, err = time.Parse(time.RFC3339, createdAtStr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing created_at: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT work_item_id, work_item_seq, project_id, title, allocated_min
+		FROM day_plan_items WHERE plan_date = ? ORDER BY position`, day)
+	if err != nil {
+		return nil, fmt.Errorf("listing day plan items: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var it domain.DayPlanItem
+		if err := rows.Scan(&it.WorkItemID, &it.WorkItemSeq, &it.ProjectID, &it.Title, &it.AllocatedMin); err != nil {
+			return nil, fmt.Errorf("scanning day plan item: %w", err)
+		}
+		p.Items = append(p.Items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating day plan items: %w", err)
+	}
+	return &p, nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDayPlanRepo_ReplaceAndGet(t *testing.T) {
+	repo := NewSQLiteDayPlanRepo(testutil.NewTestDB(t))
+	ctx := context.Background()
+
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 10, 8, 30, 0, 0, time.UTC)
+
+	_, err := repo.GetByDate(ctx, day)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	require.NoError(t, repo.Replace(ctx, &domain.DayPlan{
+		Date: day, AvailableMin: 90, CreatedAt: now,
+		Items: []domain.DayPlanItem{
+			{WorkItemID: "wi-1", WorkItemSeq: 3, ProjectID: "p1", Title: "Read ch. 2", AllocatedMin: 45},
+			{WorkItemID: "wi-2", WorkItemSeq: 5, ProjectID: "p2", Title: "Problem set", AllocatedMin: 30},
+		},
+	}))
+	got, err := repo.GetByDate(ctx, day)
+	require.NoError(t, err)
+	assert.Equal(t, 90, got.AvailableMin)
+	assert.Equal(t, now, got.CreatedAt)
+	require.Len(t, got.Items, 2)
+	assert.Equal(t, "Read ch. 2", got.Items[0].Title, "items keep their saved order")
+	assert.Equal(t, 30, got.Items[1].AllocatedMin)
+
+	require.NoError(t, repo.Replace(ctx, &domain.DayPlan{
+		Date: day, AvailableMin: 30, CreatedAt: now.Add(time.Hour),
+		Items: []domain.DayPlanItem{{WorkItemID: "wi-2", Title: "Problem set", AllocatedMin: 30}},
+	}))
+	got, err = repo.GetByDate(ctx, day)
+	require.NoError(t, err)
+	assert.Equal(t, 30, got.AvailableMin)
+	require.Len(t, got.Items, 1, "replacing drops the earlier items")
+	assert.Equal(t, "wi-2", got.Items[0].WorkItemID)
+
+	_, err = repo.GetByDate(ctx, day.AddDate(0, 0, 1))
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
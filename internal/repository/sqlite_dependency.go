@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 
 	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/domain"
@@ -73,37 +72,41 @@ func (r *SQLiteDependencyRepo) HasUnfinishedPredecessors(ctx context.Context, wo
 	return count > 0, nil
 }
 
+// ListBlockedWorkItemIDs returns the subset of candidateIDs that have at
+// least one unfinished predecessor. Blocked successors are listed in a
+// single parameterless query and intersected in memory: binding thousands of
+// candidate IDs into an IN list cost more than the lookup itself and can hit
+// SQLite's bound-variable limit on large databases.
 func (r *SQLiteDependencyRepo) ListBlockedWorkItemIDs(ctx context.Context, candidateIDs []string) (map[string]bool, error) {
+	blocked := make(map[string]bool)
 	if len(candidateIDs) == 0 {
-		return make(map[string]bool), nil
+		return blocked, nil
 	}
 
-	placeholders := make([]string, len(candidateIDs))
-	args := make([]any, len(candidateIDs))
-	for i, id := range candidateIDs {
-		placeholders[i] = "?"
-		args[i] = id
+	wanted := make(map[string]bool, len(candidateIDs))
+	for _, id := range candidateIDs {
+		wanted[id] = true
 	}
 
 	query := `SELECT DISTINCT d.successor_work_item_id
 		FROM dependencies d
 		JOIN work_items w ON d.predecessor_work_item_id = w.id
-		WHERE d.successor_work_item_id IN (` + strings.Join(placeholders, ",") + `)
-		  AND w.status NOT IN ('done', 'skipped', 'archived')`
+		WHERE w.status NOT IN ('done', 'skipped', 'archived')`
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("listing blocked work item IDs: %w", err)
 	}
 	defer rows.Close()
 
-	blocked := make(map[string]bool)
 	for rows.Next() {
 		var id string
 		if err := rows.Scan(&id); err != nil {
 			return nil, fmt.Errorf("scanning blocked work item ID: %w", err)
 		}
-		blocked[id] = true
+		if wanted[id] {
+			blocked[id] = true
+		}
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("iterating blocked work item IDs: %w", err)
@@ -111,6 +114,48 @@ func (r *SQLiteDependencyRepo) ListBlockedWorkItemIDs(ctx context.Context, candi
 	return blocked, nil
 }
 
+// ListUnfinishedPredecessors maps each of candidateIDs that has unfinished
+// predecessors to their IDs, for callers that finish work in memory (the
+// weekly plan) and need to lift blocks without writing. Like
+// ListBlockedWorkItemIDs it runs one parameterless query.
+func (r *SQLiteDependencyRepo) ListUnfinishedPredecessors(ctx context.Context, candidateIDs []string) (map[string][]string, error) {
+	preds := make(map[string][]string)
+	if len(candidateIDs) == 0 {
+		return preds, nil
+	}
+
+	wanted := make(map[string]bool, len(candidateIDs))
+	for _, id := range candidateIDs {
+		wanted[id] = true
+	}
+
+	query := `SELECT d.successor_work_item_id, d.predecessor_work_item_id
+		FROM dependencies d
+		JOIN work_items w ON d.predecessor_work_item_id = w.id
+		WHERE w.status NOT IN ('done', 'skipped', 'archived')
+		ORDER BY d.successor_work_item_id, d.predecessor_work_item_id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("listing unfinished predecessors: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var successor, predecessor string
+		if err := rows.Scan(&successor, &predecessor); err != nil {
+			return nil, fmt.Errorf("scanning unfinished predecessor: %w", err)
+		}
+		if wanted[successor] {
+			preds[successor] = append(preds[successor], predecessor)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating unfinished predecessors: %w", err)
+	}
+	return preds, nil
+}
+
 // scanDependencies scans multiple dependency rows from *sql.Rows.
 func (r *SQLiteDependencyRepo) scanDependencies(rows *sql.Rows) ([]domain.Dependency, error) {
 	var deps []domain.Dependency
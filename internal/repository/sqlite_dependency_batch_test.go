@@ -154,3 +154,62 @@ func TestListBlockedWorkItemIDs_SkippedCountsAsFinished(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, blocked, "skipped predecessor counts as finished")
 }
+
+func TestListBlockedWorkItemIDs_OnlyReturnsCandidates(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+
+	projRepo := NewSQLiteProjectRepo(db)
+	nodeRepo := NewSQLitePlanNodeRepo(db)
+	wiRepo := NewSQLiteWorkItemRepo(db)
+	depRepo := NewSQLiteDependencyRepo(db)
+
+	proj := testutil.NewTestProject("Scope")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+
+	pred := testutil.NewTestWorkItem(node.ID, "Pred")
+	inScope := testutil.NewTestWorkItem(node.ID, "InScope")
+	outOfScope := testutil.NewTestWorkItem(node.ID, "OutOfScope")
+	for _, wi := range []*domain.WorkItem{pred, inScope, outOfScope} {
+		require.NoError(t, wiRepo.Create(ctx, wi))
+	}
+	require.NoError(t, depRepo.Create(ctx, &domain.Dependency{PredecessorWorkItemID: pred.ID, SuccessorWorkItemID: inScope.ID}))
+	require.NoError(t, depRepo.Create(ctx, &domain.Dependency{PredecessorWorkItemID: pred.ID, SuccessorWorkItemID: outOfScope.ID}))
+
+	blocked, err := depRepo.ListBlockedWorkItemIDs(ctx, []string{pred.ID, inScope.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{inScope.ID: true}, blocked,
+		"blocked items outside the candidate set are not reported")
+}
+
+func TestListUnfinishedPredecessors_SkipsFinishedAndOutOfScope(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+
+	projRepo := NewSQLiteProjectRepo(db)
+	nodeRepo := NewSQLitePlanNodeRepo(db)
+	wiRepo := NewSQLiteWorkItemRepo(db)
+	depRepo := NewSQLiteDependencyRepo(db)
+
+	proj := testutil.NewTestProject("Preds")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+
+	open := testutil.NewTestWorkItem(node.ID, "Open")
+	done := testutil.NewTestWorkItem(node.ID, "Done", testutil.WithWorkItemStatus(domain.WorkItemDone))
+	succ := testutil.NewTestWorkItem(node.ID, "Successor")
+	other := testutil.NewTestWorkItem(node.ID, "Other")
+	for _, wi := range []*domain.WorkItem{open, done, succ, other} {
+		require.NoError(t, wiRepo.Create(ctx, wi))
+	}
+	require.NoError(t, depRepo.Create(ctx, &domain.Dependency{PredecessorWorkItemID: open.ID, SuccessorWorkItemID: succ.ID}))
+	require.NoError(t, depRepo.Create(ctx, &domain.Dependency{PredecessorWorkItemID: done.ID, SuccessorWorkItemID: succ.ID}))
+	require.NoError(t, depRepo.Create(ctx, &domain.Dependency{PredecessorWorkItemID: open.ID, SuccessorWorkItemID: other.ID}))
+
+	preds, err := depRepo.ListUnfinishedPredecessors(ctx, []string{open.ID, succ.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{succ.ID: {open.ID}}, preds)
+}
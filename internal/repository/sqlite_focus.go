@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteFocusRepo implements FocusRepo using a SQLite database.
+type SQLiteFocusRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteFocusRepo creates a new SQLiteFocusRepo.
+func NewSQLiteFocusRepo(conn db.DBTX) *SQLiteFocusRepo {
+	return &SQLiteFocusRepo{db: conn}
+}
+
+func (r *SQLiteFocusRepo) Add(ctx context.Context, f *domain.FocusItem) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO focus_items (work_item_id, added_at) VALUES (?, ?)`,
+		f.WorkItemID, f.AddedAt.Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("adding focus item: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteFocusRepo) Remove(ctx context.Context, workItemID string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM focus_items WHERE work_item_id = ?`, workItemID)
+	if err != nil {
+		return fmt.Errorf("removing focus item: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("removing focus item: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("focus item %s: %w", workItemID, ErrNotFound)
+	}
+	return nil
+}
+
+func (r *SQLiteFocusRepo) List(ctx context.Context) ([]domain.FocusItem, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT work_item_id, added_at FROM focus_items ORDER BY added_at, work_item_id`)
+	if err != nil {
+		return nil, fmt.Errorf("listing focus items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.FocusItem
+	for rows.Next() {
+		var f domain.FocusItem
+		var addedAtStr string
+		if err := rows.Scan(&f.WorkItemID, &addedAtStr); err != nil {
+			return nil, fmt.Errorf("scanning focus item: %w", err)
+		}
+		f.AddedAt, err = time.Parse(time.RFC3339, addedAtStr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing added_at: %w", err)
+		}
+		items = append(items, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating focus items: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLiteFocusRepo) PruneFinished(ctx context.Context) (int, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM focus_items WHERE work_item_id IN (
+		SELECT id FROM work_items
+		WHERE status IN ('done', 'skipped', 'archived') OR archived_at IS NOT NULL
+	)`)
+	if err != nil {
+		return 0, fmt.Errorf("pruning focus items: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("pruning focus items: %w", err)
+	}
+	return int(n), nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFocusRepo_AddListRemove(t *testing.T) {
+	db, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+	focus := NewSQLiteFocusRepo(db)
+
+	first := testutil.NewTestWorkItem(node.ID, "Essay")
+	second := testutil.NewTestWorkItem(node.ID, "Slides")
+	require.NoError(t, workItems.Create(ctx, first))
+	require.NoError(t, workItems.Create(ctx, second))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, focus.Add(ctx, &domain.FocusItem{WorkItemID: second.ID, AddedAt: now}))
+	require.NoError(t, focus.Add(ctx, &domain.FocusItem{WorkItemID: first.ID, AddedAt: now.Add(time.Minute)}))
+	// Re-adding keeps the original position.
+	require.NoError(t, focus.Add(ctx, &domain.FocusItem{WorkItemID: second.ID, AddedAt: now.Add(time.Hour)}))
+
+	items, err := focus.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, second.ID, items[0].WorkItemID)
+	assert.Equal(t, now, items[0].AddedAt)
+	assert.Equal(t, first.ID, items[1].WorkItemID)
+
+	candidates, err := workItems.ListSchedulable(ctx, false)
+	require.NoError(t, err)
+	for _, c := range candidates {
+		assert.True(t, c.Focused, c.WorkItem.Title)
+	}
+
+	require.NoError(t, focus.Remove(ctx, first.ID))
+	assert.True(t, errors.Is(focus.Remove(ctx, first.ID), ErrNotFound))
+
+	candidates, err = workItems.ListSchedulable(ctx, false)
+	require.NoError(t, err)
+	for _, c := range candidates {
+		assert.Equal(t, c.WorkItem.ID == second.ID, c.Focused, c.WorkItem.Title)
+	}
+}
+
+func TestFocusRepo_PruneFinishedAndCascade(t *testing.T) {
+	db, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+	focus := NewSQLiteFocusRepo(db)
+
+	open := testutil.NewTestWorkItem(node.ID, "Open")
+	done := testutil.NewTestWorkItem(node.ID, "Done", testutil.WithWorkItemStatus(domain.WorkItemDone))
+	archived := testutil.NewTestWorkItem(node.ID, "Archived")
+	deleted := testutil.NewTestWorkItem(node.ID, "Deleted")
+	for _, wi := range []*domain.WorkItem{open, done, archived, deleted} {
+		require.NoError(t, workItems.Create(ctx, wi))
+		require.NoError(t, focus.Add(ctx, &domain.FocusItem{WorkItemID: wi.ID, AddedAt: time.Now().UTC()}))
+	}
+	require.NoError(t, workItems.Archive(ctx, archived.ID))
+	require.NoError(t, workItems.Delete(ctx, deleted.ID))
+
+	n, err := focus.PruneFinished(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	items, err := focus.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, open.ID, items[0].WorkItemID)
+}
@@ -13,7 +13,7 @@ import (
 // planNodeColumns is the canonical SELECT column list for plan_nodes.
 const planNodeColumns = `id, project_id, parent_id, title, kind, order_index,
 		due_date, not_before, not_after, planned_min_budget, seq, created_at, updated_at,
-		is_default`
+		is_default, ref`
 
 // SQLitePlanNodeRepo implements PlanNodeRepo using a SQLite database.
 type SQLitePlanNodeRepo struct {
@@ -28,8 +28,8 @@ func NewSQLitePlanNodeRepo(conn db.DBTX) *SQLitePlanNodeRepo {
 func (r *SQLitePlanNodeRepo) Create(ctx context.Context, n *domain.PlanNode) error {
 	query := `INSERT INTO plan_nodes (id, project_id, parent_id, title, kind, order_index,
 		due_date, not_before, not_after, planned_min_budget, seq, created_at, updated_at,
-		is_default)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		is_default, ref)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	_, err := r.db.ExecContext(ctx, query,
 		n.ID,
 		n.ProjectID,
@@ -37,7 +37,7 @@ func (r *SQLitePlanNodeRepo) Create(ctx context.Context, n *domain.PlanNode) err
 		n.Title,
 		string(n.Kind),
 		n.OrderIndex,
-		nullableTimeToString(n.DueDate, dateLayout),
+		nullableDeadlineToString(n.DueDate),
 		nullableTimeToString(n.NotBefore, dateLayout),
 		nullableTimeToString(n.NotAfter, dateLayout),
 		nullableIntToValue(n.PlannedMinBudget),
@@ -45,6 +45,7 @@ func (r *SQLitePlanNodeRepo) Create(ctx context.Context, n *domain.PlanNode) err
 		n.CreatedAt.Format(time.RFC3339),
 		n.UpdatedAt.Format(time.RFC3339),
 		boolToInt(n.IsDefault),
+		n.Ref,
 	)
 	if err != nil {
 		return fmt.Errorf("inserting plan node: %w", err)
@@ -102,7 +103,7 @@ func (r *SQLitePlanNodeRepo) ListRoots(ctx context.Context, projectID string) ([
 func (r *SQLitePlanNodeRepo) Update(ctx context.Context, n *domain.PlanNode) error {
 	query := `UPDATE plan_nodes SET project_id = ?, parent_id = ?, title = ?, kind = ?,
 		order_index = ?, due_date = ?, not_before = ?, not_after = ?, planned_min_budget = ?,
-		seq = ?, updated_at = ?, is_default = ?
+		seq = ?, updated_at = ?, is_default = ?, ref = ?
 		WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query,
 		n.ProjectID,
@@ -110,13 +111,14 @@ func (r *SQLitePlanNodeRepo) Update(ctx context.Context, n *domain.PlanNode) err
 		n.Title,
 		string(n.Kind),
 		n.OrderIndex,
-		nullableTimeToString(n.DueDate, dateLayout),
+		nullableDeadlineToString(n.DueDate),
 		nullableTimeToString(n.NotBefore, dateLayout),
 		nullableTimeToString(n.NotAfter, dateLayout),
 		nullableIntToValue(n.PlannedMinBudget),
 		n.Seq,
 		n.UpdatedAt.Format(time.RFC3339),
 		boolToInt(n.IsDefault),
+		n.Ref,
 		n.ID,
 	)
 	if err != nil {
@@ -147,7 +149,7 @@ func (r *SQLitePlanNodeRepo) scanNode(row *sql.Row) (*domain.PlanNode, error) {
 		&n.ID, &n.ProjectID, &parentID, &n.Title, &kindStr, &n.OrderIndex,
 		&dueDateStr, &notBeforeStr, &notAfterStr, &plannedMinBudget,
 		&n.Seq, &createdAtStr, &updatedAtStr,
-		&isDefaultInt,
+		&isDefaultInt, &n.Ref,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -176,7 +178,7 @@ func (r *SQLitePlanNodeRepo) scanNodes(rows *sql.Rows) ([]*domain.PlanNode, erro
 			&n.ID, &n.ProjectID, &parentID, &n.Title, &kindStr, &n.OrderIndex,
 			&dueDateStr, &notBeforeStr, &notAfterStr, &plannedMinBudget,
 			&n.Seq, &createdAtStr, &updatedAtStr,
-			&isDefaultInt,
+			&isDefaultInt, &n.Ref,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning plan node row: %w", err)
@@ -220,7 +222,7 @@ func (r *SQLitePlanNodeRepo) populateNode(
 		return nil, fmt.Errorf("parsing updated_at: %w", parseErr)
 	}
 
-	n.DueDate = parseNullableTime(dueDateStr, dateLayout)
+	n.DueDate = parseNullableDeadline(dueDateStr)
 	n.NotBefore = parseNullableTime(notBeforeStr, dateLayout)
 	n.NotAfter = parseNullableTime(notAfterStr, dateLayout)
 
@@ -21,19 +21,27 @@ func NewSQLiteProjectRepo(conn db.DBTX) *SQLiteProjectRepo {
 }
 
 func (r *SQLiteProjectRepo) Create(ctx context.Context, p *domain.Project) error {
-	query := `INSERT INTO projects (id, short_id, name, domain, start_date, target_date, status, archived_at, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	query := `INSERT INTO projects (id, short_id, name, domain, start_date, target_date, importance, status, archived_at, created_at, updated_at, critical_snoozed_until,
+		default_work_type, default_planned_min, default_min_session_min, default_max_session_min, default_session_min)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	_, err := r.db.ExecContext(ctx, query,
 		p.ID,
 		p.ShortID,
 		p.Name,
 		p.Domain,
 		p.StartDate.Format(dateLayout),
-		nullableTimeToString(p.TargetDate, dateLayout),
+		nullableDeadlineToString(p.TargetDate),
+		p.ImportanceOrDefault(),
 		string(p.Status),
 		nullableTimeToString(p.ArchivedAt, time.RFC3339),
 		p.CreatedAt.Format(time.RFC3339),
 		p.UpdatedAt.Format(time.RFC3339),
+		nullableTimeToString(p.CriticalSnoozedUntil, time.RFC3339),
+		p.WorkDefaults.Type,
+		p.WorkDefaults.PlannedMin,
+		p.WorkDefaults.MinSessionMin,
+		p.WorkDefaults.MaxSessionMin,
+		p.WorkDefaults.DefaultSessionMin,
 	)
 	if err != nil {
 		return fmt.Errorf("inserting project: %w", err)
@@ -42,14 +50,16 @@ func (r *SQLiteProjectRepo) Create(ctx context.Context, p *domain.Project) error
 }
 
 func (r *SQLiteProjectRepo) GetByID(ctx context.Context, id string) (*domain.Project, error) {
-	query := `SELECT id, short_id, name, domain, start_date, target_date, status, archived_at, created_at, updated_at
+	query := `SELECT id, short_id, name, domain, start_date, target_date, importance, status, archived_at, created_at, updated_at, critical_snoozed_until,
+		default_work_type, default_planned_min, default_min_session_min, default_max_session_min, default_session_min
 		FROM projects WHERE id = ?`
 	row := r.db.QueryRowContext(ctx, query, id)
 	return r.scanProject(row)
 }
 
 func (r *SQLiteProjectRepo) GetByShortID(ctx context.Context, shortID string) (*domain.Project, error) {
-	query := `SELECT id, short_id, name, domain, start_date, target_date, status, archived_at, created_at, updated_at
+	query := `SELECT id, short_id, name, domain, start_date, target_date, importance, status, archived_at, created_at, updated_at, critical_snoozed_until,
+		default_work_type, default_planned_min, default_min_session_min, default_max_session_min, default_session_min
 		FROM projects WHERE UPPER(short_id) = UPPER(?)`
 	row := r.db.QueryRowContext(ctx, query, shortID)
 	return r.scanProject(row)
@@ -58,10 +68,12 @@ func (r *SQLiteProjectRepo) GetByShortID(ctx context.Context, shortID string) (*
 func (r *SQLiteProjectRepo) List(ctx context.Context, includeArchived bool) ([]*domain.Project, error) {
 	var query string
 	if includeArchived {
-		query = `SELECT id, short_id, name, domain, start_date, target_date, status, archived_at, created_at, updated_at
+		query = `SELECT id, short_id, name, domain, start_date, target_date, importance, status, archived_at, created_at, updated_at, critical_snoozed_until,
+			default_work_type, default_planned_min, default_min_session_min, default_max_session_min, default_session_min
 			FROM projects ORDER BY created_at`
 	} else {
-		query = `SELECT id, short_id, name, domain, start_date, target_date, status, archived_at, created_at, updated_at
+		query = `SELECT id, short_id, name, domain, start_date, target_date, importance, status, archived_at, created_at, updated_at, critical_snoozed_until,
+			default_work_type, default_planned_min, default_min_session_min, default_max_session_min, default_session_min
 			FROM projects WHERE archived_at IS NULL ORDER BY created_at`
 	}
 	rows, err := r.db.QueryContext(ctx, query)
@@ -85,16 +97,24 @@ func (r *SQLiteProjectRepo) List(ctx context.Context, includeArchived bool) ([]*
 }
 
 func (r *SQLiteProjectRepo) Update(ctx context.Context, p *domain.Project) error {
-	query := `UPDATE projects SET short_id = ?, name = ?, domain = ?, start_date = ?, target_date = ?, status = ?, updated_at = ?
+	query := `UPDATE projects SET short_id = ?, name = ?, domain = ?, start_date = ?, target_date = ?, importance = ?, status = ?, updated_at = ?, critical_snoozed_until = ?,
+		default_work_type = ?, default_planned_min = ?, default_min_session_min = ?, default_max_session_min = ?, default_session_min = ?
 		WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query,
 		p.ShortID,
 		p.Name,
 		p.Domain,
 		p.StartDate.Format(dateLayout),
-		nullableTimeToString(p.TargetDate, dateLayout),
+		nullableDeadlineToString(p.TargetDate),
+		p.ImportanceOrDefault(),
 		string(p.Status),
 		p.UpdatedAt.Format(time.RFC3339),
+		nullableTimeToString(p.CriticalSnoozedUntil, time.RFC3339),
+		p.WorkDefaults.Type,
+		p.WorkDefaults.PlannedMin,
+		p.WorkDefaults.MinSessionMin,
+		p.WorkDefaults.MaxSessionMin,
+		p.WorkDefaults.DefaultSessionMin,
 		p.ID,
 	)
 	if err != nil {
@@ -136,13 +156,15 @@ func (r *SQLiteProjectRepo) Delete(ctx context.Context, id string) error {
 func (r *SQLiteProjectRepo) scanProject(row *sql.Row) (*domain.Project, error) {
 	var p domain.Project
 	var startDateStr, createdAtStr, updatedAtStr, statusStr string
-	var targetDateStr, archivedAtStr sql.NullString
+	var targetDateStr, archivedAtStr, snoozedStr sql.NullString
 
 	err := row.Scan(
 		&p.ID, &p.ShortID, &p.Name, &p.Domain,
-		&startDateStr, &targetDateStr,
+		&startDateStr, &targetDateStr, &p.Importance,
 		&statusStr, &archivedAtStr,
-		&createdAtStr, &updatedAtStr,
+		&createdAtStr, &updatedAtStr, &snoozedStr,
+		&p.WorkDefaults.Type, &p.WorkDefaults.PlannedMin,
+		&p.WorkDefaults.MinSessionMin, &p.WorkDefaults.MaxSessionMin, &p.WorkDefaults.DefaultSessionMin,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -151,33 +173,35 @@ func (r *SQLiteProjectRepo) scanProject(row *sql.Row) (*domain.Project, error) {
 		return nil, fmt.Errorf("scanning project: %w", err)
 	}
 
-	return r.populateProject(&p, statusStr, startDateStr, createdAtStr, updatedAtStr, targetDateStr, archivedAtStr)
+	return r.populateProject(&p, statusStr, startDateStr, createdAtStr, updatedAtStr, targetDateStr, archivedAtStr, snoozedStr)
 }
 
 // scanProjectFromRows scans a single project row from *sql.Rows.
 func (r *SQLiteProjectRepo) scanProjectFromRows(rows *sql.Rows) (*domain.Project, error) {
 	var p domain.Project
 	var startDateStr, createdAtStr, updatedAtStr, statusStr string
-	var targetDateStr, archivedAtStr sql.NullString
+	var targetDateStr, archivedAtStr, snoozedStr sql.NullString
 
 	err := rows.Scan(
 		&p.ID, &p.ShortID, &p.Name, &p.Domain,
-		&startDateStr, &targetDateStr,
+		&startDateStr, &targetDateStr, &p.Importance,
 		&statusStr, &archivedAtStr,
-		&createdAtStr, &updatedAtStr,
+		&createdAtStr, &updatedAtStr, &snoozedStr,
+		&p.WorkDefaults.Type, &p.WorkDefaults.PlannedMin,
+		&p.WorkDefaults.MinSessionMin, &p.WorkDefaults.MaxSessionMin, &p.WorkDefaults.DefaultSessionMin,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("scanning project row: %w", err)
 	}
 
-	return r.populateProject(&p, statusStr, startDateStr, createdAtStr, updatedAtStr, targetDateStr, archivedAtStr)
+	return r.populateProject(&p, statusStr, startDateStr, createdAtStr, updatedAtStr, targetDateStr, archivedAtStr, snoozedStr)
 }
 
 // populateProject fills in parsed fields on a Project after scanning raw strings.
 func (r *SQLiteProjectRepo) populateProject(
 	p *domain.Project,
 	statusStr, startDateStr, createdAtStr, updatedAtStr string,
-	targetDateStr, archivedAtStr sql.NullString,
+	targetDateStr, archivedAtStr, snoozedStr sql.NullString,
 ) (*domain.Project, error) {
 	p.Status = domain.ProjectStatus(statusStr)
 
@@ -195,8 +219,9 @@ func (r *SQLiteProjectRepo) populateProject(
 		return nil, fmt.Errorf("parsing updated_at: %w", parseErr)
 	}
 
-	p.TargetDate = parseNullableTime(targetDateStr, dateLayout)
+	p.TargetDate = parseNullableDeadline(targetDateStr)
 	p.ArchivedAt = parseNullableTime(archivedAtStr, time.RFC3339)
+	p.CriticalSnoozedUntil = parseNullableTime(snoozedStr, time.RFC3339)
 
 	return p, nil
 }
@@ -158,3 +158,27 @@ func TestProjectRepo_NullTargetDate(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, fetched.TargetDate)
 }
+
+func TestProjectRepo_CriticalSnoozeRoundTrip(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	repo := NewSQLiteProjectRepo(db)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Thesis")
+	require.NoError(t, repo.Create(ctx, proj))
+	fetched, err := repo.GetByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.CriticalSnoozedUntil)
+
+	until := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	fetched.CriticalSnoozedUntil = &until
+	require.NoError(t, repo.Update(ctx, fetched))
+
+	list, err := repo.List(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.NotNil(t, list[0].CriticalSnoozedUntil)
+	assert.True(t, until.Equal(*list[0].CriticalSnoozedUntil))
+	assert.True(t, list[0].CriticalSnoozed(until.Add(-time.Minute)))
+	assert.False(t, list[0].CriticalSnoozed(until))
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteRiskSnapshotRepo implements RiskSnapshotRepo using a SQLite database.
+type SQLiteRiskSnapshotRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteRiskSnapshotRepo creates a new SQLiteRiskSnapshotRepo.
+func NewSQLiteRiskSnapshotRepo(conn db.DBTX) *SQLiteRiskSnapshotRepo {
+	return &SQLiteRiskSnapshotRepo{db: conn}
+}
+
+func (r *SQLiteRiskSnapshotRepo) Upsert(ctx context.Context, s *domain.RiskSnapshot) error {
+	query := `INSERT INTO risk_snapshots (project_id, snapshot_date, risk_level,
+		progress_time_pct, progress_structural_pct, planned_min, logged_min, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(project_id, snapshot_date) DO UPDATE SET
+			risk_level = excluded.risk_level,
+			progress_time_pct = excluded.progress_time_pct,
+			progress_structural_pct = excluded.progress_structural_pct,
+			planned_min = excluded.planned_min,
+			logged_min = excluded.logged_min,
+			created_at = excluded.created_at`
+	_, err := r.db.ExecContext(ctx, query,
+		s.ProjectID,
+		s.SnapshotDate.Format(dateLayout),
+		string(s.RiskLevel),
+		s.ProgressTimePct,
+		s.ProgressStructuralPct,
+		s.PlannedMin,
+		s.LoggedMin,
+		s.CreatedAt.Format(time.RFC3339),
+	)
+	if err != nil {
+		return fmt.Errorf("upserting risk snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteRiskSnapshotRepo) GetLatestOnOrBefore(ctx context.Context, projectID string, date time.Time) (*domain.RiskSnapshot, error) {
+	query := `SELECT project_id, snapshot_date, risk_level, progress_time_pct,
+		progress_structural_pct, planned_min, logged_min, created_at
+		FROM risk_snapshots
+		WHERE project_id = ? AND snapshot_date <= ?
+		ORDER BY snapshot_date DESC
+		LIMIT 1`
+	row := r.db.QueryRowContext(ctx, query, projectID, date.Format(dateLayout))
+
+	var s domain.RiskSnapshot
+	var dateStr, riskStr, createdAtStr string
+	err := row.Scan(&s.ProjectID, &dateStr, &riskStr, &s.ProgressTimePct,
+		&s.ProgressStructuralPct, &s.PlannedMin, &s.LoggedMin, &createdAtStr)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("risk snapshot for %s: %w", projectID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("scanning risk snapshot: %w", err)
+	}
+
+	s.RiskLevel = domain.RiskLevel(riskStr)
+	s.SnapshotDate, err = time.Parse(dateLayout, dateStr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing snapshot_date: %w", err)
+	}
+	s.CreatedAt, err = time.Parse(time.RFC3339, createdAtStr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing created_at: %w", err)
+	}
+	return &s, nil
+}
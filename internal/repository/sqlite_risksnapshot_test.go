@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskSnapshotRepo_UpsertAndLatestOnOrBefore(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+
+	projRepo := NewSQLiteProjectRepo(db)
+	snapRepo := NewSQLiteRiskSnapshotRepo(db)
+
+	proj := testutil.NewTestProject("History")
+	require.NoError(t, projRepo.Create(ctx, proj))
+
+	day1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 5)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, snapRepo.Upsert(ctx, &domain.RiskSnapshot{
+		ProjectID: proj.ID, SnapshotDate: day1, RiskLevel: domain.RiskAtRisk,
+		ProgressTimePct: 10, CreatedAt: now,
+	}))
+	require.NoError(t, snapRepo.Upsert(ctx, &domain.RiskSnapshot{
+		ProjectID: proj.ID, SnapshotDate: day2, RiskLevel: domain.RiskAtRisk,
+		ProgressTimePct: 20, CreatedAt: now,
+	}))
+	// Same day again: last write wins.
+	require.NoError(t, snapRepo.Upsert(ctx, &domain.RiskSnapshot{
+		ProjectID: proj.ID, SnapshotDate: day2, RiskLevel: domain.RiskOnTrack,
+		ProgressTimePct: 25, PlannedMin: 120, LoggedMin: 30, CreatedAt: now,
+	}))
+
+	got, err := snapRepo.GetLatestOnOrBefore(ctx, proj.ID, day2.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	assert.Equal(t, day2, got.SnapshotDate)
+	assert.Equal(t, domain.RiskOnTrack, got.RiskLevel)
+	assert.InDelta(t, 25, got.ProgressTimePct, 0.001)
+	assert.Equal(t, 120, got.PlannedMin)
+	assert.Equal(t, 30, got.LoggedMin)
+
+	got, err = snapRepo.GetLatestOnOrBefore(ctx, proj.ID, day2.AddDate(0, 0, -1))
+	require.NoError(t, err)
+	assert.Equal(t, day1, got.SnapshotDate)
+	assert.InDelta(t, 10, got.ProgressTimePct, 0.001)
+
+	_, err = snapRepo.GetLatestOnOrBefore(ctx, proj.ID, day1.AddDate(0, 0, -1))
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -21,8 +21,8 @@ func NewSQLiteSessionRepo(conn db.DBTX) *SQLiteSessionRepo {
 }
 
 func (r *SQLiteSessionRepo) Create(ctx context.Context, s *domain.WorkSessionLog) error {
-	query := `INSERT INTO work_session_logs (id, work_item_id, started_at, minutes, units_done_delta, note, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)`
+	query := `INSERT INTO work_session_logs (id, work_item_id, started_at, minutes, units_done_delta, note, tags, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 	_, err := r.db.ExecContext(ctx, query,
 		s.ID,
 		s.WorkItemID,
@@ -30,6 +30,7 @@ func (r *SQLiteSessionRepo) Create(ctx context.Context, s *domain.WorkSessionLog
 		s.Minutes,
 		s.UnitsDoneDelta,
 		s.Note,
+		tagsToJSON(s.Tags),
 		s.CreatedAt.Format(time.RFC3339),
 	)
 	if err != nil {
@@ -39,14 +40,14 @@ func (r *SQLiteSessionRepo) Create(ctx context.Context, s *domain.WorkSessionLog
 }
 
 func (r *SQLiteSessionRepo) GetByID(ctx context.Context, id string) (*domain.WorkSessionLog, error) {
-	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, created_at
+	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, tags, created_at
 		FROM work_session_logs WHERE id = ?`
 	row := r.db.QueryRowContext(ctx, query, id)
 	return r.scanSession(row)
 }
 
 func (r *SQLiteSessionRepo) ListByWorkItem(ctx context.Context, workItemID string) ([]*domain.WorkSessionLog, error) {
-	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, created_at
+	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, tags, created_at
 		FROM work_session_logs WHERE work_item_id = ? ORDER BY started_at`
 	rows, err := r.db.QueryContext(ctx, query, workItemID)
 	if err != nil {
@@ -57,7 +58,7 @@ func (r *SQLiteSessionRepo) ListByWorkItem(ctx context.Context, workItemID strin
 }
 
 func (r *SQLiteSessionRepo) ListRecent(ctx context.Context, days int) ([]*domain.WorkSessionLog, error) {
-	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, created_at
+	query := `SELECT id, work_item_id, started_at, minutes, units_done_delta, note, tags, created_at
 		FROM work_session_logs
 		WHERE started_at >= date('now', ? || ' days')
 		ORDER BY started_at DESC`
@@ -70,7 +71,7 @@ func (r *SQLiteSessionRepo) ListRecent(ctx context.Context, days int) ([]*domain
 }
 
 func (r *SQLiteSessionRepo) ListRecentByProject(ctx context.Context, projectID string, days int) ([]*domain.WorkSessionLog, error) {
-	query := `SELECT s.id, s.work_item_id, s.started_at, s.minutes, s.units_done_delta, s.note, s.created_at
+	query := `SELECT s.id, s.work_item_id, s.started_at, s.minutes, s.units_done_delta, s.note, s.tags, s.created_at
 		FROM work_session_logs s
 		JOIN work_items w ON s.work_item_id = w.id
 		JOIN plan_nodes n ON w.node_id = n.id
@@ -112,6 +113,48 @@ func (r *SQLiteSessionRepo) ListRecentSummaryByType(ctx context.Context, days in
 	return summaries, nil
 }
 
+// SummaryByTag sums session minutes per tag for sessions started in
+// [from, to). A session with several tags counts toward each of them;
+// untagged sessions are reported under the empty tag.
+func (r *SQLiteSessionRepo) SummaryByTag(ctx context.Context, from, to time.Time) ([]domain.SessionTagSummary, error) {
+	query := `SELECT COALESCE(t.value, '') AS tag, SUM(s.minutes) AS total_minutes, COUNT(*)
+		FROM work_session_logs s
+		LEFT JOIN json_each(s.tags) t
+		WHERE s.started_at >= ? AND s.started_at < ?
+		GROUP BY tag
+		ORDER BY total_minutes DESC, tag`
+	rows, err := r.db.QueryContext(ctx, query, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("summarizing sessions by tag: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []domain.SessionTagSummary
+	for rows.Next() {
+		var s domain.SessionTagSummary
+		if err := rows.Scan(&s.Tag, &s.TotalMinutes, &s.SessionCount); err != nil {
+			return nil, fmt.Errorf("scanning session tag summary row: %w", err)
+		}
+		summaries = append(summaries, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating session tag summaries: %w", err)
+	}
+	return summaries, nil
+}
+
+func (r *SQLiteSessionRepo) LatestLogged(ctx context.Context, projectID string) (*domain.WorkSessionLog, error) {
+	query := `SELECT s.id, s.work_item_id, s.started_at, s.minutes, s.units_done_delta, s.note, s.tags, s.created_at
+		FROM work_session_logs s
+		JOIN work_items w ON s.work_item_id = w.id
+		JOIN plan_nodes n ON w.node_id = n.id
+		WHERE ? = '' OR n.project_id = ?
+		ORDER BY s.created_at DESC, s.rowid DESC
+		LIMIT 1`
+	row := r.db.QueryRowContext(ctx, query, projectID, projectID)
+	return r.scanSession(row)
+}
+
 func (r *SQLiteSessionRepo) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM work_session_logs WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query, id)
@@ -124,10 +167,10 @@ func (r *SQLiteSessionRepo) Delete(ctx context.Context, id string) error {
 // scanSession scans a single session from a *sql.Row.
 func (r *SQLiteSessionRepo) scanSession(row *sql.Row) (*domain.WorkSessionLog, error) {
 	var s domain.WorkSessionLog
-	var startedAtStr, createdAtStr string
+	var startedAtStr, createdAtStr, tagsStr string
 
 	err := row.Scan(
-		&s.ID, &s.WorkItemID, &startedAtStr, &s.Minutes, &s.UnitsDoneDelta, &s.Note, &createdAtStr,
+		&s.ID, &s.WorkItemID, &startedAtStr, &s.Minutes, &s.UnitsDoneDelta, &s.Note, &tagsStr, &createdAtStr,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -136,7 +179,7 @@ func (r *SQLiteSessionRepo) scanSession(row *sql.Row) (*domain.WorkSessionLog, e
 		return nil, fmt.Errorf("scanning work session log: %w", err)
 	}
 
-	return r.populateSession(&s, startedAtStr, createdAtStr)
+	return r.populateSession(&s, startedAtStr, createdAtStr, tagsStr)
 }
 
 // scanSessions scans multiple sessions from *sql.Rows.
@@ -144,16 +187,16 @@ func (r *SQLiteSessionRepo) scanSessions(rows *sql.Rows) ([]*domain.WorkSessionL
 	var sessions []*domain.WorkSessionLog
 	for rows.Next() {
 		var s domain.WorkSessionLog
-		var startedAtStr, createdAtStr string
+		var startedAtStr, createdAtStr, tagsStr string
 
 		err := rows.Scan(
-			&s.ID, &s.WorkItemID, &startedAtStr, &s.Minutes, &s.UnitsDoneDelta, &s.Note, &createdAtStr,
+			&s.ID, &s.WorkItemID, &startedAtStr, &s.Minutes, &s.UnitsDoneDelta, &s.Note, &tagsStr, &createdAtStr,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning session row: %w", err)
 		}
 
-		session, parseErr := r.populateSession(&s, startedAtStr, createdAtStr)
+		session, parseErr := r.populateSession(&s, startedAtStr, createdAtStr, tagsStr)
 		if parseErr != nil {
 			return nil, parseErr
 		}
@@ -167,7 +210,7 @@ func (r *SQLiteSessionRepo) scanSessions(rows *sql.Rows) ([]*domain.WorkSessionL
 }
 
 // populateSession fills in parsed fields on a WorkSessionLog after scanning raw strings.
-func (r *SQLiteSessionRepo) populateSession(s *domain.WorkSessionLog, startedAtStr, createdAtStr string) (*domain.WorkSessionLog, error) {
+func (r *SQLiteSessionRepo) populateSession(s *domain.WorkSessionLog, startedAtStr, createdAtStr, tagsStr string) (*domain.WorkSessionLog, error) {
 	var parseErr error
 	s.StartedAt, parseErr = time.Parse(time.RFC3339, startedAtStr)
 	if parseErr != nil {
@@ -177,6 +220,10 @@ func (r *SQLiteSessionRepo) populateSession(s *domain.WorkSessionLog, startedAtS
 	if parseErr != nil {
 		return nil, fmt.Errorf("parsing created_at: %w", parseErr)
 	}
+	s.Tags, parseErr = parseTags(tagsStr)
+	if parseErr != nil {
+		return nil, parseErr
+	}
 
 	return s, nil
 }
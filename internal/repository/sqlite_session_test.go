@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -155,3 +156,47 @@ func TestSessionRepo_ListRecentByProject(t *testing.T) {
 	assert.Len(t, listB, 1)
 	assert.Equal(t, sessB.ID, listB[0].ID)
 }
+
+func TestSessionRepo_TagsRoundTrip(t *testing.T) {
+	repo, wiID := sessionTestSetup(t)
+	ctx := context.Background()
+
+	tagged := testutil.NewTestSession(wiID, 30, testutil.WithTags("billable", "research"))
+	plain := testutil.NewTestSession(wiID, 15)
+	require.NoError(t, repo.Create(ctx, tagged))
+	require.NoError(t, repo.Create(ctx, plain))
+
+	fetched, err := repo.GetByID(ctx, tagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"billable", "research"}, fetched.Tags)
+
+	fetched, err = repo.GetByID(ctx, plain.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.Tags)
+}
+
+func TestSessionRepo_SummaryByTag(t *testing.T) {
+	repo, wiID := sessionTestSetup(t)
+	ctx := context.Background()
+
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	for _, s := range []*domain.WorkSessionLog{
+		testutil.NewTestSession(wiID, 60, testutil.WithStartedAt(day), testutil.WithTags("billable")),
+		testutil.NewTestSession(wiID, 30, testutil.WithStartedAt(day.Add(2*time.Hour)), testutil.WithTags("billable", "research")),
+		testutil.NewTestSession(wiID, 20, testutil.WithStartedAt(day.Add(3*time.Hour))),
+		// Outside the range on either side.
+		testutil.NewTestSession(wiID, 90, testutil.WithStartedAt(day.AddDate(0, 0, -1)), testutil.WithTags("billable")),
+		testutil.NewTestSession(wiID, 90, testutil.WithStartedAt(day.AddDate(0, 0, 1)), testutil.WithTags("billable")),
+	} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	from := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	got, err := repo.SummaryByTag(ctx, from, from.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SessionTagSummary{
+		{Tag: "billable", TotalMinutes: 90, SessionCount: 2},
+		{Tag: "research", TotalMinutes: 30, SessionCount: 1},
+		{Tag: "", TotalMinutes: 20, SessionCount: 1},
+	}, got)
+}
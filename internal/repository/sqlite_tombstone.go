@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteTombstoneRepo implements TombstoneRepo using a SQLite database.
+// Rows are written by delete triggers (see migrations); this repo only reads them.
+type SQLiteTombstoneRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteTombstoneRepo creates a new SQLiteTombstoneRepo.
+func NewSQLiteTombstoneRepo(conn db.DBTX) *SQLiteTombstoneRepo {
+	return &SQLiteTombstoneRepo{db: conn}
+}
+
+func (r *SQLiteTombstoneRepo) ListSince(ctx context.Context, since time.Time) ([]domain.Tombstone, error) {
+	query := `SELECT entity_type, entity_id, deleted_at FROM tombstones
+		WHERE deleted_at > ?
+		ORDER BY deleted_at, entity_type, entity_id`
+	rows, err := r.db.QueryContext(ctx, query, since.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("listing tombstones: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.Tombstone
+	for rows.Next() {
+		var t domain.Tombstone
+		var deletedAtStr string
+		if err := rows.Scan(&t.EntityType, &t.EntityID, &deletedAtStr); err != nil {
+			return nil, fmt.Errorf("scanning tombstone: %w", err)
+		}
+		t.At, err = time.Parse(time.RFC3339, deletedAtStr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing deleted_at: %w", err)
+		}
+		t.Reason = domain.TombstoneDeleted
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstoneRepo_CapturesCascadedDeletes(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+
+	projRepo := NewSQLiteProjectRepo(db)
+	nodeRepo := NewSQLitePlanNodeRepo(db)
+	wiRepo := NewSQLiteWorkItemRepo(db)
+	sessRepo := NewSQLiteSessionRepo(db)
+	tombRepo := NewSQLiteTombstoneRepo(db)
+
+	proj := testutil.NewTestProject("Doomed")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node1")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Task1")
+	require.NoError(t, wiRepo.Create(ctx, wi))
+	sess := testutil.NewTestSession(wi.ID, 30)
+	require.NoError(t, sessRepo.Create(ctx, sess))
+
+	cutoff := time.Now().Add(-time.Minute)
+	require.NoError(t, projRepo.Delete(ctx, proj.ID))
+
+	tombstones, err := tombRepo.ListSince(ctx, cutoff)
+	require.NoError(t, err)
+
+	got := make(map[string]string)
+	for _, ts := range tombstones {
+		assert.Equal(t, domain.TombstoneDeleted, ts.Reason)
+		got[ts.EntityID] = ts.EntityType
+	}
+	assert.Equal(t, domain.TombstoneEntityProject, got[proj.ID])
+	assert.Equal(t, domain.TombstoneEntityNode, got[node.ID])
+	assert.Equal(t, domain.TombstoneEntityWorkItem, got[wi.ID])
+	assert.Equal(t, domain.TombstoneEntitySession, got[sess.ID])
+}
+
+func TestTombstoneRepo_ListSinceFiltersByCutoff(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+
+	projRepo := NewSQLiteProjectRepo(db)
+	tombRepo := NewSQLiteTombstoneRepo(db)
+
+	proj := testutil.NewTestProject("Gone")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	require.NoError(t, projRepo.Delete(ctx, proj.ID))
+
+	tombstones, err := tombRepo.ListSince(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, tombstones)
+}
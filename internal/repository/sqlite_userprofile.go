@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/domain"
@@ -21,11 +23,16 @@ func NewSQLiteUserProfileRepo(conn db.DBTX) *SQLiteUserProfileRepo {
 
 func (r *SQLiteUserProfileRepo) Get(ctx context.Context) (*domain.UserProfile, error) {
 	query := `SELECT id, buffer_pct, weight_deadline_pressure, weight_behind_pace,
-		weight_spacing, weight_variation, default_max_slices, baseline_daily_min
+		weight_spacing, weight_variation, weight_focus, weight_importance, default_max_slices, baseline_daily_min,
+		focus_block_min, break_min, auto_replan, autocorrect, weekday_min, max_daily_min,
+		active_hours_start, active_hours_end, respect_active_hours, validate_session_time,
+		pace_window_days, spacing_lookback_days
 		FROM user_profile WHERE id = 'default'`
 	row := r.db.QueryRowContext(ctx, query)
 
 	var p domain.UserProfile
+	var autoReplanInt, autocorrectInt, respectHoursInt, validateSessionInt int
+	var weekdayMin string
 	err := row.Scan(
 		&p.ID,
 		&p.BufferPct,
@@ -33,8 +40,22 @@ func (r *SQLiteUserProfileRepo) Get(ctx context.Context) (*domain.UserProfile, e
 		&p.WeightBehindPace,
 		&p.WeightSpacing,
 		&p.WeightVariation,
+		&p.WeightFocus,
+		&p.WeightImportance,
 		&p.DefaultMaxSlices,
 		&p.BaselineDailyMin,
+		&p.FocusBlockMin,
+		&p.BreakMin,
+		&autoReplanInt,
+		&autocorrectInt,
+		&weekdayMin,
+		&p.MaxDailyMin,
+		&p.ActiveHoursStart,
+		&p.ActiveHoursEnd,
+		&respectHoursInt,
+		&validateSessionInt,
+		&p.PaceWindowDays,
+		&p.SpacingLookbackDays,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -42,13 +63,23 @@ func (r *SQLiteUserProfileRepo) Get(ctx context.Context) (*domain.UserProfile, e
 		}
 		return nil, fmt.Errorf("scanning user profile: %w", err)
 	}
+	p.AutoReplan = intToBool(autoReplanInt)
+	p.Autocorrect = intToBool(autocorrectInt)
+	p.RespectActiveHours = intToBool(respectHoursInt)
+	p.ValidateSessionTime = intToBool(validateSessionInt)
+	if p.WeekdayMin, err = parseWeekdayMin(weekdayMin); err != nil {
+		return nil, err
+	}
 	return &p, nil
 }
 
 func (r *SQLiteUserProfileRepo) Upsert(ctx context.Context, p *domain.UserProfile) error {
 	query := `INSERT OR REPLACE INTO user_profile (id, buffer_pct, weight_deadline_pressure,
-		weight_behind_pace, weight_spacing, weight_variation, default_max_slices, baseline_daily_min)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		weight_behind_pace, weight_spacing, weight_variation, weight_focus, weight_importance, default_max_slices, baseline_daily_min,
+		focus_block_min, break_min, auto_replan, autocorrect, weekday_min, max_daily_min,
+		active_hours_start, active_hours_end, respect_active_hours, validate_session_time,
+		pace_window_days, spacing_lookback_days)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	_, err := r.db.ExecContext(ctx, query,
 		p.ID,
 		p.BufferPct,
@@ -56,11 +87,52 @@ func (r *SQLiteUserProfileRepo) Upsert(ctx context.Context, p *domain.UserProfil
 		p.WeightBehindPace,
 		p.WeightSpacing,
 		p.WeightVariation,
+		p.WeightFocus,
+		p.WeightImportance,
 		p.DefaultMaxSlices,
 		p.BaselineDailyMin,
+		p.FocusBlockMin,
+		p.BreakMin,
+		boolToInt(p.AutoReplan),
+		boolToInt(p.Autocorrect),
+		weekdayMinToString(p.WeekdayMin),
+		p.MaxDailyMin,
+		p.ActiveHoursStart,
+		p.ActiveHoursEnd,
+		boolToInt(p.RespectActiveHours),
+		boolToInt(p.ValidateSessionTime),
+		p.PaceWindowDays,
+		p.SpacingLookbackDays,
 	)
 	if err != nil {
 		return fmt.Errorf("upserting user profile: %w", err)
 	}
 	return nil
 }
+
+// weekdayMinToString encodes per-weekday minutes as "120,120,120,120,120,60,0";
+// nil encodes as "".
+func weekdayMinToString(mins []int) string {
+	parts := make([]string, len(mins))
+	for i, m := range mins {
+		parts[i] = strconv.Itoa(m)
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseWeekdayMin decodes the weekday_min column. An empty value decodes to nil.
+func parseWeekdayMin(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	mins := make([]int, len(parts))
+	for i, part := range parts {
+		m, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("parsing weekday_min %q: %w", s, err)
+		}
+		mins[i] = m
+	}
+	return mins, nil
+}
@@ -24,8 +24,13 @@ func TestUserProfileRepo_Get_DefaultSeededProfile(t *testing.T) {
 	assert.Equal(t, 0.8, profile.WeightBehindPace)
 	assert.Equal(t, 0.5, profile.WeightSpacing)
 	assert.Equal(t, 0.3, profile.WeightVariation)
+	assert.Equal(t, 1.0, profile.WeightFocus)
 	assert.Equal(t, 3, profile.DefaultMaxSlices)
 	assert.Equal(t, 30, profile.BaselineDailyMin)
+	assert.Equal(t, 25, profile.FocusBlockMin)
+	assert.Equal(t, 5, profile.BreakMin)
+	assert.False(t, profile.AutoReplan)
+	assert.Nil(t, profile.WeekdayMin)
 }
 
 func TestUserProfileRepo_Upsert_UpdatesProfile(t *testing.T) {
@@ -40,8 +45,19 @@ func TestUserProfileRepo_Upsert_UpdatesProfile(t *testing.T) {
 		WeightBehindPace:       0.9,
 		WeightSpacing:          0.7,
 		WeightVariation:        0.4,
+		WeightFocus:            1.5,
 		DefaultMaxSlices:       5,
 		BaselineDailyMin:       45,
+		FocusBlockMin:          50,
+		BreakMin:               10,
+		AutoReplan:             true,
+		WeekdayMin:             []int{120, 120, 90, 120, 60, 180, 0},
+		MaxDailyMin:            360,
+		ActiveHoursStart:       7 * 60,
+		ActiveHoursEnd:         22*60 + 30,
+		RespectActiveHours:     true,
+		PaceWindowDays:         14,
+		SpacingLookbackDays:    3,
 	}
 	require.NoError(t, repo.Upsert(ctx, updated))
 
@@ -53,8 +69,19 @@ func TestUserProfileRepo_Upsert_UpdatesProfile(t *testing.T) {
 	assert.Equal(t, updated.WeightBehindPace, got.WeightBehindPace)
 	assert.Equal(t, updated.WeightSpacing, got.WeightSpacing)
 	assert.Equal(t, updated.WeightVariation, got.WeightVariation)
+	assert.Equal(t, updated.WeightFocus, got.WeightFocus)
 	assert.Equal(t, updated.DefaultMaxSlices, got.DefaultMaxSlices)
 	assert.Equal(t, updated.BaselineDailyMin, got.BaselineDailyMin)
+	assert.Equal(t, updated.FocusBlockMin, got.FocusBlockMin)
+	assert.Equal(t, updated.BreakMin, got.BreakMin)
+	assert.True(t, got.AutoReplan)
+	assert.Equal(t, updated.WeekdayMin, got.WeekdayMin)
+	assert.Equal(t, updated.MaxDailyMin, got.MaxDailyMin)
+	assert.Equal(t, updated.ActiveHoursStart, got.ActiveHoursStart)
+	assert.Equal(t, updated.ActiveHoursEnd, got.ActiveHoursEnd)
+	assert.True(t, got.RespectActiveHours)
+	assert.Equal(t, updated.PaceWindowDays, got.PaceWindowDays)
+	assert.Equal(t, updated.SpacingLookbackDays, got.SpacingLookbackDays)
 }
 
 func TestUserProfileRepo_Get_NotFoundWhenDefaultDeleted(t *testing.T) {
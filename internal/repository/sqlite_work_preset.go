@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+)
+
+// SQLiteWorkPresetRepo implements WorkPresetRepo using a SQLite database.
+type SQLiteWorkPresetRepo struct {
+	db db.DBTX
+}
+
+// NewSQLiteWorkPresetRepo creates a new SQLiteWorkPresetRepo.
+func NewSQLiteWorkPresetRepo(conn db.DBTX) *SQLiteWorkPresetRepo {
+	return &SQLiteWorkPresetRepo{db: conn}
+}
+
+const workPresetColumns = `name, type, planned_min, min_session_min, max_session_min, default_session_min`
+
+func (r *SQLiteWorkPresetRepo) Upsert(ctx context.Context, p *domain.WorkPreset) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO work_presets (`+workPresetColumns+`) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			type = excluded.type,
+			planned_min = excluded.planned_min,
+			min_session_min = excluded.min_session_min,
+			max_session_min = excluded.max_session_min,
+			default_session_min = excluded.default_session_min`,
+		p.Name, p.Type, p.PlannedMin, p.MinSessionMin, p.MaxSessionMin, p.DefaultSessionMin)
+	if err != nil {
+		return fmt.Errorf("saving work preset: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteWorkPresetRepo) GetByName(ctx context.Context, name string) (*domain.WorkPreset, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+workPresetColumns+` FROM work_presets WHERE name = ?`, name)
+	p, err := scanWorkPreset(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("work preset %q: %w", name, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting work preset: %w", err)
+	}
+	return p, nil
+}
+
+func (r *SQLiteWorkPresetRepo) List(ctx context.Context) ([]*domain.WorkPreset, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+workPresetColumns+` FROM work_presets ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("listing work presets: %w", err)
+	}
+	defer rows.Close()
+
+	var presets []*domain.WorkPreset
+	for rows.Next() {
+		p, err := scanWorkPreset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning work preset: %w", err)
+		}
+		presets = append(presets, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating work presets: %w", err)
+	}
+	return presets, nil
+}
+
+func (r *SQLiteWorkPresetRepo) Delete(ctx context.Context, name string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM work_presets WHERE name = ?`, name)
+	if err != nil {
+		return fmt.Errorf("deleting work preset: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("deleting work preset: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("work preset %q: %w", name, ErrNotFound)
+	}
+	return nil
+}
+
+func scanWorkPreset(s interface{ Scan(dest ...any) error }) (*domain.WorkPreset, error) {
+	var p domain.WorkPreset
+	if err := s.Scan(&p.Name, &p.Type, &p.PlannedMin, &p.MinSessionMin, &p.MaxSessionMin, &p.DefaultSessionMin); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
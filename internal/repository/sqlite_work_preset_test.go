@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkPresetRepo_UpsertListDelete(t *testing.T) {
+	repo := NewSQLiteWorkPresetRepo(testutil.NewTestDB(t))
+	ctx := context.Background()
+
+	reading := &domain.WorkPreset{Name: "reading45", Type: "reading", PlannedMin: 45, MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30}
+	require.NoError(t, repo.Upsert(ctx, reading))
+	require.NoError(t, repo.Upsert(ctx, &domain.WorkPreset{Name: "drill", Type: "practice", PlannedMin: 20}))
+
+	got, err := repo.GetByName(ctx, "reading45")
+	require.NoError(t, err)
+	assert.Equal(t, reading, got)
+
+	reading.PlannedMin = 50
+	require.NoError(t, repo.Upsert(ctx, reading))
+	got, err = repo.GetByName(ctx, "reading45")
+	require.NoError(t, err)
+	assert.Equal(t, 50, got.PlannedMin, "upsert replaces the existing preset")
+
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "drill", list[0].Name, "ordered by name")
+
+	require.NoError(t, repo.Delete(ctx, "drill"))
+	assert.True(t, errors.Is(repo.Delete(ctx, "drill"), ErrNotFound))
+	_, err = repo.GetByName(ctx, "drill")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -15,7 +16,7 @@ const workItemColumns = `id, node_id, title, type, status, archived_at,
 		duration_mode, planned_min, logged_min, duration_source, estimate_confidence,
 		min_session_min, max_session_min, default_session_min, splittable,
 		units_kind, units_total, units_done, due_date, not_before, seq, created_at, updated_at,
-		description, completed_at`
+		description, completed_at, ref, initial_planned_min, checklist, tags, manual_priority, order_index`
 
 // workItemColumnsAliased is the same column list prefixed with "w." for join queries.
 const workItemColumnsAliased = `w.id, w.node_id, w.title, w.type, w.status, w.archived_at,
@@ -23,7 +24,7 @@ const workItemColumnsAliased = `w.id, w.node_id, w.title, w.type, w.status, w.ar
 		w.min_session_min, w.max_session_min, w.default_session_min, w.splittable,
 		w.units_kind, w.units_total, w.units_done, w.due_date, w.not_before, w.seq,
 		w.created_at, w.updated_at,
-		w.description, w.completed_at`
+		w.description, w.completed_at, w.ref, w.initial_planned_min, w.checklist, w.tags, w.manual_priority, w.order_index`
 
 // SQLiteWorkItemRepo implements WorkItemRepo using a SQLite database.
 type SQLiteWorkItemRepo struct {
@@ -35,14 +36,25 @@ func NewSQLiteWorkItemRepo(conn db.DBTX) *SQLiteWorkItemRepo {
 	return &SQLiteWorkItemRepo{db: conn}
 }
 
+// Create inserts w after the existing items of its node, setting OrderIndex.
 func (r *SQLiteWorkItemRepo) Create(ctx context.Context, w *domain.WorkItem) error {
+	orderIndex, err := r.nextOrderIndex(ctx, w.NodeID)
+	if err != nil {
+		return err
+	}
+	w.OrderIndex = orderIndex
+
 	query := `INSERT INTO work_items (id, node_id, title, type, status, archived_at,
 		duration_mode, planned_min, logged_min, duration_source, estimate_confidence,
 		min_session_min, max_session_min, default_session_min, splittable,
 		units_kind, units_total, units_done, due_date, not_before, seq, created_at, updated_at,
-		description, completed_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
-	_, err := r.db.ExecContext(ctx, query,
+		description, completed_at, ref, initial_planned_min, checklist, tags, manual_priority, order_index)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	initialPlanned := w.InitialPlannedMin
+	if initialPlanned == 0 {
+		initialPlanned = w.PlannedMin
+	}
+	_, err = r.db.ExecContext(ctx, query,
 		w.ID,
 		w.NodeID,
 		w.Title,
@@ -61,13 +73,19 @@ func (r *SQLiteWorkItemRepo) Create(ctx context.Context, w *domain.WorkItem) err
 		w.UnitsKind,
 		w.UnitsTotal,
 		w.UnitsDone,
-		nullableTimeToString(w.DueDate, dateLayout),
+		nullableDeadlineToString(w.DueDate),
 		nullableTimeToString(w.NotBefore, dateLayout),
 		w.Seq,
 		w.CreatedAt.Format(time.RFC3339),
 		w.UpdatedAt.Format(time.RFC3339),
 		w.Description,
 		nullableTimeToString(w.CompletedAt, time.RFC3339),
+		w.Ref,
+		initialPlanned,
+		checklistToJSON(w.Checklist),
+		tagsToJSON(w.Tags),
+		string(w.ManualPriority),
+		w.OrderIndex,
 	)
 	if err != nil {
 		return fmt.Errorf("inserting work item: %w", err)
@@ -75,6 +93,17 @@ func (r *SQLiteWorkItemRepo) Create(ctx context.Context, w *domain.WorkItem) err
 	return nil
 }
 
+// nextOrderIndex returns the order index after the last item in a node.
+func (r *SQLiteWorkItemRepo) nextOrderIndex(ctx context.Context, nodeID string) (int, error) {
+	var next int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(order_index), -1) + 1 FROM work_items WHERE node_id = ?`, nodeID).Scan(&next)
+	if err != nil {
+		return 0, fmt.Errorf("finding next work item order: %w", err)
+	}
+	return next, nil
+}
+
 func (r *SQLiteWorkItemRepo) GetByID(ctx context.Context, id string) (*domain.WorkItem, error) {
 	query := `SELECT ` + workItemColumns + ` FROM work_items WHERE id = ?`
 	row := r.db.QueryRowContext(ctx, query, id)
@@ -91,7 +120,7 @@ func (r *SQLiteWorkItemRepo) GetBySeq(ctx context.Context, projectID string, seq
 }
 
 func (r *SQLiteWorkItemRepo) ListByNode(ctx context.Context, nodeID string) ([]*domain.WorkItem, error) {
-	query := `SELECT ` + workItemColumns + ` FROM work_items WHERE node_id = ? ORDER BY created_at`
+	query := `SELECT ` + workItemColumns + ` FROM work_items WHERE node_id = ? ORDER BY order_index, created_at`
 	rows, err := r.db.QueryContext(ctx, query, nodeID)
 	if err != nil {
 		return nil, fmt.Errorf("listing work items by node: %w", err)
@@ -105,7 +134,7 @@ func (r *SQLiteWorkItemRepo) ListByProject(ctx context.Context, projectID string
 		FROM work_items w
 		JOIN plan_nodes n ON w.node_id = n.id
 		WHERE n.project_id = ?
-		ORDER BY w.created_at`
+		ORDER BY w.order_index, w.created_at`
 	rows, err := r.db.QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("listing work items by project: %w", err)
@@ -114,31 +143,33 @@ func (r *SQLiteWorkItemRepo) ListByProject(ctx context.Context, projectID string
 	return r.scanWorkItems(rows)
 }
 
-func (r *SQLiteWorkItemRepo) ListSchedulable(ctx context.Context, includeArchived bool) ([]SchedulableCandidate, error) {
-	schedulableJoinedColumns := workItemColumnsAliased + `,
+// schedulableColumns are the work item and joined node/project columns of a
+// SchedulableCandidate, in scanSchedulable order.
+const schedulableColumns = workItemColumnsAliased + `,
 			n.project_id, p.name AS project_name, p.domain AS project_domain,
-			n.title AS node_title, n.due_date AS node_due_date, p.target_date, p.start_date`
+			n.title AS node_title, n.due_date AS node_due_date, p.target_date, p.start_date, p.importance, p.critical_snoozed_until,
+			EXISTS (SELECT 1 FROM focus_items f WHERE f.work_item_id = w.id) AS focused`
 
-	var query string
+// schedulableWhere selects todo/in-progress items of active projects,
+// leaving archived ones out unless includeArchived.
+func schedulableWhere(includeArchived bool) string {
 	if includeArchived {
-		query = `SELECT ` + schedulableJoinedColumns + `
-			FROM work_items w
-			JOIN plan_nodes n ON w.node_id = n.id
-			JOIN projects p ON n.project_id = p.id
-			WHERE w.status IN ('todo', 'in_progress')
+		return `WHERE w.status IN ('todo', 'in_progress')
+			  AND p.status = 'active'`
+	}
+	return `WHERE w.status IN ('todo', 'in_progress')
+			  AND (w.archived_at IS NULL)
 			  AND p.status = 'active'
-			ORDER BY w.id`
-	} else {
-		query = `SELECT ` + schedulableJoinedColumns + `
+			  AND (p.archived_at IS NULL)`
+}
+
+func (r *SQLiteWorkItemRepo) ListSchedulable(ctx context.Context, includeArchived bool) ([]SchedulableCandidate, error) {
+	query := `SELECT ` + schedulableColumns + `
 			FROM work_items w
 			JOIN plan_nodes n ON w.node_id = n.id
 			JOIN projects p ON n.project_id = p.id
-			WHERE w.status IN ('todo', 'in_progress')
-			  AND (w.archived_at IS NULL)
-			  AND p.status = 'active'
-			  AND (p.archived_at IS NULL)
+			` + schedulableWhere(includeArchived) + `
 			ORDER BY w.id`
-	}
 
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
@@ -148,61 +179,55 @@ func (r *SQLiteWorkItemRepo) ListSchedulable(ctx context.Context, includeArchive
 
 	var candidates []SchedulableCandidate
 	for rows.Next() {
-		var w domain.WorkItem
-		var statusStr, durationModeStr, durationSourceStr string
-		var archivedAtStr, dueDateStr, notBeforeStr sql.NullString
-		var splittableInt int
-		var createdAtStr, updatedAtStr string
-		var completedAtStr sql.NullString
-
-		// Extra joined fields
-		var projectID, projectName, projectDomain, nodeTitle string
-		var nodeDueDateStr, targetDateStr, startDateStr sql.NullString
-
-		err := rows.Scan(
-			&w.ID, &w.NodeID, &w.Title, &w.Type, &statusStr, &archivedAtStr,
-			&durationModeStr, &w.PlannedMin, &w.LoggedMin, &durationSourceStr, &w.EstimateConfidence,
-			&w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin, &splittableInt,
-			&w.UnitsKind, &w.UnitsTotal, &w.UnitsDone, &dueDateStr, &notBeforeStr,
-			&w.Seq, &createdAtStr, &updatedAtStr,
-			&w.Description, &completedAtStr,
-			&projectID, &projectName, &projectDomain,
-			&nodeTitle, &nodeDueDateStr, &targetDateStr, &startDateStr,
-		)
+		candidate, err := scanSchedulable(rows)
 		if err != nil {
-			return nil, fmt.Errorf("scanning schedulable candidate: %w", err)
+			return nil, err
 		}
+		candidates = append(candidates, candidate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating schedulable candidates: %w", err)
+	}
+	return candidates, nil
+}
 
-		w.Status = domain.WorkItemStatus(statusStr)
-		w.DurationMode = domain.DurationMode(durationModeStr)
-		w.DurationSource = domain.DurationSource(durationSourceStr)
-		w.Splittable = intToBool(splittableInt)
-		w.ArchivedAt = parseNullableTime(archivedAtStr, time.RFC3339)
-		w.DueDate = parseNullableTime(dueDateStr, dateLayout)
-		w.NotBefore = parseNullableTime(notBeforeStr, dateLayout)
-		w.CompletedAt = parseNullableTime(completedAtStr, time.RFC3339)
-
-		var parseErr error
-		w.CreatedAt, parseErr = time.Parse(time.RFC3339, createdAtStr)
-		if parseErr != nil {
-			return nil, fmt.Errorf("parsing created_at: %w", parseErr)
-		}
-		w.UpdatedAt, parseErr = time.Parse(time.RFC3339, updatedAtStr)
-		if parseErr != nil {
-			return nil, fmt.Errorf("parsing updated_at: %w", parseErr)
-		}
+// ListCandidateWorkItemsWithAggregates returns what ListSchedulable does,
+// plus each item's session aggregates, in one statement. Session windows
+// start at midnight UTC paceDays/spacingDays ago, as in
+// SessionRepo.ListRecent.
+func (r *SQLiteWorkItemRepo) ListCandidateWorkItemsWithAggregates(ctx context.Context, includeArchived bool, paceDays, spacingDays int) ([]CandidateWithAggregates, error) {
+	query := `SELECT ` + schedulableColumns + `,
+			COALESCE(s.recent_min, 0), s.last_session_at
+			FROM work_items w
+			JOIN plan_nodes n ON w.node_id = n.id
+			JOIN projects p ON n.project_id = p.id
+			LEFT JOIN (
+				SELECT work_item_id,
+					SUM(CASE WHEN started_at >= date('now', ?1 || ' days') THEN minutes ELSE 0 END) AS recent_min,
+					MAX(CASE WHEN started_at >= date('now', ?2 || ' days') THEN CAST(strftime('%s', started_at) AS INTEGER) END) AS last_session_at
+				FROM work_session_logs
+				WHERE started_at >= date('now', ?1 || ' days') OR started_at >= date('now', ?2 || ' days')
+				GROUP BY work_item_id
+			) s ON s.work_item_id = w.id
+			` + schedulableWhere(includeArchived) + `
+			ORDER BY w.id`
 
-		candidate := SchedulableCandidate{
-			WorkItem:          w,
-			ProjectID:         projectID,
-			ProjectName:       projectName,
-			ProjectDomain:     projectDomain,
-			NodeTitle:         nodeTitle,
-			NodeDueDate:       parseNullableTime(nodeDueDateStr, dateLayout),
-			ProjectTargetDate: parseNullableTime(targetDateStr, dateLayout),
-			ProjectStartDate:  parseNullableTime(startDateStr, dateLayout),
+	rows, err := r.db.QueryContext(ctx, query, fmt.Sprintf("-%d", paceDays), fmt.Sprintf("-%d", spacingDays))
+	if err != nil {
+		return nil, fmt.Errorf("listing schedulable work items with aggregates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []CandidateWithAggregates
+	for rows.Next() {
+		var c CandidateWithAggregates
+		var lastSession sql.NullInt64
+		c.SchedulableCandidate, err = scanSchedulable(rows, &c.RecentMin, &lastSession)
+		if err != nil {
+			return nil, err
 		}
-		candidates = append(candidates, candidate)
+		c.LastSessionAt = unixOrNil(lastSession)
+		candidates = append(candidates, c)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("iterating schedulable candidates: %w", err)
@@ -210,6 +235,90 @@ func (r *SQLiteWorkItemRepo) ListSchedulable(ctx context.Context, includeArchive
 	return candidates, nil
 }
 
+// unixOrNil converts nullable Unix seconds to a UTC time.
+func unixOrNil(v sql.NullInt64) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	t := time.Unix(v.Int64, 0).UTC()
+	return &t
+}
+
+// scanSchedulable scans one schedulableColumns row, followed by extra
+// destinations for any columns after them.
+func scanSchedulable(rows *sql.Rows, extra ...any) (SchedulableCandidate, error) {
+	var w domain.WorkItem
+	var statusStr, durationModeStr, durationSourceStr string
+	var archivedAtStr, dueDateStr, notBeforeStr sql.NullString
+	var splittableInt int
+	var createdAtStr, updatedAtStr string
+	var completedAtStr sql.NullString
+	var initialPlanned sql.NullInt64
+	var checklistStr, tagsStr, priorityStr string
+
+	// Extra joined fields
+	var projectID, projectName, projectDomain, nodeTitle string
+	var nodeDueDateStr, targetDateStr, startDateStr, snoozedStr sql.NullString
+	var importance, focusedInt int
+
+	dest := []any{
+		&w.ID, &w.NodeID, &w.Title, &w.Type, &statusStr, &archivedAtStr,
+		&durationModeStr, &w.PlannedMin, &w.LoggedMin, &durationSourceStr, &w.EstimateConfidence,
+		&w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin, &splittableInt,
+		&w.UnitsKind, &w.UnitsTotal, &w.UnitsDone, &dueDateStr, &notBeforeStr,
+		&w.Seq, &createdAtStr, &updatedAtStr,
+		&w.Description, &completedAtStr, &w.Ref, &initialPlanned, &checklistStr, &tagsStr, &priorityStr, &w.OrderIndex,
+		&projectID, &projectName, &projectDomain,
+		&nodeTitle, &nodeDueDateStr, &targetDateStr, &startDateStr, &importance, &snoozedStr, &focusedInt,
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return SchedulableCandidate{}, fmt.Errorf("scanning schedulable candidate: %w", err)
+	}
+
+	w.Status = domain.WorkItemStatus(statusStr)
+	w.DurationMode = domain.DurationMode(durationModeStr)
+	w.DurationSource = domain.DurationSource(durationSourceStr)
+	w.Splittable = intToBool(splittableInt)
+	w.ManualPriority = domain.ManualPriority(priorityStr)
+	w.ArchivedAt = parseNullableTime(archivedAtStr, time.RFC3339)
+	w.DueDate = parseNullableDeadline(dueDateStr)
+	w.NotBefore = parseNullableTime(notBeforeStr, dateLayout)
+	w.CompletedAt = parseNullableTime(completedAtStr, time.RFC3339)
+	w.InitialPlannedMin = initialPlannedOrCurrent(initialPlanned, w.PlannedMin)
+
+	var parseErr error
+	w.Checklist, parseErr = parseChecklist(checklistStr)
+	if parseErr != nil {
+		return SchedulableCandidate{}, parseErr
+	}
+	w.Tags, parseErr = parseTags(tagsStr)
+	if parseErr != nil {
+		return SchedulableCandidate{}, parseErr
+	}
+	w.CreatedAt, parseErr = time.Parse(time.RFC3339, createdAtStr)
+	if parseErr != nil {
+		return SchedulableCandidate{}, fmt.Errorf("parsing created_at: %w", parseErr)
+	}
+	w.UpdatedAt, parseErr = time.Parse(time.RFC3339, updatedAtStr)
+	if parseErr != nil {
+		return SchedulableCandidate{}, fmt.Errorf("parsing updated_at: %w", parseErr)
+	}
+
+	return SchedulableCandidate{
+		WorkItem:                    w,
+		ProjectID:                   projectID,
+		ProjectName:                 projectName,
+		ProjectDomain:               projectDomain,
+		NodeTitle:                   nodeTitle,
+		NodeDueDate:                 parseNullableDeadline(nodeDueDateStr),
+		ProjectTargetDate:           parseNullableDeadline(targetDateStr),
+		ProjectStartDate:            parseNullableTime(startDateStr, dateLayout),
+		ProjectImportance:           importance,
+		ProjectCriticalSnoozedUntil: parseNullableTime(snoozedStr, time.RFC3339),
+		Focused:                     intToBool(focusedInt),
+	}, nil
+}
+
 func (r *SQLiteWorkItemRepo) ListCompletedSummaryByProject(ctx context.Context) ([]CompletedWorkSummary, error) {
 	query := `SELECT n.project_id,
 			COALESCE(SUM(CASE WHEN w.status IN ('done','skipped') THEN w.planned_min ELSE 0 END), 0),
@@ -245,14 +354,51 @@ func (r *SQLiteWorkItemRepo) ListCompletedSummaryByProject(ctx context.Context)
 	return summaries, nil
 }
 
+func (r *SQLiteWorkItemRepo) ListEstimateSamples(ctx context.Context) ([]EstimateSample, error) {
+	query := `SELECT w.id, w.type, COALESCE(w.initial_planned_min, w.planned_min), w.logged_min,
+		w.units_kind, w.units_total, w.units_done
+		FROM work_items w
+		WHERE w.status = 'done'
+		  AND EXISTS (SELECT 1 FROM work_session_logs s WHERE s.work_item_id = w.id)
+		ORDER BY w.type, w.id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("listing estimate samples: %w", err)
+	}
+	defer rows.Close()
+
+	var samples []EstimateSample
+	for rows.Next() {
+		var s EstimateSample
+		if err := rows.Scan(&s.WorkItemID, &s.Type, &s.InitialPlannedMin, &s.LoggedMin,
+			&s.UnitsKind, &s.UnitsTotal, &s.UnitsDone); err != nil {
+			return nil, fmt.Errorf("scanning estimate sample: %w", err)
+		}
+		samples = append(samples, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating estimate samples: %w", err)
+	}
+	return samples, nil
+}
+
+// Update saves w. OrderIndex is not written: an item keeps its place, or
+// moves to the end when its node changes. SetOrderIndex reorders.
 func (r *SQLiteWorkItemRepo) Update(ctx context.Context, w *domain.WorkItem) error {
-	query := `UPDATE work_items SET node_id = ?, title = ?, type = ?, status = ?, archived_at = ?,
+	query := `UPDATE work_items SET
+		order_index = CASE WHEN node_id = ? THEN order_index
+			ELSE (SELECT COALESCE(MAX(order_index), -1) + 1 FROM work_items WHERE node_id = ?) END,
+		node_id = ?, title = ?, type = ?, status = ?, archived_at = ?,
 		duration_mode = ?, planned_min = ?, logged_min = ?, duration_source = ?, estimate_confidence = ?,
 		min_session_min = ?, max_session_min = ?, default_session_min = ?, splittable = ?,
 		units_kind = ?, units_total = ?, units_done = ?, due_date = ?, not_before = ?,
-		seq = ?, updated_at = ?, description = ?, completed_at = ?
+		seq = ?, updated_at = ?, description = ?, completed_at = ?, ref = ?, checklist = ?, tags = ?,
+		manual_priority = ?
 		WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query,
+		w.NodeID,
+		w.NodeID,
 		w.NodeID,
 		w.Title,
 		w.Type,
@@ -270,12 +416,16 @@ func (r *SQLiteWorkItemRepo) Update(ctx context.Context, w *domain.WorkItem) err
 		w.UnitsKind,
 		w.UnitsTotal,
 		w.UnitsDone,
-		nullableTimeToString(w.DueDate, dateLayout),
+		nullableDeadlineToString(w.DueDate),
 		nullableTimeToString(w.NotBefore, dateLayout),
 		w.Seq,
 		w.UpdatedAt.Format(time.RFC3339),
 		w.Description,
 		nullableTimeToString(w.CompletedAt, time.RFC3339),
+		w.Ref,
+		checklistToJSON(w.Checklist),
+		tagsToJSON(w.Tags),
+		string(w.ManualPriority),
 		w.ID,
 	)
 	if err != nil {
@@ -284,6 +434,24 @@ func (r *SQLiteWorkItemRepo) Update(ctx context.Context, w *domain.WorkItem) err
 	return nil
 }
 
+func (r *SQLiteWorkItemRepo) SetInitialPlannedMin(ctx context.Context, id string, minutes int) error {
+	query := `UPDATE work_items SET initial_planned_min = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, minutes, id)
+	if err != nil {
+		return fmt.Errorf("setting initial planned minutes: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteWorkItemRepo) SetOrderIndex(ctx context.Context, id string, index int) error {
+	query := `UPDATE work_items SET order_index = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, index, id)
+	if err != nil {
+		return fmt.Errorf("setting work item order: %w", err)
+	}
+	return nil
+}
+
 func (r *SQLiteWorkItemRepo) Archive(ctx context.Context, id string) error {
 	now := nowUTC()
 	query := `UPDATE work_items SET status = 'archived', archived_at = ?, updated_at = ? WHERE id = ?`
@@ -294,6 +462,26 @@ func (r *SQLiteWorkItemRepo) Archive(ctx context.Context, id string) error {
 	return nil
 }
 
+func (r *SQLiteWorkItemRepo) ArchiveDone(ctx context.Context, projectID string) (int, error) {
+	now := nowUTC()
+	query := `UPDATE work_items SET status = 'archived', archived_at = ?, updated_at = ?
+		WHERE status = 'done'`
+	args := []any{now, now}
+	if projectID != "" {
+		query += ` AND node_id IN (SELECT id FROM plan_nodes WHERE project_id = ?)`
+		args = append(args, projectID)
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("archiving done work items: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("archiving done work items: %w", err)
+	}
+	return int(n), nil
+}
+
 func (r *SQLiteWorkItemRepo) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM work_items WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query, id)
@@ -311,6 +499,8 @@ func (r *SQLiteWorkItemRepo) scanWorkItem(row *sql.Row) (*domain.WorkItem, error
 	var splittableInt int
 	var createdAtStr, updatedAtStr string
 	var completedAtStr sql.NullString
+	var initialPlanned sql.NullInt64
+	var checklistStr, tagsStr, priorityStr string
 
 	err := row.Scan(
 		&w.ID, &w.NodeID, &w.Title, &w.Type, &statusStr, &archivedAtStr,
@@ -318,7 +508,7 @@ func (r *SQLiteWorkItemRepo) scanWorkItem(row *sql.Row) (*domain.WorkItem, error
 		&w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin, &splittableInt,
 		&w.UnitsKind, &w.UnitsTotal, &w.UnitsDone, &dueDateStr, &notBeforeStr,
 		&w.Seq, &createdAtStr, &updatedAtStr,
-		&w.Description, &completedAtStr,
+		&w.Description, &completedAtStr, &w.Ref, &initialPlanned, &checklistStr, &tagsStr, &priorityStr, &w.OrderIndex,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -328,7 +518,7 @@ func (r *SQLiteWorkItemRepo) scanWorkItem(row *sql.Row) (*domain.WorkItem, error
 	}
 
 	return r.populateWorkItem(&w, statusStr, durationModeStr, durationSourceStr,
-		archivedAtStr, dueDateStr, notBeforeStr, completedAtStr, splittableInt, createdAtStr, updatedAtStr)
+		archivedAtStr, dueDateStr, notBeforeStr, completedAtStr, initialPlanned, splittableInt, createdAtStr, updatedAtStr, checklistStr, tagsStr, priorityStr)
 }
 
 // scanWorkItems scans multiple work items from *sql.Rows.
@@ -341,6 +531,8 @@ func (r *SQLiteWorkItemRepo) scanWorkItems(rows *sql.Rows) ([]*domain.WorkItem,
 		var splittableInt int
 		var createdAtStr, updatedAtStr string
 		var completedAtStr sql.NullString
+		var initialPlanned sql.NullInt64
+		var checklistStr, tagsStr, priorityStr string
 
 		err := rows.Scan(
 			&w.ID, &w.NodeID, &w.Title, &w.Type, &statusStr, &archivedAtStr,
@@ -348,14 +540,14 @@ func (r *SQLiteWorkItemRepo) scanWorkItems(rows *sql.Rows) ([]*domain.WorkItem,
 			&w.MinSessionMin, &w.MaxSessionMin, &w.DefaultSessionMin, &splittableInt,
 			&w.UnitsKind, &w.UnitsTotal, &w.UnitsDone, &dueDateStr, &notBeforeStr,
 			&w.Seq, &createdAtStr, &updatedAtStr,
-			&w.Description, &completedAtStr,
+			&w.Description, &completedAtStr, &w.Ref, &initialPlanned, &checklistStr, &tagsStr, &priorityStr, &w.OrderIndex,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning work item row: %w", err)
 		}
 
 		item, err := r.populateWorkItem(&w, statusStr, durationModeStr, durationSourceStr,
-			archivedAtStr, dueDateStr, notBeforeStr, completedAtStr, splittableInt, createdAtStr, updatedAtStr)
+			archivedAtStr, dueDateStr, notBeforeStr, completedAtStr, initialPlanned, splittableInt, createdAtStr, updatedAtStr, checklistStr, tagsStr, priorityStr)
 		if err != nil {
 			return nil, err
 		}
@@ -372,20 +564,32 @@ func (r *SQLiteWorkItemRepo) populateWorkItem(
 	w *domain.WorkItem,
 	statusStr, durationModeStr, durationSourceStr string,
 	archivedAtStr, dueDateStr, notBeforeStr, completedAtStr sql.NullString,
+	initialPlanned sql.NullInt64,
 	splittableInt int,
 	createdAtStr, updatedAtStr string,
+	checklistStr, tagsStr, priorityStr string,
 ) (*domain.WorkItem, error) {
 	w.Status = domain.WorkItemStatus(statusStr)
 	w.DurationMode = domain.DurationMode(durationModeStr)
 	w.DurationSource = domain.DurationSource(durationSourceStr)
 	w.Splittable = intToBool(splittableInt)
+	w.ManualPriority = domain.ManualPriority(priorityStr)
 
 	w.ArchivedAt = parseNullableTime(archivedAtStr, time.RFC3339)
-	w.DueDate = parseNullableTime(dueDateStr, dateLayout)
+	w.DueDate = parseNullableDeadline(dueDateStr)
 	w.NotBefore = parseNullableTime(notBeforeStr, dateLayout)
 	w.CompletedAt = parseNullableTime(completedAtStr, time.RFC3339)
+	w.InitialPlannedMin = initialPlannedOrCurrent(initialPlanned, w.PlannedMin)
 
 	var parseErr error
+	w.Checklist, parseErr = parseChecklist(checklistStr)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	w.Tags, parseErr = parseTags(tagsStr)
+	if parseErr != nil {
+		return nil, parseErr
+	}
 	w.CreatedAt, parseErr = time.Parse(time.RFC3339, createdAtStr)
 	if parseErr != nil {
 		return nil, fmt.Errorf("parsing created_at: %w", parseErr)
@@ -397,3 +601,45 @@ func (r *SQLiteWorkItemRepo) populateWorkItem(
 
 	return w, nil
 }
+
+// initialPlannedOrCurrent falls back to the current estimate for rows written
+// before initial_planned_min existed.
+func initialPlannedOrCurrent(v sql.NullInt64, plannedMin int) int {
+	if v.Valid {
+		return int(v.Int64)
+	}
+	return plannedMin
+}
+
+// checklistEntry is the stored JSON shape of a domain.ChecklistItem.
+type checklistEntry struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// checklistToJSON encodes a checklist for the work_items.checklist column.
+func checklistToJSON(items []domain.ChecklistItem) string {
+	entries := make([]checklistEntry, len(items))
+	for i, c := range items {
+		entries[i] = checklistEntry{Text: c.Text, Done: c.Done}
+	}
+	data, _ := json.Marshal(entries) // plain structs; cannot fail
+	return string(data)
+}
+
+// parseChecklist decodes the work_items.checklist column. Empty checklists
+// decode to nil.
+func parseChecklist(s string) ([]domain.ChecklistItem, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	var entries []checklistEntry
+	if err := json.Unmarshal([]byte(s), &entries); err != nil {
+		return nil, fmt.Errorf("parsing checklist: %w", err)
+	}
+	items := make([]domain.ChecklistItem, len(entries))
+	for i, e := range entries {
+		items[i] = domain.ChecklistItem{Text: e.Text, Done: e.Done}
+	}
+	return items, nil
+}
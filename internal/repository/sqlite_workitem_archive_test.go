@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemRepo_ArchiveDone(t *testing.T) {
+	_, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+
+	other := testutil.NewTestProject("Other Project")
+	require.NoError(t, projects.Create(ctx, other))
+	otherNode := testutil.NewTestNode(other.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, otherNode))
+
+	done := testutil.NewTestWorkItem(node.ID, "Done", testutil.WithWorkItemStatus(domain.WorkItemDone))
+	todo := testutil.NewTestWorkItem(node.ID, "Todo")
+	otherDone := testutil.NewTestWorkItem(otherNode.ID, "Other done", testutil.WithWorkItemStatus(domain.WorkItemDone))
+	for _, wi := range []*domain.WorkItem{done, todo, otherDone} {
+		require.NoError(t, workItems.Create(ctx, wi))
+	}
+
+	n, err := workItems.ArchiveDone(ctx, node.ProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	got, err := workItems.GetByID(ctx, done.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemArchived, got.Status)
+	assert.NotNil(t, got.ArchivedAt)
+
+	got, err = workItems.GetByID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemTodo, got.Status, "unfinished items are left alone")
+
+	got, err = workItems.GetByID(ctx, otherDone.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemDone, got.Status, "other projects are out of scope")
+
+	n, err = workItems.ArchiveDone(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "empty project ID covers every project")
+}
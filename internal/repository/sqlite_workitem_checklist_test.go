@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemRepo_ChecklistRoundTrip(t *testing.T) {
+	_, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+
+	wi := testutil.NewTestWorkItem(node.ID, "Essay")
+	require.NoError(t, workItems.Create(ctx, wi))
+
+	got, err := workItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Checklist)
+
+	got.Checklist = []domain.ChecklistItem{{Text: "outline", Done: true}, {Text: "draft"}}
+	require.NoError(t, workItems.Update(ctx, got))
+
+	items, err := workItems.ListByNode(ctx, node.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, got.Checklist, items[0].Checklist)
+
+	candidates, err := workItems.ListSchedulable(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, got.Checklist, candidates[0].WorkItem.Checklist)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemRepo_TimedDeadlineOnUTCMidnightRoundTrip(t *testing.T) {
+	prev := time.Local
+	time.Local = time.FixedZone("PDT", -7*60*60)
+	t.Cleanup(func() { time.Local = prev })
+
+	_, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+
+	// 17:00 PDT is 00:00 UTC; it must still come back as a timed deadline.
+	due, err := domain.ParseDeadline("2026-03-10 17:00")
+	require.NoError(t, err)
+	wi := testutil.NewTestWorkItem(node.ID, "Essay", testutil.WithWorkItemDueDate(due))
+	require.NoError(t, workItems.Create(ctx, wi))
+
+	got, err := workItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.DueDate)
+	assert.True(t, got.DueDate.Equal(due))
+	assert.True(t, domain.DeadlineHasTime(*got.DueDate))
+	assert.Equal(t, "2026-03-10 17:00", domain.FormatDeadline(*got.DueDate))
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemRepo_InitialPlannedMinSurvivesReEstimate(t *testing.T) {
+	_, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+
+	wi := testutil.NewTestWorkItem(node.ID, "Essay", testutil.WithPlannedMin(60))
+	require.NoError(t, workItems.Create(ctx, wi))
+
+	wi.PlannedMin = 95
+	require.NoError(t, workItems.Update(ctx, wi))
+
+	got, err := workItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 95, got.PlannedMin)
+	assert.Equal(t, 60, got.InitialPlannedMin)
+}
+
+func TestWorkItemRepo_ListEstimateSamples_OnlyDoneWithSessions(t *testing.T) {
+	db, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+	sessions := NewSQLiteSessionRepo(db)
+
+	doneWithSession := testutil.NewTestWorkItem(node.ID, "Done logged",
+		testutil.WithWorkItemType("writing"),
+		testutil.WithPlannedMin(60),
+		testutil.WithLoggedMin(90),
+		testutil.WithWorkItemStatus(domain.WorkItemDone))
+	doneNoSession := testutil.NewTestWorkItem(node.ID, "Done unlogged",
+		testutil.WithPlannedMin(60),
+		testutil.WithWorkItemStatus(domain.WorkItemDone))
+	todoWithSession := testutil.NewTestWorkItem(node.ID, "Todo logged", testutil.WithPlannedMin(60))
+	for _, wi := range []*domain.WorkItem{doneWithSession, doneNoSession, todoWithSession} {
+		require.NoError(t, workItems.Create(ctx, wi))
+	}
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(doneWithSession.ID, 90)))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(todoWithSession.ID, 30)))
+
+	samples, err := workItems.ListEstimateSamples(ctx)
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	assert.Equal(t, EstimateSample{
+		WorkItemID:        doneWithSession.ID,
+		Type:              "writing",
+		InitialPlannedMin: 60,
+		LoggedMin:         90,
+	}, samples[0])
+}
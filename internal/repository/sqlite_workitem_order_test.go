@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemRepo_OrderIndex(t *testing.T) {
+	_, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, project, node := setupSchedulableNode(t, projects, nodes)
+
+	a := testutil.NewTestWorkItem(node.ID, "A")
+	b := testutil.NewTestWorkItem(node.ID, "B")
+	c := testutil.NewTestWorkItem(node.ID, "C")
+	for _, w := range []*domain.WorkItem{a, b, c} {
+		require.NoError(t, workItems.Create(ctx, w))
+	}
+	assert.Equal(t, []int{0, 1, 2}, []int{a.OrderIndex, b.OrderIndex, c.OrderIndex})
+
+	require.NoError(t, workItems.SetOrderIndex(ctx, c.ID, 0))
+	require.NoError(t, workItems.SetOrderIndex(ctx, a.ID, 1))
+	require.NoError(t, workItems.SetOrderIndex(ctx, b.ID, 2))
+	assert.Equal(t, []string{"C", "A", "B"}, nodeTitles(t, workItems, node.ID))
+	byProject, err := workItems.ListByProject(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, byProject, 3)
+	assert.Equal(t, "C", byProject[0].Title)
+
+	// Update keeps the place within the node...
+	a.Title = "A2"
+	require.NoError(t, workItems.Update(ctx, a))
+	assert.Equal(t, []string{"C", "A2", "B"}, nodeTitles(t, workItems, node.ID))
+
+	// ...and appends to a new node.
+	other := testutil.NewTestNode(project.ID, "Other")
+	require.NoError(t, nodes.Create(ctx, other))
+	d := testutil.NewTestWorkItem(other.ID, "D")
+	require.NoError(t, workItems.Create(ctx, d))
+	c.NodeID = other.ID
+	require.NoError(t, workItems.Update(ctx, c))
+	got, err := workItems.GetByID(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.OrderIndex)
+}
+
+func nodeTitles(t *testing.T, workItems *SQLiteWorkItemRepo, nodeID string) []string {
+	t.Helper()
+	items, err := workItems.ListByNode(context.Background(), nodeID)
+	require.NoError(t, err)
+	out := make([]string, len(items))
+	for i, w := range items {
+		out[i] = w.Title
+	}
+	return out
+}
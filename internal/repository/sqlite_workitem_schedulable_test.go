@@ -140,3 +140,50 @@ func TestWorkItemRepo_ListSchedulable_DependencyBlockedItemsAreStillReturned(t *
 	assert.True(t, ids[pred.ID])
 	assert.True(t, ids[succ.ID], "dependency filtering is applied at service layer, not repository layer")
 }
+
+func TestWorkItemRepo_ListCandidateWorkItemsWithAggregates(t *testing.T) {
+	db, projects, nodes, workItems, _ := setupSchedulableRepos(t)
+	ctx, _, node := setupSchedulableNode(t, projects, nodes)
+	sessions := NewSQLiteSessionRepo(db)
+
+	worked := testutil.NewTestWorkItem(node.ID, "Worked")
+	idle := testutil.NewTestWorkItem(node.ID, "Idle")
+	require.NoError(t, workItems.Create(ctx, worked))
+	require.NoError(t, workItems.Create(ctx, idle))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	latest := now.Add(-26 * time.Hour)
+	// Stored with a +02:00 offset: the latest start must still compare as
+	// an instant, not as text.
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(worked.ID, 30,
+		testutil.WithStartedAt(latest.In(time.FixedZone("CEST", 2*60*60))))))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(worked.ID, 20,
+		testutil.WithStartedAt(now.AddDate(0, 0, -4)))))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(worked.ID, 45,
+		testutil.WithStartedAt(now.AddDate(0, 0, -20)))))
+
+	candidates, err := workItems.ListCandidateWorkItemsWithAggregates(ctx, false, 7, 14)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+	byID := make(map[string]CandidateWithAggregates, len(candidates))
+	for _, c := range candidates {
+		byID[c.WorkItem.ID] = c
+	}
+
+	got := byID[worked.ID]
+	assert.Equal(t, "Worked", got.WorkItem.Title)
+	assert.Equal(t, 50, got.RecentMin, "the 20-day-old session is outside the pace window")
+	require.NotNil(t, got.LastSessionAt)
+	assert.True(t, latest.Equal(*got.LastSessionAt))
+
+	assert.Zero(t, byID[idle.ID].RecentMin)
+	assert.Nil(t, byID[idle.ID].LastSessionAt)
+
+	candidates, err = workItems.ListCandidateWorkItemsWithAggregates(ctx, false, 30, 14)
+	require.NoError(t, err)
+	for _, c := range candidates {
+		if c.WorkItem.ID == worked.ID {
+			assert.Equal(t, 95, c.RecentMin, "a 30-day pace window takes all three")
+		}
+	}
+}
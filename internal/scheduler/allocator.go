@@ -1,15 +1,26 @@
 package scheduler
 
 import (
+	"fmt"
+
 	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
 )
 
 // AllocateSlices takes sorted scored candidates and available time,
-// returns WorkSlices respecting session bounds.
+// returns WorkSlices respecting session bounds. maxProjects > 0 caps how many
+// distinct projects get slices: once that many have one, candidates from
+// other projects are passed over and the time goes to the projects already
+// chosen. 0 means no cap. projectBudgetMin > 0 caps the minutes any one
+// project's slices may take, except that a project's first slice may
+// still reach its minimum session; time no project can take stays
+// unallocated. 0 means no budget.
 func AllocateSlices(
 	candidates []ScoredCandidate,
 	availableMin int,
 	maxSlices int,
+	maxProjects int,
+	projectBudgetMin int,
 	enforceVariation bool,
 ) ([]app.WorkSlice, []app.ConstraintBlocker) {
 	var slices []app.WorkSlice
@@ -17,6 +28,33 @@ func AllocateSlices(
 	var pass1Candidates []ScoredCandidate // parallel to slices — tracks pass-1 origins for extension
 	remaining := availableMin
 	projectsUsed := make(map[string]bool)
+	projectMin := make(map[string]int)
+
+	// budgetFor is the time c may be given: what is left overall, further
+	// capped by its project's budget when there is one
+	budgetFor := func(c ScoredCandidate) int {
+		if projectBudgetMin <= 0 {
+			return remaining
+		}
+		left := projectBudgetMin - projectMin[c.Input.ProjectID]
+		if projectMin[c.Input.ProjectID] == 0 {
+			left = max(left, c.Input.MinSessionMin)
+		}
+		return min(remaining, left)
+	}
+
+	// allocate sizes c's slice by its budget. A project budget running out
+	// is not a constraint on the item, so a blocker is only reported when it
+	// also holds against all the remaining time.
+	allocate := func(c ScoredCandidate) (*app.WorkSlice, *app.ConstraintBlocker) {
+		budget := budgetFor(c)
+		slice, blocker := tryAllocate(c, budget)
+		if blocker == nil || budget >= remaining {
+			return slice, blocker
+		}
+		_, blocker = tryAllocate(c, remaining)
+		return nil, blocker
+	}
 
 	// First pass: prefer variation (one item per project)
 	var deferred []ScoredCandidate
@@ -31,13 +69,18 @@ func AllocateSlices(
 			continue
 		}
 
+		// Past the project cap only the chosen projects get more time
+		if maxProjects > 0 && !projectsUsed[c.Input.ProjectID] && len(projectsUsed) >= maxProjects {
+			continue
+		}
+
 		// Skip same-project for variation in first pass
 		if enforceVariation && projectsUsed[c.Input.ProjectID] {
 			deferred = append(deferred, c)
 			continue
 		}
 
-		slice, blocker := tryAllocate(c, remaining)
+		slice, blocker := allocate(c)
 		if blocker != nil {
 			blockers = append(blockers, *blocker)
 			continue
@@ -47,6 +90,7 @@ func AllocateSlices(
 			pass1Candidates = append(pass1Candidates, c)
 			remaining -= slice.AllocatedMin
 			projectsUsed[c.Input.ProjectID] = true
+			projectMin[c.Input.ProjectID] += slice.AllocatedMin
 		}
 	}
 
@@ -59,13 +103,17 @@ func AllocateSlices(
 		if remaining <= 0 {
 			break
 		}
-		workLeft := c.Input.PlannedMin - c.Input.LoggedMin
+		workLeft := c.Input.RemainingMin()
 		ceiling := min(c.Input.MaxSessionMin, workLeft)
 		headroom := ceiling - slices[i].AllocatedMin
+		if projectBudgetMin > 0 {
+			headroom = min(headroom, projectBudgetMin-projectMin[c.Input.ProjectID])
+		}
 		if headroom > 0 {
 			extend := min(headroom, remaining)
 			slices[i].AllocatedMin += extend
 			remaining -= extend
+			projectMin[c.Input.ProjectID] += extend
 		}
 	}
 
@@ -74,7 +122,7 @@ func AllocateSlices(
 		if len(slices) >= maxSlices || remaining <= 0 {
 			break
 		}
-		slice, blocker := tryAllocate(c, remaining)
+		slice, blocker := allocate(c)
 		if blocker != nil {
 			blockers = append(blockers, *blocker)
 			continue
@@ -82,6 +130,7 @@ func AllocateSlices(
 		if slice != nil {
 			slices = append(slices, *slice)
 			remaining -= slice.AllocatedMin
+			projectMin[c.Input.ProjectID] += slice.AllocatedMin
 		}
 	}
 
@@ -92,9 +141,42 @@ func tryAllocate(c ScoredCandidate, remaining int) (*app.WorkSlice, *app.Constra
 	minS := c.Input.MinSessionMin
 	maxS := c.Input.MaxSessionMin
 	defS := c.Input.DefaultSessionMin
+	workRemaining := c.Input.RemainingMin()
+	atomic := c.Input.Atomic && c.Input.PlannedMin > 0 && workRemaining > 0
+
+	// Atomic items need their whole remaining estimate in one block
+	if atomic && remaining < workRemaining {
+		return nil, &app.ConstraintBlocker{
+			EntityType: "work_item",
+			EntityID:   c.Input.WorkItemID,
+			Code:       app.BlockerNeedsLongerBlock,
+			Message:    fmt.Sprintf("Not splittable: needs an unbroken %dm block", workRemaining),
+		}
+	}
+
+	// A requested minimum block raises the lower bound; items that can't use
+	// that much time in one session are skipped rather than clamped
+	lower := minS
+	if floor := c.Input.MinBlockMin; floor > minS && !(c.Input.PlannedMin > 0 && workRemaining <= 0) {
+		usable := min(maxS, remaining)
+		if atomic {
+			usable = workRemaining
+		} else if c.Input.PlannedMin > 0 {
+			usable = min(usable, workRemaining)
+		}
+		if usable < floor {
+			return nil, &app.ConstraintBlocker{
+				EntityType: "work_item",
+				EntityID:   c.Input.WorkItemID,
+				Code:       app.BlockerInsufficientTime,
+				Message:    fmt.Sprintf("'%s' fits at most %dm, below the %dm minimum block", c.Input.Title, usable, floor),
+			}
+		}
+		lower = floor
+	}
 
-	// Can't fit minimum session
-	if remaining < minS {
+	// Can't fit minimum session (an atomic item's whole remainder already fit)
+	if remaining < minS && !atomic {
 		return nil, &app.ConstraintBlocker{
 			EntityType: "work_item",
 			EntityID:   c.Input.WorkItemID,
@@ -105,10 +187,9 @@ func tryAllocate(c ScoredCandidate, remaining int) (*app.WorkSlice, *app.Constra
 
 	// Clamp allocation to [min, min(max, remaining)]
 	upper := min(maxS, remaining)
-	allocated := clamp(defS, minS, upper)
+	allocated := clamp(defS, lower, upper)
 
 	// No remaining work — item is fully logged
-	workRemaining := c.Input.PlannedMin - c.Input.LoggedMin
 	if c.Input.PlannedMin > 0 && workRemaining <= 0 {
 		return nil, &app.ConstraintBlocker{
 			EntityType: "work_item",
@@ -118,9 +199,12 @@ func tryAllocate(c ScoredCandidate, remaining int) (*app.WorkSlice, *app.Constra
 		}
 	}
 
-	// Don't over-allocate past remaining planned work
-	if workRemaining > 0 && workRemaining < allocated {
-		allocated = clamp(workRemaining, minS, upper)
+	// Atomic items take all remaining work, even past max session; others
+	// don't over-allocate past remaining planned work
+	if atomic {
+		allocated = workRemaining
+	} else if workRemaining > 0 && workRemaining < allocated {
+		allocated = clamp(workRemaining, lower, upper)
 	}
 
 	reasons := make([]app.RecommendationReason, len(c.Reasons))
@@ -136,7 +220,7 @@ func tryAllocate(c ScoredCandidate, remaining int) (*app.WorkSlice, *app.Constra
 
 	var dueDateStr *string
 	if c.Input.DueDate != nil {
-		s := c.Input.DueDate.Format("2006-01-02")
+		s := domain.FormatDeadline(*c.Input.DueDate)
 		dueDateStr = &s
 	}
 
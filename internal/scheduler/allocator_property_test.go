@@ -17,6 +17,8 @@ func TestAllocateSlices_Invariants_AllocatedNeverExceedsRequested(t *testing.T)
 	for trial := 0; trial < 200; trial++ {
 		availableMin := rng.Intn(240) + 1 // 1–240 min
 		maxSlices := rng.Intn(5) + 1      // 1–5 slices
+		maxProjects := rng.Intn(3)        // 0 (no cap) to 2 projects
+		projectBudget := rng.Intn(4) * 30 // 0 (no budget) to 90 min
 		enforceVar := rng.Intn(2) == 1
 
 		numCandidates := rng.Intn(8) + 1
@@ -48,7 +50,7 @@ func TestAllocateSlices_Invariants_AllocatedNeverExceedsRequested(t *testing.T)
 			}
 		}
 
-		slices, _ := AllocateSlices(candidates, availableMin, maxSlices, enforceVar)
+		slices, _ := AllocateSlices(candidates, availableMin, maxSlices, maxProjects, projectBudget, enforceVar)
 
 		// Invariant 1: total allocated ≤ available
 		totalAllocated := 0
@@ -75,6 +77,33 @@ func TestAllocateSlices_Invariants_AllocatedNeverExceedsRequested(t *testing.T)
 		// Invariant 4: number of slices ≤ maxSlices
 		assert.LessOrEqual(t, len(slices), maxSlices,
 			"trial %d: number of slices (%d) must not exceed maxSlices (%d)", trial, len(slices), maxSlices)
+
+		// Invariant 5: distinct projects ≤ maxProjects when capped
+		if maxProjects > 0 {
+			projects := make(map[string]bool)
+			for _, s := range slices {
+				projects[s.ProjectID] = true
+			}
+			assert.LessOrEqual(t, len(projects), maxProjects,
+				"trial %d: slices span %d projects, cap is %d", trial, len(projects), maxProjects)
+		}
+
+		// Invariant 6: a project stays within its budget, except that its
+		// first slice may reach that slice's minimum session
+		if projectBudget > 0 {
+			perProject := make(map[string]int)
+			ceiling := make(map[string]int)
+			for _, s := range slices {
+				if _, ok := ceiling[s.ProjectID]; !ok {
+					ceiling[s.ProjectID] = max(projectBudget, s.MinSessionMin)
+				}
+				perProject[s.ProjectID] += s.AllocatedMin
+			}
+			for p, total := range perProject {
+				assert.LessOrEqual(t, total, ceiling[p],
+					"trial %d: project %s got %dm, budget is %dm", trial, p, total, projectBudget)
+			}
+		}
 	}
 }
 
@@ -104,7 +133,7 @@ func TestAllocateSlices_Invariant_NoOverAllocatePastRemaining(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 60, 3, false)
+	slices, _ := AllocateSlices(candidates, 60, 3, 0, 0, false)
 
 	if len(slices) > 0 {
 		// Should not allocate more than remaining work (10 min)
@@ -255,7 +284,7 @@ func TestAllocateSlices_BoundsEnforcement_ExtremeCases(t *testing.T) {
 				},
 			}
 
-			slices, _ := AllocateSlices(candidates, tc.availableMin, 5, false)
+			slices, _ := AllocateSlices(candidates, tc.availableMin, 5, 0, 0, false)
 
 			if tc.expectSlice {
 				assert.Len(t, slices, 1, "should allocate exactly one slice")
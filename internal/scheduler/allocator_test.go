@@ -35,7 +35,7 @@ func TestAllocateSlices_SessionBoundsNeverViolated(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 60, 3, false)
+	slices, _ := AllocateSlices(candidates, 60, 3, 0, 0, false)
 
 	require.Len(t, slices, 1)
 	assert.GreaterOrEqual(t, slices[0].AllocatedMin, 20, "must respect min session")
@@ -59,13 +59,102 @@ func TestAllocateSlices_InsufficientTimeBlocked(t *testing.T) {
 		},
 	}
 
-	slices, blockers := AllocateSlices(candidates, 15, 3, false) // 15 < min 20
+	slices, blockers := AllocateSlices(candidates, 15, 3, 0, 0, false) // 15 < min 20
 
 	assert.Empty(t, slices)
 	assert.NotEmpty(t, blockers)
 	assert.Equal(t, contract.BlockerSessionMinExceedsAvail, blockers[0].Code)
 }
 
+func TestAllocateSlices_AtomicTakesAllRemainingOrNothing(t *testing.T) {
+	atomic := func() []ScoredCandidate {
+		return []ScoredCandidate{
+			{
+				Input: ScoringInput{
+					WorkItemID:        "wi-1",
+					ProjectID:         "p-1",
+					ProjectName:       "A",
+					Title:             "Exam",
+					MinSessionMin:     15,
+					MaxSessionMin:     60,
+					DefaultSessionMin: 30,
+					PlannedMin:        120,
+					LoggedMin:         30,
+					Atomic:            true,
+				},
+				Score: 50.0,
+			},
+		}
+	}
+
+	slices, blockers := AllocateSlices(atomic(), 60, 3, 0, 0, false)
+	assert.Empty(t, slices, "a 90-minute atomic item must not get a shorter slice")
+	require.Len(t, blockers, 1)
+	assert.Equal(t, contract.BlockerNeedsLongerBlock, blockers[0].Code)
+	assert.Contains(t, blockers[0].Message, "90m")
+
+	slices, _ = AllocateSlices(atomic(), 120, 3, 0, 0, false)
+	require.Len(t, slices, 1)
+	assert.Equal(t, 90, slices[0].AllocatedMin, "atomic items get all remaining work, past max session")
+}
+
+func TestAllocateSlices_AtomicFitsBelowMinSession(t *testing.T) {
+	candidates := []ScoredCandidate{
+		{
+			Input: ScoringInput{
+				WorkItemID:        "wi-1",
+				ProjectID:         "p-1",
+				ProjectName:       "A",
+				Title:             "Wrap up",
+				MinSessionMin:     15,
+				MaxSessionMin:     60,
+				DefaultSessionMin: 30,
+				PlannedMin:        40,
+				LoggedMin:         30,
+				Atomic:            true,
+			},
+			Score: 50.0,
+		},
+	}
+
+	slices, blockers := AllocateSlices(candidates, 12, 3, 0, 0, false)
+	assert.Empty(t, blockers, "the whole 10m remainder fits in 12m")
+	require.Len(t, slices, 1)
+	assert.Equal(t, 10, slices[0].AllocatedMin)
+}
+
+func TestAllocateSlices_MinBlockRaisesFloorOrSkips(t *testing.T) {
+	candidate := func(maxS, minBlock int) []ScoredCandidate {
+		return []ScoredCandidate{
+			{
+				Input: ScoringInput{
+					WorkItemID:        "wi-1",
+					ProjectID:         "p-1",
+					ProjectName:       "A",
+					Title:             "Drill",
+					MinSessionMin:     15,
+					MaxSessionMin:     maxS,
+					DefaultSessionMin: 15,
+					PlannedMin:        120,
+					MinBlockMin:       minBlock,
+				},
+				Score: 50.0,
+			},
+		}
+	}
+
+	slices, blockers := AllocateSlices(candidate(20, 25), 60, 3, 0, 0, false)
+	assert.Empty(t, slices, "an item capped at 20m cannot fill a 25m block")
+	require.Len(t, blockers, 1)
+	assert.Equal(t, contract.BlockerInsufficientTime, blockers[0].Code)
+	assert.Contains(t, blockers[0].Message, "25m minimum")
+
+	slices, _ = AllocateSlices(candidate(60, 45), 60, 3, 0, 0, false)
+	require.Len(t, slices, 1)
+	assert.GreaterOrEqual(t, slices[0].AllocatedMin, 45)
+	assert.Equal(t, 15, slices[0].MinSessionMin, "slice keeps the item's own min session")
+}
+
 func TestAllocateSlices_VariationPrefersMultipleProjects(t *testing.T) {
 	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
 	due := now.AddDate(0, 0, 14)
@@ -112,7 +201,7 @@ func TestAllocateSlices_VariationPrefersMultipleProjects(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 90, 3, true)
+	slices, _ := AllocateSlices(candidates, 90, 3, 0, 0, true)
 
 	// With variation, should include item from project B even though A scored higher
 	projectIDs := make(map[string]bool)
@@ -147,13 +236,122 @@ func TestAllocateSlices_ExtendBeforeAddingSameProject(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 60, 5, true)
+	slices, _ := AllocateSlices(candidates, 60, 5, 0, 0, true)
 
 	require.Len(t, slices, 1, "should allocate one slice — extend wi-1 instead of adding wi-2")
 	assert.Equal(t, "wi-1", slices[0].WorkItemID)
 	assert.Equal(t, 60, slices[0].AllocatedMin, "wi-1 should be extended to fill available time")
 }
 
+func TestAllocateSlices_MaxProjectsConcentratesOnTopProjects(t *testing.T) {
+	item := func(id, project string, score float64) ScoredCandidate {
+		return ScoredCandidate{
+			Input: ScoringInput{
+				WorkItemID: id, ProjectID: project, ProjectName: project, Title: id,
+				MinSessionMin: 15, MaxSessionMin: 45, DefaultSessionMin: 30,
+				PlannedMin: 300, NodeID: "n-" + id,
+			},
+			Score: score,
+		}
+	}
+	candidates := []ScoredCandidate{
+		item("wi-1", "p-1", 90),
+		item("wi-3", "p-2", 80),
+		item("wi-4", "p-3", 70),
+		item("wi-2", "p-1", 60),
+	}
+
+	projects := func(slices []contract.WorkSlice) map[string]bool {
+		seen := make(map[string]bool)
+		for _, sl := range slices {
+			seen[sl.ProjectID] = true
+		}
+		return seen
+	}
+
+	uncapped, _ := AllocateSlices(candidates, 150, 5, 0, 0, true)
+	assert.True(t, projects(uncapped)["p-3"], "without a cap every project gets a slice")
+
+	slices, _ := AllocateSlices(candidates, 150, 5, 2, 0, true)
+	assert.Equal(t, map[string]bool{"p-1": true, "p-2": true}, projects(slices), "only the two top-ranked projects")
+	require.Len(t, slices, 3)
+	assert.Equal(t, []string{"wi-1", "wi-3", "wi-2"}, []string{slices[0].WorkItemID, slices[1].WorkItemID, slices[2].WorkItemID},
+		"variation still spreads the first pass across the chosen projects")
+	total := 0
+	for _, sl := range slices {
+		total += sl.AllocatedMin
+	}
+	assert.Equal(t, 120, total, "the skipped project's time goes to the chosen ones")
+}
+
+func TestAllocateSlices_ProjectBudgetSharesTime(t *testing.T) {
+	item := func(id, project string, score float64) ScoredCandidate {
+		return ScoredCandidate{
+			Input: ScoringInput{
+				WorkItemID: id, ProjectID: project, ProjectName: project, Title: id,
+				MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30,
+				PlannedMin: 300, NodeID: "n-" + id,
+			},
+			Score: score,
+		}
+	}
+	candidates := []ScoredCandidate{
+		item("wi-1", "p-1", 90),
+		item("wi-2", "p-1", 85),
+		item("wi-3", "p-1", 80),
+		item("wi-4", "p-2", 50),
+	}
+	perProject := func(slices []contract.WorkSlice) map[string]int {
+		mins := make(map[string]int)
+		for _, sl := range slices {
+			mins[sl.ProjectID] += sl.AllocatedMin
+		}
+		return mins
+	}
+
+	unbudgeted, _ := AllocateSlices(candidates, 120, 5, 0, 0, false)
+	assert.Equal(t, map[string]int{"p-1": 90, "p-2": 30}, perProject(unbudgeted))
+
+	slices, blockers := AllocateSlices(candidates, 120, 5, 0, 60, false)
+	assert.Equal(t, map[string]int{"p-1": 60, "p-2": 30}, perProject(slices))
+	assert.Empty(t, blockers, "items passed over for the budget are not blocked")
+
+	slices, _ = AllocateSlices(candidates, 120, 5, 0, 10, false)
+	require.Len(t, slices, 2, "a budget below the minimum session still allows one slice per project")
+	assert.Equal(t, 15, slices[0].AllocatedMin)
+	assert.Equal(t, "wi-4", slices[1].WorkItemID)
+}
+
+func TestAllocateSlices_ProjectBudgetKeepsRealBlockers(t *testing.T) {
+	candidates := []ScoredCandidate{
+		{
+			Input: ScoringInput{
+				WorkItemID: "wi-1", ProjectID: "p-1", ProjectName: "A", Title: "Task",
+				MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30,
+				PlannedMin: 300, NodeID: "n-1",
+			},
+			Score: 90,
+		},
+		{
+			// Needs 150 unbroken minutes: more than the 90 left, not just
+			// more than its project's budget.
+			Input: ScoringInput{
+				WorkItemID: "wi-2", ProjectID: "p-2", ProjectName: "B", Title: "Exam",
+				MinSessionMin: 15, MaxSessionMin: 60, DefaultSessionMin: 30,
+				PlannedMin: 150, NodeID: "n-2", Atomic: true,
+			},
+			Score: 80,
+		},
+	}
+
+	slices, blockers := AllocateSlices(candidates, 120, 5, 0, 60, false)
+	require.Len(t, slices, 1)
+	assert.Equal(t, "wi-1", slices[0].WorkItemID)
+	require.Len(t, blockers, 1)
+	assert.Equal(t, "wi-2", blockers[0].EntityID)
+	assert.Equal(t, contract.BlockerNeedsLongerBlock, blockers[0].Code)
+}
+
 func TestAllocateSlices_ExtensionCappedByMaxSession(t *testing.T) {
 	candidates := []ScoredCandidate{
 		{
@@ -176,7 +374,7 @@ func TestAllocateSlices_ExtensionCappedByMaxSession(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 90, 5, true)
+	slices, _ := AllocateSlices(candidates, 90, 5, 0, 0, true)
 
 	require.Len(t, slices, 2, "wi-1 caps at 40, so wi-2 fills the rest")
 	assert.Equal(t, "wi-1", slices[0].WorkItemID)
@@ -207,7 +405,7 @@ func TestAllocateSlices_ExtensionCappedByWorkRemaining(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 90, 5, true)
+	slices, _ := AllocateSlices(candidates, 90, 5, 0, 0, true)
 
 	require.Len(t, slices, 2)
 	assert.Equal(t, "wi-1", slices[0].WorkItemID)
@@ -235,7 +433,7 @@ func TestAllocateSlices_FullyLoggedItemBlocked(t *testing.T) {
 		},
 	}
 
-	slices, blockers := AllocateSlices(candidates, 60, 3, false)
+	slices, blockers := AllocateSlices(candidates, 60, 3, 0, 0, false)
 
 	assert.Empty(t, slices, "fully logged item should not be allocated")
 	require.Len(t, blockers, 1)
@@ -261,7 +459,7 @@ func TestAllocateSlices_OverLoggedItemBlocked(t *testing.T) {
 		},
 	}
 
-	slices, blockers := AllocateSlices(candidates, 60, 3, false)
+	slices, blockers := AllocateSlices(candidates, 60, 3, 0, 0, false)
 
 	assert.Empty(t, slices, "over-logged item should not be allocated")
 	require.Len(t, blockers, 1)
@@ -301,7 +499,7 @@ func TestAllocateSlices_ExtensionMultipleProjects(t *testing.T) {
 		},
 	}
 
-	slices, _ := AllocateSlices(candidates, 90, 5, true)
+	slices, _ := AllocateSlices(candidates, 90, 5, 0, 0, true)
 
 	require.Len(t, slices, 2, "one per project — extension fills before adding deferred")
 	total := slices[0].AllocatedMin + slices[1].AllocatedMin
@@ -11,7 +11,7 @@ func SmoothReEstimate(currentPlannedMin, loggedMin, unitsTotal, unitsDone int) i
 		return currentPlannedMin
 	}
 
-	pacePerUnit := float64(loggedMin) / float64(unitsDone)
+	pacePerUnit := UnitPace(loggedMin, unitsDone)
 	impliedTotal := pacePerUnit * float64(unitsTotal)
 
 	newPlanned := 0.7*float64(currentPlannedMin) + 0.3*impliedTotal
@@ -23,3 +23,24 @@ func SmoothReEstimate(currentPlannedMin, loggedMin, unitsTotal, unitsDone int) i
 	}
 	return result
 }
+
+// UnitPace returns the observed minutes per unit (logged minutes over units
+// done), or 0 without unit progress.
+func UnitPace(loggedMin, unitsDone int) float64 {
+	if unitsDone <= 0 {
+		return 0
+	}
+	return float64(loggedMin) / float64(unitsDone)
+}
+
+// RemainingMin estimates the minutes of work left on an item. With unit
+// progress and logged time it is remaining units × the observed minutes per
+// unit, so it self-corrects as units are logged; otherwise it falls back to
+// planned minus logged. The unit-based figure is never negative.
+func RemainingMin(plannedMin, loggedMin, unitsTotal, unitsDone int) int {
+	if unitsTotal <= 0 || unitsDone <= 0 || loggedMin <= 0 {
+		return plannedMin - loggedMin
+	}
+	pacePerUnit := UnitPace(loggedMin, unitsDone)
+	return max(int(math.Round(pacePerUnit*float64(unitsTotal-unitsDone))), 0)
+}
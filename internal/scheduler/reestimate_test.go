@@ -152,3 +152,31 @@ func TestSmoothReEstimate_FormulaCombinations(t *testing.T) {
 		})
 	}
 }
+
+func TestRemainingMin(t *testing.T) {
+	tests := []struct {
+		name                         string
+		planned, logged, total, done int
+		expected                     int
+	}{
+		{"no units falls back to planned minus logged", 100, 30, 0, 0, 70},
+		{"no units done yet", 100, 30, 10, 0, 70},
+		{"nothing logged", 100, 0, 10, 3, 100},
+		{"slower than planned", 100, 60, 10, 3, 140}, // 20m/unit × 7 units
+		{"faster than planned", 100, 20, 10, 4, 30},  // 5m/unit × 6 units
+		{"all units done", 100, 50, 10, 10, 0},
+		{"units done past total", 100, 50, 10, 12, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, RemainingMin(tc.planned, tc.logged, tc.total, tc.done))
+		})
+	}
+}
+
+func TestUnitPace(t *testing.T) {
+	assert.Equal(t, 20.0, UnitPace(60, 3))
+	assert.Equal(t, 7.5, UnitPace(30, 4))
+	assert.Equal(t, 0.0, UnitPace(60, 0), "no units done means no pace")
+}
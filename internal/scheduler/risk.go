@@ -23,6 +23,13 @@ type RiskInput struct {
 	// DueBasedExpectedPct is the % of total work expected to be done by now based on individual
 	// item due dates. Zero means no data available (preserves existing behavior).
 	DueBasedExpectedPct float64
+	// MaxDailyMin is the most work a day can hold. Zero skips the
+	// feasibility check.
+	MaxDailyMin int
+	// RemainingWorkMin is the work left summed over items with RemainingMin,
+	// so unit-paced items count what their pace predicts. Nil falls back to
+	// PlannedMin - LoggedMin.
+	RemainingWorkMin *int
 }
 
 type RiskResult struct {
@@ -32,10 +39,20 @@ type RiskResult struct {
 	RequiredDailyMin float64
 	SlackMinPerDay   float64
 	ProgressTimePct  float64
+	// Infeasible is set when the remaining work (without buffer) exceeds
+	// MaxDailyMin for every day left before the deadline; ShortfallMin is
+	// the excess. Past-due projects are never flagged: their deadline is
+	// already missed, not merely out of reach.
+	Infeasible   bool
+	ShortfallMin int
 }
 
 func ComputeRisk(input RiskInput) RiskResult {
-	remaining := int(math.Max(0, float64(input.PlannedMin-input.LoggedMin)*(1+input.BufferPct)))
+	workLeft := input.PlannedMin - input.LoggedMin
+	if input.RemainingWorkMin != nil {
+		workLeft = *input.RemainingWorkMin
+	}
+	remaining := int(math.Max(0, float64(workLeft)*(1+input.BufferPct)))
 
 	var progressTimePct float64
 	if input.PlannedMin > 0 {
@@ -51,7 +68,8 @@ func ComputeRisk(input RiskInput) RiskResult {
 		}
 	}
 
-	daysLeft := int(math.Ceil(input.TargetDate.Sub(input.Now).Hours() / 24))
+	hoursLeft := input.TargetDate.Sub(input.Now).Hours()
+	daysLeft := int(math.Ceil(hoursLeft / 24))
 	daysLeftPtr := &daysLeft
 
 	// Past due
@@ -66,7 +84,13 @@ func ComputeRisk(input RiskInput) RiskResult {
 		}
 	}
 
-	requiredDaily := float64(remaining) / float64(daysLeft)
+	// A deadline with a time of day less than a day out spreads the work over
+	// the hours actually left, not a whole day.
+	daysForWork := float64(daysLeft)
+	if hoursLeft < 24 && domain.DeadlineHasTime(*input.TargetDate) {
+		daysForWork = hoursLeft / 24
+	}
+	requiredDaily := float64(remaining) / daysForWork
 	slack := input.RecentDailyMin - requiredDaily
 
 	result := RiskResult{
@@ -76,6 +100,13 @@ func ComputeRisk(input RiskInput) RiskResult {
 		SlackMinPerDay:   slack,
 		ProgressTimePct:  progressTimePct,
 	}
+	if input.MaxDailyMin > 0 {
+		capacity := int(daysForWork * float64(input.MaxDailyMin))
+		if shortfall := workLeft - capacity; shortfall > 0 {
+			result.Infeasible = true
+			result.ShortfallMin = shortfall
+		}
+	}
 
 	onPace := isStructurallyOnPace(input)
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestComputeRisk_NoTargetDate(t *testing.T) {
@@ -376,3 +377,104 @@ func TestComputeRisk_Boundary_DaysLeft4_3DayRuleNotApplies(t *testing.T) {
 	// Actually: required = 600/4 = 150, recent = 100, ratio = 1.5 (not > 1.5, so falls to next case)
 	assert.Equal(t, domain.RiskAtRisk, result.Level, "daysLeft 4 with ratio boundary")
 }
+
+func TestComputeRisk_TimedDeadlineWithinDay_UsesHoursLeft(t *testing.T) {
+	// 120 min left with 6 hours to a 17:00 deadline: required = 120 / (6/24) = 480/day.
+	now := time.Date(2025, 3, 15, 11, 0, 0, 0, time.UTC)
+	target := time.Date(2025, 3, 15, 17, 0, 0, 0, time.UTC)
+	result := ComputeRisk(RiskInput{
+		Now:            now,
+		TargetDate:     &target,
+		PlannedMin:     120,
+		RecentDailyMin: 200,
+	})
+	require.NotNil(t, result.DaysLeft)
+	assert.Equal(t, 1, *result.DaysLeft)
+	assert.InDelta(t, 480, result.RequiredDailyMin, 0.01)
+	assert.Equal(t, domain.RiskCritical, result.Level, "480/day against 200/day recent is critical")
+
+	// A date-only deadline the same distance away keeps whole-day math.
+	dateOnly := time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)
+	result = ComputeRisk(RiskInput{
+		Now:            time.Date(2025, 3, 15, 18, 0, 0, 0, time.UTC),
+		TargetDate:     &dateOnly,
+		PlannedMin:     120,
+		RecentDailyMin: 200,
+	})
+	assert.InDelta(t, 120, result.RequiredDailyMin, 0.01)
+	assert.Equal(t, domain.RiskOnTrack, result.Level)
+}
+
+func TestComputeRisk_LocalDeadlineOnUTCMidnight_UsesHoursLeft(t *testing.T) {
+	prev := time.Local
+	time.Local = time.FixedZone("PDT", -7*60*60)
+	t.Cleanup(func() { time.Local = prev })
+
+	// 17:00 PDT is midnight UTC, but it is still a timed deadline 6 hours out.
+	target, err := domain.ParseDeadline("2025-03-15 17:00")
+	require.NoError(t, err)
+	result := ComputeRisk(RiskInput{
+		Now:            target.Add(-6 * time.Hour),
+		TargetDate:     &target,
+		PlannedMin:     120,
+		RecentDailyMin: 200,
+	})
+	assert.InDelta(t, 480, result.RequiredDailyMin, 0.01)
+	assert.Equal(t, domain.RiskCritical, result.Level)
+}
+
+func TestComputeRisk_Infeasible_RemainingExceedsDailyCapacity(t *testing.T) {
+	target := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC) // 2 days
+	input := RiskInput{
+		Now:            time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		TargetDate:     &target,
+		PlannedMin:     1200,
+		LoggedMin:      100,
+		BufferPct:      0.5,
+		RecentDailyMin: 60,
+		MaxDailyMin:    480,
+	}
+	result := ComputeRisk(input)
+	assert.True(t, result.Infeasible)
+	assert.Equal(t, 1100-960, result.ShortfallMin, "buffer is not part of the physical shortfall")
+
+	input.LoggedMin = 240
+	result = ComputeRisk(input)
+	assert.False(t, result.Infeasible, "exactly filling every day is still possible")
+	assert.Zero(t, result.ShortfallMin)
+
+	input.MaxDailyMin = 0
+	input.LoggedMin = 0
+	assert.False(t, ComputeRisk(input).Infeasible, "no capacity set skips the check")
+}
+
+func TestComputeRisk_Infeasible_NotFlaggedWhenPastDue(t *testing.T) {
+	yesterday := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	result := ComputeRisk(RiskInput{
+		Now:         time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC),
+		TargetDate:  &yesterday,
+		PlannedMin:  5000,
+		MaxDailyMin: 480,
+	})
+	assert.Equal(t, domain.RiskCritical, result.Level)
+	assert.False(t, result.Infeasible)
+}
+
+func TestComputeRisk_RemainingWorkMinOverridesPlannedMinusLogged(t *testing.T) {
+	target := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC) // 2 days
+	// Unit pace says more is left than planned - logged.
+	remaining := 1400
+	result := ComputeRisk(RiskInput{
+		Now:              time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		TargetDate:       &target,
+		PlannedMin:       1000,
+		LoggedMin:        600,
+		RecentDailyMin:   60,
+		MaxDailyMin:      480,
+		RemainingWorkMin: &remaining,
+	})
+	assert.Equal(t, 1400, result.RemainingMin)
+	assert.InDelta(t, 700, result.RequiredDailyMin, 0.01)
+	assert.True(t, result.Infeasible)
+	assert.Equal(t, 1400-960, result.ShortfallMin)
+}
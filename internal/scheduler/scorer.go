@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/app"
@@ -12,6 +13,8 @@ type ScoringWeights struct {
 	BehindPace       float64
 	Spacing          float64
 	Variation        float64
+	Focus            float64
+	Importance       float64
 }
 
 func defaultWeights() ScoringWeights {
@@ -20,6 +23,8 @@ func defaultWeights() ScoringWeights {
 		BehindPace:       0.8,
 		Spacing:          0.5,
 		Variation:        0.3,
+		Focus:            1.0,
+		Importance:       1.0,
 	}
 }
 
@@ -41,6 +46,29 @@ type ScoringInput struct {
 	// Work item status for momentum scoring
 	Status domain.WorkItemStatus
 
+	// Pinned marks the item the user is continuing; it skips the spacing
+	// penalty so "already worked today" doesn't push it off the plan.
+	Pinned bool
+
+	// Focused marks an item on the user's focus list.
+	Focused bool
+
+	// ManualPriority is the user's ranking override (work priority); see
+	// CanonicalSort.
+	ManualPriority domain.ManualPriority
+
+	// ProjectImportance is the project's 1-5 importance; 3 (or 0, unset)
+	// is neutral.
+	ProjectImportance int
+
+	// Atomic marks a non-splittable item: the allocator gives it all of its
+	// remaining work in one slice or nothing.
+	Atomic bool
+
+	// MinBlockMin is the shortest slice the user will accept (what-now
+	// --min-block); the allocator skips items that can't fill it.
+	MinBlockMin int
+
 	// Work item fields for allocation
 	MinSessionMin     int
 	MaxSessionMin     int
@@ -48,9 +76,16 @@ type ScoringInput struct {
 	Splittable        bool
 	PlannedMin        int
 	LoggedMin         int
+	UnitsTotal        int
+	UnitsDone         int
 	NodeID            string
 }
 
+// RemainingMin is the work left on the item; see RemainingMin.
+func (in ScoringInput) RemainingMin() int {
+	return RemainingMin(in.PlannedMin, in.LoggedMin, in.UnitsTotal, in.UnitsDone)
+}
+
 type ScoredCandidate struct {
 	Input   ScoringInput
 	Score   float64
@@ -85,6 +120,9 @@ func ScoreWorkItem(input ScoringInput) ScoredCandidate {
 		scoreMomentum,
 		scoreCriticalBonus,
 		scoreSafeMix,
+		scoreFocus,
+		scoreImportance,
+		scoreManualPriority,
 	}
 	for _, f := range factors {
 		delta, reason := f(input)
@@ -149,6 +187,14 @@ func scoreSpacing(input ScoringInput) (float64, *app.RecommendationReason) {
 		return 0, nil
 	}
 	daysAgo := *input.LastSessionDaysAgo
+	if input.Pinned && daysAgo == 0 {
+		zero := 0.0
+		return 0, &app.RecommendationReason{
+			Code:        app.ReasonContinuePinned,
+			Message:     "Continuing current item — spacing penalty waived",
+			WeightDelta: &zero,
+		}
+	}
 	var delta float64
 	var code app.RecommendationReasonCode
 	var msg string
@@ -230,6 +276,59 @@ func scoreSafeMix(input ScoringInput) (float64, *app.RecommendationReason) {
 	return 0, nil
 }
 
+func scoreFocus(input ScoringInput) (float64, *app.RecommendationReason) {
+	if !input.Focused {
+		return 0, nil
+	}
+	delta := 25.0 * input.Weights.Focus
+	return delta, &app.RecommendationReason{
+		Code:        app.ReasonFocusList,
+		Message:     "On your focus list",
+		WeightDelta: &delta,
+	}
+}
+
+// scoreManualPriority explains a work priority override. It adds nothing to
+// the score: the override acts through CanonicalSort, not the weights.
+func scoreManualPriority(input ScoringInput) (float64, *app.RecommendationReason) {
+	var msg string
+	switch input.ManualPriority {
+	case domain.PriorityTop:
+		msg = "Pinned to the top with work priority"
+	case domain.PriorityHigh:
+		msg = "Marked high priority"
+	default:
+		return 0, nil
+	}
+	zero := 0.0
+	return 0, &app.RecommendationReason{
+		Code:        app.ReasonManualPriority,
+		Message:     msg,
+		WeightDelta: &zero,
+	}
+}
+
+// scoreImportance shifts the score by 5 points (times the weight) per
+// importance level above or below neutral, independent of deadlines.
+func scoreImportance(input ScoringInput) (float64, *app.RecommendationReason) {
+	if input.ProjectImportance == 0 || input.ProjectImportance == domain.DefaultImportance {
+		return 0, nil
+	}
+	delta := 5.0 * float64(input.ProjectImportance-domain.DefaultImportance) * input.Weights.Importance
+	if delta == 0 {
+		return 0, nil
+	}
+	msg := "Project marked important"
+	if delta < 0 {
+		msg = "Project marked low importance"
+	}
+	return delta, &app.RecommendationReason{
+		Code:        app.ReasonProjectImportance,
+		Message:     fmt.Sprintf("%s (%d/5)", msg, input.ProjectImportance),
+		WeightDelta: &delta,
+	}
+}
+
 func formatDeadlineMessage(daysUntil int) string {
 	switch {
 	case daysUntil <= 0:
@@ -152,6 +152,98 @@ func TestScoreWorkItem_SpacingPenalty_WorkedToday(t *testing.T) {
 	assert.True(t, hasSpacingBlocked, "should have SPACING_BLOCKED reason when worked today")
 }
 
+func TestScoreWorkItem_PinnedWaivesSpacingPenalty(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	daysAgo := 0
+	input := ScoringInput{
+		WorkItemID:         "wi-1",
+		ProjectID:          "p-1",
+		ProjectName:        "Test",
+		Title:              "Task",
+		ProjectRisk:        domain.RiskOnTrack,
+		Now:                now,
+		LastSessionDaysAgo: &daysAgo,
+		Weights:            defaultWeights(),
+		Mode:               domain.ModeBalanced,
+	}
+	unpinned := ScoreWorkItem(input)
+
+	input.Pinned = true
+	pinned := ScoreWorkItem(input)
+
+	assert.Greater(t, pinned.Score, unpinned.Score)
+	codes := make(map[contract.RecommendationReasonCode]bool)
+	for _, r := range pinned.Reasons {
+		codes[r.Code] = true
+	}
+	assert.True(t, codes[contract.ReasonContinuePinned])
+	assert.False(t, codes[contract.ReasonSpacingBlocked])
+}
+
+func TestScoreWorkItem_FocusBoostScalesWithWeight(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	input := ScoringInput{
+		WorkItemID:  "wi-1",
+		ProjectID:   "p-1",
+		ProjectName: "Test",
+		Title:       "Task",
+		ProjectRisk: domain.RiskOnTrack,
+		Now:         now,
+		Weights:     defaultWeights(),
+		Mode:        domain.ModeBalanced,
+	}
+	plain := ScoreWorkItem(input)
+
+	input.Focused = true
+	focused := ScoreWorkItem(input)
+	assert.InDelta(t, 25.0, focused.Score-plain.Score, 0.001)
+	codes := make(map[contract.RecommendationReasonCode]bool)
+	for _, r := range focused.Reasons {
+		codes[r.Code] = true
+	}
+	assert.True(t, codes[contract.ReasonFocusList])
+
+	input.Weights.Focus = 2.0
+	heavier := ScoreWorkItem(input)
+	assert.InDelta(t, 50.0, heavier.Score-plain.Score, 0.001)
+}
+
+func TestScoreWorkItem_ProjectImportance(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	input := ScoringInput{
+		WorkItemID:  "wi-1",
+		ProjectID:   "p-1",
+		ProjectName: "Test",
+		Title:       "Task",
+		ProjectRisk: domain.RiskOnTrack,
+		Now:         now,
+		Weights:     defaultWeights(),
+		Mode:        domain.ModeBalanced,
+	}
+	unset := ScoreWorkItem(input)
+
+	input.ProjectImportance = domain.DefaultImportance
+	neutral := ScoreWorkItem(input)
+	assert.InDelta(t, unset.Score, neutral.Score, 0.001)
+
+	input.ProjectImportance = 5
+	high := ScoreWorkItem(input)
+	assert.InDelta(t, 10.0, high.Score-neutral.Score, 0.001)
+	codes := make(map[contract.RecommendationReasonCode]bool)
+	for _, r := range high.Reasons {
+		codes[r.Code] = true
+	}
+	assert.True(t, codes[contract.ReasonProjectImportance])
+
+	input.ProjectImportance = 1
+	low := ScoreWorkItem(input)
+	assert.InDelta(t, -10.0, low.Score-neutral.Score, 0.001)
+
+	input.Weights.Importance = 0
+	ignored := ScoreWorkItem(input)
+	assert.InDelta(t, neutral.Score, ignored.Score, 0.001)
+}
+
 func TestScoreWorkItem_VariationBonus(t *testing.T) {
 	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
 
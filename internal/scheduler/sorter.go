@@ -1,8 +1,10 @@
 package scheduler
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 )
 
@@ -18,23 +20,76 @@ func RiskPriority(r domain.RiskLevel) int {
 	}
 }
 
+// focusRanked reports whether an item is ranked ahead as part of the focus
+// list. A zero focus weight turns the override off.
+func focusRanked(in ScoringInput) bool {
+	return in.Focused && in.Weights.Focus > 0
+}
+
+// importanceRank is the project's importance scaled by the importance
+// weight, so it only orders items while the weight is on.
+func importanceRank(in ScoringInput) float64 {
+	if in.Weights.Importance <= 0 {
+		return 0
+	}
+	importance := in.ProjectImportance
+	if importance == 0 {
+		importance = domain.DefaultImportance
+	}
+	return float64(importance) * in.Weights.Importance
+}
+
+// manualTop reports whether the user pinned an item to the top with work
+// priority. A blocked item (outside critical scope) is not pinned.
+func manualTop(c ScoredCandidate) bool {
+	return c.Input.ManualPriority == domain.PriorityTop && !c.Blocked
+}
+
 // CanonicalSort sorts scored candidates by the deterministic canonical rules:
-// 1. Risk: critical > at_risk > on_track
-// 2. Due date: earliest first (nil last)
-// 3. Score: higher first
-// 4. Project name: lexical ascending
-// 5. Work item ID: lexical ascending
+// 1. Manual top priority: pinned items first, unless blocked
+// 2. Risk: critical > at_risk > on_track
+// 3. Manual high priority: ahead of the rest of the risk tier
+// 4. Focus list: focused items first (unless the focus weight is zero)
+// 5. Project importance: higher first (unless the importance weight is zero)
+// 6. Due date: earliest first (nil last)
+// 7. Score: higher first
+// 8. Project name: lexical ascending
+// 9. Work item ID: lexical ascending
 func CanonicalSort(candidates []ScoredCandidate) {
 	sort.SliceStable(candidates, func(i, j int) bool {
 		a, b := candidates[i], candidates[j]
 
-		// 1. Risk priority
+		// 1. Manual top priority
+		topA, topB := manualTop(a), manualTop(b)
+		if topA != topB {
+			return topA
+		}
+
+		// 2. Risk priority
 		riskA, riskB := RiskPriority(a.Input.ProjectRisk), RiskPriority(b.Input.ProjectRisk)
 		if riskA != riskB {
 			return riskA < riskB
 		}
 
-		// 2. Due date (earliest first, nil last)
+		// 3. Manual high priority
+		highA, highB := a.Input.ManualPriority == domain.PriorityHigh, b.Input.ManualPriority == domain.PriorityHigh
+		if highA != highB {
+			return highA
+		}
+
+		// 4. Focus list
+		focusA, focusB := focusRanked(a.Input), focusRanked(b.Input)
+		if focusA != focusB {
+			return focusA
+		}
+
+		// 5. Project importance
+		impA, impB := importanceRank(a.Input), importanceRank(b.Input)
+		if impA != impB {
+			return impA > impB
+		}
+
+		// 6. Due date (earliest first, nil last)
 		dueDateA, dueDateB := a.Input.DueDate, b.Input.DueDate
 		if (dueDateA == nil) != (dueDateB == nil) {
 			return dueDateA != nil // non-nil before nil
@@ -43,17 +98,86 @@ func CanonicalSort(candidates []ScoredCandidate) {
 			return dueDateA.Before(*dueDateB)
 		}
 
-		// 3. Score (higher first)
+		// 7. Score (higher first)
 		if a.Score != b.Score {
 			return a.Score > b.Score
 		}
 
-		// 4. Project name (lexical)
+		// 8. Project name (lexical)
 		if a.Input.ProjectName != b.Input.ProjectName {
 			return a.Input.ProjectName < b.Input.ProjectName
 		}
 
-		// 5. Work item ID (lexical)
+		// 9. Work item ID (lexical)
 		return a.Input.WorkItemID < b.Input.WorkItemID
 	})
 }
+
+// PinFirst moves the unblocked candidate with the given work item ID to the
+// front, preserving the relative order of the rest. Returns false if the item
+// is absent or blocked (e.g. outside critical scope), leaving order unchanged.
+func PinFirst(candidates []ScoredCandidate, workItemID string) bool {
+	for i, c := range candidates {
+		if c.Input.WorkItemID != workItemID {
+			continue
+		}
+		if c.Blocked {
+			return false
+		}
+		copy(candidates[1:i+1], candidates[:i])
+		candidates[0] = c
+		return true
+	}
+	return false
+}
+
+// WarmupMaxMin is the most remaining work an item can have and still serve
+// as a warmup.
+const WarmupMaxMin = 30
+
+// WarmupFirst moves a short warmup item to the front, so the top candidate
+// (the deep item) comes second. The warmup is the unblocked candidate other
+// than the top one with the least remaining estimated work, at most
+// WarmupMaxMin; ties keep canonical order. With variation enforced it must
+// come from another project, or the deep item would be deferred. Returns
+// false, leaving order unchanged, when no candidate qualifies.
+func WarmupFirst(candidates []ScoredCandidate, enforceVariation bool) bool {
+	top := -1
+	for i, c := range candidates {
+		if !c.Blocked {
+			top = i
+			break
+		}
+	}
+	if top < 0 {
+		return false
+	}
+
+	warmup, warmupLeft := -1, 0
+	for i, c := range candidates {
+		if i == top || c.Blocked || c.Input.PlannedMin <= 0 {
+			continue
+		}
+		if enforceVariation && c.Input.ProjectID == candidates[top].Input.ProjectID {
+			continue
+		}
+		left := c.Input.RemainingMin()
+		if left <= 0 || left > WarmupMaxMin {
+			continue
+		}
+		if warmup < 0 || left < warmupLeft {
+			warmup, warmupLeft = i, left
+		}
+	}
+	if warmup < 0 {
+		return false
+	}
+
+	zero := 0.0
+	candidates[warmup].Reasons = append(candidates[warmup].Reasons, app.RecommendationReason{
+		Code:        app.ReasonWarmup,
+		Message:     fmt.Sprintf("Short warmup (%dm left) before deep work", warmupLeft),
+		WeightDelta: &zero,
+	})
+	return PinFirst(candidates, candidates[warmup].Input.WorkItemID)
+}
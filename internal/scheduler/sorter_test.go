@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,6 +66,30 @@ func TestCanonicalSort_NilDueDateLast(t *testing.T) {
 	assert.Equal(t, "No Due", candidates[1].Input.ProjectName)
 }
 
+func TestCanonicalSort_FocusedBeforeEarlierDueWithinRiskTier(t *testing.T) {
+	earlyDue := time.Now().Add(2 * 24 * time.Hour)
+	focused := makeCandidate("Focused", "wi-1", domain.RiskOnTrack, nil, 10)
+	focused.Input.Focused = true
+	focused.Input.Weights.Focus = 1.0
+
+	candidates := []ScoredCandidate{
+		makeCandidate("Urgent", "wi-2", domain.RiskOnTrack, &earlyDue, 50),
+		focused,
+		makeCandidate("Critical", "wi-3", domain.RiskCritical, &earlyDue, 50),
+	}
+
+	CanonicalSort(candidates)
+
+	assert.Equal(t, "Critical", candidates[0].Input.ProjectName, "risk still outranks focus")
+	assert.Equal(t, "Focused", candidates[1].Input.ProjectName)
+	assert.Equal(t, "Urgent", candidates[2].Input.ProjectName)
+
+	// Zero weight disables the override.
+	candidates[1].Input.Weights.Focus = 0
+	CanonicalSort(candidates)
+	assert.Equal(t, "Urgent", candidates[1].Input.ProjectName)
+}
+
 func TestCanonicalSort_ScoreTiebreak(t *testing.T) {
 	due := time.Now().Add(7 * 24 * time.Hour)
 
@@ -97,3 +122,102 @@ func TestCanonicalSort_StableTiebreak_NameThenID(t *testing.T) {
 	assert.Equal(t, "wi-3", candidates[1].Input.WorkItemID)
 	assert.Equal(t, "Bravo", candidates[2].Input.ProjectName)
 }
+
+func TestPinFirst_MovesItemAndKeepsRestOrdered(t *testing.T) {
+	candidates := []ScoredCandidate{
+		makeCandidate("A", "wi-1", domain.RiskOnTrack, nil, 30),
+		makeCandidate("B", "wi-2", domain.RiskOnTrack, nil, 20),
+		makeCandidate("C", "wi-3", domain.RiskOnTrack, nil, 10),
+	}
+
+	require.True(t, PinFirst(candidates, "wi-3"))
+	assert.Equal(t, "wi-3", candidates[0].Input.WorkItemID)
+	assert.Equal(t, "wi-1", candidates[1].Input.WorkItemID)
+	assert.Equal(t, "wi-2", candidates[2].Input.WorkItemID)
+}
+
+func TestPinFirst_BlockedOrMissingIsNoop(t *testing.T) {
+	blocked := makeCandidate("B", "wi-2", domain.RiskOnTrack, nil, 20)
+	blocked.Blocked = true
+	candidates := []ScoredCandidate{
+		makeCandidate("A", "wi-1", domain.RiskOnTrack, nil, 30),
+		blocked,
+	}
+
+	assert.False(t, PinFirst(candidates, "wi-2"))
+	assert.False(t, PinFirst(candidates, "wi-9"))
+	assert.Equal(t, "wi-1", candidates[0].Input.WorkItemID)
+}
+
+func warmupCandidate(projectID, workItemID string, plannedMin, loggedMin int, score float64) ScoredCandidate {
+	c := makeCandidate(projectID, workItemID, domain.RiskOnTrack, nil, score)
+	c.Input.ProjectID = projectID
+	c.Input.PlannedMin = plannedMin
+	c.Input.LoggedMin = loggedMin
+	return c
+}
+
+func TestWarmupFirst_PicksShortestRemainingAheadOfTop(t *testing.T) {
+	candidates := []ScoredCandidate{
+		warmupCandidate("p1", "deep", 240, 0, 90),
+		warmupCandidate("p2", "medium", 60, 0, 80),
+		warmupCandidate("p3", "short", 60, 45, 70),
+		warmupCandidate("p4", "tiny", 20, 0, 60),
+	}
+
+	require.True(t, WarmupFirst(candidates, false))
+	assert.Equal(t, "short", candidates[0].Input.WorkItemID, "15m left beats 20m left")
+	assert.Equal(t, "deep", candidates[1].Input.WorkItemID)
+	require.NotEmpty(t, candidates[0].Reasons)
+	assert.Equal(t, app.ReasonWarmup, candidates[0].Reasons[len(candidates[0].Reasons)-1].Code)
+}
+
+func TestWarmupFirst_VariationSkipsTopProject(t *testing.T) {
+	candidates := []ScoredCandidate{
+		warmupCandidate("p1", "deep", 240, 0, 90),
+		warmupCandidate("p1", "same-project", 15, 0, 80),
+		warmupCandidate("p2", "other", 25, 0, 70),
+	}
+
+	require.True(t, WarmupFirst(candidates, true))
+	assert.Equal(t, "other", candidates[0].Input.WorkItemID)
+	assert.Equal(t, "deep", candidates[1].Input.WorkItemID)
+}
+
+func TestWarmupFirst_NoShortItemKeepsOrder(t *testing.T) {
+	candidates := []ScoredCandidate{
+		warmupCandidate("p1", "deep", 240, 0, 90),
+		warmupCandidate("p2", "long", 120, 0, 80),
+	}
+
+	assert.False(t, WarmupFirst(candidates, false))
+	assert.Equal(t, "deep", candidates[0].Input.WorkItemID)
+	assert.Empty(t, candidates[0].Reasons)
+}
+
+func TestCanonicalSort_ManualPriority(t *testing.T) {
+	earlyDue := time.Now().Add(2 * 24 * time.Hour)
+	top := makeCandidate("Pinned", "wi-1", domain.RiskOnTrack, nil, 10)
+	top.Input.ManualPriority = domain.PriorityTop
+	high := makeCandidate("High", "wi-2", domain.RiskOnTrack, nil, 10)
+	high.Input.ManualPriority = domain.PriorityHigh
+
+	candidates := []ScoredCandidate{
+		makeCandidate("Urgent", "wi-3", domain.RiskOnTrack, &earlyDue, 90),
+		high,
+		makeCandidate("Critical", "wi-4", domain.RiskCritical, &earlyDue, 50),
+		top,
+	}
+
+	CanonicalSort(candidates)
+
+	assert.Equal(t, "Pinned", candidates[0].Input.ProjectName, "top outranks risk")
+	assert.Equal(t, "Critical", candidates[1].Input.ProjectName, "high does not outrank risk")
+	assert.Equal(t, "High", candidates[2].Input.ProjectName, "high leads its risk tier")
+	assert.Equal(t, "Urgent", candidates[3].Input.ProjectName)
+
+	// A blocked item is not pinned.
+	candidates[0].Blocked = true
+	CanonicalSort(candidates)
+	assert.Equal(t, "Critical", candidates[0].Input.ProjectName)
+}
@@ -100,7 +100,7 @@ func TestArchiveProject_ExcludesFromStatus(t *testing.T) {
 		testutil.WithPlannedMin(60), testutil.WithSessionBounds(15, 60, 30))
 	require.NoError(t, workItems.Create(ctx, wi2))
 
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 
 	// Both projects should appear before archiving.
 	req := contract.NewStatusRequest()
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type archiveService struct {
+	archive repository.ArchiveRepo
+	uow     db.UnitOfWork
+}
+
+func NewArchiveService(archive repository.ArchiveRepo, uow db.UnitOfWork) ArchiveService {
+	return &archiveService{archive: archive, uow: uow}
+}
+
+func (s *archiveService) List(ctx context.Context) ([]domain.ArchivedEntity, error) {
+	return s.archive.ListArchived(ctx)
+}
+
+func (s *archiveService) Purge(ctx context.Context, cutoff time.Time, dryRun bool) ([]domain.ArchivedEntity, error) {
+	var purged []domain.ArchivedEntity
+	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		archived, err := repository.NewSQLiteArchiveRepo(tx).ListArchived(ctx)
+		if err != nil {
+			return err
+		}
+		projects := make(map[string]bool)
+		for _, e := range archived {
+			if !e.ArchivedBefore(cutoff) {
+				continue
+			}
+			purged = append(purged, e)
+			if e.EntityType == domain.TombstoneEntityProject {
+				projects[e.ID] = true
+			}
+		}
+		if dryRun {
+			return nil
+		}
+
+		txProjects := repository.NewSQLiteProjectRepo(tx)
+		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+		for _, e := range purged {
+			switch {
+			case e.EntityType == domain.TombstoneEntityProject:
+				err = txProjects.Delete(ctx, e.ID)
+			case !projects[e.ProjectID]:
+				// Items in a purged project go with it by cascade.
+				err = txWorkItems.Delete(ctx, e.ID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
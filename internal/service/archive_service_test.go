@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveService_PurgeRespectsCutoffAndDryRun(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	ctx := context.Background()
+	projects := repository.NewSQLiteProjectRepo(database)
+	nodes := repository.NewSQLitePlanNodeRepo(database)
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	sessions := repository.NewSQLiteSessionRepo(database)
+	svc := NewArchiveService(repository.NewSQLiteArchiveRepo(database), testutil.NewTestUoW(database))
+
+	now := time.Now().UTC()
+	old := now.AddDate(0, 0, -120).Format(time.RFC3339)
+	recent := now.AddDate(0, 0, -10).Format(time.RFC3339)
+
+	// An old archived project with an item and a session.
+	oldProj := testutil.NewTestProject("Old")
+	require.NoError(t, projects.Create(ctx, oldProj))
+	oldNode := testutil.NewTestNode(oldProj.ID, "N")
+	require.NoError(t, nodes.Create(ctx, oldNode))
+	oldItem := testutil.NewTestWorkItem(oldNode.ID, "Old task")
+	require.NoError(t, workItems.Create(ctx, oldItem))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(oldItem.ID, 30)))
+	require.NoError(t, projects.Archive(ctx, oldProj.ID))
+	// The item was archived separately too; it must go with its project.
+	require.NoError(t, workItems.Archive(ctx, oldItem.ID))
+
+	// An active project with one old and one recent archived item.
+	live := testutil.NewTestProject("Live")
+	require.NoError(t, projects.Create(ctx, live))
+	liveNode := testutil.NewTestNode(live.ID, "N")
+	require.NoError(t, nodes.Create(ctx, liveNode))
+	stale := testutil.NewTestWorkItem(liveNode.ID, "Stale")
+	fresh := testutil.NewTestWorkItem(liveNode.ID, "Fresh")
+	kept := testutil.NewTestWorkItem(liveNode.ID, "Kept")
+	require.NoError(t, workItems.Create(ctx, stale))
+	require.NoError(t, workItems.Create(ctx, fresh))
+	require.NoError(t, workItems.Create(ctx, kept))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(stale.ID, 20)))
+	require.NoError(t, workItems.Archive(ctx, stale.ID))
+	require.NoError(t, workItems.Archive(ctx, fresh.ID))
+
+	_, err := database.Exec(`UPDATE projects SET archived_at = ? WHERE id = ?`, old, oldProj.ID)
+	require.NoError(t, err)
+	_, err = database.Exec(`UPDATE work_items SET archived_at = ? WHERE id IN (?, ?)`, old, oldItem.ID, stale.ID)
+	require.NoError(t, err)
+	_, err = database.Exec(`UPDATE work_items SET archived_at = ? WHERE id = ?`, recent, fresh.ID)
+	require.NoError(t, err)
+
+	archived, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, archived, 4)
+
+	cutoff := now.AddDate(0, 0, -90)
+	preview, err := svc.Purge(ctx, cutoff, true)
+	require.NoError(t, err)
+	require.Len(t, preview, 3)
+	for _, e := range preview {
+		assert.NotEqual(t, fresh.ID, e.ID, "recently archived item is inside the retention window")
+		if e.ID == oldProj.ID {
+			assert.Equal(t, domain.TombstoneEntityProject, e.EntityType)
+			assert.Equal(t, 1, e.SessionCount)
+		}
+	}
+	_, err = projects.GetByID(ctx, oldProj.ID)
+	require.NoError(t, err, "dry run must not delete")
+
+	purged, err := svc.Purge(ctx, cutoff, false)
+	require.NoError(t, err)
+	assert.Len(t, purged, 3)
+
+	_, err = projects.GetByID(ctx, oldProj.ID)
+	assert.Error(t, err)
+	_, err = workItems.GetByID(ctx, oldItem.ID)
+	assert.Error(t, err)
+	_, err = workItems.GetByID(ctx, stale.ID)
+	assert.Error(t, err)
+	staleSessions, err := sessions.ListByWorkItem(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Empty(t, staleSessions)
+
+	_, err = workItems.GetByID(ctx, fresh.ID)
+	assert.NoError(t, err)
+	_, err = workItems.GetByID(ctx, kept.ID)
+	assert.NoError(t, err)
+
+	archived, err = svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Equal(t, fresh.ID, archived[0].ID)
+}
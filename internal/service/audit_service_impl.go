@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+// auditUseCaseObserver appends successful entity mutations to the audit log
+// and forwards every event to the next observer. Audit writes are
+// best-effort: a failed append never fails the use case that produced it.
+type auditUseCaseObserver struct {
+	events repository.AuditRepo
+	next   UseCaseObserver
+}
+
+// NewAuditUseCaseObserver records mutation events in events, then passes all
+// events on to next (nil means no further observer).
+func NewAuditUseCaseObserver(events repository.AuditRepo, next UseCaseObserver) UseCaseObserver {
+	if next == nil {
+		next = NoopUseCaseObserver{}
+	}
+	return &auditUseCaseObserver{events: events, next: next}
+}
+
+func (o *auditUseCaseObserver) ObserveUseCase(ctx context.Context, event UseCaseEvent) {
+	o.next.ObserveUseCase(ctx, event)
+	if !event.Success || event.EntityID == "" {
+		return
+	}
+	_ = o.events.Append(ctx, &domain.AuditEvent{
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Action:     event.Name,
+		Changes:    event.Changes,
+		OccurredAt: event.StartedAt,
+	})
+}
+
+type auditService struct {
+	events repository.AuditRepo
+}
+
+func NewAuditService(events repository.AuditRepo) AuditService {
+	return &auditService{events: events}
+}
+
+func (s *auditService) History(ctx context.Context, entityID string) ([]*domain.AuditEvent, error) {
+	return s.events.ListByEntity(ctx, entityID)
+}
+
+// workItemChanges returns the audited fields that differ between before and
+// after. A nil before (creation) reports every set field.
+func workItemChanges(before, after *domain.WorkItem) map[string]domain.FieldChange {
+	fields := func(w *domain.WorkItem) map[string]string {
+		if w == nil {
+			return map[string]string{}
+		}
+		m := map[string]string{
+			"title":       w.Title,
+			"type":        w.Type,
+			"status":      string(w.Status),
+			"node_id":     w.NodeID,
+			"planned_min": strconv.Itoa(w.PlannedMin),
+			"logged_min":  strconv.Itoa(w.LoggedMin),
+			"units_done":  strconv.Itoa(w.UnitsDone),
+		}
+		if w.DueDate != nil {
+			m["due_date"] = domain.FormatDeadline(*w.DueDate)
+		}
+		if w.NotBefore != nil {
+			m["not_before"] = w.NotBefore.Format("2006-01-02")
+		}
+		if len(w.Tags) > 0 {
+			m["tags"] = strings.Join(w.Tags, ",")
+		}
+		return m
+	}
+	from, to := fields(before), fields(after)
+	changes := map[string]domain.FieldChange{}
+	for k, v := range to {
+		if before == nil && (v == "" || v == "0" || k == "node_id") {
+			continue // keep creation entries compact
+		}
+		if from[k] != v {
+			changes[k] = domain.FieldChange{From: from[k], To: v}
+		}
+	}
+	for k, v := range from {
+		if _, ok := to[k]; !ok {
+			changes[k] = domain.FieldChange{From: v}
+		}
+	}
+	return changes
+}
+
+// snapshotWorkItem returns a shallow copy of w for later diffing.
+func snapshotWorkItem(w *domain.WorkItem) *domain.WorkItem {
+	if w == nil {
+		return nil
+	}
+	c := *w
+	return &c
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	events []UseCaseEvent
+}
+
+func (o *recordingObserver) ObserveUseCase(_ context.Context, e UseCaseEvent) {
+	o.events = append(o.events, e)
+}
+
+func TestAuditObserver_RecordsOnlySuccessfulEntityMutations(t *testing.T) {
+	repo := repository.NewSQLiteAuditRepo(testutil.NewTestDB(t))
+	next := &recordingObserver{}
+	obs := NewAuditUseCaseObserver(repo, next)
+	ctx := context.Background()
+
+	obs.ObserveUseCase(ctx, UseCaseEvent{Name: "what-now", Success: true})
+	obs.ObserveUseCase(ctx, UseCaseEvent{Name: "mark-done", EntityID: "wi-1", Err: errors.New("boom")})
+	obs.ObserveUseCase(ctx, UseCaseEvent{Name: "mark-done", EntityType: "work_item", EntityID: "wi-1", Success: true})
+
+	assert.Len(t, next.events, 3, "every event is forwarded")
+	events, err := repo.ListByEntity(ctx, "wi-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "mark-done", events[0].Action)
+	assert.Equal(t, "work_item", events[0].EntityType)
+}
+
+func TestAuditService_WorkItemLifecycle(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	uow := testutil.NewTestUoW(database)
+	auditRepo := repository.NewSQLiteAuditRepo(database)
+	obs := NewAuditUseCaseObserver(auditRepo, nil)
+	projRepo := repository.NewSQLiteProjectRepo(database)
+	nodeRepo := repository.NewSQLitePlanNodeRepo(database)
+	wiRepo := repository.NewSQLiteWorkItemRepo(database)
+	workItems := NewWorkItemService(wiRepo, nodeRepo, uow, obs)
+	sessions := NewSessionService(repository.NewSQLiteSessionRepo(database), uow, obs)
+	history := NewAuditService(auditRepo)
+	ctx := context.Background()
+
+	_, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	wi := testutil.NewTestWorkItem(nodeID, "Essay", testutil.WithPlannedMin(60))
+	wi.ID = ""
+	require.NoError(t, workItems.Create(ctx, wi))
+
+	wi.Title = "Essay draft"
+	require.NoError(t, workItems.Update(ctx, wi))
+	require.NoError(t, sessions.LogSession(ctx, testutil.NewTestSession(wi.ID, 30)))
+	require.NoError(t, workItems.MarkDone(ctx, wi.ID))
+	require.Error(t, workItems.MarkInProgress(ctx, wi.ID), "done items can't restart")
+
+	events, err := history.History(ctx, wi.ID)
+	require.NoError(t, err)
+	actions := make([]string, len(events))
+	for i, e := range events {
+		actions[i] = e.Action
+	}
+	assert.Equal(t, []string{"create-work-item", "update-work-item", "log-session", "mark-done"}, actions,
+		"failed mutations are not recorded")
+
+	assert.Equal(t, domain.FieldChange{To: "Essay"}, events[0].Changes["title"])
+	assert.Equal(t, domain.FieldChange{To: "60"}, events[0].Changes["planned_min"])
+	assert.NotContains(t, events[0].Changes, "logged_min", "zero values are left out of creation")
+	assert.Equal(t, map[string]domain.FieldChange{"title": {From: "Essay", To: "Essay draft"}}, events[1].Changes)
+	assert.Equal(t, domain.FieldChange{From: "0", To: "30"}, events[2].Changes["logged_min"])
+	assert.Equal(t, domain.FieldChange{From: "in_progress", To: "done"}, events[3].Changes["status"])
+
+	require.NoError(t, workItems.Delete(ctx, wi.ID))
+	events, err = history.History(ctx, wi.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 5, "history outlives the item")
+	assert.Equal(t, "delete-work-item", events[4].Action)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/repository"
@@ -289,3 +290,56 @@ func TestE2E_ConcurrentSessionLogging_DifferentWorkItems(t *testing.T) {
 			"Item %d should be in_progress", idx+1)
 	}
 }
+
+// TestE2E_ConcurrentRecommendAndLog_NoLockErrors runs what-now reads and
+// session writes in parallel without any retry loop: with WAL, a busy timeout
+// and IMMEDIATE transactions from db.OpenDB, readers never see SQLITE_BUSY and
+// writers queue for the lock instead of failing.
+func TestE2E_ConcurrentRecommendAndLog_NoLockErrors(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, uow := setupConcurrentRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Stress", testutil.WithTargetDate(time.Now().UTC().AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	item := testutil.NewTestWorkItem(node.ID, "Reading",
+		testutil.WithPlannedMin(600),
+		testutil.WithSessionBounds(15, 90, 45),
+	)
+	require.NoError(t, workItems.Create(ctx, item))
+
+	sessionSvc := NewSessionService(sessions, uow)
+	whatNow := NewWhatNowService(workItems, sessions, deps, profiles)
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errCh := make(chan error, 2*workers)
+	for i := 1; i <= workers; i++ {
+		wg.Add(2)
+		go func(minutes int) {
+			defer wg.Done()
+			errCh <- sessionSvc.LogSession(ctx, &domain.WorkSessionLog{
+				WorkItemID: item.ID,
+				StartedAt:  time.Now().UTC().Add(-time.Duration(minutes) * time.Minute),
+				Minutes:    minutes,
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := whatNow.Recommend(ctx, app.NewWhatNowRequest(60))
+			errCh <- err
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+
+	// Writers were serialized, so no logged minutes were lost either.
+	updated, err := workItems.GetByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, workers*(workers+1)/2, updated.LoggedMin)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type dayPlanService struct {
+	plans     repository.DayPlanRepo
+	sessions  repository.SessionRepo
+	workItems repository.WorkItemRepo
+	uow       db.UnitOfWork
+}
+
+func NewDayPlanService(plans repository.DayPlanRepo, sessions repository.SessionRepo, workItems repository.WorkItemRepo, uow db.UnitOfWork) DayPlanService {
+	return &dayPlanService{plans: plans, sessions: sessions, workItems: workItems, uow: uow}
+}
+
+func (s *dayPlanService) Save(ctx context.Context, now time.Time, availableMin int, slices []app.WorkSlice) (*domain.DayPlan, error) {
+	if len(slices) == 0 {
+		return nil, fmt.Errorf("nothing to save: what-now recommended no work")
+	}
+	plan := &domain.DayPlan{
+		Date:         planDay(now),
+		AvailableMin: availableMin,
+		CreatedAt:    now.UTC().Truncate(time.Second),
+	}
+	for _, sl := range slices {
+		plan.Items = append(plan.Items, domain.DayPlanItem{
+			WorkItemID:   sl.WorkItemID,
+			WorkItemSeq:  sl.WorkItemSeq,
+			ProjectID:    sl.ProjectID,
+			Title:        sl.Title,
+			AllocatedMin: sl.AllocatedMin,
+		})
+	}
+	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		return repository.NewSQLiteDayPlanRepo(tx).Replace(ctx, plan)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (s *dayPlanService) Get(ctx context.Context, day time.Time) (*domain.DayPlan, error) {
+	p, err := s.plans.GetByDate(ctx, planDay(day))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("no plan saved for %s (save one with: what-now --save-plan)", day.Format("2006-01-02"))
+	}
+	return p, err
+}
+
+func (s *dayPlanService) Adherence(ctx context.Context, day, now time.Time) (*app.DayPlanAdherence, error) {
+	plan, err := s.Get(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	sessions, err := s.sessions.ListRecent(ctx, int(now.Sub(start).Hours()/24)+2)
+	if err != nil {
+		return nil, err
+	}
+	logged := make(map[string]int)
+	for _, sess := range sessions {
+		if !sess.StartedAt.Before(start) && sess.StartedAt.Before(end) {
+			logged[sess.WorkItemID] += sess.Minutes
+		}
+	}
+
+	res := &app.DayPlanAdherence{Plan: plan, PlannedMin: plan.PlannedMin()}
+	planned := make(map[string]bool, len(plan.Items))
+	for _, it := range plan.Items {
+		p := app.DayPlanProgress{DayPlanItem: it, LoggedMin: logged[it.WorkItemID]}
+		planned[it.WorkItemID] = true
+		if w, err := s.workItems.GetByID(ctx, it.WorkItemID); err == nil {
+			p.Done = w.Status == domain.WorkItemDone
+		}
+		res.CoveredMin += min(p.LoggedMin, it.AllocatedMin)
+		res.Items = append(res.Items, p)
+	}
+	for id, m := range logged {
+		if !planned[id] {
+			res.UnplannedMin += m
+		}
+	}
+	return res, nil
+}
+
+// planDay is the calendar date of t in its own location, as stored in
+// day_plans.
+func planDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDayPlan_SaveAndAdherence(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	ctx := context.Background()
+	projects := repository.NewSQLiteProjectRepo(database)
+	nodes := repository.NewSQLitePlanNodeRepo(database)
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	sessions := repository.NewSQLiteSessionRepo(database)
+
+	proj := testutil.NewTestProject("Thesis")
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Chapter 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	read := testutil.NewTestWorkItem(node.ID, "Read", testutil.WithPlannedMin(120))
+	write := testutil.NewTestWorkItem(node.ID, "Write", testutil.WithPlannedMin(120))
+	other := testutil.NewTestWorkItem(node.ID, "Other", testutil.WithPlannedMin(120))
+	require.NoError(t, workItems.Create(ctx, read))
+	require.NoError(t, workItems.Create(ctx, write))
+	require.NoError(t, workItems.Create(ctx, other))
+
+	plans := NewDayPlanService(repository.NewSQLiteDayPlanRepo(database), sessions, workItems, testutil.NewTestUoW(database))
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	_, err := plans.Save(ctx, now, 60, nil)
+	require.Error(t, err, "an empty recommendation is not saved")
+
+	_, err = plans.Save(ctx, now, 60, []app.WorkSlice{
+		{WorkItemID: read.ID, ProjectID: proj.ID, Title: "Read", AllocatedMin: 30},
+		{WorkItemID: write.ID, ProjectID: proj.ID, Title: "Write", AllocatedMin: 30},
+	})
+	require.NoError(t, err)
+
+	logAt := func(w string, minutes int, at time.Time) {
+		require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(w, minutes, testutil.WithStartedAt(at))))
+	}
+	logAt(read.ID, 40, dayStart.Add(time.Hour))
+	logAt(read.ID, 15, dayStart.Add(-time.Hour)) // yesterday: not counted
+	logAt(write.ID, 10, dayStart.Add(2*time.Hour))
+	logAt(other.ID, 20, dayStart.Add(3*time.Hour))
+	write.Status = domain.WorkItemDone
+	require.NoError(t, workItems.Update(ctx, write))
+
+	res, err := plans.Adherence(ctx, now, now)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 2)
+	assert.Equal(t, 40, res.Items[0].LoggedMin)
+	assert.False(t, res.Items[0].Done)
+	assert.Equal(t, 10, res.Items[1].LoggedMin)
+	assert.True(t, res.Items[1].Done)
+	assert.Equal(t, 60, res.PlannedMin)
+	assert.Equal(t, 40, res.CoveredMin, "logged time counts up to each item's allocation")
+	assert.Equal(t, 20, res.UnplannedMin)
+	assert.InDelta(t, 66.7, res.AdherencePct(), 0.1)
+
+	_, err = plans.Get(ctx, now.AddDate(0, 0, -1))
+	assert.ErrorContains(t, err, "no plan saved")
+}
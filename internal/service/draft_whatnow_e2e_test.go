@@ -94,7 +94,7 @@ func TestDraftWizard_ImportSchema_ThenWhatNow_E2E(t *testing.T) {
 // that includes a special assessment node (mimicking the wizard's special
 // node phase) and verifies status reporting works correctly.
 func TestDraftWizard_WithSpecialNode_ThenStatus_E2E(t *testing.T) {
-	projects, _, workItems, _, sessions, profiles, uow := setupRepos(t)
+	projects, nodes, workItems, _, sessions, profiles, uow := setupRepos(t)
 	ctx := context.Background()
 
 	targetDate := "2026-12-01"
@@ -137,7 +137,7 @@ func TestDraftWizard_WithSpecialNode_ThenStatus_E2E(t *testing.T) {
 	require.NoError(t, err)
 
 	// Status should show the project.
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusResp, err := statusSvc.GetStatus(ctx, statusReq)
 	require.NoError(t, err)
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+const exportDateLayout = "2006-01-02"
+
+type exportService struct {
+	uow      db.UnitOfWork
+	observer UseCaseObserver
+}
+
+func NewExportService(
+	uow db.UnitOfWork,
+	observers ...UseCaseObserver,
+) ExportService {
+	return &exportService{
+		uow:      uow,
+		observer: useCaseObserverOrNoop(observers),
+	}
+}
+
+// Export builds an incremental export envelope containing every entity
+// created or updated after req.Since, plus tombstones for entities that were
+// deleted or archived after the cutoff. All reads happen in one transaction
+// so the envelope is a consistent snapshot.
+func (s *exportService) Export(ctx context.Context, req app.ExportRequest) (env *app.ExportEnvelope, err error) {
+	startedAt := time.Now().UTC()
+	fields := map[string]any{
+		"incremental": !req.Since.IsZero(),
+	}
+	defer func() {
+		if env != nil {
+			fields["project_count"] = len(env.Projects)
+			fields["node_count"] = len(env.Nodes)
+			fields["work_item_count"] = len(env.WorkItems)
+			fields["session_count"] = len(env.Sessions)
+			fields["tombstone_count"] = len(env.Tombstones)
+		}
+		s.observer.ObserveUseCase(ctx, UseCaseEvent{
+			Name:      "export",
+			StartedAt: startedAt,
+			Duration:  time.Since(startedAt),
+			Success:   err == nil,
+			Err:       err,
+			Fields:    fields,
+		})
+	}()
+
+	now := time.Now().UTC()
+	if req.Now != nil {
+		now = req.Now.UTC()
+	}
+	since := req.Since.UTC()
+
+	env = &app.ExportEnvelope{
+		FormatVersion: app.ExportFormatVersion,
+		GeneratedAt:   now.Format(time.RFC3339),
+		Projects:      []app.ExportProject{},
+		Nodes:         []app.ExportNode{},
+		WorkItems:     []app.ExportWorkItem{},
+		Sessions:      []app.ExportSession{},
+		Tombstones:    []app.ExportTombstone{},
+	}
+	if !req.Since.IsZero() {
+		env.Since = since.Format(time.RFC3339)
+	}
+
+	changed := func(created, updated time.Time) bool {
+		return req.Since.IsZero() || created.After(since) || updated.After(since)
+	}
+	archivedAfterCutoff := func(archivedAt *time.Time) bool {
+		return archivedAt != nil && (req.Since.IsZero() || archivedAt.After(since))
+	}
+
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txProjects := repository.NewSQLiteProjectRepo(tx)
+		txNodes := repository.NewSQLitePlanNodeRepo(tx)
+		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+		txSessions := repository.NewSQLiteSessionRepo(tx)
+		txTombstones := repository.NewSQLiteTombstoneRepo(tx)
+
+		projects, err := txProjects.List(ctx, true)
+		if err != nil {
+			return fmt.Errorf("listing projects: %w", err)
+		}
+		for _, p := range projects {
+			if changed(p.CreatedAt, p.UpdatedAt) {
+				env.Projects = append(env.Projects, exportProject(p))
+			}
+			if archivedAfterCutoff(p.ArchivedAt) {
+				env.Tombstones = append(env.Tombstones,
+					archivedTombstone(domain.TombstoneEntityProject, p.ID, *p.ArchivedAt))
+			}
+
+			nodes, err := txNodes.ListByProject(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("listing nodes for project %s: %w", p.ID, err)
+			}
+			for _, n := range nodes {
+				if changed(n.CreatedAt, n.UpdatedAt) {
+					env.Nodes = append(env.Nodes, exportNode(n))
+				}
+			}
+
+			items, err := txWorkItems.ListByProject(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("listing work items for project %s: %w", p.ID, err)
+			}
+			for _, w := range items {
+				if changed(w.CreatedAt, w.UpdatedAt) {
+					env.WorkItems = append(env.WorkItems, exportWorkItem(w))
+				}
+				if archivedAfterCutoff(w.ArchivedAt) {
+					env.Tombstones = append(env.Tombstones,
+						archivedTombstone(domain.TombstoneEntityWorkItem, w.ID, *w.ArchivedAt))
+				}
+
+				sessions, err := txSessions.ListByWorkItem(ctx, w.ID)
+				if err != nil {
+					return fmt.Errorf("listing sessions for work item %s: %w", w.ID, err)
+				}
+				for _, ws := range sessions {
+					if req.Since.IsZero() || ws.CreatedAt.After(since) {
+						env.Sessions = append(env.Sessions, exportSession(ws))
+					}
+				}
+			}
+		}
+
+		deleted, err := txTombstones.ListSince(ctx, since)
+		if err != nil {
+			return err
+		}
+		for _, t := range deleted {
+			env.Tombstones = append(env.Tombstones, app.ExportTombstone{
+				EntityType: t.EntityType,
+				EntityID:   t.EntityID,
+				Reason:     string(t.Reason),
+				At:         t.At.UTC().Format(time.RFC3339),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(env.Tombstones, func(i, j int) bool {
+		return env.Tombstones[i].At < env.Tombstones[j].At
+	})
+	return env, nil
+}
+
+func archivedTombstone(entityType, id string, at time.Time) app.ExportTombstone {
+	return app.ExportTombstone{
+		EntityType: entityType,
+		EntityID:   id,
+		Reason:     string(domain.TombstoneArchived),
+		At:         at.UTC().Format(time.RFC3339),
+	}
+}
+
+func exportTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+func exportDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(exportDateLayout)
+	return &s
+}
+
+// exportDeadline keeps a deadline's time of day, in the same form the CLI
+// and importer accept.
+func exportDeadline(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := domain.FormatDeadline(*t)
+	return &s
+}
+
+func exportProject(p *domain.Project) app.ExportProject {
+	return app.ExportProject{
+		ID:         p.ID,
+		ShortID:    p.ShortID,
+		Name:       p.Name,
+		Domain:     p.Domain,
+		StartDate:  p.StartDate.Format(exportDateLayout),
+		TargetDate: exportDeadline(p.TargetDate),
+		Status:     string(p.Status),
+		ArchivedAt: exportTimestamp(p.ArchivedAt),
+		CreatedAt:  p.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func exportNode(n *domain.PlanNode) app.ExportNode {
+	return app.ExportNode{
+		ID:               n.ID,
+		ProjectID:        n.ProjectID,
+		ParentID:         n.ParentID,
+		Seq:              n.Seq,
+		Title:            n.Title,
+		Kind:             string(n.Kind),
+		IsDefault:        n.IsDefault,
+		OrderIndex:       n.OrderIndex,
+		DueDate:          exportDeadline(n.DueDate),
+		NotBefore:        exportDate(n.NotBefore),
+		NotAfter:         exportDate(n.NotAfter),
+		PlannedMinBudget: n.PlannedMinBudget,
+		CreatedAt:        n.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:        n.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func exportWorkItem(w *domain.WorkItem) app.ExportWorkItem {
+	return app.ExportWorkItem{
+		ID:                 w.ID,
+		NodeID:             w.NodeID,
+		Seq:                w.Seq,
+		Title:              w.Title,
+		Description:        w.Description,
+		Type:               w.Type,
+		Status:             string(w.Status),
+		DurationMode:       string(w.DurationMode),
+		PlannedMin:         w.PlannedMin,
+		LoggedMin:          w.LoggedMin,
+		DurationSource:     string(w.DurationSource),
+		EstimateConfidence: w.EstimateConfidence,
+		MinSessionMin:      w.MinSessionMin,
+		MaxSessionMin:      w.MaxSessionMin,
+		DefaultSessionMin:  w.DefaultSessionMin,
+		Splittable:         w.Splittable,
+		UnitsKind:          w.UnitsKind,
+		UnitsTotal:         w.UnitsTotal,
+		UnitsDone:          w.UnitsDone,
+		DueDate:            exportDeadline(w.DueDate),
+		NotBefore:          exportDate(w.NotBefore),
+		ArchivedAt:         exportTimestamp(w.ArchivedAt),
+		CompletedAt:        exportTimestamp(w.CompletedAt),
+		CreatedAt:          w.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          w.UpdatedAt.UTC().Format(time.RFC3339),
+		Checklist:          exportChecklist(w.Checklist),
+	}
+}
+
+func exportChecklist(items []domain.ChecklistItem) []app.ExportChecklistItem {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]app.ExportChecklistItem, len(items))
+	for i, c := range items {
+		out[i] = app.ExportChecklistItem{Text: c.Text, Done: c.Done}
+	}
+	return out
+}
+
+func exportSession(s *domain.WorkSessionLog) app.ExportSession {
+	return app.ExportSession{
+		ID:             s.ID,
+		WorkItemID:     s.WorkItemID,
+		StartedAt:      s.StartedAt.UTC().Format(time.RFC3339),
+		Minutes:        s.Minutes,
+		UnitsDoneDelta: s.UnitsDoneDelta,
+		Note:           s.Note,
+		Tags:           s.Tags,
+		CreatedAt:      s.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
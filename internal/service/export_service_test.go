@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_FullWhenSinceZero(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Full")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Task")
+	wi.Checklist = []domain.ChecklistItem{{Text: "step", Done: true}}
+	require.NoError(t, wiRepo.Create(ctx, wi))
+	require.NoError(t, sessRepo.Create(ctx, testutil.NewTestSession(wi.ID, 30)))
+
+	env, err := NewExportService(uow).Export(ctx, app.ExportRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, app.ExportFormatVersion, env.FormatVersion)
+	assert.Empty(t, env.Since)
+	assert.Len(t, env.Projects, 1)
+	assert.Len(t, env.Nodes, 1)
+	assert.Len(t, env.WorkItems, 1)
+	assert.Equal(t, []app.ExportChecklistItem{{Text: "step", Done: true}}, env.WorkItems[0].Checklist)
+	assert.Len(t, env.Sessions, 1)
+	assert.Empty(t, env.Tombstones)
+}
+
+func TestExport_KeepsDeadlineTimeOfDay(t *testing.T) {
+	projRepo, nodes, wiRepo, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Timed")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	due, err := domain.ParseDeadline("2026-03-15 17:30")
+	require.NoError(t, err)
+	wi := testutil.NewTestWorkItem(node.ID, "Task", testutil.WithWorkItemDueDate(due))
+	require.NoError(t, wiRepo.Create(ctx, wi))
+
+	env, err := NewExportService(uow).Export(ctx, app.ExportRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, env.WorkItems, 1)
+	require.NotNil(t, env.WorkItems[0].DueDate)
+	assert.Equal(t, "2026-03-15 17:30", *env.WorkItems[0].DueDate)
+}
+
+func TestExport_SinceFiltersUnchangedEntities(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	old := time.Now().UTC().Add(-48 * time.Hour)
+	stale := testutil.NewTestProject("Stale")
+	stale.CreatedAt, stale.UpdatedAt = old, old
+	require.NoError(t, projRepo.Create(ctx, stale))
+	staleNode := testutil.NewTestNode(stale.ID, "Old Node")
+	staleNode.CreatedAt, staleNode.UpdatedAt = old, old
+	require.NoError(t, nodes.Create(ctx, staleNode))
+	staleItem := testutil.NewTestWorkItem(staleNode.ID, "Old Task")
+	staleItem.CreatedAt, staleItem.UpdatedAt = old, old
+	require.NoError(t, wiRepo.Create(ctx, staleItem))
+	oldSession := testutil.NewTestSession(staleItem.ID, 20)
+	oldSession.StartedAt, oldSession.CreatedAt = old, old
+	require.NoError(t, sessRepo.Create(ctx, oldSession))
+
+	// Fresh activity after the cutoff.
+	newSession := testutil.NewTestSession(staleItem.ID, 40)
+	require.NoError(t, sessRepo.Create(ctx, newSession))
+
+	since := time.Now().UTC().Add(-24 * time.Hour)
+	env, err := NewExportService(uow).Export(ctx, app.ExportRequest{Since: since})
+	require.NoError(t, err)
+
+	assert.Equal(t, since.Format(time.RFC3339), env.Since, "cutoff should be echoed back")
+	assert.Empty(t, env.Projects)
+	assert.Empty(t, env.Nodes)
+	assert.Empty(t, env.WorkItems)
+	require.Len(t, env.Sessions, 1)
+	assert.Equal(t, newSession.ID, env.Sessions[0].ID)
+}
+
+func TestExport_IncludesTombstonesForArchivedAndDeleted(t *testing.T) {
+	projRepo, nodes, wiRepo, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Churn")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	archived := testutil.NewTestWorkItem(node.ID, "Archived")
+	require.NoError(t, wiRepo.Create(ctx, archived))
+	removed := testutil.NewTestWorkItem(node.ID, "Removed")
+	require.NoError(t, wiRepo.Create(ctx, removed))
+
+	since := time.Now().UTC().Add(-time.Minute)
+	require.NoError(t, wiRepo.Archive(ctx, archived.ID))
+	require.NoError(t, wiRepo.Delete(ctx, removed.ID))
+
+	env, err := NewExportService(uow).Export(ctx, app.ExportRequest{Since: since})
+	require.NoError(t, err)
+
+	reasons := make(map[string]string)
+	for _, ts := range env.Tombstones {
+		assert.Equal(t, domain.TombstoneEntityWorkItem, ts.EntityType)
+		reasons[ts.EntityID] = ts.Reason
+	}
+	assert.Equal(t, string(domain.TombstoneArchived), reasons[archived.ID])
+	assert.Equal(t, string(domain.TombstoneDeleted), reasons[removed.ID])
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type focusService struct {
+	focus     repository.FocusRepo
+	workItems repository.WorkItemRepo
+}
+
+func NewFocusService(focus repository.FocusRepo, workItems repository.WorkItemRepo) FocusService {
+	return &focusService{focus: focus, workItems: workItems}
+}
+
+func (s *focusService) Add(ctx context.Context, workItemID string) error {
+	w, err := s.workItems.GetByID(ctx, workItemID)
+	if err != nil {
+		return err
+	}
+	if w.IsTerminal() {
+		return fmt.Errorf("cannot focus '%s': work item is %s", w.Title, w.Status)
+	}
+	return s.focus.Add(ctx, &domain.FocusItem{WorkItemID: w.ID, AddedAt: time.Now().UTC()})
+}
+
+func (s *focusService) Remove(ctx context.Context, workItemID string) error {
+	if err := s.focus.Remove(ctx, workItemID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("work item is not on the focus list")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *focusService) List(ctx context.Context) ([]*domain.WorkItem, error) {
+	if _, err := s.focus.PruneFinished(ctx); err != nil {
+		return nil, err
+	}
+	entries, err := s.focus.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*domain.WorkItem, 0, len(entries))
+	for _, e := range entries {
+		w, err := s.workItems.GetByID(ctx, e.WorkItemID)
+		if err != nil {
+			return nil, fmt.Errorf("loading focused item %s: %w", e.WorkItemID, err)
+		}
+		items = append(items, w)
+	}
+	return items, nil
+}
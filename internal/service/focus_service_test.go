@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/contract"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFocus_BoostsWhatNowAndPrunesFinishedItems(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	uow := testutil.NewTestUoW(database)
+	ctx := context.Background()
+	projects := repository.NewSQLiteProjectRepo(database)
+	nodes := repository.NewSQLitePlanNodeRepo(database)
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	profiles := repository.NewSQLiteUserProfileRepo(database)
+
+	now := time.Now().UTC()
+	proj := testutil.NewTestProject("Thesis", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Chapter 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	urgent := testutil.NewTestWorkItem(node.ID, "Urgent",
+		testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(15, 60, 30),
+		testutil.WithWorkItemDueDate(now.AddDate(0, 0, 5)),
+	)
+	chosen := testutil.NewTestWorkItem(node.ID, "Chosen",
+		testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, urgent))
+	require.NoError(t, workItems.Create(ctx, chosen))
+
+	focus := NewFocusService(repository.NewSQLiteFocusRepo(database), workItems)
+	whatNow := NewWhatNowService(workItems, repository.NewSQLiteSessionRepo(database),
+		repository.NewSQLiteDependencyRepo(database), profiles)
+	req := contract.NewWhatNowRequest(30)
+	req.Now = &now
+	req.MaxSlices = 1
+
+	resp, err := whatNow.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Recommendations, 1)
+	assert.Equal(t, urgent.ID, resp.Recommendations[0].WorkItemID)
+
+	require.NoError(t, focus.Add(ctx, chosen.ID))
+	resp, err = whatNow.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Recommendations, 1)
+	assert.Equal(t, chosen.ID, resp.Recommendations[0].WorkItemID)
+	var codes []contract.RecommendationReasonCode
+	for _, r := range resp.Recommendations[0].Reasons {
+		codes = append(codes, r.Code)
+	}
+	assert.Contains(t, codes, contract.ReasonFocusList)
+
+	// A zero focus weight turns the boost off.
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.WeightFocus = 0
+	require.NoError(t, profiles.Upsert(ctx, profile))
+	resp, err = whatNow.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, urgent.ID, resp.Recommendations[0].WorkItemID)
+
+	// Finished items drop off the list.
+	items, err := focus.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NoError(t, NewWorkItemService(workItems, nodes, uow).MarkDone(ctx, chosen.ID))
+	items, err = focus.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	err = focus.Add(ctx, chosen.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "work item is done")
+	err = focus.Remove(ctx, urgent.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not on the focus list")
+}
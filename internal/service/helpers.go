@@ -15,6 +15,7 @@ import (
 type projectMetrics struct {
 	PlannedMin          int
 	LoggedMin           int
+	RemainingMin        int
 	DoneCount           int
 	TotalCount          int
 	DonePlannedMin      int
@@ -36,6 +37,9 @@ func aggregateProjectMetrics(items []*domain.WorkItem, project *domain.Project,
 		if item.Status == domain.WorkItemDone || item.Status == domain.WorkItemSkipped {
 			m.DoneCount++
 			m.DonePlannedMin += item.PlannedMin
+			m.RemainingMin += item.PlannedMin - item.EffectiveLoggedMin()
+		} else {
+			m.RemainingMin += scheduler.RemainingMin(item.PlannedMin, item.LoggedMin, item.UnitsTotal, item.UnitsDone)
 		}
 	}
 
@@ -72,17 +76,19 @@ func aggregateProjectMetrics(items []*domain.WorkItem, project *domain.Project,
 }
 
 // buildRiskInput constructs a RiskInput from pre-computed metrics.
-func buildRiskInput(m projectMetrics, targetDate *time.Time, bufferPct float64, effectiveDailyMin float64, now time.Time) scheduler.RiskInput {
+func buildRiskInput(m projectMetrics, targetDate *time.Time, bufferPct float64, effectiveDailyMin float64, maxDailyMin int, now time.Time) scheduler.RiskInput {
 	return scheduler.RiskInput{
 		Now:                 now,
 		TargetDate:          targetDate,
 		PlannedMin:          m.PlannedMin,
 		LoggedMin:           m.LoggedMin,
+		RemainingWorkMin:    &m.RemainingMin,
 		BufferPct:           bufferPct,
 		RecentDailyMin:      effectiveDailyMin,
 		ProgressPct:         m.ProgressPct,
 		TimeElapsedPct:      m.TimeElapsedPct,
 		DueBasedExpectedPct: m.DueBasedExpectedPct,
+		MaxDailyMin:         maxDailyMin,
 	}
 }
 
@@ -129,7 +135,7 @@ func computeProjectRiskSnapshot(
 	}
 	recentDailyMin, effectiveDailyMin := recentDailyPace(recentSessions, days, profile.BaselineDailyMin)
 
-	risk := scheduler.ComputeRisk(buildRiskInput(m, p.TargetDate, profile.BufferPct, effectiveDailyMin, now))
+	risk := scheduler.ComputeRisk(buildRiskInput(m, p.TargetDate, profile.BufferPct, effectiveDailyMin, profile.MaxDailyCapacity(), now))
 
 	return &projectRiskSnapshot{
 		Metrics:           m,
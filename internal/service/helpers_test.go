@@ -96,3 +96,31 @@ func TestAggregateProjectMetrics_InProgressItemUsesActualLogged(t *testing.T) {
 
 	assert.Equal(t, 30, m.LoggedMin, "in-progress item should use actual logged minutes")
 }
+
+func TestAggregateProjectMetrics_RemainingUsesUnitPace(t *testing.T) {
+	now := time.Now().UTC()
+	proj := &domain.Project{ID: "proj-1", StartDate: now.AddDate(0, -1, 0)}
+
+	items := []*domain.WorkItem{
+		{
+			ID:         "wi-1",
+			Status:     domain.WorkItemInProgress,
+			PlannedMin: 100,
+			LoggedMin:  60,
+			UnitsTotal: 10,
+			UnitsDone:  3, // 20m/unit × 7 units left
+		},
+		{
+			ID:         "wi-2",
+			Status:     domain.WorkItemDone,
+			PlannedMin: 60,
+			LoggedMin:  30,
+			UnitsTotal: 10,
+			UnitsDone:  5, // done items have nothing left
+		},
+	}
+
+	m := aggregateProjectMetrics(items, proj, now)
+
+	assert.Equal(t, 140, m.RemainingMin)
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/alexanderramin/kairos/internal/repository"
+	tmpl "github.com/alexanderramin/kairos/internal/template"
+)
+
+func (s *importService) MergeProject(ctx context.Context, filePath string, opts MergeImportOptions) (*MergeImportResult, error) {
+	schema, err := importer.LoadImportSchema(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading import file: %w", err)
+	}
+	return s.mergeSchema(ctx, schema, opts, "file")
+}
+
+func (s *importService) MergeProjectFromSchema(ctx context.Context, schema *importer.ImportSchema, opts MergeImportOptions) (*MergeImportResult, error) {
+	return s.mergeSchema(ctx, schema, opts, "schema")
+}
+
+// mergeSchema reconciles an import schema with the project that has the same
+// short_id. Nodes and work items are matched by ref: matches are updated in
+// place, new refs are created, and (with Prune) work items whose ref vanished
+// are archived. Logged minutes, units done, status, and sessions of existing
+// items are never touched. If no project matches, the schema is imported as new.
+func (s *importService) mergeSchema(ctx context.Context, schema *importer.ImportSchema, opts MergeImportOptions, source string) (result *MergeImportResult, err error) {
+	startedAt := time.Now().UTC()
+	fields := map[string]any{
+		"source": source,
+		"prune":  opts.Prune,
+	}
+	defer func() {
+		if result != nil {
+			fields["created"] = result.Created
+			fields["nodes_added"] = result.NodesAdded
+			fields["nodes_updated"] = result.NodesUpdated
+			fields["work_items_added"] = result.WorkItemsAdded
+			fields["work_items_updated"] = result.WorkItemsUpdated
+			fields["work_items_archived"] = result.WorkItemsArchived
+		}
+		s.observer.ObserveUseCase(ctx, UseCaseEvent{
+			Name:      "merge-import-project",
+			StartedAt: startedAt,
+			Duration:  time.Since(startedAt),
+			Success:   err == nil,
+			Err:       err,
+			Fields:    fields,
+		})
+	}()
+
+	if errs := importer.ValidateImportSchema(schema); len(errs) > 0 {
+		return nil, formatValidationErrors(errs)
+	}
+
+	generated, err := importer.Convert(schema)
+	if err != nil {
+		return nil, fmt.Errorf("converting import schema: %w", err)
+	}
+
+	result = &MergeImportResult{}
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		existing, err := repository.NewSQLiteProjectRepo(tx).GetByShortID(ctx, generated.Project.ShortID)
+		if errors.Is(err, repository.ErrNotFound) {
+			if err := createGeneratedProject(ctx, tx, generated); err != nil {
+				return err
+			}
+			result.Project = generated.Project
+			result.Created = true
+			result.NodesAdded = len(generated.Nodes)
+			result.WorkItemsAdded = len(generated.WorkItems)
+			result.DependenciesAdded = len(generated.Dependencies)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("looking up project %s: %w", generated.Project.ShortID, err)
+		}
+		result.Project = existing
+		return mergeIntoProject(ctx, tx, existing, generated, opts, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func mergeIntoProject(
+	ctx context.Context,
+	tx db.DBTX,
+	project *domain.Project,
+	generated *tmpl.GeneratedProject,
+	opts MergeImportOptions,
+	result *MergeImportResult,
+) error {
+	txProjects := repository.NewSQLiteProjectRepo(tx)
+	txNodes := repository.NewSQLitePlanNodeRepo(tx)
+	txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+	txDeps := repository.NewSQLiteDependencyRepo(tx)
+	txSeq := repository.NewSQLiteProjectSequenceRepo(tx)
+	now := time.Now().UTC()
+
+	project.Name = generated.Project.Name
+	project.Domain = generated.Project.Domain
+	project.StartDate = generated.Project.StartDate
+	project.TargetDate = generated.Project.TargetDate
+	project.UpdatedAt = now
+	if err := txProjects.Update(ctx, project); err != nil {
+		return fmt.Errorf("updating project: %w", err)
+	}
+
+	// idMap translates the UUIDs minted by Convert into the IDs that end up
+	// persisted (existing IDs for matched refs, the fresh ones otherwise).
+	idMap := make(map[string]string, len(generated.Nodes)+len(generated.WorkItems))
+	mapID := func(id string) string {
+		if mapped, ok := idMap[id]; ok {
+			return mapped
+		}
+		return id
+	}
+
+	existingNodes, err := txNodes.ListByProject(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("listing nodes: %w", err)
+	}
+	nodesByRef := make(map[string]*domain.PlanNode, len(existingNodes))
+	for _, n := range existingNodes {
+		if n.Ref != "" {
+			nodesByRef[n.Ref] = n
+		}
+	}
+	// Projects imported before refs were recorded have nothing to match on;
+	// merging would duplicate every node and work item.
+	if len(existingNodes) > 0 && len(nodesByRef) == 0 {
+		return domain.Errorf(domain.CodeInvalidState,
+			"project %s has no import refs to merge on; re-import it as a new project", project.ShortID)
+	}
+
+	for _, gn := range generated.Nodes {
+		var parentID *string
+		if gn.ParentID != nil {
+			pid := mapID(*gn.ParentID)
+			parentID = &pid
+		}
+
+		if cur, ok := nodesByRef[gn.Ref]; ok {
+			idMap[gn.ID] = cur.ID
+			cur.ParentID = parentID
+			cur.Title = gn.Title
+			cur.Kind = gn.Kind
+			cur.OrderIndex = gn.OrderIndex
+			cur.DueDate = gn.DueDate
+			cur.NotBefore = gn.NotBefore
+			cur.NotAfter = gn.NotAfter
+			cur.PlannedMinBudget = gn.PlannedMinBudget
+			cur.UpdatedAt = now
+			if err := txNodes.Update(ctx, cur); err != nil {
+				return fmt.Errorf("updating node %q: %w", cur.Title, err)
+			}
+			result.NodesUpdated++
+			continue
+		}
+
+		seq, err := txSeq.NextProjectSeq(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("allocating seq for node %q: %w", gn.Title, err)
+		}
+		gn.ProjectID = project.ID
+		gn.ParentID = parentID
+		gn.Seq = seq
+		if err := txNodes.Create(ctx, gn); err != nil {
+			return fmt.Errorf("creating node %q: %w", gn.Title, err)
+		}
+		result.NodesAdded++
+	}
+
+	existingItems, err := txWorkItems.ListByProject(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("listing work items: %w", err)
+	}
+	itemsByRef := make(map[string]*domain.WorkItem, len(existingItems))
+	for _, w := range existingItems {
+		if w.Ref != "" {
+			itemsByRef[w.Ref] = w
+		}
+	}
+
+	seenRefs := make(map[string]bool, len(generated.WorkItems))
+	for _, gw := range generated.WorkItems {
+		seenRefs[gw.Ref] = true
+		nodeID := mapID(gw.NodeID)
+
+		if cur, ok := itemsByRef[gw.Ref]; ok {
+			idMap[gw.ID] = cur.ID
+			cur.NodeID = nodeID
+			cur.Title = gw.Title
+			cur.Description = gw.Description
+			cur.Type = gw.Type
+			cur.DurationMode = gw.DurationMode
+			cur.PlannedMin = gw.PlannedMin
+			cur.EstimateConfidence = gw.EstimateConfidence
+			cur.MinSessionMin = gw.MinSessionMin
+			cur.MaxSessionMin = gw.MaxSessionMin
+			cur.DefaultSessionMin = gw.DefaultSessionMin
+			cur.Splittable = gw.Splittable
+			cur.UnitsKind = gw.UnitsKind
+			cur.UnitsTotal = gw.UnitsTotal
+			cur.DueDate = gw.DueDate
+			cur.NotBefore = gw.NotBefore
+			cur.UpdatedAt = now
+			if err := txWorkItems.Update(ctx, cur); err != nil {
+				return fmt.Errorf("updating work item %q: %w", cur.Title, err)
+			}
+			result.WorkItemsUpdated++
+			continue
+		}
+
+		seq, err := txSeq.NextProjectSeq(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("allocating seq for work item %q: %w", gw.Title, err)
+		}
+		gw.NodeID = nodeID
+		gw.Seq = seq
+		if err := txWorkItems.Create(ctx, gw); err != nil {
+			return fmt.Errorf("creating work item %q: %w", gw.Title, err)
+		}
+		result.WorkItemsAdded++
+	}
+
+	for _, dep := range generated.Dependencies {
+		d := domain.Dependency{
+			PredecessorWorkItemID: mapID(dep.PredecessorWorkItemID),
+			SuccessorWorkItemID:   mapID(dep.SuccessorWorkItemID),
+		}
+		preds, err := txDeps.ListPredecessors(ctx, d.SuccessorWorkItemID)
+		if err != nil {
+			return fmt.Errorf("listing predecessors: %w", err)
+		}
+		if hasPredecessor(preds, d.PredecessorWorkItemID) {
+			continue
+		}
+		if err := txDeps.Create(ctx, &d); err != nil {
+			return fmt.Errorf("creating dependency: %w", err)
+		}
+		result.DependenciesAdded++
+	}
+
+	if !opts.Prune {
+		return nil
+	}
+	for _, w := range existingItems {
+		if w.Ref == "" || seenRefs[w.Ref] || w.Status == domain.WorkItemArchived {
+			continue
+		}
+		if err := txWorkItems.Archive(ctx, w.ID); err != nil {
+			return fmt.Errorf("archiving work item %q: %w", w.Title, err)
+		}
+		result.WorkItemsArchived++
+	}
+	return nil
+}
+
+func hasPredecessor(deps []domain.Dependency, predecessorID string) bool {
+	for _, d := range deps {
+		if d.PredecessorWorkItemID == predecessorID {
+			return true
+		}
+	}
+	return false
+}
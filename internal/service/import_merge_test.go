@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mergeBaseSchema() *importer.ImportSchema {
+	return &importer.ImportSchema{
+		Project: importer.ProjectImport{
+			ShortID:   "SYNC01",
+			Name:      "Synced Plan",
+			Domain:    "education",
+			StartDate: "2026-01-05",
+		},
+		Nodes: []importer.NodeImport{
+			{Ref: "wk1", Title: "Week 1", Kind: "week", Order: 0},
+			{Ref: "wk2", Title: "Week 2", Kind: "week", Order: 1},
+		},
+		WorkItems: []importer.WorkItemImport{
+			{Ref: "read1", NodeRef: "wk1", Title: "Read ch1", Type: "reading", PlannedMin: ptrInt(60)},
+			{Ref: "read2", NodeRef: "wk2", Title: "Read ch2", Type: "reading", PlannedMin: ptrInt(60)},
+		},
+	}
+}
+
+func TestMergeImport_CreatesWhenNoShortIDMatch(t *testing.T) {
+	_, _, workItems, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	result, err := svc.MergeProjectFromSchema(ctx, mergeBaseSchema(), MergeImportOptions{})
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	assert.Equal(t, 2, result.WorkItemsAdded)
+
+	items, err := workItems.ListByProject(ctx, result.Project.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.NotEmpty(t, items[0].Ref, "imported items should remember their ref")
+}
+
+func TestMergeImport_UpdatesByRefAndPreservesProgress(t *testing.T) {
+	projects, nodes, workItems, _, sessions, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	first, err := svc.ImportProjectFromSchema(ctx, mergeBaseSchema())
+	require.NoError(t, err)
+
+	items, err := workItems.ListByProject(ctx, first.Project.ID)
+	require.NoError(t, err)
+	var read1 *domain.WorkItem
+	for _, w := range items {
+		if w.Ref == "read1" {
+			read1 = w
+		}
+	}
+	require.NotNil(t, read1)
+
+	sessionSvc := NewSessionService(sessions, uow)
+	require.NoError(t, sessionSvc.LogSession(ctx, testutil.NewTestSession(read1.ID, 25)))
+
+	updated := mergeBaseSchema()
+	updated.Project.Name = "Synced Plan v2"
+	due := "2026-02-01"
+	updated.Nodes[0].Title = "Week 1 (revised)"
+	updated.Nodes[0].DueDate = &due
+	updated.WorkItems[0].Title = "Read chapter 1"
+	updated.WorkItems[0].PlannedMin = ptrInt(90)
+	updated.WorkItems[0].Description = "Sections 1.1-1.4 only"
+	updated.Nodes = append(updated.Nodes, importer.NodeImport{Ref: "wk3", Title: "Week 3", Kind: "week", Order: 2})
+	updated.WorkItems = append(updated.WorkItems,
+		importer.WorkItemImport{Ref: "read3", NodeRef: "wk3", Title: "Read ch3", Type: "reading", PlannedMin: ptrInt(45)})
+
+	result, err := svc.MergeProjectFromSchema(ctx, updated, MergeImportOptions{})
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.Equal(t, first.Project.ID, result.Project.ID)
+	assert.Equal(t, 2, result.NodesUpdated)
+	assert.Equal(t, 1, result.NodesAdded)
+	assert.Equal(t, 2, result.WorkItemsUpdated)
+	assert.Equal(t, 1, result.WorkItemsAdded)
+
+	proj, err := projects.GetByID(ctx, first.Project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Synced Plan v2", proj.Name)
+
+	wi, err := workItems.GetByID(ctx, read1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Read chapter 1", wi.Title)
+	assert.Equal(t, 90, wi.PlannedMin)
+	assert.Equal(t, "Sections 1.1-1.4 only", wi.Description)
+	assert.Equal(t, 25, wi.LoggedMin, "logged minutes must survive a merge")
+	assert.Equal(t, domain.WorkItemInProgress, wi.Status)
+
+	logged, err := sessions.ListByWorkItem(ctx, read1.ID)
+	require.NoError(t, err)
+	assert.Len(t, logged, 1, "session history must survive a merge")
+
+	node, err := nodes.GetByID(ctx, wi.NodeID)
+	require.NoError(t, err)
+	assert.Equal(t, "Week 1 (revised)", node.Title)
+	require.NotNil(t, node.DueDate)
+
+	all, err := workItems.ListByProject(ctx, first.Project.ID)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+	seqs := make(map[int]bool)
+	for _, w := range all {
+		assert.False(t, seqs[w.Seq], "seq %d should be unique", w.Seq)
+		seqs[w.Seq] = true
+	}
+}
+
+func TestMergeImport_PruneArchivesMissingRefs(t *testing.T) {
+	_, _, workItems, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	first, err := svc.ImportProjectFromSchema(ctx, mergeBaseSchema())
+	require.NoError(t, err)
+
+	trimmed := mergeBaseSchema()
+	trimmed.WorkItems = trimmed.WorkItems[:1]
+
+	// Without --prune the missing item is left alone.
+	result, err := svc.MergeProjectFromSchema(ctx, trimmed, MergeImportOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.WorkItemsArchived)
+
+	result, err = svc.MergeProjectFromSchema(ctx, trimmed, MergeImportOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.WorkItemsArchived)
+
+	items, err := workItems.ListByProject(ctx, first.Project.ID)
+	require.NoError(t, err)
+	for _, w := range items {
+		if w.Ref == "read2" {
+			assert.Equal(t, domain.WorkItemArchived, w.Status)
+		} else {
+			assert.NotEqual(t, domain.WorkItemArchived, w.Status)
+		}
+	}
+}
+
+func TestMergeImport_RefusesProjectWithoutRefs(t *testing.T) {
+	projects, nodes, workItems, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	// A project imported before refs were recorded.
+	proj := testutil.NewTestProject("Synced Plan", testutil.WithShortID("SYNC01"))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Read ch1")))
+
+	_, err := svc.MergeProjectFromSchema(ctx, mergeBaseSchema(), MergeImportOptions{})
+	require.Error(t, err)
+	assert.Equal(t, domain.CodeInvalidState, domain.CodeOf(err))
+	assert.Contains(t, err.Error(), "no import refs")
+
+	all, err := workItems.ListByProject(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Len(t, all, 1, "a refused merge must not add items")
+}
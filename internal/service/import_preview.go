@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+// PreviewImport runs the same checks as ImportProject — schema validation
+// (node_refs, dependency refs, cycles, dates) plus a short_id collision check
+// against the database — and reports what the import would create. Nothing
+// is written. Only a file that cannot be read or parsed returns an error.
+func (s *importService) PreviewImport(ctx context.Context, filePath string) (*ImportPreview, error) {
+	schema, err := importer.LoadImportSchema(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading import file: %w", err)
+	}
+
+	preview := &ImportPreview{
+		ShortID: strings.ToUpper(schema.Project.ShortID),
+		Name:    schema.Project.Name,
+	}
+	for _, e := range importer.ValidateImportSchema(schema) {
+		preview.Problems = append(preview.Problems, e.Error())
+	}
+
+	if preview.ShortID != "" {
+		err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+			_, err := repository.NewSQLiteProjectRepo(tx).GetByShortID(ctx, preview.ShortID)
+			return err
+		})
+		switch {
+		case err == nil:
+			preview.Problems = append(preview.Problems,
+				fmt.Sprintf("project %s already exists (use --merge to update it in place)", preview.ShortID))
+		case !errors.Is(err, repository.ErrNotFound):
+			return nil, fmt.Errorf("checking short_id: %w", err)
+		}
+	}
+	if len(preview.Problems) > 0 {
+		return preview, nil
+	}
+
+	generated, err := importer.Convert(schema)
+	if err != nil {
+		preview.Problems = append(preview.Problems, err.Error())
+		return preview, nil
+	}
+	preview.NodeCount = len(generated.Nodes)
+	preview.WorkItemCount = len(generated.WorkItems)
+	preview.DependencyCount = len(generated.Dependencies)
+	return preview, nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/importer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewImport_CleanFileCountsWithoutWriting(t *testing.T) {
+	projects, _, _, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	schema := mergeBaseSchema()
+	schema.Dependencies = []importer.DependencyImport{{PredecessorRef: "read1", SuccessorRef: "read2"}}
+	preview, err := svc.PreviewImport(ctx, writeImportJSON(t, schema))
+	require.NoError(t, err)
+	assert.Empty(t, preview.Problems)
+	assert.Equal(t, "SYNC01", preview.ShortID)
+	assert.Equal(t, 2, preview.NodeCount)
+	assert.Equal(t, 2, preview.WorkItemCount)
+	assert.Equal(t, 1, preview.DependencyCount)
+
+	all, err := projects.List(ctx, true)
+	require.NoError(t, err)
+	assert.Empty(t, all, "a dry run must not create anything")
+}
+
+func TestPreviewImport_ReportsEveryProblem(t *testing.T) {
+	_, _, _, _, _, _, uow := setupRepos(t)
+	ctx := context.Background()
+	svc := NewImportService(uow)
+
+	_, err := svc.ImportProjectFromSchema(ctx, mergeBaseSchema())
+	require.NoError(t, err)
+
+	schema := mergeBaseSchema()
+	schema.Project.ShortID = "sync01"
+	schema.WorkItems[1].NodeRef = "wk9"
+	schema.Dependencies = []importer.DependencyImport{{PredecessorRef: "read1", SuccessorRef: "missing"}}
+	preview, err := svc.PreviewImport(ctx, writeImportJSON(t, schema))
+	require.NoError(t, err)
+	require.Len(t, preview.Problems, 3)
+	assert.Contains(t, preview.Problems[0], "wk9")
+	assert.Contains(t, preview.Problems[1], "missing")
+	assert.Contains(t, preview.Problems[2], "SYNC01 already exists")
+	assert.Zero(t, preview.WorkItemCount, "counts are left empty when there are problems")
+}
+
+func TestPreviewImport_UnreadableFile(t *testing.T) {
+	_, _, _, _, _, _, uow := setupRepos(t)
+	_, err := NewImportService(uow).PreviewImport(context.Background(), "does-not-exist.json")
+	assert.ErrorContains(t, err, "loading import file")
+}
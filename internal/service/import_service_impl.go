@@ -8,6 +8,7 @@ import (
 	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/importer"
 	"github.com/alexanderramin/kairos/internal/repository"
+	tmpl "github.com/alexanderramin/kairos/internal/template"
 )
 
 type importService struct {
@@ -68,34 +69,7 @@ func (s *importService) importSchema(ctx context.Context, schema *importer.Impor
 	}
 
 	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
-		txProjects := repository.NewSQLiteProjectRepo(tx)
-		txNodes := repository.NewSQLitePlanNodeRepo(tx)
-		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
-		txDeps := repository.NewSQLiteDependencyRepo(tx)
-
-		if err := txProjects.Create(ctx, generated.Project); err != nil {
-			return fmt.Errorf("creating project: %w", err)
-		}
-
-		for _, node := range generated.Nodes {
-			if err := txNodes.Create(ctx, node); err != nil {
-				return fmt.Errorf("creating node %q: %w", node.Title, err)
-			}
-		}
-
-		for _, wi := range generated.WorkItems {
-			if err := txWorkItems.Create(ctx, wi); err != nil {
-				return fmt.Errorf("creating work item %q: %w", wi.Title, err)
-			}
-		}
-
-		for _, dep := range generated.Dependencies {
-			if err := txDeps.Create(ctx, &dep); err != nil {
-				return fmt.Errorf("creating dependency: %w", err)
-			}
-		}
-
-		return nil
+		return createGeneratedProject(ctx, tx, generated)
 	})
 	if err != nil {
 		return nil, err
@@ -110,6 +84,39 @@ func (s *importService) importSchema(ctx context.Context, schema *importer.Impor
 	return result, nil
 }
 
+// createGeneratedProject persists a freshly converted project with all of its
+// nodes, work items, and dependencies using tx-scoped repositories.
+func createGeneratedProject(ctx context.Context, tx db.DBTX, generated *tmpl.GeneratedProject) error {
+	txProjects := repository.NewSQLiteProjectRepo(tx)
+	txNodes := repository.NewSQLitePlanNodeRepo(tx)
+	txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+	txDeps := repository.NewSQLiteDependencyRepo(tx)
+
+	if err := txProjects.Create(ctx, generated.Project); err != nil {
+		return fmt.Errorf("creating project: %w", err)
+	}
+
+	for _, node := range generated.Nodes {
+		if err := txNodes.Create(ctx, node); err != nil {
+			return fmt.Errorf("creating node %q: %w", node.Title, err)
+		}
+	}
+
+	for _, wi := range generated.WorkItems {
+		if err := txWorkItems.Create(ctx, wi); err != nil {
+			return fmt.Errorf("creating work item %q: %w", wi.Title, err)
+		}
+	}
+
+	for _, dep := range generated.Dependencies {
+		if err := txDeps.Create(ctx, &dep); err != nil {
+			return fmt.Errorf("creating dependency: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func formatValidationErrors(errs []error) error {
 	msg := fmt.Sprintf("import validation failed (%d errors):", len(errs))
 	for _, e := range errs {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/alexanderramin/kairos/internal/app"
 	"github.com/alexanderramin/kairos/internal/domain"
@@ -15,17 +16,29 @@ type ProjectService interface {
 	Update(ctx context.Context, p *domain.Project) error
 	Archive(ctx context.Context, id string) error
 	Unarchive(ctx context.Context, id string) error
+	// ShiftDates moves the project's start and target dates, and every node
+	// and work item date, by days calendar days in one transaction. Logged
+	// sessions are left alone. Returns how many nodes and items moved.
+	ShiftDates(ctx context.Context, id string, days int) (nodes, items int, err error)
 	Delete(ctx context.Context, id string, force bool) error
 }
 
 type NodeService interface {
 	Create(ctx context.Context, n *domain.PlanNode) error
+	// CreateSiblings creates nodes that share a project and parent in one
+	// transaction, in the given order, numbering their OrderIndex straight
+	// after the parent's existing children (or the project's root nodes).
+	CreateSiblings(ctx context.Context, nodes []*domain.PlanNode) error
 	GetByID(ctx context.Context, id string) (*domain.PlanNode, error)
 	GetBySeq(ctx context.Context, projectID string, seq int) (*domain.PlanNode, error)
 	ListByProject(ctx context.Context, projectID string) ([]*domain.PlanNode, error)
 	ListChildren(ctx context.Context, parentID string) ([]*domain.PlanNode, error)
 	ListRoots(ctx context.Context, projectID string) ([]*domain.PlanNode, error)
 	Update(ctx context.Context, n *domain.PlanNode) error
+	// UpdatePropagatingDue saves n and, in the same transaction, copies its
+	// due date onto open work items in n and its descendant nodes that have no
+	// due date of their own. Returns how many work items changed.
+	UpdatePropagatingDue(ctx context.Context, n *domain.PlanNode) (int, error)
 	Delete(ctx context.Context, id string) error
 }
 
@@ -38,19 +51,69 @@ type WorkItemService interface {
 	Update(ctx context.Context, w *domain.WorkItem) error
 	MarkDone(ctx context.Context, id string) error
 	MarkInProgress(ctx context.Context, id string) error
+	// Clone creates a copy of item id (see domain.WorkItem.Clone) in nodeID,
+	// or in the item's own node when nodeID is empty. An empty title keeps
+	// the original's in another node and takes domain.NextCloneTitle in the
+	// same node.
+	Clone(ctx context.Context, id, nodeID, title string) (*domain.WorkItem, error)
+	// BumpEstimate adjusts planned minutes by deltaMin (clamped to the logged
+	// minutes) and returns the updated item with its previous estimate.
+	BumpEstimate(ctx context.Context, id string, deltaMin int) (*domain.WorkItem, int, error)
+	// Move shifts item id offset places among its node's items (negative
+	// is up) and renumbers their OrderIndex. It reports false and
+	// changes nothing when the target is outside the node.
+	Move(ctx context.Context, id string, offset int) (bool, error)
 	Archive(ctx context.Context, id string) error
+	// ArchiveDone archives all done items in a project (every project when
+	// projectID is empty) in one transaction, returning the count.
+	ArchiveDone(ctx context.Context, projectID string) (int, error)
 	Delete(ctx context.Context, id string) error
 }
 
 type SessionService interface {
+	// LogSession stores s and re-estimates its work item. With the profile's
+	// ValidateSessionTime on, s must fit between its StartedAt and now (see
+	// domain.WorkSessionLog.CheckElapsed); so must the sessions of
+	// LogSessionAndFinish, LogPomodoros and LogSplit.
 	LogSession(ctx context.Context, s *domain.WorkSessionLog) error
+	// LogSessionAndFinish logs s and marks its work item done in the same
+	// transaction; the finished item is not re-estimated.
+	LogSessionAndFinish(ctx context.Context, s *domain.WorkSessionLog) error
+	// LogPomodoros logs count focus blocks (profile FocusBlockMin each, spaced
+	// by BreakMin) in one transaction. template supplies the work item, note
+	// and units; a zero StartedAt means the last block ends now.
+	LogPomodoros(ctx context.Context, template *domain.WorkSessionLog, count int) ([]*domain.WorkSessionLog, error)
+	// LogSplit logs one sitting divided over several work items: one
+	// session per item, all in one transaction and all with the same
+	// StartedAt (the first session's; zero means now). Each item is
+	// re-estimated as for LogSession.
+	LogSplit(ctx context.Context, sessions []*domain.WorkSessionLog) error
 	GetByID(ctx context.Context, id string) (*domain.WorkSessionLog, error)
 	ListByWorkItem(ctx context.Context, workItemID string) ([]*domain.WorkSessionLog, error)
 	ListRecent(ctx context.Context, days int) ([]*domain.WorkSessionLog, error)
+	// ListRecentByProject returns the sessions of one project's work items
+	// started in the last days, newest first, in a single query.
+	ListRecentByProject(ctx context.Context, projectID string, days int) ([]*domain.WorkSessionLog, error)
 	ListRecentSummaryByType(ctx context.Context, days int) ([]domain.SessionSummaryByType, error)
+	// SummaryByTag sums minutes per session tag for sessions started in
+	// [from, to). Sessions with several tags count under each one; untagged
+	// sessions are grouped under "".
+	SummaryByTag(ctx context.Context, from, to time.Time) ([]domain.SessionTagSummary, error)
+	// UndoLast deletes the most recently logged session (within projectID
+	// when set) and takes its minutes and units back off the work item, in
+	// one transaction. It refuses when the session was logged more than
+	// maxAge ago; maxAge <= 0 removes any age. Returns the removed session
+	// and the updated item.
+	UndoLast(ctx context.Context, projectID string, maxAge time.Duration) (*domain.WorkSessionLog, *domain.WorkItem, error)
 	Delete(ctx context.Context, id string) error
 }
 
+// AuditService reads the mutation log written by NewAuditUseCaseObserver.
+type AuditService interface {
+	// History returns an entity's recorded mutations, oldest first.
+	History(ctx context.Context, entityID string) ([]*domain.AuditEvent, error)
+}
+
 type WhatNowService interface {
 	Recommend(ctx context.Context, req app.WhatNowRequest) (*app.WhatNowResponse, error)
 }
@@ -67,11 +130,100 @@ type TemplateService interface {
 	List(ctx context.Context) ([]domain.Template, error)
 	Get(ctx context.Context, name string) (*domain.Template, error)
 	InitProject(ctx context.Context, templateName string, projectName string, shortID string, startDate string, dueDate *string, vars map[string]string) (*domain.Project, error)
+	// Validate checks a template file (or installed template ref) without
+	// touching the database.
+	Validate(ctx context.Context, path string) (*TemplateValidation, error)
 }
 
+type TemplateValidation = app.TemplateValidation
+
 type ImportResult = app.ImportResult
 
+type MergeImportOptions = app.MergeImportOptions
+
+type MergeImportResult = app.MergeImportResult
+
+type ImportPreview = app.ImportPreview
+
 type ImportService interface {
 	ImportProject(ctx context.Context, filePath string) (*ImportResult, error)
+	PreviewImport(ctx context.Context, filePath string) (*ImportPreview, error)
 	ImportProjectFromSchema(ctx context.Context, schema *importer.ImportSchema) (*ImportResult, error)
+	MergeProject(ctx context.Context, filePath string, opts MergeImportOptions) (*MergeImportResult, error)
+	MergeProjectFromSchema(ctx context.Context, schema *importer.ImportSchema, opts MergeImportOptions) (*MergeImportResult, error)
+}
+
+type StatsService interface {
+	EstimateAccuracy(ctx context.Context) (*app.EstimateAccuracyResponse, error)
+	// SuggestEstimate proposes planned minutes for a new item from the
+	// logged time of completed items of the same type.
+	SuggestEstimate(ctx context.Context, req app.EstimateSuggestionRequest) (*app.EstimateSuggestion, error)
+}
+
+// FocusService manages the user's pinned focus list. Focused items get a
+// what-now ranking boost; finished or archived items are pruned on List.
+type FocusService interface {
+	Add(ctx context.Context, workItemID string) error
+	Remove(ctx context.Context, workItemID string) error
+	List(ctx context.Context) ([]*domain.WorkItem, error)
+}
+
+// ProfileService reads and updates the single user profile (pace baseline,
+// pomodoro lengths, auto-replan).
+type ProfileService interface {
+	Get(ctx context.Context) (*domain.UserProfile, error)
+	Update(ctx context.Context, p *domain.UserProfile) error
+}
+
+// WorkPresetService manages named work item presets used by
+// `work add --preset` and the draft wizard.
+type WorkPresetService interface {
+	Save(ctx context.Context, p *domain.WorkPreset) error
+	Get(ctx context.Context, name string) (*domain.WorkPreset, error)
+	List(ctx context.Context) ([]*domain.WorkPreset, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// ArchiveService lists archived projects and work items and permanently
+// removes those past a retention window.
+type ArchiveService interface {
+	List(ctx context.Context) ([]domain.ArchivedEntity, error)
+	// Purge deletes every project and work item archived before cutoff, with
+	// their nodes and sessions, in one transaction, and returns them. With
+	// dryRun it only returns what would be deleted.
+	Purge(ctx context.Context, cutoff time.Time, dryRun bool) ([]domain.ArchivedEntity, error)
+}
+
+// DayPlanService saves what-now agendas as the plan for a day and compares
+// them with the sessions logged that day.
+type DayPlanService interface {
+	// Save stores slices, in order, as the plan for now's calendar day,
+	// replacing any plan saved earlier that day.
+	Save(ctx context.Context, now time.Time, availableMin int, slices []app.WorkSlice) (*domain.DayPlan, error)
+	// Get returns the plan saved for day's calendar date.
+	Get(ctx context.Context, day time.Time) (*domain.DayPlan, error)
+	// Adherence compares the plan for day with the minutes logged on each
+	// planned item during that day (in day's location).
+	Adherence(ctx context.Context, day, now time.Time) (*app.DayPlanAdherence, error)
+}
+
+// WeeklyPlanService spreads remaining work over the next seven days.
+type WeeklyPlanService interface {
+	// Plan simulates a what-now allocation for each day from now's calendar
+	// day on, giving each day the profile's availability for its weekday.
+	// Each day's slices count as logged for the days after it, so remaining
+	// work, deadline pressure and spacing carry forward. Nothing is saved.
+	Plan(ctx context.Context, now time.Time) (*app.WeeklyPlan, error)
+}
+
+// WeeklyReviewService looks back over the past week and ahead to the next.
+type WeeklyReviewService interface {
+	// Review gathers the time logged and items finished in the seven days
+	// before req.Now, the projects whose risk rose since then, and the top
+	// items of the weekly plan from req.Now.
+	Review(ctx context.Context, req app.WeeklyReviewRequest) (*app.WeeklyReview, error)
+}
+
+type ExportService interface {
+	Export(ctx context.Context, req app.ExportRequest) (*app.ExportEnvelope, error)
 }
@@ -19,7 +19,7 @@ import (
 // correct recommendations: critical-first ordering, session bounds, variation, and
 // allocation invariants.
 func TestE2E_MultiProjectWhatNow_FullPipeline(t *testing.T) {
-	projects, _, workItems, deps, sessions, profiles, uow := setupRepos(t)
+	projects, nodes, workItems, deps, sessions, profiles, uow := setupRepos(t)
 	ctx := context.Background()
 
 	now := time.Now().UTC()
@@ -154,7 +154,7 @@ func TestE2E_MultiProjectWhatNow_FullPipeline(t *testing.T) {
 		"should recommend items from projects B and/or C after critical mode ends")
 
 	// === Phase 3: Status verification ===
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusReq.Now = &now
 	statusResp, err := statusSvc.GetStatus(ctx, statusReq)
@@ -190,7 +190,7 @@ func TestE2E_StatusMixedRiskLevels(t *testing.T) {
 	projOnTrack := testutil_newProjectWithWork(t, projects, nodes, workItems,
 		"Relaxed Project", now.AddDate(0, 3, 0), 60)
 
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 
@@ -47,6 +47,60 @@ func (s *nodeService) Create(ctx context.Context, n *domain.PlanNode) error {
 	})
 }
 
+func (s *nodeService) CreateSiblings(ctx context.Context, nodes []*domain.PlanNode) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	first := nodes[0]
+	for _, n := range nodes[1:] {
+		if n.ProjectID != first.ProjectID || (n.ParentID == nil) != (first.ParentID == nil) ||
+			(n.ParentID != nil && *n.ParentID != *first.ParentID) {
+			return fmt.Errorf("sibling nodes must share a project and parent")
+		}
+	}
+	now := time.Now().UTC()
+
+	return s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txNodes := repository.NewSQLitePlanNodeRepo(tx)
+		txSeqs := repository.NewSQLiteProjectSequenceRepo(tx)
+
+		var existing []*domain.PlanNode
+		var err error
+		if first.ParentID != nil {
+			existing, err = txNodes.ListChildren(ctx, *first.ParentID)
+		} else {
+			existing, err = txNodes.ListRoots(ctx, first.ProjectID)
+		}
+		if err != nil {
+			return err
+		}
+		next := 0
+		for _, n := range existing {
+			if n.OrderIndex >= next {
+				next = n.OrderIndex + 1
+			}
+		}
+
+		for i, n := range nodes {
+			if n.ID == "" {
+				n.ID = uuid.New().String()
+			}
+			n.CreatedAt = now
+			n.UpdatedAt = now
+			n.OrderIndex = next + i
+			seq, err := txSeqs.NextProjectSeq(ctx, n.ProjectID)
+			if err != nil {
+				return fmt.Errorf("assigning seq: %w", err)
+			}
+			n.Seq = seq
+			if err := txNodes.Create(ctx, n); err != nil {
+				return fmt.Errorf("creating node '%s': %w", n.Title, err)
+			}
+		}
+		return nil
+	})
+}
+
 func (s *nodeService) GetByID(ctx context.Context, id string) (*domain.PlanNode, error) {
 	return s.nodes.GetByID(ctx, id)
 }
@@ -72,6 +126,68 @@ func (s *nodeService) Update(ctx context.Context, n *domain.PlanNode) error {
 	return s.nodes.Update(ctx, n)
 }
 
+func (s *nodeService) UpdatePropagatingDue(ctx context.Context, n *domain.PlanNode) (int, error) {
+	if n.DueDate == nil {
+		return 0, fmt.Errorf("node '%s' has no due date to propagate", n.Title)
+	}
+	now := time.Now().UTC()
+	n.UpdatedAt = now
+
+	changed := 0
+	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txNodes := repository.NewSQLitePlanNodeRepo(tx)
+		txItems := repository.NewSQLiteWorkItemRepo(tx)
+
+		before, err := txNodes.GetByID(ctx, n.ID)
+		if err != nil {
+			return err
+		}
+		if err := txNodes.Update(ctx, n); err != nil {
+			return err
+		}
+
+		all, err := txNodes.ListByProject(ctx, n.ProjectID)
+		if err != nil {
+			return err
+		}
+		for _, nodeID := range descendantNodeIDs(n.ID, all) {
+			items, err := txItems.ListByNode(ctx, nodeID)
+			if err != nil {
+				return err
+			}
+			for _, w := range items {
+				if !w.InheritDueDate(*n.DueDate, before.DueDate, now) {
+					continue
+				}
+				if err := txItems.Update(ctx, w); err != nil {
+					return fmt.Errorf("propagating due date to '%s': %w", w.Title, err)
+				}
+				changed++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// descendantNodeIDs returns rootID followed by the IDs of all nodes beneath it.
+func descendantNodeIDs(rootID string, nodes []*domain.PlanNode) []string {
+	children := make(map[string][]string)
+	for _, n := range nodes {
+		if n.ParentID != nil {
+			children[*n.ParentID] = append(children[*n.ParentID], n.ID)
+		}
+	}
+	ids := []string{rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids
+}
+
 func (s *nodeService) Delete(ctx context.Context, id string) error {
 	return s.nodes.Delete(ctx, id)
 }
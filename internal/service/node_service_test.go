@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/repository"
@@ -106,6 +108,42 @@ func TestNodeService_ListChildren(t *testing.T) {
 	assert.Len(t, children, 2)
 }
 
+func TestNodeService_CreateSiblings_OrdersAfterExisting(t *testing.T) {
+	svc, projRepo, _ := setupNodeService(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("NodeSvcSiblings")
+	require.NoError(t, projRepo.Create(ctx, proj))
+
+	parent := testutil.NewTestNode(proj.ID, "Term")
+	require.NoError(t, svc.Create(ctx, parent))
+	intro := testutil.NewTestNode(proj.ID, "Intro", testutil.WithParentID(parent.ID))
+	intro.OrderIndex = 4
+	require.NoError(t, svc.Create(ctx, intro))
+
+	var weeks []*domain.PlanNode
+	for i := 1; i <= 3; i++ {
+		parentID := parent.ID
+		weeks = append(weeks, &domain.PlanNode{ProjectID: proj.ID, ParentID: &parentID, Title: fmt.Sprintf("Week %d", i), Kind: domain.NodeWeek})
+	}
+	require.NoError(t, svc.CreateSiblings(ctx, weeks))
+
+	children, err := svc.ListChildren(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 4)
+	orders := map[string]int{}
+	seqs := map[int]bool{}
+	for _, c := range children {
+		orders[c.Title] = c.OrderIndex
+		seqs[c.Seq] = true
+	}
+	assert.Equal(t, map[string]int{"Intro": 4, "Week 1": 5, "Week 2": 6, "Week 3": 7}, orders)
+	assert.Len(t, seqs, 4, "each node gets its own seq")
+
+	other := &domain.PlanNode{ProjectID: proj.ID, Title: "Loose", Kind: domain.NodeWeek}
+	assert.Error(t, svc.CreateSiblings(ctx, []*domain.PlanNode{weeks[0], other}), "siblings must share a parent")
+}
+
 func TestNodeService_Update(t *testing.T) {
 	svc, projRepo, _ := setupNodeService(t)
 	ctx := context.Background()
@@ -124,6 +162,102 @@ func TestNodeService_Update(t *testing.T) {
 	assert.Equal(t, "NewTitle", fetched.Title)
 }
 
+func TestNodeService_UpdatePropagatingDue(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	ctx := context.Background()
+	projRepo := repository.NewSQLiteProjectRepo(database)
+	nodeRepo := repository.NewSQLitePlanNodeRepo(database)
+	wiRepo := repository.NewSQLiteWorkItemRepo(database)
+	svc := NewNodeService(nodeRepo, testutil.NewTestUoW(database))
+
+	proj := testutil.NewTestProject("Propagate")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	week := testutil.NewTestNode(proj.ID, "Week 3")
+	require.NoError(t, svc.Create(ctx, week))
+	child := testutil.NewTestNode(proj.ID, "Lab", testutil.WithParentID(week.ID))
+	require.NoError(t, svc.Create(ctx, child))
+	other := testutil.NewTestNode(proj.ID, "Week 4")
+	require.NoError(t, svc.Create(ctx, other))
+
+	own := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	plain := testutil.NewTestWorkItem(week.ID, "Plain")
+	nested := testutil.NewTestWorkItem(child.ID, "Nested")
+	overridden := testutil.NewTestWorkItem(week.ID, "Overridden", testutil.WithWorkItemDueDate(own))
+	done := testutil.NewTestWorkItem(week.ID, "Done", testutil.WithWorkItemStatus(domain.WorkItemDone))
+	elsewhere := testutil.NewTestWorkItem(other.ID, "Elsewhere")
+	for _, w := range []*domain.WorkItem{plain, nested, overridden, done, elsewhere} {
+		require.NoError(t, wiRepo.Create(ctx, w))
+	}
+
+	due := time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)
+	week.DueDate = &due
+	n, err := svc.UpdatePropagatingDue(ctx, week)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	dueOf := func(id string) *time.Time {
+		w, err := wiRepo.GetByID(ctx, id)
+		require.NoError(t, err)
+		return w.DueDate
+	}
+	assert.Equal(t, due, *dueOf(plain.ID))
+	assert.Equal(t, due, *dueOf(nested.ID))
+	assert.Equal(t, own, *dueOf(overridden.ID))
+	assert.Nil(t, dueOf(done.ID))
+	assert.Nil(t, dueOf(elsewhere.ID))
+	fetched, err := svc.GetByID(ctx, week.ID)
+	require.NoError(t, err)
+	assert.Equal(t, due, *fetched.DueDate)
+
+	// Moving the node date again carries inherited items along.
+	later := due.AddDate(0, 0, 7)
+	week.DueDate = &later
+	n, err = svc.UpdatePropagatingDue(ctx, week)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, later, *dueOf(plain.ID))
+	assert.Equal(t, own, *dueOf(overridden.ID))
+
+	week.DueDate = nil
+	_, err = svc.UpdatePropagatingDue(ctx, week)
+	assert.Error(t, err)
+}
+
+func TestNodeService_UpdatePropagatingDue_RollsBackOnFailure(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	ctx := context.Background()
+	projRepo := repository.NewSQLiteProjectRepo(database)
+	nodeRepo := repository.NewSQLitePlanNodeRepo(database)
+	wiRepo := repository.NewSQLiteWorkItemRepo(database)
+
+	proj := testutil.NewTestProject("Rollback")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+	first := testutil.NewTestWorkItem(node.ID, "First")
+	second := testutil.NewTestWorkItem(node.ID, "Second")
+	require.NoError(t, wiRepo.Create(ctx, first))
+	require.NoError(t, wiRepo.Create(ctx, second))
+
+	// Exec #1 updates the node, #2 the first item; fail on the second item.
+	svc := NewNodeService(nodeRepo, &testutil.FailOnNthExecUoW{
+		DB: database, FailOn: 3, Err: fmt.Errorf("injected failure"),
+	})
+	due := time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)
+	node.DueDate = &due
+	_, err := svc.UpdatePropagatingDue(ctx, node)
+	require.Error(t, err)
+
+	fetched, err := nodeRepo.GetByID(ctx, node.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.DueDate)
+	for _, id := range []string{first.ID, second.ID} {
+		w, err := wiRepo.GetByID(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, w.DueDate)
+	}
+}
+
 func TestNodeService_Delete(t *testing.T) {
 	svc, projRepo, _ := setupNodeService(t)
 	ctx := context.Background()
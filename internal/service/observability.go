@@ -4,7 +4,11 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"sort"
+	"sync"
 	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
 )
 
 // UseCaseEvent captures lightweight execution telemetry for a service use case.
@@ -15,6 +19,13 @@ type UseCaseEvent struct {
 	Err       error
 	Fields    map[string]any
 	StartedAt time.Time
+
+	// Mutating use cases also name the entity they changed and the fields
+	// that differ, so an audit observer can record them. EntityID is empty
+	// for read-only use cases.
+	EntityType string
+	EntityID   string
+	Changes    map[string]domain.FieldChange
 }
 
 // UseCaseObserver receives use-case execution events.
@@ -59,6 +70,124 @@ func (o *logUseCaseObserver) ObserveUseCase(ctx context.Context, event UseCaseEv
 	o.logger.InfoContext(ctx, "service_use_case", attrs...)
 }
 
+type multiUseCaseObserver []UseCaseObserver
+
+// NewMultiUseCaseObserver fans each event out to every non-nil observer, in
+// order. With no observers it returns NoopUseCaseObserver.
+func NewMultiUseCaseObserver(observers ...UseCaseObserver) UseCaseObserver {
+	var m multiUseCaseObserver
+	for _, obs := range observers {
+		if obs != nil {
+			m = append(m, obs)
+		}
+	}
+	switch len(m) {
+	case 0:
+		return NoopUseCaseObserver{}
+	case 1:
+		return m[0]
+	}
+	return m
+}
+
+func (m multiUseCaseObserver) ObserveUseCase(ctx context.Context, event UseCaseEvent) {
+	for _, obs := range m {
+		obs.ObserveUseCase(ctx, event)
+	}
+}
+
+// timingSampleCap bounds the latency samples kept per use case; older
+// samples are overwritten so long sessions use constant memory.
+const timingSampleCap = 1024
+
+// UseCaseTiming summarises the observed latencies of one use case.
+// Percentiles cover the most recent timingSampleCap calls.
+type UseCaseTiming struct {
+	Name   string
+	Calls  int
+	Errors int
+	P50    time.Duration
+	P95    time.Duration
+	Max    time.Duration
+}
+
+type timingSeries struct {
+	calls   int
+	errors  int
+	max     time.Duration
+	samples []time.Duration
+	next    int
+}
+
+// TimingUseCaseObserver accumulates per-use-case call counts and latencies in
+// memory. It is safe for concurrent use.
+type TimingUseCaseObserver struct {
+	mu     sync.Mutex
+	series map[string]*timingSeries
+}
+
+// NewTimingUseCaseObserver returns an empty timing observer.
+func NewTimingUseCaseObserver() *TimingUseCaseObserver {
+	return &TimingUseCaseObserver{series: map[string]*timingSeries{}}
+}
+
+func (o *TimingUseCaseObserver) ObserveUseCase(_ context.Context, event UseCaseEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	ts, ok := o.series[event.Name]
+	if !ok {
+		ts = &timingSeries{}
+		o.series[event.Name] = ts
+	}
+	ts.calls++
+	if !event.Success {
+		ts.errors++
+	}
+	if event.Duration > ts.max {
+		ts.max = event.Duration
+	}
+	if len(ts.samples) < timingSampleCap {
+		ts.samples = append(ts.samples, event.Duration)
+		return
+	}
+	ts.samples[ts.next] = event.Duration
+	ts.next = (ts.next + 1) % timingSampleCap
+}
+
+// Snapshot returns the timings observed so far, sorted by use case name.
+func (o *TimingUseCaseObserver) Snapshot() []UseCaseTiming {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	out := make([]UseCaseTiming, 0, len(o.series))
+	for name, ts := range o.series {
+		sorted := make([]time.Duration, len(ts.samples))
+		copy(sorted, ts.samples)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		out = append(out, UseCaseTiming{
+			Name:   name,
+			Calls:  ts.calls,
+			Errors: ts.errors,
+			P50:    durationPercentile(sorted, 50),
+			P95:    durationPercentile(sorted, 95),
+			Max:    ts.max,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// durationPercentile returns the nearest-rank percentile of sorted samples.
+func durationPercentile(sorted []time.Duration, pct int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (pct*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
 func useCaseObserverOrNoop(observers []UseCaseObserver) UseCaseObserver {
 	for _, obs := range observers {
 		if obs != nil {
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimingUseCaseObserver_Snapshot(t *testing.T) {
+	obs := NewTimingUseCaseObserver()
+	ctx := context.Background()
+	for i := 1; i <= 100; i++ {
+		obs.ObserveUseCase(ctx, UseCaseEvent{Name: "what-now", Duration: time.Duration(i) * time.Millisecond, Success: true})
+	}
+	obs.ObserveUseCase(ctx, UseCaseEvent{Name: "replan", Duration: 5 * time.Millisecond, Err: errors.New("boom")})
+
+	snap := obs.Snapshot()
+	require.Len(t, snap, 2)
+	assert.Equal(t, "replan", snap[0].Name)
+	assert.Equal(t, 1, snap[0].Calls)
+	assert.Equal(t, 1, snap[0].Errors)
+	assert.Equal(t, 5*time.Millisecond, snap[0].P95)
+
+	wn := snap[1]
+	assert.Equal(t, 100, wn.Calls)
+	assert.Equal(t, 0, wn.Errors)
+	assert.Equal(t, 50*time.Millisecond, wn.P50)
+	assert.Equal(t, 95*time.Millisecond, wn.P95)
+	assert.Equal(t, 100*time.Millisecond, wn.Max)
+}
+
+func TestTimingUseCaseObserver_BoundsSamples(t *testing.T) {
+	obs := NewTimingUseCaseObserver()
+	ctx := context.Background()
+	obs.ObserveUseCase(ctx, UseCaseEvent{Name: "x", Duration: time.Hour, Success: true})
+	for i := 0; i < timingSampleCap; i++ {
+		obs.ObserveUseCase(ctx, UseCaseEvent{Name: "x", Duration: time.Millisecond, Success: true})
+	}
+
+	snap := obs.Snapshot()
+	require.Len(t, snap, 1)
+	assert.Equal(t, timingSampleCap+1, snap[0].Calls)
+	assert.Equal(t, time.Millisecond, snap[0].P95, "oldest sample is overwritten")
+	assert.Equal(t, time.Hour, snap[0].Max, "max spans every call")
+}
+
+func TestNewMultiUseCaseObserver(t *testing.T) {
+	a, b := &recordingObserver{}, &recordingObserver{}
+	multi := NewMultiUseCaseObserver(a, nil, b)
+	multi.ObserveUseCase(context.Background(), UseCaseEvent{Name: "log-session"})
+
+	require.Len(t, a.events, 1)
+	require.Len(t, b.events, 1)
+	assert.Equal(t, "log-session", b.events[0].Name)
+
+	assert.IsType(t, NoopUseCaseObserver{}, NewMultiUseCaseObserver())
+	assert.Same(t, a, NewMultiUseCaseObserver(nil, a))
+}
@@ -135,7 +135,7 @@ func TestPersona_GradStudent_MixedCompletion(t *testing.T) {
 	}
 
 	// === Phase 4: Status check — verify all 3 projects reported ===
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusReq.Now = &now
 
@@ -326,7 +326,7 @@ func TestPersona_Freelancer_DeadlineCrunch(t *testing.T) {
 	}
 
 	// === Phase 4: Verify D (no deadline) status ===
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusReq.Now = &now
 
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type profileService struct {
+	profiles repository.UserProfileRepo
+}
+
+func NewProfileService(profiles repository.UserProfileRepo) ProfileService {
+	return &profileService{profiles: profiles}
+}
+
+func (s *profileService) Get(ctx context.Context) (*domain.UserProfile, error) {
+	return s.profiles.Get(ctx)
+}
+
+func (s *profileService) Update(ctx context.Context, p *domain.UserProfile) error {
+	if p.FocusBlockMin <= 0 {
+		return fmt.Errorf("focus block must be positive, got %dm", p.FocusBlockMin)
+	}
+	if p.BreakMin < 0 {
+		return fmt.Errorf("break cannot be negative, got %dm", p.BreakMin)
+	}
+	if p.BufferPct < 0 || p.BufferPct > 1 {
+		return fmt.Errorf("deadline buffer must be between 0%% and 100%%, got %.0f%%", p.BufferPct*100)
+	}
+	if p.BaselineDailyMin <= 0 {
+		return fmt.Errorf("baseline daily minutes must be positive, got %dm", p.BaselineDailyMin)
+	}
+	if p.MaxDailyMin < 0 || p.MaxDailyMin > 24*60 {
+		return fmt.Errorf("max daily minutes must be between 0 (default %dm) and 24h, got %dm", domain.DefaultMaxDailyMin, p.MaxDailyMin)
+	}
+	if p.PaceWindowDays < 0 || p.PaceWindowDays > domain.MaxRecentWindowDays {
+		return fmt.Errorf("pace window must be between 1 and %d days (0 for the default %d), got %d", domain.MaxRecentWindowDays, domain.DefaultPaceWindowDays, p.PaceWindowDays)
+	}
+	if p.SpacingLookbackDays < 0 || p.SpacingLookbackDays > domain.MaxRecentWindowDays {
+		return fmt.Errorf("spacing look-back must be between 1 and %d days (0 for the default %d), got %d", domain.MaxRecentWindowDays, domain.DefaultSpacingLookbackDays, p.SpacingLookbackDays)
+	}
+	for _, m := range []int{p.ActiveHoursStart, p.ActiveHoursEnd} {
+		if m < 0 || m >= 24*60 {
+			return fmt.Errorf("active hours must be times of day between 00:00 and 23:59, got %dm after midnight", m)
+		}
+	}
+	for _, w := range p.ScoringWeights() {
+		if *w.Value < 0 || *w.Value > domain.MaxScoringWeight {
+			return fmt.Errorf("weight %s must be between 0 and %g, got %g", w.Name, domain.MaxScoringWeight, *w.Value)
+		}
+	}
+	for _, m := range p.WeekdayMin {
+		if m < 0 || m > 24*60 {
+			return fmt.Errorf("availability must be between 0 and 24h a day, got %dm", m)
+		}
+	}
+	return s.profiles.Upsert(ctx, p)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/alexanderramin/kairos/internal/db"
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/repository"
 	"github.com/google/uuid"
@@ -12,16 +13,20 @@ import (
 
 type projectService struct {
 	projects repository.ProjectRepo
+	uow      db.UnitOfWork
 }
 
-func NewProjectService(projects repository.ProjectRepo) ProjectService {
-	return &projectService{projects: projects}
+func NewProjectService(projects repository.ProjectRepo, uow db.UnitOfWork) ProjectService {
+	return &projectService{projects: projects, uow: uow}
 }
 
 func (s *projectService) Create(ctx context.Context, p *domain.Project) error {
 	if err := p.ValidateShortID(); err != nil {
 		return err
 	}
+	if err := p.ValidateImportance(); err != nil {
+		return err
+	}
 	if p.ID == "" {
 		p.ID = uuid.New().String()
 	}
@@ -43,6 +48,12 @@ func (s *projectService) List(ctx context.Context, includeArchived bool) ([]*dom
 }
 
 func (s *projectService) Update(ctx context.Context, p *domain.Project) error {
+	if err := p.ValidateImportance(); err != nil {
+		return err
+	}
+	if err := p.WorkDefaults.Validate(); err != nil {
+		return err
+	}
 	p.UpdatedAt = time.Now().UTC()
 	return s.projects.Update(ctx, p)
 }
@@ -55,6 +66,62 @@ func (s *projectService) Unarchive(ctx context.Context, id string) error {
 	return s.projects.Unarchive(ctx, id)
 }
 
+func (s *projectService) ShiftDates(ctx context.Context, id string, days int) (int, int, error) {
+	if days == 0 {
+		return 0, 0, fmt.Errorf("shift must be at least one day")
+	}
+	now := time.Now().UTC()
+
+	var nodesMoved, itemsMoved int
+	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txProjects := repository.NewSQLiteProjectRepo(tx)
+		txNodes := repository.NewSQLitePlanNodeRepo(tx)
+		txItems := repository.NewSQLiteWorkItemRepo(tx)
+
+		p, err := txProjects.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		p.ShiftDates(days, now)
+		if err := txProjects.Update(ctx, p); err != nil {
+			return err
+		}
+
+		nodes, err := txNodes.ListByProject(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if !n.ShiftDates(days, now) {
+				continue
+			}
+			if err := txNodes.Update(ctx, n); err != nil {
+				return fmt.Errorf("shifting node '%s': %w", n.Title, err)
+			}
+			nodesMoved++
+		}
+
+		items, err := txItems.ListByProject(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, w := range items {
+			if !w.ShiftDates(days, now) {
+				continue
+			}
+			if err := txItems.Update(ctx, w); err != nil {
+				return fmt.Errorf("shifting work item '%s': %w", w.Title, err)
+			}
+			itemsMoved++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return nodesMoved, itemsMoved, nil
+}
+
 func (s *projectService) Delete(ctx context.Context, id string, force bool) error {
 	if !force {
 		p, err := s.projects.GetByID(ctx, id)
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alexanderramin/kairos/internal/domain"
 	"github.com/alexanderramin/kairos/internal/testutil"
@@ -11,10 +12,10 @@ import (
 )
 
 func TestProjectService_Create_ValidShortID(t *testing.T) {
-	projects, _, _, _, _, _, _ := setupRepos(t)
+	projects, _, _, _, _, _, uow := setupRepos(t)
 	ctx := context.Background()
 
-	svc := NewProjectService(projects)
+	svc := NewProjectService(projects, uow)
 
 	proj := &domain.Project{
 		Name:    "Philosophy Essay",
@@ -35,10 +36,10 @@ func TestProjectService_Create_ValidShortID(t *testing.T) {
 }
 
 func TestProjectService_Create_InvalidShortID(t *testing.T) {
-	projects, _, _, _, _, _, _ := setupRepos(t)
+	projects, _, _, _, _, _, uow := setupRepos(t)
 	ctx := context.Background()
 
-	svc := NewProjectService(projects)
+	svc := NewProjectService(projects, uow)
 
 	tests := []struct {
 		name    string
@@ -67,10 +68,10 @@ func TestProjectService_Create_InvalidShortID(t *testing.T) {
 }
 
 func TestProjectService_Delete_RequiresArchiveFirst(t *testing.T) {
-	projects, _, _, _, _, _, _ := setupRepos(t)
+	projects, _, _, _, _, _, uow := setupRepos(t)
 	ctx := context.Background()
 
-	svc := NewProjectService(projects)
+	svc := NewProjectService(projects, uow)
 
 	proj := testutil.NewTestProject("Active Project")
 	require.NoError(t, projects.Create(ctx, proj))
@@ -86,10 +87,10 @@ func TestProjectService_Delete_RequiresArchiveFirst(t *testing.T) {
 }
 
 func TestProjectService_Delete_ForceBypassesGuard(t *testing.T) {
-	projects, _, _, _, _, _, _ := setupRepos(t)
+	projects, _, _, _, _, _, uow := setupRepos(t)
 	ctx := context.Background()
 
-	svc := NewProjectService(projects)
+	svc := NewProjectService(projects, uow)
 
 	proj := testutil.NewTestProject("Active Project")
 	require.NoError(t, projects.Create(ctx, proj))
@@ -102,3 +103,62 @@ func TestProjectService_Delete_ForceBypassesGuard(t *testing.T) {
 	_, err = svc.GetByID(ctx, proj.ID)
 	assert.Error(t, err, "project should be deleted")
 }
+
+func TestProjectService_ShiftDates_MovesPlanButNotSessions(t *testing.T) {
+	projects, nodes, workItems, _, sessions, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	svc := NewProjectService(projects, uow)
+
+	target := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	proj := testutil.NewTestProject("Course", testutil.WithTargetDate(target))
+	require.NoError(t, projects.Create(ctx, proj))
+
+	nodeDue := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	dated := testutil.NewTestNode(proj.ID, "Week 1", testutil.WithNodeDueDate(nodeDue))
+	require.NoError(t, nodes.Create(ctx, dated))
+	undated := testutil.NewTestNode(proj.ID, "Extras")
+	require.NoError(t, nodes.Create(ctx, undated))
+
+	itemDue := time.Date(2026, 3, 20, 15, 30, 0, 0, time.UTC)
+	withDue := testutil.NewTestWorkItem(dated.ID, "Reading", testutil.WithWorkItemDueDate(itemDue))
+	require.NoError(t, workItems.Create(ctx, withDue))
+	noDue := testutil.NewTestWorkItem(undated.ID, "Optional")
+	require.NoError(t, workItems.Create(ctx, noDue))
+
+	loggedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	sess := testutil.NewTestSession(withDue.ID, 30, testutil.WithStartedAt(loggedAt))
+	require.NoError(t, sessions.Create(ctx, sess))
+
+	nodesMoved, itemsMoved, err := svc.ShiftDates(ctx, proj.ID, 14)
+	require.NoError(t, err)
+	assert.Equal(t, 1, nodesMoved)
+	assert.Equal(t, 1, itemsMoved)
+
+	gotProj, err := projects.GetByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, proj.StartDate.AddDate(0, 0, 14).Format("2006-01-02"), gotProj.StartDate.Format("2006-01-02"))
+	require.NotNil(t, gotProj.TargetDate)
+	assert.Equal(t, "2026-06-15", gotProj.TargetDate.Format("2006-01-02"))
+
+	gotNode, err := nodes.GetByID(ctx, dated.ID)
+	require.NoError(t, err)
+	require.NotNil(t, gotNode.DueDate)
+	assert.Equal(t, "2026-04-15", gotNode.DueDate.Format("2006-01-02"))
+	gotUndated, err := nodes.GetByID(ctx, undated.ID)
+	require.NoError(t, err)
+	assert.Nil(t, gotUndated.DueDate)
+
+	gotItem, err := workItems.GetByID(ctx, withDue.ID)
+	require.NoError(t, err)
+	require.NotNil(t, gotItem.DueDate)
+	assert.Equal(t, itemDue.Local().AddDate(0, 0, 14).Format("2006-01-02 15:04"),
+		gotItem.DueDate.Local().Format("2006-01-02 15:04"), "timed deadline keeps its local time")
+	gotNoDue, err := workItems.GetByID(ctx, noDue.ID)
+	require.NoError(t, err)
+	assert.Nil(t, gotNoDue.DueDate)
+
+	gotSess, err := sessions.GetByID(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.True(t, loggedAt.Equal(gotSess.StartedAt), "logged sessions are history and do not move")
+}
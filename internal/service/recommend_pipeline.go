@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/app"
@@ -20,17 +21,32 @@ type ProjectAggregates struct {
 	RecentMin  map[string]int
 	TargetDate map[string]*time.Time
 	StartDate  map[string]*time.Time
+	// PaceWindowDays is the number of days RecentMin covers.
+	PaceWindowDays int
 }
 
 // RecommendationContext bundles all data loaded for a recommendation cycle.
 type RecommendationContext struct {
-	Now                time.Time
-	Candidates         []repository.SchedulableCandidate
-	RecentSessions     []*domain.WorkSessionLog
-	CompletedSummaries []repository.CompletedWorkSummary
-	Weights            scheduler.ScoringWeights
-	BufferPct          float64
-	BaselineDailyMin   int
+	Now        time.Time
+	Candidates []repository.SchedulableCandidate
+	// RecentMin holds each item's session minutes over the last
+	// PaceWindowDays days, summed per project into the recent daily pace;
+	// LastSessionAt holds its latest session start within the spacing
+	// look-back. Load fills both windows from the profile; a zero
+	// PaceWindowDays counts as domain.DefaultPaceWindowDays.
+	RecentMin           map[string]int
+	LastSessionAt       map[string]time.Time
+	PaceWindowDays      int
+	SpacingLookbackDays int
+	CompletedSummaries  []repository.CompletedWorkSummary
+	Weights             scheduler.ScoringWeights
+	BufferPct           float64
+	BaselineDailyMin    int
+	// MaxDailyMin is the profile's daily capacity for the feasibility
+	// check; zero skips it.
+	MaxDailyMin int
+	// Profile is the user profile the settings above were read from.
+	Profile *domain.UserProfile
 }
 
 // ContextLoader loads all data needed for a recommendation cycle.
@@ -49,6 +65,15 @@ func (cl *ContextLoader) Load(ctx context.Context, req app.WhatNowRequest) (*Rec
 		}
 	}
 
+	switch req.Strategy {
+	case "", app.WhatNowStrategyPriority, app.WhatNowStrategyWarmup:
+	default:
+		return nil, &app.WhatNowError{
+			Code:    app.ErrInvalidStrategy,
+			Message: fmt.Sprintf("unknown strategy %q (use priority or warmup)", req.Strategy),
+		}
+	}
+
 	now := time.Now().UTC()
 	if req.Now != nil {
 		now = *req.Now
@@ -59,10 +84,23 @@ func (cl *ContextLoader) Load(ctx context.Context, req app.WhatNowRequest) (*Rec
 		return nil, fmt.Errorf("loading user profile: %w", err)
 	}
 
-	candidates, err := cl.workItems.ListSchedulable(ctx, req.IncludeArchived)
+	paceDays, lookbackDays := profile.PaceWindow(), profile.SpacingLookback()
+	withAggregates, err := cl.workItems.ListCandidateWorkItemsWithAggregates(ctx, req.IncludeArchived, paceDays, lookbackDays)
 	if err != nil {
 		return nil, fmt.Errorf("loading schedulable items: %w", err)
 	}
+	candidates := make([]repository.SchedulableCandidate, 0, len(withAggregates))
+	recentMin := make(map[string]int)
+	lastSessionAt := make(map[string]time.Time)
+	for _, c := range withAggregates {
+		candidates = append(candidates, c.SchedulableCandidate)
+		if c.RecentMin > 0 {
+			recentMin[c.WorkItem.ID] = c.RecentMin
+		}
+		if c.LastSessionAt != nil {
+			lastSessionAt[c.WorkItem.ID] = *c.LastSessionAt
+		}
+	}
 	candidates = filterCandidatesByScope(candidates, req.ProjectScope)
 	if len(candidates) == 0 {
 		return nil, &app.WhatNowError{
@@ -71,47 +109,59 @@ func (cl *ContextLoader) Load(ctx context.Context, req app.WhatNowRequest) (*Rec
 		}
 	}
 
-	recentSessions, err := cl.sessions.ListRecent(ctx, 7)
-	if err != nil {
-		return nil, fmt.Errorf("loading recent sessions: %w", err)
-	}
-
 	completedSummaries, err := cl.workItems.ListCompletedSummaryByProject(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("loading completed work summaries: %w", err)
 	}
 
 	return &RecommendationContext{
-		Now:                now,
-		Candidates:         candidates,
-		RecentSessions:     recentSessions,
-		CompletedSummaries: completedSummaries,
+		Now:                 now,
+		Candidates:          candidates,
+		RecentMin:           recentMin,
+		LastSessionAt:       lastSessionAt,
+		PaceWindowDays:      paceDays,
+		SpacingLookbackDays: lookbackDays,
+		CompletedSummaries:  completedSummaries,
 		Weights: scheduler.ScoringWeights{
 			DeadlinePressure: profile.WeightDeadlinePressure,
 			BehindPace:       profile.WeightBehindPace,
 			Spacing:          profile.WeightSpacing,
 			Variation:        profile.WeightVariation,
+			Focus:            profile.WeightFocus,
+			Importance:       profile.WeightImportance,
 		},
 		BufferPct:        profile.BufferPct,
 		BaselineDailyMin: profile.BaselineDailyMin,
+		MaxDailyMin:      profile.MaxDailyCapacity(),
+		Profile:          profile,
 	}, nil
 }
 
 // ComputeAggregates builds per-project risk, totals, and recent session data.
 func ComputeAggregates(rctx *RecommendationContext) ProjectAggregates {
-	agg, idx := buildProjectIndex(rctx.Candidates, rctx.CompletedSummaries, rctx.RecentSessions, rctx.Now)
-	computeProjectRisks(&agg, idx, rctx.Now, rctx.BufferPct, rctx.BaselineDailyMin)
+	agg, idx := buildProjectIndex(rctx.Candidates, rctx.CompletedSummaries, rctx.RecentMin, rctx.Now)
+	paceDays := paceWindowDays(rctx.PaceWindowDays)
+	computeProjectRisks(&agg, idx, rctx.Now, rctx.BufferPct, rctx.BaselineDailyMin, rctx.MaxDailyMin, paceDays)
 	return ProjectAggregates{
-		Risks:      agg.risks,
-		Names:      agg.names,
-		Planned:    agg.planned,
-		Logged:     agg.logged,
-		RecentMin:  agg.recentMin,
-		TargetDate: agg.targetDate,
-		StartDate:  agg.startDate,
+		Risks:          agg.risks,
+		Names:          agg.names,
+		Planned:        agg.planned,
+		Logged:         agg.logged,
+		RecentMin:      agg.recentMin,
+		TargetDate:     agg.targetDate,
+		StartDate:      agg.startDate,
+		PaceWindowDays: paceDays,
 	}
 }
 
+// paceWindowDays returns days, or domain.DefaultPaceWindowDays when unset.
+func paceWindowDays(days int) int {
+	if days <= 0 {
+		return domain.DefaultPaceWindowDays
+	}
+	return days
+}
+
 // DetermineMode returns Critical if any project has critical risk, otherwise Balanced.
 func DetermineMode(agg ProjectAggregates) domain.PlanMode {
 	for _, risk := range agg.Risks {
@@ -143,7 +193,17 @@ func (br *BlockResolver) Resolve(
 	if err != nil {
 		return nil, nil, fmt.Errorf("checking dependencies: %w", err)
 	}
+	unblocked, blockers := resolveBlocks(candidates, blockedSet, now)
+	return unblocked, blockers, nil
+}
 
+// resolveBlocks applies the dependency (blockedSet), NotBefore and
+// WorkComplete checks of Resolve to candidates.
+func resolveBlocks(
+	candidates []repository.SchedulableCandidate,
+	blockedSet map[string]bool,
+	now time.Time,
+) ([]repository.SchedulableCandidate, []app.ConstraintBlocker) {
 	var unblocked []repository.SchedulableCandidate
 	var blockers []app.ConstraintBlocker
 
@@ -168,7 +228,7 @@ func (br *BlockResolver) Resolve(
 			continue
 		}
 
-		if c.WorkItem.PlannedMin > 0 && c.WorkItem.LoggedMin >= c.WorkItem.PlannedMin {
+		if c.WorkItem.PlannedMin > 0 && scheduler.RemainingMin(c.WorkItem.PlannedMin, c.WorkItem.LoggedMin, c.WorkItem.UnitsTotal, c.WorkItem.UnitsDone) <= 0 {
 			blockers = append(blockers, app.ConstraintBlocker{
 				EntityType: "work_item",
 				EntityID:   c.WorkItem.ID,
@@ -181,19 +241,21 @@ func (br *BlockResolver) Resolve(
 		unblocked = append(unblocked, c)
 	}
 
-	return unblocked, blockers, nil
+	return unblocked, blockers
 }
 
-// ScoreCandidates builds scoring input for each candidate and delegates to scheduler.ScoreWorkItem.
+// ScoreCandidates builds scoring input for each candidate and delegates to
+// scheduler.ScoreWorkItem. lastSessionAt should cover the spacing look-back:
+// items without a session in it score as never worked on.
 func ScoreCandidates(
 	candidates []repository.SchedulableCandidate,
-	recentSessions []*domain.WorkSessionLog,
+	lastSessionAt map[string]time.Time,
 	agg ProjectAggregates,
 	weights scheduler.ScoringWeights,
 	mode domain.PlanMode,
 	now time.Time,
 ) []scheduler.ScoredCandidate {
-	lastSessionDaysAgo := buildLastSessionIndex(recentSessions, now)
+	lastSessionDaysAgo := buildLastSessionIndex(lastSessionAt, now)
 
 	scored := make([]scheduler.ScoredCandidate, 0, len(candidates))
 	for _, c := range candidates {
@@ -225,7 +287,13 @@ func ScoreCandidates(
 			Splittable:          c.WorkItem.Splittable,
 			PlannedMin:          c.WorkItem.PlannedMin,
 			LoggedMin:           c.WorkItem.LoggedMin,
+			UnitsTotal:          c.WorkItem.UnitsTotal,
+			UnitsDone:           c.WorkItem.UnitsDone,
 			NodeID:              c.WorkItem.NodeID,
+			Focused:             c.Focused,
+			ManualPriority:      c.WorkItem.ManualPriority,
+			ProjectImportance:   c.ProjectImportance,
+			Atomic:              !c.WorkItem.Splittable,
 		}
 
 		scored = append(scored, scheduler.ScoreWorkItem(input))
@@ -235,15 +303,32 @@ func ScoreCandidates(
 
 // buildLastSessionIndex computes days-ago-since-last-session per work item.
 // Returns a map of work item ID → days ago (only entries for items with sessions).
-func buildLastSessionIndex(sessions []*domain.WorkSessionLog, now time.Time) map[string]int {
-	lastSessionDaysAgo := make(map[string]int)
+func buildLastSessionIndex(lastSessionAt map[string]time.Time, now time.Time) map[string]int {
+	lastSessionDaysAgo := make(map[string]int, len(lastSessionAt))
+	for id, at := range lastSessionAt {
+		lastSessionDaysAgo[id] = int(now.Sub(at).Hours() / 24)
+	}
+	return lastSessionDaysAgo
+}
+
+// sessionAggregates sums each work item's session minutes since paceFrom
+// and finds its latest session start since spacingFrom, the per-item inputs
+// ListCandidateWorkItemsWithAggregates returns for the real history.
+func sessionAggregates(sessions []*domain.WorkSessionLog, paceFrom, spacingFrom time.Time) (map[string]int, map[string]time.Time) {
+	recentMin := make(map[string]int)
+	lastSessionAt := make(map[string]time.Time)
 	for _, sess := range sessions {
-		daysAgo := int(now.Sub(sess.StartedAt).Hours() / 24)
-		if existing, ok := lastSessionDaysAgo[sess.WorkItemID]; !ok || daysAgo < existing {
-			lastSessionDaysAgo[sess.WorkItemID] = daysAgo
+		if !sess.StartedAt.Before(paceFrom) {
+			recentMin[sess.WorkItemID] += sess.Minutes
+		}
+		if sess.StartedAt.Before(spacingFrom) {
+			continue
+		}
+		if last, ok := lastSessionAt[sess.WorkItemID]; !ok || sess.StartedAt.After(last) {
+			lastSessionAt[sess.WorkItemID] = sess.StartedAt
 		}
 	}
-	return lastSessionDaysAgo
+	return recentMin, lastSessionAt
 }
 
 // AssembleResponse builds the final WhatNowResponse from slices, blockers, and project aggregates.
@@ -255,14 +340,29 @@ func AssembleResponse(
 	blockers []app.ConstraintBlocker,
 	agg ProjectAggregates,
 ) *app.WhatNowResponse {
+	// Projects in a fixed order, most at risk first, so the response does
+	// not depend on map iteration.
+	pids := make([]string, 0, len(agg.Risks))
+	for pid := range agg.Risks {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		pi, pj := scheduler.RiskPriority(agg.Risks[pids[i]].Level), scheduler.RiskPriority(agg.Risks[pids[j]].Level)
+		if pi != pj {
+			return pi < pj
+		}
+		return pids[i] < pids[j]
+	})
+
 	var riskSummaries []app.RiskSummary
-	for pid, risk := range agg.Risks {
+	for _, pid := range pids {
+		risk := agg.Risks[pid]
 		var dueDateStr *string
 		if agg.TargetDate[pid] != nil {
-			ds := agg.TargetDate[pid].Format("2006-01-02")
+			ds := domain.FormatDeadline(*agg.TargetDate[pid])
 			dueDateStr = &ds
 		}
-		recentDaily := float64(agg.RecentMin[pid]) / 7.0
+		recentDaily := float64(agg.RecentMin[pid]) / float64(paceWindowDays(agg.PaceWindowDays))
 		riskSummaries = append(riskSummaries, app.RiskSummary{
 			ProjectID:         pid,
 			ProjectName:       agg.Names[pid],
@@ -276,6 +376,8 @@ func AssembleResponse(
 			RecentDailyMin:    recentDaily,
 			SlackMinPerDay:    risk.SlackMinPerDay,
 			ProgressTimePct:   risk.ProgressTimePct,
+			Infeasible:        risk.Infeasible,
+			ShortfallMin:      risk.ShortfallMin,
 		})
 	}
 
@@ -285,8 +387,8 @@ func AssembleResponse(
 	}
 
 	var policyMessages []string
-	for pid, risk := range agg.Risks {
-		if risk.Level == domain.RiskOnTrack {
+	for _, pid := range pids {
+		if agg.Risks[pid].Level == domain.RiskOnTrack {
 			policyMessages = append(policyMessages, fmt.Sprintf("%s is on track, secondary work is safe", agg.Names[pid]))
 		}
 	}
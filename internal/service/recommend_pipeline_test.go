@@ -142,6 +142,50 @@ func TestBlockResolver_WorkCompleteConstraint(t *testing.T) {
 	assert.Equal(t, app.BlockerWorkComplete, blockers[0].Code)
 }
 
+func TestBlockResolver_WorkCompleteUsesUnitPace(t *testing.T) {
+	_, _, _, deps, _, _, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	resolver := &BlockResolver{deps: deps}
+	candidates := []repository.SchedulableCandidate{
+		{
+			// Planned time is used up, but 7 of 10 units are still left.
+			WorkItem: domain.WorkItem{
+				ID:         "wi-slow",
+				Title:      "Slow Reading",
+				PlannedMin: 60,
+				LoggedMin:  60,
+				UnitsTotal: 10,
+				UnitsDone:  3,
+			},
+			ProjectID:   "proj-1",
+			ProjectName: "Test",
+		},
+		{
+			// Every unit is done ahead of the planned time.
+			WorkItem: domain.WorkItem{
+				ID:         "wi-fast",
+				Title:      "Fast Reading",
+				PlannedMin: 100,
+				LoggedMin:  40,
+				UnitsTotal: 10,
+				UnitsDone:  10,
+			},
+			ProjectID:   "proj-1",
+			ProjectName: "Test",
+		},
+	}
+
+	unblocked, blockers, err := resolver.Resolve(ctx, candidates, now)
+	require.NoError(t, err)
+	require.Len(t, unblocked, 1)
+	assert.Equal(t, "wi-slow", unblocked[0].WorkItem.ID)
+	require.Len(t, blockers, 1)
+	assert.Equal(t, "wi-fast", blockers[0].EntityID)
+	assert.Equal(t, app.BlockerWorkComplete, blockers[0].Code)
+}
+
 func TestBlockResolver_MixedConstraints(t *testing.T) {
 	projects, nodes, workItems, deps, _, _, _ := setupRepos(t)
 	ctx := context.Background()
@@ -338,7 +382,7 @@ func TestComputeAggregates_RiskLevels(t *testing.T) {
 			},
 		},
 		CompletedSummaries: nil,
-		RecentSessions:     nil,
+		RecentMin:          nil,
 		BufferPct:          0.1,
 		BaselineDailyMin:   30,
 	}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/app"
@@ -12,6 +14,10 @@ import (
 	"github.com/alexanderramin/kairos/internal/scheduler"
 )
 
+// errReplanDryRun aborts the re-estimation transaction on a dry run so the
+// same write path runs but nothing is committed.
+var errReplanDryRun = errors.New("replan dry run: rolling back")
+
 type replanService struct {
 	projects  repository.ProjectRepo
 	workItems repository.WorkItemRepo
@@ -49,6 +55,7 @@ func (s *replanService) Replan(ctx context.Context, req app.ReplanRequest) (resp
 		"trigger":          req.Trigger,
 		"include_archived": req.IncludeArchived,
 		"project_scope":    len(req.ProjectScope),
+		"dry_run":          req.DryRun,
 	}
 	defer func() {
 		if resp != nil {
@@ -71,17 +78,17 @@ func (s *replanService) Replan(ctx context.Context, req app.ReplanRequest) (resp
 	}
 	fields["strategy"] = strategy
 
-	days := req.IncludeRecentSessionDays
-	if days <= 0 {
-		days = 7
-	}
-
 	var profile *domain.UserProfile
 	profile, err = s.profiles.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("loading profile: %w", err)
 	}
 
+	days := req.IncludeRecentSessionDays
+	if days <= 0 {
+		days = profile.PaceWindow()
+	}
+
 	var projects []*domain.Project
 	projects, err = s.projects.List(ctx, req.IncludeArchived)
 	if err != nil {
@@ -116,14 +123,14 @@ func (s *replanService) Replan(ctx context.Context, req app.ReplanRequest) (resp
 		riskBefore := snap.Risk
 
 		// Re-estimate work items within a transaction
-		changedCount, err := s.reestimateItems(ctx, items, now)
+		changes, err := s.reestimateItems(ctx, items, now, req.DryRun)
 		if err != nil {
 			return nil, err
 		}
 
 		// Recompute risk after re-estimation
 		metricsAfter := aggregateProjectMetrics(items, p, now)
-		riskAfter := scheduler.ComputeRisk(buildRiskInput(metricsAfter, p.TargetDate, profile.BufferPct, snap.EffectiveDailyMin, now))
+		riskAfter := scheduler.ComputeRisk(buildRiskInput(metricsAfter, p.TargetDate, profile.BufferPct, snap.EffectiveDailyMin, profile.MaxDailyCapacity(), now))
 
 		if riskAfter.Level == domain.RiskCritical {
 			hasCritical = true
@@ -138,7 +145,8 @@ func (s *replanService) Replan(ctx context.Context, req app.ReplanRequest) (resp
 			RequiredDailyMinAfter:  riskAfter.RequiredDailyMin,
 			RemainingMinBefore:     riskBefore.RemainingMin,
 			RemainingMinAfter:      riskAfter.RemainingMin,
-			ChangedItemsCount:      changedCount,
+			ChangedItemsCount:      len(changes),
+			ItemChanges:            changes,
 		})
 	}
 
@@ -154,45 +162,67 @@ func (s *replanService) Replan(ctx context.Context, req app.ReplanRequest) (resp
 		RecomputedProjects: len(activeProjects),
 		Deltas:             deltas,
 		GlobalModeAfter:    globalMode,
+		DryRun:             req.DryRun,
 	}
 
 	return resp, nil
 }
 
-// reestimateItems applies smooth re-estimation to eligible items within a transaction.
-func (s *replanService) reestimateItems(ctx context.Context, items []*domain.WorkItem, now time.Time) (int, error) {
+// reestimateItems applies smooth re-estimation to eligible items within a
+// transaction. On a dry run the transaction is always rolled back, so the
+// returned changes describe what would have been written.
+func (s *replanService) reestimateItems(ctx context.Context, items []*domain.WorkItem, now time.Time, dryRun bool) ([]app.ReplanItemChange, error) {
 	// Collect items that need re-estimation first.
-	type reestimate struct {
-		item       *domain.WorkItem
-		newPlanned int
-	}
-	var updates []reestimate
+	var updates []*domain.WorkItem
+	var changes []app.ReplanItemChange
 	for _, item := range items {
 		if !item.EligibleForReestimate() {
 			continue
 		}
+		before := item.PlannedMin
 		newPlanned := scheduler.SmoothReEstimate(item.PlannedMin, item.LoggedMin, item.UnitsTotal, item.UnitsDone)
 		if item.ApplyReestimate(newPlanned, now) {
-			updates = append(updates, reestimate{item: item, newPlanned: newPlanned})
+			updates = append(updates, item)
+			pace := scheduler.UnitPace(item.LoggedMin, item.UnitsDone)
+			changes = append(changes, app.ReplanItemChange{
+				WorkItemID:       item.ID,
+				Title:            item.Title,
+				PlannedMinBefore: before,
+				PlannedMinAfter:  item.PlannedMin,
+				LoggedMin:        item.LoggedMin,
+				UnitsDone:        item.UnitsDone,
+				UnitsTotal:       item.UnitsTotal,
+				UnitsKind:        item.UnitsKind,
+				MinPerUnit:       pace,
+				ImpliedTotalMin:  int(math.Round(pace * float64(item.UnitsTotal))),
+			})
 		}
 	}
 
 	if len(updates) == 0 {
-		return 0, nil
+		return nil, nil
 	}
 
 	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
 		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
-		for _, u := range updates {
-			if err := txWorkItems.Update(ctx, u.item); err != nil {
-				return fmt.Errorf("updating work item %s: %w", u.item.ID, err)
+		for _, item := range updates {
+			if err := txWorkItems.Update(ctx, item); err != nil {
+				return fmt.Errorf("updating work item %s: %w", item.ID, err)
 			}
 		}
+		if dryRun {
+			return errReplanDryRun
+		}
 		return nil
 	})
+	// Compare by identity: a failed rollback wraps the sentinel, and that
+	// must still surface as an error.
+	if dryRun && err == errReplanDryRun {
+		err = nil
+	}
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return len(updates), nil
+	return changes, nil
 }
@@ -291,3 +291,57 @@ func TestReplan_Idempotency_MultipleCallsConvergeThenStabilize(t *testing.T) {
 			"after convergence, all subsequent replans should report zero changes (iteration %d)", i)
 	}
 }
+
+func TestReplan_DryRun_ReportsChangesWithoutPersisting(t *testing.T) {
+	projects, nodes, workItems, _, sessions, profiles, uow := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	proj := testutil.NewTestProject("Study", testutil.WithTargetDate(now.AddDate(0, 2, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Read Chapters",
+		testutil.WithPlannedMin(100),
+		testutil.WithLoggedMin(60),
+		testutil.WithUnits("chapters", 10, 3),
+		testutil.WithDurationMode(domain.DurationEstimate),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wi))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(wi.ID, 30, testutil.WithStartedAt(now.Add(-24*time.Hour)))))
+
+	svc := NewReplanService(projects, workItems, sessions, profiles, uow)
+	req := contract.NewReplanRequest(domain.TriggerManual)
+	req.Now = &now
+	req.DryRun = true
+
+	resp, err := svc.Replan(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	require.Len(t, resp.Deltas, 1)
+	require.Len(t, resp.Deltas[0].ItemChanges, 1)
+	change := resp.Deltas[0].ItemChanges[0]
+	assert.Equal(t, wi.ID, change.WorkItemID)
+	assert.Equal(t, 100, change.PlannedMinBefore)
+	assert.Equal(t, 130, change.PlannedMinAfter)
+	assert.Equal(t, 1, resp.Deltas[0].ChangedItemsCount)
+	// 60m over 3 of 10 chapters: 20m each, 200m in total at that pace.
+	assert.Equal(t, 20.0, change.MinPerUnit)
+	assert.Equal(t, 200, change.ImpliedTotalMin)
+	assert.Equal(t, []int{60, 3, 10}, []int{change.LoggedMin, change.UnitsDone, change.UnitsTotal})
+	assert.Equal(t, "chapters", change.UnitsKind)
+
+	stored, err := workItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 100, stored.PlannedMin, "dry run must not persist re-estimates")
+
+	// A real replan afterwards applies exactly what the dry run previewed.
+	req.DryRun = false
+	resp, err = svc.Replan(ctx, req)
+	require.NoError(t, err)
+	stored, err = workItems.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, change.PlannedMinAfter, stored.PlannedMin)
+	assert.False(t, resp.DryRun)
+}
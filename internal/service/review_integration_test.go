@@ -85,7 +85,7 @@ func TestWeeklyReview_FullPipeline(t *testing.T) {
 	}
 
 	// === Step 1: Get project status (as the review command does) ===
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusReq.Now = &now
 
@@ -181,7 +181,7 @@ func TestWeeklyReview_NoSessions_ProducesEmptyReview(t *testing.T) {
 	require.NoError(t, workItems.Create(ctx, wi))
 
 	// Get status (no sessions)
-	statusSvc := NewStatusService(projects, workItems, sessions, profiles)
+	statusSvc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	statusReq := contract.NewStatusRequest()
 	statusReq.Now = &now
 
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+// autoReplanSessionService wraps a SessionService and, when the profile has
+// AutoReplan set, replans the logged item's project after each successful
+// log. The replan is scoped to that one project and best effort: a failed
+// replan never fails the log.
+type autoReplanSessionService struct {
+	SessionService
+	replan    ReplanService
+	profiles  repository.UserProfileRepo
+	workItems repository.WorkItemRepo
+	nodes     repository.PlanNodeRepo
+}
+
+// NewAutoReplanSessionService decorates sessions with the opt-in
+// post-log replan (trigger SESSION_LOGGED).
+func NewAutoReplanSessionService(
+	sessions SessionService,
+	replan ReplanService,
+	profiles repository.UserProfileRepo,
+	workItems repository.WorkItemRepo,
+	nodes repository.PlanNodeRepo,
+) SessionService {
+	return &autoReplanSessionService{
+		SessionService: sessions,
+		replan:         replan,
+		profiles:       profiles,
+		workItems:      workItems,
+		nodes:          nodes,
+	}
+}
+
+func (s *autoReplanSessionService) LogSession(ctx context.Context, session *domain.WorkSessionLog) error {
+	if err := s.SessionService.LogSession(ctx, session); err != nil {
+		return err
+	}
+	s.replanAfterLog(ctx, session.WorkItemID)
+	return nil
+}
+
+func (s *autoReplanSessionService) LogSessionAndFinish(ctx context.Context, session *domain.WorkSessionLog) error {
+	if err := s.SessionService.LogSessionAndFinish(ctx, session); err != nil {
+		return err
+	}
+	s.replanAfterLog(ctx, session.WorkItemID)
+	return nil
+}
+
+func (s *autoReplanSessionService) LogPomodoros(ctx context.Context, template *domain.WorkSessionLog, count int) ([]*domain.WorkSessionLog, error) {
+	logged, err := s.SessionService.LogPomodoros(ctx, template, count)
+	if err != nil {
+		return nil, err
+	}
+	s.replanAfterLog(ctx, template.WorkItemID)
+	return logged, nil
+}
+
+func (s *autoReplanSessionService) LogSplit(ctx context.Context, sessions []*domain.WorkSessionLog) error {
+	if err := s.SessionService.LogSplit(ctx, sessions); err != nil {
+		return err
+	}
+	ids := make([]string, len(sessions))
+	for i, session := range sessions {
+		ids[i] = session.WorkItemID
+	}
+	s.replanAfterLog(ctx, ids...)
+	return nil
+}
+
+// replanAfterLog replans the projects of the given work items in one run.
+func (s *autoReplanSessionService) replanAfterLog(ctx context.Context, workItemIDs ...string) {
+	profile, err := s.profiles.Get(ctx)
+	if err != nil || !profile.AutoReplan {
+		return
+	}
+	var scope []string
+	seen := make(map[string]bool)
+	for _, id := range workItemIDs {
+		wi, err := s.workItems.GetByID(ctx, id)
+		if err != nil {
+			continue
+		}
+		node, err := s.nodes.GetByID(ctx, wi.NodeID)
+		if err != nil || seen[node.ProjectID] {
+			continue
+		}
+		seen[node.ProjectID] = true
+		scope = append(scope, node.ProjectID)
+	}
+	if len(scope) == 0 {
+		return
+	}
+
+	req := app.NewReplanRequest(domain.TriggerSessionLogged)
+	req.ProjectScope = scope
+	req.Explain = false
+	_, _ = s.replan.Replan(ctx, req)
+}
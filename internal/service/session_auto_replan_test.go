@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoReplanSessionService_ReplansOnlyLoggedProjectWhenEnabled(t *testing.T) {
+	projects, nodes, workItems, _, sessions, profiles, uow := setupRepos(t)
+	ctx := context.Background()
+	target := time.Now().UTC().AddDate(0, 2, 0)
+
+	// Each project gets an item whose pace implies a 130m re-estimate
+	// (see TestReplan_SmoothReEstimation_UpdatesDB) plus, in the first, a
+	// plain item to log against.
+	behindPace := func(name string) (*domain.PlanNode, *domain.WorkItem) {
+		proj := testutil.NewTestProject(name, testutil.WithTargetDate(target))
+		require.NoError(t, projects.Create(ctx, proj))
+		node := testutil.NewTestNode(proj.ID, "Week 1")
+		require.NoError(t, nodes.Create(ctx, node))
+		wi := testutil.NewTestWorkItem(node.ID, "Read Chapters",
+			testutil.WithPlannedMin(100),
+			testutil.WithLoggedMin(60),
+			testutil.WithUnits("chapters", 10, 3),
+			testutil.WithDurationMode(domain.DurationEstimate),
+			testutil.WithSessionBounds(15, 60, 30),
+		)
+		require.NoError(t, workItems.Create(ctx, wi))
+		return node, wi
+	}
+	node, logged := behindPace("Logged")
+	_, other := behindPace("Other")
+	practice := testutil.NewTestWorkItem(node.ID, "Practice",
+		testutil.WithPlannedMin(120), testutil.WithSessionBounds(15, 60, 30))
+	require.NoError(t, workItems.Create(ctx, practice))
+
+	replan := NewReplanService(projects, workItems, sessions, profiles, uow)
+	svc := NewAutoReplanSessionService(NewSessionService(sessions, uow), replan, profiles, workItems, nodes)
+	plannedMin := func(id string) int {
+		wi, err := workItems.GetByID(ctx, id)
+		require.NoError(t, err)
+		return wi.PlannedMin
+	}
+
+	require.NoError(t, svc.LogSession(ctx, testutil.NewTestSession(practice.ID, 30)))
+	assert.Equal(t, 100, plannedMin(logged.ID), "auto-replan is off by default")
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.AutoReplan = true
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	require.NoError(t, svc.LogSession(ctx, testutil.NewTestSession(practice.ID, 30)))
+	assert.Equal(t, 130, plannedMin(logged.ID), "the logged project is replanned")
+	assert.Equal(t, 100, plannedMin(other.ID), "other projects are left alone")
+}
@@ -254,3 +254,81 @@ func TestSessionDelete_ReplanConvergesAfterDeletion(t *testing.T) {
 			"recommendations must be deterministic after delete+replan")
 	}
 }
+
+func TestSessionUndoLast_RevertsLatestLogOnItem(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Undo Test")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	right := testutil.NewTestWorkItem(node.ID, "Right Item", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, right))
+	wrong := testutil.NewTestWorkItem(node.ID, "Wrong Item", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, wrong))
+
+	other := testutil.NewTestProject("Other")
+	require.NoError(t, projRepo.Create(ctx, other))
+	otherNode := testutil.NewTestNode(other.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, otherNode))
+	otherItem := testutil.NewTestWorkItem(otherNode.ID, "Elsewhere", testutil.WithPlannedMin(60))
+	require.NoError(t, wiRepo.Create(ctx, otherItem))
+
+	svc := NewSessionService(sessRepo, uow)
+	require.NoError(t, svc.LogSession(ctx, testutil.NewTestSession(right.ID, 30)))
+	mistake := testutil.NewTestSession(wrong.ID, 45)
+	require.NoError(t, svc.LogSessionAndFinish(ctx, mistake))
+
+	removed, item, err := svc.UndoLast(ctx, proj.ID, 10*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, mistake.ID, removed.ID)
+	assert.Equal(t, wrong.ID, item.ID)
+
+	got, err := wiRepo.GetByID(ctx, wrong.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.LoggedMin)
+	assert.Equal(t, domain.WorkItemTodo, got.Status, "finished by the undone log, now reopened with no sessions")
+	_, err = sessRepo.GetByID(ctx, mistake.ID)
+	assert.Error(t, err)
+
+	kept, err := wiRepo.GetByID(ctx, right.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, kept.LoggedMin)
+
+	// A newer session in another project is out of scope.
+	require.NoError(t, svc.LogSession(ctx, testutil.NewTestSession(otherItem.ID, 20)))
+	removed, _, err = svc.UndoLast(ctx, proj.ID, 10*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, right.ID, removed.WorkItemID)
+
+	_, _, err = svc.UndoLast(ctx, proj.ID, 0)
+	assert.ErrorContains(t, err, "no logged sessions")
+}
+
+func TestSessionUndoLast_RefusesOldSessionWithoutForce(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Undo Age")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Item", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, wi))
+
+	// Logged yesterday: stored directly so created_at is in the past.
+	old := testutil.NewTestSession(wi.ID, 30)
+	old.CreatedAt = time.Now().UTC().Add(-24 * time.Hour)
+	require.NoError(t, sessRepo.Create(ctx, old))
+
+	svc := NewSessionService(sessRepo, uow)
+	_, _, err := svc.UndoLast(ctx, "", 10*time.Minute)
+	require.ErrorIs(t, err, ErrSessionTooOld)
+	_, err = sessRepo.GetByID(ctx, old.ID)
+	require.NoError(t, err, "refused undo leaves the session")
+
+	removed, _, err := svc.UndoLast(ctx, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, old.ID, removed.ID)
+}
@@ -57,6 +57,43 @@ func TestLogSession_RollbackOnSessionCreateFailure(t *testing.T) {
 	assert.Empty(t, sessions, "no sessions should exist after rollback")
 }
 
+func TestLogPomodoros_RollbackLeavesNoPartialBlocks(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	projRepo := repository.NewSQLiteProjectRepo(database)
+	nodeRepo := repository.NewSQLitePlanNodeRepo(database)
+	wiRepo := repository.NewSQLiteWorkItemRepo(database)
+	sessRepo := repository.NewSQLiteSessionRepo(database)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Pomodoro Rollback")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+	item := testutil.NewTestWorkItem(node.ID, "Read chapter", testutil.WithPlannedMin(60))
+	require.NoError(t, wiRepo.Create(ctx, item))
+
+	// Each block is 2 execs (workItems.Update, sessions.Create); fail the
+	// second block's session insert after the first block has been written.
+	failUoW := &testutil.FailOnNthExecUoW{
+		DB:     database,
+		FailOn: 4,
+		Err:    fmt.Errorf("injected session create failure"),
+	}
+	svc := NewSessionService(sessRepo, failUoW)
+
+	_, err := svc.LogPomodoros(ctx, &domain.WorkSessionLog{WorkItemID: item.ID}, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "injected session create failure")
+
+	wi, err := wiRepo.GetByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, wi.LoggedMin, "logged_min should be unchanged after rollback")
+
+	sessions, err := sessRepo.ListByWorkItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions, "no blocks should survive a failed batch")
+}
+
 func TestLogSession_RollbackOnWorkItemUpdateFailure(t *testing.T) {
 	database := testutil.NewTestDB(t)
 	projRepo := repository.NewSQLiteProjectRepo(database)
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/db"
@@ -29,21 +31,36 @@ func NewSessionService(
 	}
 }
 
-func (s *sessionService) LogSession(ctx context.Context, session *domain.WorkSessionLog) (err error) {
+func (s *sessionService) LogSession(ctx context.Context, session *domain.WorkSessionLog) error {
+	return s.logSession(ctx, session, false)
+}
+
+func (s *sessionService) LogSessionAndFinish(ctx context.Context, session *domain.WorkSessionLog) error {
+	return s.logSession(ctx, session, true)
+}
+
+// logSession stores session in one transaction; with finish set the work
+// item is also marked done there, which skips re-estimation.
+func (s *sessionService) logSession(ctx context.Context, session *domain.WorkSessionLog, finish bool) (err error) {
 	startedAt := time.Now().UTC()
 	fields := map[string]any{
 		"work_item_id": session.WorkItemID,
 		"minutes":      session.Minutes,
 		"units_delta":  session.UnitsDoneDelta,
+		"finish":       finish,
 	}
+	var before, after *domain.WorkItem
 	defer func() {
 		s.observer.ObserveUseCase(ctx, UseCaseEvent{
-			Name:      "log-session",
-			StartedAt: startedAt,
-			Duration:  time.Since(startedAt),
-			Success:   err == nil,
-			Err:       err,
-			Fields:    fields,
+			Name:       "log-session",
+			StartedAt:  startedAt,
+			Duration:   time.Since(startedAt),
+			Success:    err == nil,
+			Err:        err,
+			Fields:     fields,
+			EntityType: "work_item",
+			EntityID:   session.WorkItemID,
+			Changes:    workItemChanges(before, after),
 		})
 	}()
 
@@ -51,32 +68,226 @@ func (s *sessionService) LogSession(ctx context.Context, session *domain.WorkSes
 		session.ID = uuid.New().String()
 	}
 	session.CreatedAt = time.Now().UTC()
+	if session.StartedAt.IsZero() {
+		session.StartedAt = session.CreatedAt
+	}
 	fields["session_id"] = session.ID
 
 	return s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
-		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
-		txSessions := repository.NewSQLiteSessionRepo(tx)
+		if err := checkSessionElapsed(ctx, tx, session, startedAt); err != nil {
+			return err
+		}
+		var err error
+		before, after, err = logSessionTx(ctx, tx, session, finish)
+		return err
+	})
+}
 
-		// Read work item within transaction
-		wi, err := txWorkItems.GetByID(ctx, session.WorkItemID)
+// checkSessionElapsed applies WorkSessionLog.CheckElapsed when the profile
+// turns on ValidateSessionTime.
+func checkSessionElapsed(ctx context.Context, tx db.DBTX, session *domain.WorkSessionLog, now time.Time) error {
+	profile, err := repository.NewSQLiteUserProfileRepo(tx).Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !profile.ValidateSessionTime {
+		return nil
+	}
+	return session.CheckElapsed(now)
+}
+
+// logSessionTx applies a session to its work item (with smooth re-estimation)
+// and stores it, using repos scoped to tx. With finish set the item is marked
+// done instead of re-estimated. It returns the work item as it was before and
+// after the session, for audit diffs.
+func logSessionTx(ctx context.Context, tx db.DBTX, session *domain.WorkSessionLog, finish bool) (before, after *domain.WorkItem, err error) {
+	txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+	txSessions := repository.NewSQLiteSessionRepo(tx)
+
+	// Read work item within transaction
+	wi, err := txWorkItems.GetByID(ctx, session.WorkItemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	before = snapshotWorkItem(wi)
+
+	now := time.Now().UTC()
+	if err := wi.ApplySession(session.Minutes, session.UnitsDoneDelta, now); err != nil {
+		return nil, nil, err
+	}
+	if finish {
+		if err := wi.MarkDone(now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if wi.EligibleForReestimate() {
+		newPlanned := scheduler.SmoothReEstimate(wi.PlannedMin, wi.LoggedMin, wi.UnitsTotal, wi.UnitsDone)
+		wi.ApplyReestimate(newPlanned, now)
+	}
+	if err := txWorkItems.Update(ctx, wi); err != nil {
+		return nil, nil, err
+	}
+
+	session.Tags = domain.NormalizeTags(session.Tags)
+	if err := txSessions.Create(ctx, session); err != nil {
+		return nil, nil, err
+	}
+	return before, wi, nil
+}
+
+func (s *sessionService) LogPomodoros(ctx context.Context, template *domain.WorkSessionLog, count int) (logged []*domain.WorkSessionLog, err error) {
+	startedAt := time.Now().UTC()
+	fields := map[string]any{
+		"work_item_id": template.WorkItemID,
+		"count":        count,
+	}
+	var before, after *domain.WorkItem
+	defer func() {
+		s.observer.ObserveUseCase(ctx, UseCaseEvent{
+			Name:       "log-pomodoros",
+			StartedAt:  startedAt,
+			Duration:   time.Since(startedAt),
+			Success:    err == nil,
+			Err:        err,
+			Fields:     fields,
+			EntityType: "work_item",
+			EntityID:   template.WorkItemID,
+			Changes:    workItemChanges(before, after),
+		})
+	}()
+
+	if count <= 0 {
+		return nil, fmt.Errorf("pomodoro count must be positive, got %d", count)
+	}
+
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		profile, err := repository.NewSQLiteUserProfileRepo(tx).Get(ctx)
 		if err != nil {
 			return err
 		}
+		blockMin, breakMin := profile.PomodoroLengths()
+		step := time.Duration(blockMin+breakMin) * time.Minute
 
-		now := time.Now().UTC()
-		if err := wi.ApplySession(session.Minutes, session.UnitsDoneDelta, now); err != nil {
-			return err
+		// Without an explicit start, the last block ends now.
+		first := template.StartedAt
+		if first.IsZero() {
+			first = startedAt.Add(-time.Duration(count*blockMin+(count-1)*breakMin) * time.Minute)
+		}
+		if profile.ValidateSessionTime {
+			// The whole run, breaks included, has to fit before now; one
+			// without a start ends now and is checked as just finished.
+			run := &domain.WorkSessionLog{StartedAt: template.StartedAt, Minutes: count*blockMin + (count-1)*breakMin, DayOnly: template.DayOnly}
+			if run.StartedAt.IsZero() {
+				run.StartedAt = startedAt
+			}
+			if err := run.CheckElapsed(startedAt); err != nil {
+				return err
+			}
 		}
 
-		if wi.EligibleForReestimate() {
-			newPlanned := scheduler.SmoothReEstimate(wi.PlannedMin, wi.LoggedMin, wi.UnitsTotal, wi.UnitsDone)
-			wi.ApplyReestimate(newPlanned, now)
+		logged = make([]*domain.WorkSessionLog, 0, count)
+		for i := 0; i < count; i++ {
+			session := &domain.WorkSessionLog{
+				ID:         uuid.New().String(),
+				WorkItemID: template.WorkItemID,
+				StartedAt:  first.Add(time.Duration(i) * step).UTC(),
+				Minutes:    blockMin,
+				Note:       template.Note,
+				Tags:       template.Tags,
+				CreatedAt:  startedAt,
+			}
+			// Units completed are credited once, to the final block.
+			if i == count-1 {
+				session.UnitsDoneDelta = template.UnitsDoneDelta
+			}
+			blockBefore, blockAfter, err := logSessionTx(ctx, tx, session, false)
+			if err != nil {
+				return err
+			}
+			if before == nil {
+				before = blockBefore
+			}
+			after = blockAfter
+			logged = append(logged, session)
 		}
-		if err := txWorkItems.Update(ctx, wi); err != nil {
-			return err
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logged, nil
+}
+
+func (s *sessionService) LogSplit(ctx context.Context, sessions []*domain.WorkSessionLog) (err error) {
+	startedAt := time.Now().UTC()
+	befores := make([]*domain.WorkItem, len(sessions))
+	afters := make([]*domain.WorkItem, len(sessions))
+	// Each part is audited as its own log-session on its own item.
+	defer func() {
+		for i, session := range sessions {
+			s.observer.ObserveUseCase(ctx, UseCaseEvent{
+				Name:      "log-session",
+				StartedAt: startedAt,
+				Duration:  time.Since(startedAt),
+				Success:   err == nil,
+				Err:       err,
+				Fields: map[string]any{
+					"work_item_id": session.WorkItemID,
+					"session_id":   session.ID,
+					"minutes":      session.Minutes,
+					"split_parts":  len(sessions),
+				},
+				EntityType: "work_item",
+				EntityID:   session.WorkItemID,
+				Changes:    workItemChanges(befores[i], afters[i]),
+			})
 		}
+	}()
 
-		return txSessions.Create(ctx, session)
+	if len(sessions) < 2 {
+		return fmt.Errorf("a split needs at least two work items, got %d", len(sessions))
+	}
+	seen := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		if seen[session.WorkItemID] {
+			return fmt.Errorf("work item %s appears more than once in the split", session.WorkItemID)
+		}
+		seen[session.WorkItemID] = true
+		if session.Minutes <= 0 {
+			return fmt.Errorf("each split part needs positive minutes, got %d", session.Minutes)
+		}
+	}
+
+	shared := sessions[0].StartedAt
+	if shared.IsZero() {
+		shared = startedAt
+	}
+	for _, session := range sessions {
+		if session.ID == "" {
+			session.ID = uuid.New().String()
+		}
+		session.StartedAt = shared
+		session.CreatedAt = startedAt
+	}
+
+	return s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		// The parts run one after another from the shared start.
+		sitting := &domain.WorkSessionLog{StartedAt: shared, DayOnly: sessions[0].DayOnly}
+		for _, session := range sessions {
+			sitting.Minutes += session.Minutes
+		}
+		if err := checkSessionElapsed(ctx, tx, sitting, startedAt); err != nil {
+			return err
+		}
+		for i, session := range sessions {
+			var err error
+			befores[i], afters[i], err = logSessionTx(ctx, tx, session, false)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 }
 
@@ -92,10 +303,90 @@ func (s *sessionService) ListRecent(ctx context.Context, days int) ([]*domain.Wo
 	return s.sessions.ListRecent(ctx, days)
 }
 
+func (s *sessionService) ListRecentByProject(ctx context.Context, projectID string, days int) ([]*domain.WorkSessionLog, error) {
+	return s.sessions.ListRecentByProject(ctx, projectID, days)
+}
+
 func (s *sessionService) ListRecentSummaryByType(ctx context.Context, days int) ([]domain.SessionSummaryByType, error) {
 	return s.sessions.ListRecentSummaryByType(ctx, days)
 }
 
+func (s *sessionService) SummaryByTag(ctx context.Context, from, to time.Time) ([]domain.SessionTagSummary, error) {
+	if !to.After(from) {
+		return nil, fmt.Errorf("report range is empty: %s is not before %s",
+			from.Format(domain.DeadlineDateLayout), to.Format(domain.DeadlineDateLayout))
+	}
+	return s.sessions.SummaryByTag(ctx, from, to)
+}
+
+// ErrSessionTooOld is returned by UndoLast when the latest session was
+// logged longer ago than the allowed age.
+var ErrSessionTooOld = &domain.Error{Code: domain.CodeSessionTooOld, Message: "last session is too old to undo"}
+
+func (s *sessionService) UndoLast(ctx context.Context, projectID string, maxAge time.Duration) (removed *domain.WorkSessionLog, item *domain.WorkItem, err error) {
+	startedAt := time.Now().UTC()
+	fields := map[string]any{"project_id": projectID}
+	var before *domain.WorkItem
+	defer func() {
+		event := UseCaseEvent{
+			Name:       "undo-session",
+			StartedAt:  startedAt,
+			Duration:   time.Since(startedAt),
+			Success:    err == nil,
+			Err:        err,
+			Fields:     fields,
+			EntityType: "work_item",
+		}
+		if item != nil {
+			event.EntityID = item.ID
+			event.Changes = workItemChanges(before, item)
+		}
+		s.observer.ObserveUseCase(ctx, event)
+	}()
+
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txSessions := repository.NewSQLiteSessionRepo(tx)
+		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+
+		session, err := txSessions.LatestLogged(ctx, projectID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return fmt.Errorf("no logged sessions to undo")
+			}
+			return err
+		}
+		if age := startedAt.Sub(session.CreatedAt); maxAge > 0 && age > maxAge {
+			return fmt.Errorf("%w: logged %s ago (limit %s)", ErrSessionTooOld,
+				age.Round(time.Minute), maxAge)
+		}
+		fields["session_id"] = session.ID
+
+		wi, err := txWorkItems.GetByID(ctx, session.WorkItemID)
+		if err != nil {
+			return err
+		}
+		before = snapshotWorkItem(wi)
+
+		if err := txSessions.Delete(ctx, session.ID); err != nil {
+			return err
+		}
+		remaining, err := txSessions.ListByWorkItem(ctx, wi.ID)
+		if err != nil {
+			return err
+		}
+		wi.RevertSession(session.Minutes, session.UnitsDoneDelta, session.CreatedAt, len(remaining), startedAt)
+		if err := txWorkItems.Update(ctx, wi); err != nil {
+			return err
+		}
+		removed, item = session, wi
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return removed, item, nil
+}
+
 func (s *sessionService) Delete(ctx context.Context, id string) error {
 	return s.sessions.Delete(ctx, id)
 }
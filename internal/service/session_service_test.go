@@ -106,6 +106,111 @@ func TestLogSession_TriggersReEstimation(t *testing.T) {
 	assert.Equal(t, 3, updated.UnitsDone)
 }
 
+func TestLogSessionAndFinish_MarksDoneWithoutReEstimation(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Study")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+
+	wi := testutil.NewTestWorkItem(node.ID, "Read",
+		testutil.WithPlannedMin(100),
+		testutil.WithUnits("pages", 10, 0),
+		testutil.WithDurationMode(domain.DurationEstimate),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, wiRepo.Create(ctx, wi))
+
+	svc := NewSessionService(sessRepo, uow)
+
+	// The same session re-estimates to 130 under LogSession.
+	sess := testutil.NewTestSession(wi.ID, 60, testutil.WithUnitsDelta(3))
+	require.NoError(t, svc.LogSessionAndFinish(ctx, sess))
+
+	updated, err := wiRepo.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemDone, updated.Status)
+	assert.NotNil(t, updated.CompletedAt)
+	assert.Equal(t, 100, updated.PlannedMin, "finishing should skip re-estimation")
+	assert.Equal(t, 60, updated.LoggedMin)
+
+	sessions, err := sessRepo.ListByWorkItem(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestSessionService_LogPomodoros(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, profiles, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Study")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Read Chapter", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, wi))
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.FocusBlockMin = 30
+	profile.BreakMin = 10
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	svc := NewSessionService(sessRepo, uow)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	logged, err := svc.LogPomodoros(ctx, &domain.WorkSessionLog{
+		WorkItemID: wi.ID, StartedAt: start, Note: "deep work", UnitsDoneDelta: 2,
+	}, 3)
+	require.NoError(t, err)
+	require.Len(t, logged, 3)
+	for i, s := range logged {
+		assert.Equal(t, 30, s.Minutes)
+		assert.Equal(t, start.Add(time.Duration(i*40)*time.Minute), s.StartedAt, "block %d spaced by block+break", i)
+		assert.Equal(t, "deep work", s.Note)
+	}
+	assert.Equal(t, 0, logged[0].UnitsDoneDelta)
+	assert.Equal(t, 2, logged[2].UnitsDoneDelta, "units credited to the final block")
+
+	stored, err := sessRepo.ListByWorkItem(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Len(t, stored, 3, "each block is its own session row")
+
+	updated, err := wiRepo.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 90, updated.LoggedMin)
+	assert.Equal(t, 2, updated.UnitsDone)
+
+	_, err = svc.LogPomodoros(ctx, &domain.WorkSessionLog{WorkItemID: wi.ID}, 0)
+	require.Error(t, err)
+}
+
+func TestSessionService_LogPomodoros_EndsNowByDefault(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Study")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	wi := testutil.NewTestWorkItem(node.ID, "Read Chapter", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, wi))
+
+	svc := NewSessionService(sessRepo, uow)
+
+	before := time.Now().UTC()
+	logged, err := svc.LogPomodoros(ctx, &domain.WorkSessionLog{WorkItemID: wi.ID}, 2)
+	require.NoError(t, err)
+	require.Len(t, logged, 2)
+
+	// Default profile: 25m blocks, 5m break → first block started 55m ago.
+	lastEnd := logged[1].StartedAt.Add(25 * time.Minute)
+	assert.WithinDuration(t, before, lastEnd, 5*time.Second)
+	assert.Equal(t, 30*time.Minute, logged[1].StartedAt.Sub(logged[0].StartedAt))
+}
+
 func TestSessionService_ListRecent(t *testing.T) {
 	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
 	ctx := context.Background()
@@ -148,3 +253,112 @@ func TestSessionService_Delete(t *testing.T) {
 	_, err := sessRepo.GetByID(ctx, session.ID)
 	require.Error(t, err)
 }
+
+func TestSessionService_LogSplit(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, _, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Study")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	reading := testutil.NewTestWorkItem(node.ID, "Read Chapter", testutil.WithPlannedMin(120))
+	require.NoError(t, wiRepo.Create(ctx, reading))
+	notes := testutil.NewTestWorkItem(node.ID, "Write Notes", testutil.WithPlannedMin(60))
+	require.NoError(t, wiRepo.Create(ctx, notes))
+
+	svc := NewSessionService(sessRepo, uow)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	parts := []*domain.WorkSessionLog{
+		{WorkItemID: reading.ID, StartedAt: start, Minutes: 60, Note: "one sitting"},
+		{WorkItemID: notes.ID, Minutes: 30, Note: "one sitting"},
+	}
+	require.NoError(t, svc.LogSplit(ctx, parts))
+	for _, p := range parts {
+		assert.NotEmpty(t, p.ID)
+		assert.Equal(t, start, p.StartedAt, "every part takes the first part's start")
+	}
+
+	r, err := wiRepo.GetByID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 60, r.LoggedMin)
+	n, err := wiRepo.GetByID(ctx, notes.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, n.LoggedMin)
+
+	err = svc.LogSplit(ctx, []*domain.WorkSessionLog{{WorkItemID: reading.ID, Minutes: 30}})
+	assert.ErrorContains(t, err, "at least two")
+	err = svc.LogSplit(ctx, []*domain.WorkSessionLog{
+		{WorkItemID: reading.ID, Minutes: 30},
+		{WorkItemID: reading.ID, Minutes: 30},
+	})
+	assert.ErrorContains(t, err, "more than once")
+	err = svc.LogSplit(ctx, []*domain.WorkSessionLog{
+		{WorkItemID: reading.ID, Minutes: 30},
+		{WorkItemID: "missing", Minutes: 30},
+	})
+	require.Error(t, err)
+	r, err = wiRepo.GetByID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 60, r.LoggedMin, "a failed part rolls back the whole split")
+}
+
+func TestSessionService_ValidateSessionTime(t *testing.T) {
+	projRepo, nodes, wiRepo, _, sessRepo, profiles, uow := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Study")
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	reading := testutil.NewTestWorkItem(node.ID, "Read Chapter", testutil.WithPlannedMin(600))
+	require.NoError(t, wiRepo.Create(ctx, reading))
+	notes := testutil.NewTestWorkItem(node.ID, "Write Notes", testutil.WithPlannedMin(600))
+	require.NoError(t, wiRepo.Create(ctx, notes))
+
+	svc := NewSessionService(sessRepo, uow)
+	hourAgo := time.Now().UTC().Add(-time.Hour)
+	long := func() *domain.WorkSessionLog {
+		return &domain.WorkSessionLog{WorkItemID: reading.ID, StartedAt: hourAgo, Minutes: 600}
+	}
+
+	require.NoError(t, svc.LogSession(ctx, long()), "off by default")
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.ValidateSessionTime = true
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	assert.ErrorContains(t, svc.LogSession(ctx, long()), "can't log 600m")
+	dayOnly := long()
+	dayOnly.DayOnly = true
+	assert.NoError(t, svc.LogSession(ctx, dayOnly), "a day-only start is not checked")
+	assert.NoError(t, svc.LogSession(ctx, &domain.WorkSessionLog{WorkItemID: reading.ID, StartedAt: hourAgo, Minutes: 60}))
+
+	// Without a start a session ends now, so it can't reach back past today.
+	assert.ErrorContains(t, svc.LogSession(ctx, &domain.WorkSessionLog{WorkItemID: reading.ID, Minutes: 1500}),
+		"can't log 1500m ending now")
+	_, err = svc.LogPomodoros(ctx, &domain.WorkSessionLog{WorkItemID: reading.ID}, 60)
+	assert.ErrorContains(t, err, "ending now", "sixty blocks and their breaks take over a day")
+	err = svc.LogSplit(ctx, []*domain.WorkSessionLog{
+		{WorkItemID: reading.ID, Minutes: 800},
+		{WorkItemID: notes.ID, Minutes: 800},
+	})
+	assert.ErrorContains(t, err, "can't log 1600m ending now")
+
+	// Each part fits on its own, but together they overrun the hour.
+	err = svc.LogSplit(ctx, []*domain.WorkSessionLog{
+		{WorkItemID: reading.ID, StartedAt: hourAgo, Minutes: 40},
+		{WorkItemID: notes.ID, Minutes: 40},
+	})
+	assert.ErrorContains(t, err, "can't log 80m")
+
+	// Three default 25m blocks with 5m breaks need 85m.
+	_, err = svc.LogPomodoros(ctx, &domain.WorkSessionLog{WorkItemID: reading.ID, StartedAt: hourAgo}, 3)
+	assert.ErrorContains(t, err, "can't log 85m")
+
+	r, err := wiRepo.GetByID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1260, r.LoggedMin, "rejected sessions are not logged")
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type statsService struct {
+	workItems repository.WorkItemRepo
+}
+
+func NewStatsService(workItems repository.WorkItemRepo) StatsService {
+	return &statsService{workItems: workItems}
+}
+
+func (s *statsService) EstimateAccuracy(ctx context.Context) (*app.EstimateAccuracyResponse, error) {
+	samples, err := s.workItems.ListEstimateSamples(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading estimate samples: %w", err)
+	}
+
+	resp := &app.EstimateAccuracyResponse{}
+	byType := make(map[string][]float64)
+	var all []float64
+	for _, sample := range samples {
+		if sample.InitialPlannedMin <= 0 {
+			resp.SkippedNoEstimate++
+			continue
+		}
+		ratio := float64(sample.LoggedMin) / float64(sample.InitialPlannedMin)
+		byType[sample.Type] = append(byType[sample.Type], ratio)
+		all = append(all, ratio)
+	}
+
+	for typ, ratios := range byType {
+		resp.ByType = append(resp.ByType, summarizeRatios(typ, ratios))
+	}
+	sort.Slice(resp.ByType, func(i, j int) bool {
+		if resp.ByType[i].Count != resp.ByType[j].Count {
+			return resp.ByType[i].Count > resp.ByType[j].Count
+		}
+		return resp.ByType[i].Type < resp.ByType[j].Type
+	})
+	resp.Overall = summarizeRatios("", all)
+
+	return resp, nil
+}
+
+// defaultEstimateMin is suggested when there is no history for a type; it
+// is one default-length work block, short enough to be re-estimated quickly.
+const defaultEstimateMin = 60
+
+func (s *statsService) SuggestEstimate(ctx context.Context, req app.EstimateSuggestionRequest) (*app.EstimateSuggestion, error) {
+	samples, err := s.workItems.ListEstimateSamples(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading estimate samples: %w", err)
+	}
+
+	sug := &app.EstimateSuggestion{Type: req.Type, Units: req.Units, UnitLabel: req.UnitLabel}
+	var typed []repository.EstimateSample
+	for _, sample := range samples {
+		if strings.EqualFold(sample.Type, req.Type) && sample.LoggedMin > 0 {
+			typed = append(typed, sample)
+		}
+	}
+
+	if req.Units > 0 {
+		loggedMin, units, count := 0, 0, 0
+		for _, sample := range typed {
+			n := sample.UnitsDone
+			if n <= 0 {
+				n = sample.UnitsTotal
+			}
+			if n <= 0 || (req.UnitLabel != "" && !strings.EqualFold(sample.UnitsKind, req.UnitLabel)) {
+				continue
+			}
+			loggedMin += sample.LoggedMin
+			units += n
+			count++
+		}
+		if count > 0 {
+			sug.Basis = app.EstimateBasisPerUnit
+			sug.SampleCount = count
+			sug.MinPerUnit = float64(loggedMin) / float64(units)
+			sug.MeanMin = float64(loggedMin) / float64(count)
+			sug.SuggestedMin = roundEstimate(sug.MinPerUnit * float64(req.Units))
+			return sug, nil
+		}
+	}
+
+	if len(typed) == 0 {
+		sug.Basis = app.EstimateBasisDefault
+		sug.SuggestedMin = defaultEstimateMin
+		return sug, nil
+	}
+	total := 0
+	for _, sample := range typed {
+		total += sample.LoggedMin
+	}
+	sug.Basis = app.EstimateBasisAverage
+	sug.SampleCount = len(typed)
+	sug.MeanMin = float64(total) / float64(len(typed))
+	sug.SuggestedMin = roundEstimate(sug.MeanMin)
+	return sug, nil
+}
+
+// roundEstimate rounds minutes to the nearest 5, never below 5.
+func roundEstimate(minutes float64) int {
+	return max(int(math.Round(minutes/5))*5, 5)
+}
+
+// summarizeRatios computes mean, population standard deviation, and range.
+func summarizeRatios(typ string, ratios []float64) app.EstimateAccuracy {
+	acc := app.EstimateAccuracy{Type: typ, Count: len(ratios)}
+	if len(ratios) == 0 {
+		return acc
+	}
+
+	acc.MinRatio, acc.MaxRatio = ratios[0], ratios[0]
+	var sum float64
+	for _, r := range ratios {
+		sum += r
+		acc.MinRatio = math.Min(acc.MinRatio, r)
+		acc.MaxRatio = math.Max(acc.MaxRatio, r)
+	}
+	acc.MeanRatio = sum / float64(len(ratios))
+
+	var sq float64
+	for _, r := range ratios {
+		d := r - acc.MeanRatio
+		sq += d * d
+	}
+	acc.StdDevRatio = math.Sqrt(sq / float64(len(ratios)))
+	return acc
+}
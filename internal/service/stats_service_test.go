@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats_EstimateAccuracyGroupsByType(t *testing.T) {
+	projects, nodes, workItems, _, sessions, _, _ := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Thesis")
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Chapter")
+	require.NoError(t, nodes.Create(ctx, node))
+
+	done := func(title, typ string, planned, logged int) {
+		wi := testutil.NewTestWorkItem(node.ID, title,
+			testutil.WithWorkItemType(typ),
+			testutil.WithPlannedMin(planned),
+			testutil.WithLoggedMin(logged),
+			testutil.WithWorkItemStatus(domain.WorkItemDone))
+		require.NoError(t, workItems.Create(ctx, wi))
+		require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(wi.ID, logged)))
+
+		// Re-estimation after the fact must not move the baseline.
+		wi.PlannedMin = logged
+		require.NoError(t, workItems.Update(ctx, wi))
+	}
+	done("Draft intro", "writing", 60, 90)
+	done("Draft body", "writing", 60, 120)
+	done("Read paper", "reading", 40, 40)
+	done("Unestimated", "reading", 0, 25)
+
+	svc := NewStatsService(workItems)
+	resp, err := svc.EstimateAccuracy(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, resp.ByType, 2)
+	writing := resp.ByType[0]
+	assert.Equal(t, "writing", writing.Type)
+	assert.Equal(t, 2, writing.Count)
+	assert.InDelta(t, 1.75, writing.MeanRatio, 0.001)
+	assert.InDelta(t, 0.25, writing.StdDevRatio, 0.001)
+	assert.InDelta(t, 1.5, writing.MinRatio, 0.001)
+	assert.InDelta(t, 2.0, writing.MaxRatio, 0.001)
+
+	reading := resp.ByType[1]
+	assert.Equal(t, "reading", reading.Type)
+	assert.Equal(t, 1, reading.Count)
+	assert.InDelta(t, 1.0, reading.MeanRatio, 0.001)
+
+	assert.Equal(t, 3, resp.Overall.Count)
+	assert.Equal(t, 1, resp.SkippedNoEstimate)
+}
+
+func TestStats_SuggestEstimate(t *testing.T) {
+	projects, nodes, workItems, _, sessions, _, _ := setupRepos(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Thesis")
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Chapter")
+	require.NoError(t, nodes.Create(ctx, node))
+
+	done := func(title, typ string, logged int, opts ...testutil.WorkItemOption) {
+		opts = append(opts,
+			testutil.WithWorkItemType(typ),
+			testutil.WithPlannedMin(60),
+			testutil.WithLoggedMin(logged),
+			testutil.WithWorkItemStatus(domain.WorkItemDone))
+		wi := testutil.NewTestWorkItem(node.ID, title, opts...)
+		require.NoError(t, workItems.Create(ctx, wi))
+		require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(wi.ID, logged)))
+	}
+	done("Draft intro", "writing", 90, testutil.WithUnits("pages", 2, 2))
+	done("Draft body", "writing", 150, testutil.WithUnits("pages", 4, 4))
+	done("Outline", "writing", 30)
+	done("Read paper", "reading", 40)
+	done("Read book", "reading", 52)
+
+	svc := NewStatsService(workItems)
+
+	sug, err := svc.SuggestEstimate(ctx, app.EstimateSuggestionRequest{Type: "writing", Units: 3, UnitLabel: "pages"})
+	require.NoError(t, err)
+	assert.Equal(t, app.EstimateBasisPerUnit, sug.Basis)
+	assert.Equal(t, 2, sug.SampleCount)
+	assert.InDelta(t, 40.0, sug.MinPerUnit, 0.001)
+	assert.Equal(t, 120, sug.SuggestedMin)
+
+	sug, err = svc.SuggestEstimate(ctx, app.EstimateSuggestionRequest{Type: "Reading"})
+	require.NoError(t, err)
+	assert.Equal(t, app.EstimateBasisAverage, sug.Basis)
+	assert.Equal(t, 2, sug.SampleCount)
+	assert.Equal(t, 45, sug.SuggestedMin, "mean 46m rounds to 45m")
+
+	sug, err = svc.SuggestEstimate(ctx, app.EstimateSuggestionRequest{Type: "writing", Units: 2, UnitLabel: "slides"})
+	require.NoError(t, err)
+	assert.Equal(t, app.EstimateBasisAverage, sug.Basis, "no slide history falls back to the type average")
+	assert.Equal(t, 3, sug.SampleCount)
+
+	sug, err = svc.SuggestEstimate(ctx, app.EstimateSuggestionRequest{Type: "exercise"})
+	require.NoError(t, err)
+	assert.Equal(t, app.EstimateBasisDefault, sug.Basis)
+	assert.Equal(t, defaultEstimateMin, sug.SuggestedMin)
+	assert.Zero(t, sug.SampleCount)
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -14,22 +16,31 @@ import (
 
 type statusService struct {
 	projects  repository.ProjectRepo
+	nodes     repository.PlanNodeRepo
 	workItems repository.WorkItemRepo
 	sessions  repository.SessionRepo
 	profiles  repository.UserProfileRepo
+	snapshots repository.RiskSnapshotRepo
 }
 
+// NewStatusService creates a StatusService. snapshots may be nil, in which
+// case no risk history is recorded and comparisons report "no history";
+// nodes may be nil, in which case no project reports a next milestone.
 func NewStatusService(
 	projects repository.ProjectRepo,
+	nodes repository.PlanNodeRepo,
 	workItems repository.WorkItemRepo,
 	sessions repository.SessionRepo,
 	profiles repository.UserProfileRepo,
+	snapshots repository.RiskSnapshotRepo,
 ) StatusService {
 	return &statusService{
 		projects:  projects,
+		nodes:     nodes,
 		workItems: workItems,
 		sessions:  sessions,
 		profiles:  profiles,
+		snapshots: snapshots,
 	}
 }
 
@@ -39,16 +50,16 @@ func (s *statusService) GetStatus(ctx context.Context, req app.StatusRequest) (*
 		now = *req.Now
 	}
 
-	days := req.IncludeRecentSessionDays
-	if days <= 0 {
-		days = 7
-	}
-
 	profile, err := s.profiles.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("loading user profile: %w", err)
 	}
 
+	days := req.IncludeRecentSessionDays
+	if days <= 0 {
+		days = profile.PaceWindow()
+	}
+
 	projects, err := s.projects.List(ctx, req.IncludeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("loading projects: %w", err)
@@ -61,14 +72,46 @@ func (s *statusService) GetStatus(ctx context.Context, req app.StatusRequest) (*
 		return nil, err
 	}
 
+	if req.Recalc {
+		if err := s.recordSnapshots(ctx, views, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.CompareTo != nil {
+		if err := s.attachDeltas(ctx, views, projects, *req.CompareTo); err != nil {
+			return nil, err
+		}
+	}
+
 	sortStatusViews(views)
 
 	return &app.StatusResponse{
-		Summary: buildStatusSummary(views, now),
+		Summary:  buildStatusSummary(views, now),
 		Projects: views,
+		Warnings: criticalSnoozeWarnings(projects, views, now),
 	}, nil
 }
 
+// criticalSnoozeWarnings reminds the user of each critical project whose
+// critical mode is snoozed (what-now --snooze-critical) until it expires.
+func criticalSnoozeWarnings(projects []*domain.Project, views []app.ProjectStatusView, now time.Time) []string {
+	critical := make(map[string]bool)
+	for _, v := range views {
+		if v.RiskLevel == domain.RiskCritical {
+			critical[v.ProjectID] = true
+		}
+	}
+	var warnings []string
+	for _, p := range projects {
+		if critical[p.ID] && p.CriticalSnoozed(now) {
+			warnings = append(warnings, fmt.Sprintf("'%s' is critical but snoozed until %s; its deadline is not being worked",
+				p.Name, p.CriticalSnoozedUntil.Local().Format("2006-01-02 15:04")))
+		}
+	}
+	return warnings
+}
+
 func (s *statusService) buildProjectViews(
 	ctx context.Context,
 	projects []*domain.Project,
@@ -82,7 +125,11 @@ func (s *statusService) buildProjectViews(
 			continue
 		}
 
-		snap, _, err := computeProjectRiskSnapshot(ctx, p, s.workItems, s.sessions, profile, days, now)
+		snap, items, err := computeProjectRiskSnapshot(ctx, p, s.workItems, s.sessions, profile, days, now)
+		if err != nil {
+			return nil, err
+		}
+		milestone, err := s.nextMilestone(ctx, p.ID, items, now)
 		if err != nil {
 			return nil, err
 		}
@@ -94,11 +141,11 @@ func (s *statusService) buildProjectViews(
 
 		var dueDateStr *string
 		if p.TargetDate != nil {
-			ds := p.TargetDate.Format("2006-01-02")
+			ds := domain.FormatDeadline(*p.TargetDate)
 			dueDateStr = &ds
 		}
 
-		views = append(views, app.ProjectStatusView{
+		view := app.ProjectStatusView{
 			ProjectID:             p.ID,
 			ProjectName:           p.Name,
 			Status:                p.Status,
@@ -107,6 +154,8 @@ func (s *statusService) buildProjectViews(
 			DaysLeft:              snap.Risk.DaysLeft,
 			ProgressTimePct:       snap.Risk.ProgressTimePct,
 			ProgressStructuralPct: structuralPct,
+			TimeElapsedPct:        math.Max(0, math.Min(100, snap.Metrics.TimeElapsedPct)),
+			WorkDonePct:           snap.Metrics.ProgressPct,
 			PlannedMinTotal:       snap.Metrics.PlannedMin,
 			LoggedMinTotal:        snap.Metrics.LoggedMin,
 			RemainingMinTotal:     snap.Risk.RemainingMin,
@@ -114,11 +163,114 @@ func (s *statusService) buildProjectViews(
 			RecentDailyMin:        snap.RecentDailyMin,
 			SlackMinPerDay:        snap.Risk.SlackMinPerDay,
 			SafeForSecondaryWork:  snap.Risk.Level == domain.RiskOnTrack,
-		})
+			Infeasible:            snap.Risk.Infeasible,
+			ShortfallMin:          snap.Risk.ShortfallMin,
+			NextMilestone:         milestone,
+		}
+		if p.NotStarted(now) {
+			markUpcoming(&view, p.StartDate)
+		}
+		views = append(views, view)
 	}
 	return views, nil
 }
 
+// nextMilestone finds the project's soonest assessment node with a due date
+// whose work is not all finished, counting items in its child nodes too. An
+// assessment with no items yet still counts, as nothing shows it is done.
+func (s *statusService) nextMilestone(ctx context.Context, projectID string, items []*domain.WorkItem, now time.Time) (*app.ProjectMilestone, error) {
+	if s.nodes == nil {
+		return nil, nil
+	}
+	nodes, err := s.nodes.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("loading nodes for project %s: %w", projectID, err)
+	}
+
+	children := make(map[string][]string)
+	for _, n := range nodes {
+		if n.ParentID != nil {
+			children[*n.ParentID] = append(children[*n.ParentID], n.ID)
+		}
+	}
+	itemsByNode := make(map[string][]*domain.WorkItem)
+	for _, it := range items {
+		if it.Status != domain.WorkItemArchived {
+			itemsByNode[it.NodeID] = append(itemsByNode[it.NodeID], it)
+		}
+	}
+
+	var next *domain.PlanNode
+	var nextStarted bool
+	for _, n := range nodes {
+		if n.Kind != domain.NodeAssessment || n.DueDate == nil {
+			continue
+		}
+		if next != nil && !n.DueDate.Before(*next.DueDate) {
+			continue
+		}
+		open, started := milestoneProgress(n.ID, children, itemsByNode)
+		if open {
+			next, nextStarted = n, started
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+	return &app.ProjectMilestone{
+		NodeID:   next.ID,
+		Title:    next.Title,
+		DueDate:  domain.FormatDeadline(*next.DueDate),
+		DaysLeft: int(math.Ceil(next.DueDate.Sub(now).Hours() / 24)),
+		Started:  nextStarted,
+	}, nil
+}
+
+// milestoneProgress walks a node's subtree and reports whether any of its
+// work is still open (or it has none) and whether any of it has begun.
+func milestoneProgress(nodeID string, children map[string][]string, itemsByNode map[string][]*domain.WorkItem) (open, started bool) {
+	total := 0
+	stack := []string{nodeID}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		stack = append(stack, children[id]...)
+		for _, it := range itemsByNode[id] {
+			total++
+			switch it.Status {
+			case domain.WorkItemTodo:
+				open = true
+			case domain.WorkItemInProgress:
+				open = true
+				started = true
+			case domain.WorkItemDone:
+				started = true
+			}
+			if it.LoggedMin > 0 {
+				started = true
+			}
+		}
+	}
+	return open || total == 0, started
+}
+
+// markUpcoming labels a view for a project that hasn't started yet. Its
+// time-based pace (required daily minutes, slack, days-behind risk) would be
+// measured against a timeline that hasn't begun, so it is reported as on
+// track with those figures cleared; work done early still shows as progress.
+func markUpcoming(v *app.ProjectStatusView, start time.Time) {
+	startsOn := start.Format("2006-01-02")
+	v.Upcoming = true
+	v.StartsOn = &startsOn
+	v.RiskLevel = domain.RiskOnTrack
+	v.TimeElapsedPct = 0
+	v.RequiredDailyMin = 0
+	v.SlackMinPerDay = 0
+	v.SafeForSecondaryWork = true
+	v.Infeasible = false
+	v.ShortfallMin = 0
+}
+
 func sortStatusViews(views []app.ProjectStatusView) {
 	sort.Slice(views, func(i, j int) bool {
 		ri := scheduler.RiskPriority(views[i].RiskLevel)
@@ -126,6 +278,9 @@ func sortStatusViews(views []app.ProjectStatusView) {
 		if ri != rj {
 			return ri < rj
 		}
+		if views[i].Upcoming != views[j].Upcoming {
+			return !views[i].Upcoming
+		}
 		if (views[i].DueDate == nil) != (views[j].DueDate == nil) {
 			return views[i].DueDate != nil
 		}
@@ -137,8 +292,12 @@ func sortStatusViews(views []app.ProjectStatusView) {
 }
 
 func buildStatusSummary(views []app.ProjectStatusView, now time.Time) app.GlobalStatusSummary {
-	var countOnTrack, countAtRisk, countCritical int
+	var countOnTrack, countAtRisk, countCritical, countUpcoming int
 	for _, v := range views {
+		if v.Upcoming {
+			countUpcoming++
+			continue
+		}
 		switch v.RiskLevel {
 		case domain.RiskOnTrack:
 			countOnTrack++
@@ -163,11 +322,86 @@ func buildStatusSummary(views []app.ProjectStatusView, now time.Time) app.Global
 
 	return app.GlobalStatusSummary{
 		GeneratedAt:     now,
-		CountsTotal:     countOnTrack + countAtRisk + countCritical,
+		CountsTotal:     countOnTrack + countAtRisk + countCritical + countUpcoming,
 		CountsOnTrack:   countOnTrack,
 		CountsAtRisk:    countAtRisk,
 		CountsCritical:  countCritical,
+		CountsUpcoming:  countUpcoming,
 		GlobalModeIfNow: globalMode,
 		PolicyMessage:   policyMsg,
 	}
 }
+
+// recordSnapshots upserts today's risk snapshot for every computed view so that
+// later status --compare calls have history to diff against.
+func (s *statusService) recordSnapshots(ctx context.Context, views []app.ProjectStatusView, now time.Time) error {
+	if s.snapshots == nil {
+		return nil
+	}
+	day := snapshotDay(now)
+	for _, v := range views {
+		err := s.snapshots.Upsert(ctx, &domain.RiskSnapshot{
+			ProjectID:             v.ProjectID,
+			SnapshotDate:          day,
+			RiskLevel:             v.RiskLevel,
+			ProgressTimePct:       v.ProgressTimePct,
+			ProgressStructuralPct: v.ProgressStructuralPct,
+			PlannedMin:            v.PlannedMinTotal,
+			LoggedMin:             v.LoggedMinTotal,
+			CreatedAt:             now,
+		})
+		if err != nil {
+			return fmt.Errorf("recording risk snapshot for %s: %w", v.ProjectName, err)
+		}
+	}
+	return nil
+}
+
+// attachDeltas fills in each view's Delta against the latest snapshot taken on
+// or before compareTo. Projects created after compareTo are marked new.
+func (s *statusService) attachDeltas(
+	ctx context.Context,
+	views []app.ProjectStatusView,
+	projects []*domain.Project,
+	compareTo time.Time,
+) error {
+	createdAt := make(map[string]time.Time, len(projects))
+	for _, p := range projects {
+		createdAt[p.ID] = p.CreatedAt
+	}
+
+	day := snapshotDay(compareTo)
+	for i := range views {
+		v := &views[i]
+		delta := &app.ProjectStatusDelta{}
+		v.Delta = delta
+
+		if created, ok := createdAt[v.ProjectID]; ok && snapshotDay(created).After(day) {
+			delta.IsNew = true
+			continue
+		}
+		if s.snapshots == nil {
+			continue
+		}
+
+		snap, err := s.snapshots.GetLatestOnOrBefore(ctx, v.ProjectID, day)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("loading risk snapshot for %s: %w", v.ProjectName, err)
+		}
+		delta.HasSnapshot = true
+		delta.SnapshotDate = snap.SnapshotDate
+		delta.RiskBefore = snap.RiskLevel
+		delta.ProgressTimePctBefore = snap.ProgressTimePct
+		delta.ProgressTimePctDelta = v.ProgressTimePct - snap.ProgressTimePct
+	}
+	return nil
+}
+
+// snapshotDay truncates t to its UTC calendar date.
+func snapshotDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
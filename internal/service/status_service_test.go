@@ -7,6 +7,7 @@ import (
 
 	"github.com/alexanderramin/kairos/internal/contract"
 	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
 	"github.com/alexanderramin/kairos/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,7 +33,7 @@ func TestStatus_CriticalProjectDetected(t *testing.T) {
 	)
 	require.NoError(t, workItems.Create(ctx, wi))
 
-	svc := NewStatusService(projects, workItems, sessions, profiles)
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 
@@ -71,7 +72,7 @@ func TestStatus_AllOnTrack_SafeForSecondary(t *testing.T) {
 	sess := testutil.NewTestSession(wi.ID, 30, testutil.WithStartedAt(now.Add(-24*time.Hour)))
 	require.NoError(t, sessions.Create(ctx, sess))
 
-	svc := NewStatusService(projects, workItems, sessions, profiles)
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 
@@ -104,7 +105,7 @@ func TestStatus_ArchivedProjectExcluded(t *testing.T) {
 	require.NoError(t, projects.Create(ctx, archived))
 	require.NoError(t, projects.Archive(ctx, archived.ID))
 
-	svc := NewStatusService(projects, workItems, sessions, profiles)
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 	req.IncludeArchived = false
@@ -142,7 +143,7 @@ func TestStatus_ProgressPctCanExceed100(t *testing.T) {
 	sess := testutil.NewTestSession(wi.ID, 30, testutil.WithStartedAt(now.Add(-24*time.Hour)))
 	require.NoError(t, sessions.Create(ctx, sess))
 
-	svc := NewStatusService(projects, workItems, sessions, profiles)
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 
@@ -186,7 +187,7 @@ func TestStatus_SortingOrder_CriticalBeforeOnTrack(t *testing.T) {
 	)
 	require.NoError(t, workItems.Create(ctx, wiCrit))
 
-	svc := NewStatusService(projects, workItems, sessions, profiles)
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
 	req := contract.NewStatusRequest()
 	req.Now = &now
 
@@ -196,3 +197,249 @@ func TestStatus_SortingOrder_CriticalBeforeOnTrack(t *testing.T) {
 	require.GreaterOrEqual(t, len(resp.Projects), 2)
 	assert.Equal(t, critical.ID, resp.Projects[0].ProjectID, "critical project should sort before on-track")
 }
+
+func TestStatus_CompareTo_ReportsDeltaAndNewProjects(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	projects := repository.NewSQLiteProjectRepo(database)
+	nodes := repository.NewSQLitePlanNodeRepo(database)
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	sessions := repository.NewSQLiteSessionRepo(database)
+	profiles := repository.NewSQLiteUserProfileRepo(database)
+	snapshots := repository.NewSQLiteRiskSnapshotRepo(database)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	compareTo := now.AddDate(0, 0, -7)
+
+	old := testutil.NewTestProject("Old Project", testutil.WithTargetDate(now.AddDate(0, 2, 0)))
+	old.CreatedAt = now.AddDate(0, 0, -30)
+	require.NoError(t, projects.Create(ctx, old))
+	oldNode := testutil.NewTestNode(old.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, oldNode))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(oldNode.ID, "Task",
+		testutil.WithPlannedMin(100), testutil.WithLoggedMin(40))))
+
+	unrecorded := testutil.NewTestProject("Unrecorded Project")
+	unrecorded.CreatedAt = now.AddDate(0, 0, -30)
+	require.NoError(t, projects.Create(ctx, unrecorded))
+
+	fresh := testutil.NewTestProject("Fresh Project")
+	fresh.CreatedAt = now.AddDate(0, 0, -2)
+	require.NoError(t, projects.Create(ctx, fresh))
+
+	require.NoError(t, snapshots.Upsert(ctx, &domain.RiskSnapshot{
+		ProjectID:       old.ID,
+		SnapshotDate:    snapshotDay(now.AddDate(0, 0, -10)),
+		RiskLevel:       domain.RiskCritical,
+		ProgressTimePct: 15,
+		CreatedAt:       now.AddDate(0, 0, -10),
+	}))
+
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, snapshots)
+	req := contract.NewStatusRequest()
+	req.Now = &now
+	req.CompareTo = &compareTo
+
+	resp, err := svc.GetStatus(ctx, req)
+	require.NoError(t, err)
+
+	byName := make(map[string]contract.ProjectStatusView)
+	for _, v := range resp.Projects {
+		byName[v.ProjectName] = v
+	}
+
+	oldView := byName["Old Project"]
+	require.NotNil(t, oldView.Delta)
+	assert.True(t, oldView.Delta.HasSnapshot)
+	assert.False(t, oldView.Delta.IsNew)
+	assert.Equal(t, domain.RiskCritical, oldView.Delta.RiskBefore)
+	assert.InDelta(t, 15, oldView.Delta.ProgressTimePctBefore, 0.001)
+	assert.InDelta(t, oldView.ProgressTimePct-15, oldView.Delta.ProgressTimePctDelta, 0.001)
+
+	require.NotNil(t, byName["Unrecorded Project"].Delta)
+	assert.False(t, byName["Unrecorded Project"].Delta.HasSnapshot)
+	assert.False(t, byName["Unrecorded Project"].Delta.IsNew)
+
+	require.NotNil(t, byName["Fresh Project"].Delta)
+	assert.True(t, byName["Fresh Project"].Delta.IsNew)
+
+	// The recalc pass recorded today's snapshot for every project.
+	today, err := snapshots.GetLatestOnOrBefore(ctx, old.ID, now)
+	require.NoError(t, err)
+	assert.Equal(t, snapshotDay(now), today.SnapshotDate)
+	assert.Equal(t, oldView.RiskLevel, today.RiskLevel)
+}
+
+func TestStatusAndWhatNow_ShareProfileDeadlineBuffer(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	proj := testutil.NewTestProject("Thesis", testutil.WithTargetDate(now.AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Draft")
+	require.NoError(t, nodes.Create(ctx, node))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Write",
+		testutil.WithPlannedMin(200),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.BufferPct = 0.25
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	statusReq := contract.NewStatusRequest()
+	statusReq.Now = &now
+	statusResp, err := NewStatusService(projects, nodes, workItems, sessions, profiles, nil).GetStatus(ctx, statusReq)
+	require.NoError(t, err)
+	require.Len(t, statusResp.Projects, 1)
+
+	whatNowReq := contract.NewWhatNowRequest(60)
+	whatNowReq.Now = &now
+	whatNowResp, err := NewWhatNowService(workItems, sessions, deps, profiles).Recommend(ctx, whatNowReq)
+	require.NoError(t, err)
+	require.NotEmpty(t, whatNowResp.TopRiskProjects)
+
+	assert.Equal(t, 250, statusResp.Projects[0].RemainingMinTotal, "200 * 1.25")
+	assert.Equal(t, statusResp.Projects[0].RemainingMinTotal, whatNowResp.TopRiskProjects[0].RemainingMinTotal)
+	assert.InDelta(t, statusResp.Projects[0].RequiredDailyMin, whatNowResp.TopRiskProjects[0].RequiredDailyMin, 0.01)
+}
+
+func TestStatusAndWhatNow_FlagInfeasibleDeadline(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	seed := func(name string, due time.Time, plannedMin int) {
+		proj := testutil.NewTestProject(name, testutil.WithTargetDate(due))
+		require.NoError(t, projects.Create(ctx, proj))
+		node := testutil.NewTestNode(proj.ID, "Draft")
+		require.NoError(t, nodes.Create(ctx, node))
+		require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Write",
+			testutil.WithPlannedMin(plannedMin),
+			testutil.WithSessionBounds(15, 60, 30),
+		)))
+	}
+	seed("Essay", now.AddDate(0, 0, 2), 300)
+	seed("Thesis", now.AddDate(0, 2, 0), 300)
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultMaxDailyMin, profile.MaxDailyMin, "migration default")
+	profile.MaxDailyMin = 60
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	statusReq := contract.NewStatusRequest()
+	statusReq.Now = &now
+	statusResp, err := NewStatusService(projects, nodes, workItems, sessions, profiles, nil).GetStatus(ctx, statusReq)
+	require.NoError(t, err)
+	byName := make(map[string]contract.ProjectStatusView)
+	for _, v := range statusResp.Projects {
+		byName[v.ProjectName] = v
+	}
+	assert.True(t, byName["Essay"].Infeasible, "300m cannot fit in 2 days at 60m a day")
+	assert.Equal(t, 180, byName["Essay"].ShortfallMin)
+	assert.False(t, byName["Thesis"].Infeasible)
+
+	whatNowReq := contract.NewWhatNowRequest(60)
+	whatNowReq.Now = &now
+	whatNowResp, err := NewWhatNowService(workItems, sessions, deps, profiles).Recommend(ctx, whatNowReq)
+	require.NoError(t, err)
+	for _, rs := range whatNowResp.TopRiskProjects {
+		assert.Equal(t, byName[rs.ProjectName].Infeasible, rs.Infeasible, rs.ProjectName)
+		assert.Equal(t, byName[rs.ProjectName].ShortfallMin, rs.ShortfallMin, rs.ProjectName)
+	}
+}
+
+func TestStatus_NotStartedProjectIsUpcoming(t *testing.T) {
+	projects, nodes, workItems, _, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	start := now.AddDate(0, 0, 10)
+
+	proj := testutil.NewTestProject("Next Term",
+		testutil.WithStartDate(start), testutil.WithTargetDate(now.AddDate(0, 0, 12)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Pre-reading",
+		testutil.WithPlannedMin(1200),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
+	req := contract.NewStatusRequest()
+	req.Now = &now
+
+	resp, err := svc.GetStatus(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Projects, 1)
+	v := resp.Projects[0]
+	assert.True(t, v.Upcoming)
+	require.NotNil(t, v.StartsOn)
+	assert.Equal(t, start.Format("2006-01-02"), *v.StartsOn)
+	assert.Equal(t, domain.RiskOnTrack, v.RiskLevel, "no pace is due before the start date")
+	assert.Zero(t, v.RequiredDailyMin)
+	assert.False(t, v.Infeasible)
+	assert.Equal(t, 1, resp.Summary.CountsUpcoming)
+	assert.Zero(t, resp.Summary.CountsOnTrack)
+	assert.Equal(t, 1, resp.Summary.CountsTotal)
+	assert.Equal(t, domain.ModeBalanced, resp.Summary.GlobalModeIfNow)
+
+	// Once the start date passes, pace applies as usual.
+	later := start.Add(time.Hour)
+	req.Now = &later
+	resp, err = svc.GetStatus(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, resp.Projects[0].Upcoming)
+	assert.Equal(t, domain.RiskCritical, resp.Projects[0].RiskLevel)
+}
+
+func TestStatus_NextMilestoneIsSoonestOpenAssessment(t *testing.T) {
+	projects, nodes, workItems, _, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	proj := testutil.NewTestProject("Physics", testutil.WithTargetDate(now.AddDate(0, 4, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+
+	addAssessment := func(title string, dueIn int, status domain.WorkItemStatus, loggedMin int) *domain.PlanNode {
+		n := testutil.NewTestNode(proj.ID, title,
+			testutil.WithNodeKind(domain.NodeAssessment),
+			testutil.WithNodeDueDate(now.AddDate(0, 0, dueIn)))
+		require.NoError(t, nodes.Create(ctx, n))
+		require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(n.ID, title+" prep",
+			testutil.WithPlannedMin(120),
+			testutil.WithLoggedMin(loggedMin),
+			testutil.WithWorkItemStatus(status))))
+		return n
+	}
+	addAssessment("TMA 01", 3, domain.WorkItemDone, 120)
+	tma02 := addAssessment("TMA 02", 20, domain.WorkItemInProgress, 30)
+	addAssessment("Exam", 60, domain.WorkItemTodo, 0)
+
+	// A week node due sooner is not a milestone.
+	week := testutil.NewTestNode(proj.ID, "Week 1", testutil.WithNodeDueDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, nodes.Create(ctx, week))
+
+	svc := NewStatusService(projects, nodes, workItems, sessions, profiles, nil)
+	req := contract.NewStatusRequest()
+	req.Now = &now
+
+	resp, err := svc.GetStatus(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Projects, 1)
+	m := resp.Projects[0].NextMilestone
+	require.NotNil(t, m, "the done TMA 01 is skipped for the open TMA 02")
+	assert.Equal(t, tma02.ID, m.NodeID)
+	assert.Equal(t, "TMA 02", m.Title)
+	assert.Equal(t, 20, m.DaysLeft)
+	assert.True(t, m.Started)
+
+	// Without nodes wired in there is no milestone to report.
+	resp, err = NewStatusService(projects, nil, workItems, sessions, profiles, nil).GetStatus(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, resp.Projects[0].NextMilestone)
+}
@@ -113,3 +113,38 @@ func TestTemplateService_DirectoryWithSubdirectories(t *testing.T) {
 	assert.Len(t, list, 1, "should only list root-level templates")
 	assert.Equal(t, "Root Template", list[0].Name)
 }
+
+// TestTemplateService_Validate reports every problem in a template file, and
+// resolves installed template refs, without needing a database.
+func TestTemplateService_Validate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "good.json"), []byte(`{
+		"id": "good", "name": "Good", "version": "1.0.0", "domain": "fitness",
+		"nodes": [{"id": "n1", "title": "Block", "kind": "stage"}],
+		"work_items": [{"id": "w1", "node_id": "n1", "title": "Run", "type": "workout"}]
+	}`), 0644))
+	badPath := filepath.Join(t.TempDir(), "bad.json")
+	require.NoError(t, os.WriteFile(badPath, []byte(`{
+		"id": "bad", "name": "Bad", "version": "1.0.0",
+		"nodes": [{"id": "n1", "title": "Block", "kind": "phase"}],
+		"work_items": [{"id": "w1", "node_id": "n1", "title": "Run"}]
+	}`), 0644))
+
+	svc := NewTemplateService(dir, nil)
+
+	result, err := svc.Validate(context.Background(), badPath)
+	require.NoError(t, err)
+	assert.False(t, result.Valid())
+	assert.Contains(t, result.Errors, "template domain is required")
+	assert.Contains(t, result.Errors, `node[0]: invalid kind "phase"`)
+	assert.Contains(t, result.Errors, "work_item[0]: type is required")
+
+	result, err = svc.Validate(context.Background(), "good")
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Equal(t, filepath.Join(dir, "good.json"), result.Path)
+	assert.Len(t, result.Warnings, 1)
+
+	_, err = svc.Validate(context.Background(), filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -168,6 +169,33 @@ func (s *templateService) InitProject(ctx context.Context, templateName, project
 	return project, nil
 }
 
+func (s *templateService) Validate(ctx context.Context, path string) (*TemplateValidation, error) {
+	// Fall back to installed templates so `template validate <ref>` works too.
+	if _, statErr := os.Stat(path); statErr != nil {
+		entry, err := s.resolveTemplate(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading template: %w", statErr)
+		}
+		path = entry.Path
+	}
+
+	schema, err := tmpl.LoadSchema(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading template %s: %w", path, err)
+	}
+
+	result := &TemplateValidation{
+		Path:     path,
+		ID:       schema.ID,
+		Name:     schema.Name,
+		Warnings: tmpl.WorkItemTypeWarnings(schema),
+	}
+	for _, e := range tmpl.ValidateSchema(schema) {
+		result.Errors = append(result.Errors, e.Error())
+	}
+	return result, nil
+}
+
 func (s *templateService) resolveTemplate(name string) (*templateEntry, error) {
 	input := strings.TrimSpace(name)
 	if input == "" {
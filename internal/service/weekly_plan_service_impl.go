@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/scheduler"
+)
+
+const (
+	// weeklyPlanDays is how many days weekly plan covers, today included.
+	weeklyPlanDays = 7
+	// weeklyPlanMaxSlices caps the slices per simulated day. A whole day
+	// holds more sessions than a single what-now suggestion.
+	weeklyPlanMaxSlices = 6
+)
+
+type weeklyPlanService struct {
+	loader   *ContextLoader
+	resolver *BlockResolver
+	profiles repository.UserProfileRepo
+}
+
+func NewWeeklyPlanService(
+	workItems repository.WorkItemRepo,
+	sessions repository.SessionRepo,
+	deps repository.DependencyRepo,
+	profiles repository.UserProfileRepo,
+) WeeklyPlanService {
+	return &weeklyPlanService{
+		loader: &ContextLoader{
+			workItems: workItems,
+			sessions:  sessions,
+			profiles:  profiles,
+		},
+		resolver: &BlockResolver{deps: deps},
+		profiles: profiles,
+	}
+}
+
+func (s *weeklyPlanService) Plan(ctx context.Context, now time.Time) (*app.WeeklyPlan, error) {
+	profile, err := s.profiles.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading user profile: %w", err)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	plan := &app.WeeklyPlan{}
+	for i := range weeklyPlanDays {
+		day := today.AddDate(0, 0, i)
+		plan.Days = append(plan.Days, app.WeeklyPlanDay{Date: day, AvailableMin: profile.AvailableMinOn(day.Weekday())})
+	}
+	if plan.AvailableMin() <= 0 {
+		return nil, fmt.Errorf("no time available this week (set it with: profile set availability=...)")
+	}
+
+	rctx, err := s.loader.Load(ctx, app.WhatNowRequest{AvailableMin: plan.AvailableMin(), Now: &now})
+	var wnErr *app.WhatNowError
+	if errors.As(err, &wnErr) && wnErr.Code == app.ErrNoCandidates {
+		return plan, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Simulated sessions lower each item's remaining work linearly, so
+	// unit-based estimates are fixed up front as a plain planned total.
+	remaining := make(map[string]int)
+	plan.ProjectNames = make(map[string]string)
+	for i := range rctx.Candidates {
+		c := &rctx.Candidates[i]
+		plan.ProjectNames[c.ProjectID] = c.ProjectName
+		w := &c.WorkItem
+		left := scheduler.RemainingMin(w.PlannedMin, w.LoggedMin, w.UnitsTotal, w.UnitsDone)
+		w.PlannedMin = w.LoggedMin + max(left, 0)
+		w.UnitsTotal, w.UnitsDone = 0, 0
+		remaining[c.ProjectID] += max(left, 0)
+	}
+	candidates := rctx.Candidates
+
+	// Dependencies are resolved in memory: an item the plan finishes on one
+	// day unblocks its successors from the next, though it is not done yet.
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.WorkItem.ID
+	}
+	preds, err := s.resolver.deps.ListUnfinishedPredecessors(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("checking dependencies: %w", err)
+	}
+	finished := make(map[string]bool)
+
+	// Each day sees the sessions before it, real or planned, within the
+	// profile's pace window and spacing look-back.
+	history, err := s.loader.sessions.ListRecent(ctx, max(rctx.PaceWindowDays, rctx.SpacingLookbackDays))
+	if err != nil {
+		return nil, fmt.Errorf("loading recent sessions: %w", err)
+	}
+	scheduledByDue := make(map[string]int)
+	for i := range plan.Days {
+		day := &plan.Days[i]
+		if day.AvailableMin <= 0 {
+			continue
+		}
+		dayNow := day.Date
+		if i == 0 {
+			dayNow = now
+		}
+		// Items the plan has finished drop out, as done items leave what-now.
+		rctx.Candidates = openCandidates(rctx.Candidates, finished)
+		if len(rctx.Candidates) == 0 {
+			break
+		}
+		byID := make(map[string]*repository.SchedulableCandidate, len(rctx.Candidates))
+		for j := range rctx.Candidates {
+			byID[rctx.Candidates[j].WorkItem.ID] = &rctx.Candidates[j]
+		}
+		rctx.Now = dayNow
+		rctx.RecentMin, rctx.LastSessionAt = sessionAggregates(history,
+			day.Date.AddDate(0, 0, -rctx.PaceWindowDays), day.Date.AddDate(0, 0, -rctx.SpacingLookbackDays))
+
+		agg := ComputeAggregates(rctx)
+		unblocked, _ := resolveBlocks(rctx.Candidates, blockedBy(preds, finished), dayNow)
+		scored := ScoreCandidates(unblocked, rctx.LastSessionAt, agg, rctx.Weights, DetermineMode(agg), dayNow)
+		scheduler.CanonicalSort(scored)
+		day.Slices, _ = scheduler.AllocateSlices(scored, day.AvailableMin, weeklyPlanMaxSlices, 0, 0, true)
+		fillDay(day, byID)
+
+		for _, sl := range day.Slices {
+			c := byID[sl.WorkItemID]
+			c.WorkItem.LoggedMin += sl.AllocatedMin
+			// Without an estimate there is nothing to work through: the
+			// item's one session finishes it as far as the plan can tell.
+			if c.WorkItem.PlannedMin <= 0 || c.WorkItem.LoggedMin >= c.WorkItem.PlannedMin {
+				finished[sl.WorkItemID] = true
+			}
+			history = append(history, &domain.WorkSessionLog{
+				WorkItemID: sl.WorkItemID,
+				StartedAt:  day.Date,
+				Minutes:    sl.AllocatedMin,
+			})
+			if c.ProjectTargetDate != nil && deadlineDay(*c.ProjectTargetDate) >= day.Date.Format(domain.DeadlineDateLayout) {
+				scheduledByDue[c.ProjectID] += sl.AllocatedMin
+			}
+		}
+	}
+
+	plan.Infeasible = infeasibleProjects(candidates, remaining, scheduledByDue, plan.Days[len(plan.Days)-1].Date)
+	return plan, nil
+}
+
+// fillDay hands time the allocation left over to the day's slices in order,
+// each up to its max session and its item's remaining work. What-now stops
+// at one default-length session per item; a planned day can do more. Items
+// without an estimate keep their allocated session.
+func fillDay(day *app.WeeklyPlanDay, byID map[string]*repository.SchedulableCandidate) {
+	spare := day.AvailableMin - day.AllocatedMin()
+	for i := range day.Slices {
+		if spare <= 0 {
+			return
+		}
+		sl := &day.Slices[i]
+		w := byID[sl.WorkItemID].WorkItem
+		if w.PlannedMin <= 0 {
+			continue
+		}
+		ceiling := min(sl.MaxSessionMin, w.PlannedMin-w.LoggedMin)
+		if extra := min(ceiling-sl.AllocatedMin, spare); extra > 0 {
+			sl.AllocatedMin += extra
+			spare -= extra
+		}
+	}
+}
+
+// infeasibleProjects lists projects due on or before lastDay whose remaining
+// work exceeds what was scheduled by their deadline, soonest deadline first.
+func infeasibleProjects(
+	candidates []repository.SchedulableCandidate,
+	remaining, scheduledByDue map[string]int,
+	lastDay time.Time,
+) []app.InfeasibleProject {
+	seen := make(map[string]bool)
+	var out []app.InfeasibleProject
+	for _, c := range candidates {
+		if seen[c.ProjectID] || c.ProjectTargetDate == nil {
+			continue
+		}
+		seen[c.ProjectID] = true
+		if deadlineDay(*c.ProjectTargetDate) > lastDay.Format(domain.DeadlineDateLayout) {
+			continue
+		}
+		p := app.InfeasibleProject{
+			ProjectID:    c.ProjectID,
+			ProjectName:  c.ProjectName,
+			DueDate:      *c.ProjectTargetDate,
+			RemainingMin: remaining[c.ProjectID],
+			ScheduledMin: scheduledByDue[c.ProjectID],
+		}
+		if p.ShortfallMin() > 0 {
+			out = append(out, p)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].DueDate.Before(out[j].DueDate) })
+	return out
+}
+
+// deadlineDay is the calendar date of a deadline as YYYY-MM-DD: the stored
+// date for date-only deadlines, the local date for ones with a time of day.
+func deadlineDay(t time.Time) string {
+	return domain.FormatDeadline(t)[:len(domain.DeadlineDateLayout)]
+}
+
+// openCandidates returns a copy of candidates without items whose planned
+// work is fully logged or that the plan has finished.
+func openCandidates(candidates []repository.SchedulableCandidate, finished map[string]bool) []repository.SchedulableCandidate {
+	var out []repository.SchedulableCandidate
+	for _, c := range candidates {
+		if finished[c.WorkItem.ID] || (c.WorkItem.PlannedMin > 0 && c.WorkItem.LoggedMin >= c.WorkItem.PlannedMin) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// blockedBy returns the items with an unfinished predecessor the plan has not
+// finished yet.
+func blockedBy(preds map[string][]string, finished map[string]bool) map[string]bool {
+	blocked := make(map[string]bool)
+	for id, ps := range preds {
+		for _, p := range ps {
+			if !finished[p] {
+				blocked[id] = true
+				break
+			}
+		}
+	}
+	return blocked
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeeklyPlan_SpreadsWorkAndFlagsInfeasible(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC) // Monday
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.WeekdayMin = []int{60, 60, 60, 60, 60, 0, 0}
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	// Due Wednesday with more work than three 60m days can hold.
+	urgent := testutil.NewTestProject("Essay", testutil.WithTargetDate(time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, projects.Create(ctx, urgent))
+	urgentNode := testutil.NewTestNode(urgent.ID, "Draft")
+	require.NoError(t, nodes.Create(ctx, urgentNode))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(urgentNode.ID, "Write",
+		testutil.WithPlannedMin(240), testutil.WithSessionBounds(15, 60, 30))))
+
+	later := testutil.NewTestProject("Reading", testutil.WithTargetDate(now.AddDate(0, 2, 0)))
+	require.NoError(t, projects.Create(ctx, later))
+	laterNode := testutil.NewTestNode(later.ID, "Book")
+	require.NoError(t, nodes.Create(ctx, laterNode))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(laterNode.ID, "Read",
+		testutil.WithPlannedMin(90), testutil.WithSessionBounds(15, 60, 30))))
+
+	plan, err := NewWeeklyPlanService(workItems, sessions, deps, profiles).Plan(ctx, now)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Days, 7)
+	assert.Equal(t, time.Monday, plan.Days[0].Date.Weekday())
+	assert.Equal(t, 300, plan.AvailableMin())
+	for _, d := range plan.Days {
+		assert.LessOrEqual(t, d.AllocatedMin(), d.AvailableMin, d.Date.Weekday().String())
+		for _, sl := range d.Slices {
+			assert.LessOrEqual(t, sl.AllocatedMin, 60, "session bounds hold")
+		}
+	}
+	assert.Empty(t, plan.Days[5].Slices, "no time on Saturday")
+
+	perProject := make(map[string]int)
+	for _, d := range plan.Days {
+		for _, sl := range d.Slices {
+			perProject[sl.ProjectID] += sl.AllocatedMin
+		}
+	}
+	assert.Equal(t, 240, perProject[urgent.ID], "the overdue essay keeps going after Wednesday")
+	assert.Equal(t, 60, perProject[later.ID], "reading starts once the critical essay is done")
+	assert.Equal(t, 300, plan.AllocatedMin(), "enough open work to fill every available minute")
+
+	require.Len(t, plan.Infeasible, 1)
+	inf := plan.Infeasible[0]
+	assert.Equal(t, urgent.ID, inf.ProjectID)
+	assert.Equal(t, 240, inf.RemainingMin)
+	assert.Equal(t, 180, inf.ScheduledMin, "only Monday to Wednesday count")
+	assert.Equal(t, 60, inf.ShortfallMin())
+}
+
+func TestWeeklyPlan_FinishedPredecessorsUnblockSuccessors(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC) // Monday
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.WeekdayMin = []int{120, 120, 120, 120, 120, 0, 0}
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	// Due Friday: three chained 60m items fit easily, one a day.
+	course := testutil.NewTestProject("Course", testutil.WithTargetDate(time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, projects.Create(ctx, course))
+	node := testutil.NewTestNode(course.ID, "Module 1")
+	require.NoError(t, nodes.Create(ctx, node))
+	var chain []string
+	for _, title := range []string{"Lecture 1", "Lecture 2", "Lecture 3"} {
+		wi := testutil.NewTestWorkItem(node.ID, title,
+			testutil.WithPlannedMin(60), testutil.WithSessionBounds(15, 60, 60))
+		require.NoError(t, workItems.Create(ctx, wi))
+		if len(chain) > 0 {
+			require.NoError(t, deps.Create(ctx, &domain.Dependency{
+				PredecessorWorkItemID: chain[len(chain)-1],
+				SuccessorWorkItemID:   wi.ID,
+			}))
+		}
+		chain = append(chain, wi.ID)
+	}
+
+	// An item without an estimate is planned once, not every day.
+	misc := testutil.NewTestProject("Misc")
+	require.NoError(t, projects.Create(ctx, misc))
+	miscNode := testutil.NewTestNode(misc.ID, "Inbox")
+	require.NoError(t, nodes.Create(ctx, miscNode))
+	open := testutil.NewTestWorkItem(miscNode.ID, "Tidy notes",
+		testutil.WithPlannedMin(0), testutil.WithSessionBounds(15, 60, 30))
+	require.NoError(t, workItems.Create(ctx, open))
+
+	plan, err := NewWeeklyPlanService(workItems, sessions, deps, profiles).Plan(ctx, now)
+	require.NoError(t, err)
+
+	planned := make(map[string][]int) // work item → day indexes
+	minutes := make(map[string]int)
+	for i, d := range plan.Days {
+		for _, sl := range d.Slices {
+			planned[sl.WorkItemID] = append(planned[sl.WorkItemID], i)
+			minutes[sl.WorkItemID] += sl.AllocatedMin
+		}
+	}
+	for i, id := range chain {
+		assert.Equal(t, []int{i}, planned[id], "lecture %d follows the one before it", i+1)
+		assert.Equal(t, 60, minutes[id])
+	}
+	assert.Len(t, planned[open.ID], 1, "planned once")
+	assert.Equal(t, 30, minutes[open.ID], "no top-up past the default session without an estimate")
+	assert.Empty(t, plan.Infeasible, "the chain fits before Friday")
+}
+
+func TestWeeklyPlan_NoWorkAndNoTime(t *testing.T) {
+	_, _, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	svc := NewWeeklyPlanService(workItems, sessions, deps, profiles)
+
+	plan, err := svc.Plan(ctx, now)
+	require.NoError(t, err, "no open work is an empty plan, not an error")
+	assert.Len(t, plan.Days, 7)
+	assert.Zero(t, plan.AllocatedMin())
+	assert.Equal(t, 7*30, plan.AvailableMin(), "baseline-daily when no weekly availability is set")
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.WeekdayMin = make([]int, 7)
+	require.NoError(t, profiles.Upsert(ctx, profile))
+	_, err = svc.Plan(ctx, now)
+	require.Error(t, err)
+}
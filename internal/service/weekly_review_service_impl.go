@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/scheduler"
+)
+
+const (
+	// weeklyReviewDays is the period a weekly review looks back over.
+	weeklyReviewDays = 7
+	// weeklyReviewMaxActions caps the next-week action list.
+	weeklyReviewMaxActions = 5
+)
+
+type weeklyReviewService struct {
+	status    StatusService
+	weekly    WeeklyPlanService
+	workItems repository.WorkItemRepo
+	sessions  repository.SessionRepo
+}
+
+func NewWeeklyReviewService(
+	status StatusService,
+	weekly WeeklyPlanService,
+	workItems repository.WorkItemRepo,
+	sessions repository.SessionRepo,
+) WeeklyReviewService {
+	return &weeklyReviewService{status: status, weekly: weekly, workItems: workItems, sessions: sessions}
+}
+
+func (s *weeklyReviewService) Review(ctx context.Context, req app.WeeklyReviewRequest) (*app.WeeklyReview, error) {
+	now := time.Now().UTC()
+	if req.Now != nil {
+		now = *req.Now
+	}
+	from := now.AddDate(0, 0, -weeklyReviewDays)
+	review := &app.WeeklyReview{From: from, To: now}
+
+	statusReq := app.NewStatusRequest()
+	statusReq.Now = &now
+	statusReq.CompareTo = &from
+	status, err := s.status.GetStatus(ctx, statusReq)
+	if err != nil {
+		return nil, fmt.Errorf("getting status: %w", err)
+	}
+
+	// Map each item to its project so sessions can be counted per project.
+	projectOf := make(map[string]int)
+	for i, p := range status.Projects {
+		review.Projects = append(review.Projects, app.WeeklyReviewProject{
+			ProjectID:   p.ProjectID,
+			ProjectName: p.ProjectName,
+			RiskLevel:   p.RiskLevel,
+			PlannedMin:  p.PlannedMinTotal,
+		})
+		items, err := s.workItems.ListByProject(ctx, p.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("listing work items: %w", err)
+		}
+		for _, w := range items {
+			projectOf[w.ID] = i
+			if w.CompletedAt != nil && !w.CompletedAt.Before(from) && w.CompletedAt.Before(now) {
+				review.Completed = append(review.Completed, app.WeeklyReviewItem{
+					ProjectName: p.ProjectName,
+					WorkItemSeq: w.Seq,
+					Title:       w.Title,
+					CompletedAt: *w.CompletedAt,
+				})
+			}
+		}
+
+		if r, ok := worsenedRisk(p); ok {
+			review.Worsened = append(review.Worsened, r)
+		}
+	}
+	sort.SliceStable(review.Completed, func(i, j int) bool {
+		return review.Completed[i].CompletedAt.Before(review.Completed[j].CompletedAt)
+	})
+	sort.SliceStable(review.Worsened, func(i, j int) bool {
+		return scheduler.RiskPriority(review.Worsened[i].RiskLevel) < scheduler.RiskPriority(review.Worsened[j].RiskLevel)
+	})
+
+	// ListRecent counts back from the wall clock, not from now.
+	sessions, err := s.sessions.ListRecent(ctx, int(time.Since(from).Hours()/24)+2)
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess.StartedAt.Before(from) || !sess.StartedAt.Before(now) {
+			continue
+		}
+		review.LoggedMin += sess.Minutes
+		review.SessionCount++
+		if i, ok := projectOf[sess.WorkItemID]; ok {
+			review.Projects[i].LoggedMin += sess.Minutes
+			review.Projects[i].Sessions++
+		}
+	}
+	sort.SliceStable(review.Projects, func(i, j int) bool {
+		return review.Projects[i].LoggedMin > review.Projects[j].LoggedMin
+	})
+
+	plan, err := s.weekly.Plan(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("planning next week: %w", err)
+	}
+	review.NextWeek = nextWeekActions(plan, weeklyReviewMaxActions)
+	return review, nil
+}
+
+// worsenedRisk reports a project whose risk level rose since the comparison
+// snapshot, or whose deadline is out of reach at the max daily minutes.
+func worsenedRisk(p app.ProjectStatusView) (app.WeeklyReviewRisk, bool) {
+	r := app.WeeklyReviewRisk{
+		ProjectID:    p.ProjectID,
+		ProjectName:  p.ProjectName,
+		DueDate:      p.DueDate,
+		RiskLevel:    p.RiskLevel,
+		Infeasible:   p.Infeasible,
+		ShortfallMin: p.ShortfallMin,
+	}
+	rose := false
+	if p.Delta != nil && p.Delta.HasSnapshot {
+		r.RiskBefore = p.Delta.RiskBefore
+		rose = scheduler.RiskPriority(p.RiskLevel) < scheduler.RiskPriority(p.Delta.RiskBefore)
+	}
+	return r, rose || p.Infeasible
+}
+
+// nextWeekActions merges a weekly plan's slices per work item, keeping the
+// order in which the plan first schedules each, up to limit items.
+func nextWeekActions(plan *app.WeeklyPlan, limit int) []app.WeeklyReviewAction {
+	var out []app.WeeklyReviewAction
+	index := make(map[string]int)
+	for _, d := range plan.Days {
+		for _, sl := range d.Slices {
+			i, ok := index[sl.WorkItemID]
+			if !ok {
+				if len(out) == limit {
+					continue
+				}
+				i = len(out)
+				index[sl.WorkItemID] = i
+				out = append(out, app.WeeklyReviewAction{
+					WorkItemID:  sl.WorkItemID,
+					WorkItemSeq: sl.WorkItemSeq,
+					Title:       sl.Title,
+					ProjectName: plan.ProjectNames[sl.ProjectID],
+				})
+			}
+			a := &out[i]
+			if len(a.Days) == 0 || !a.Days[len(a.Days)-1].Equal(d.Date) {
+				a.Days = append(a.Days, d.Date)
+			}
+			a.Minutes += sl.AllocatedMin
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeeklyReview_LooksBackAndPlansAhead(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	projects := repository.NewSQLiteProjectRepo(database)
+	nodes := repository.NewSQLitePlanNodeRepo(database)
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	deps := repository.NewSQLiteDependencyRepo(database)
+	sessions := repository.NewSQLiteSessionRepo(database)
+	profiles := repository.NewSQLiteUserProfileRepo(database)
+	snapshots := repository.NewSQLiteRiskSnapshotRepo(database)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	essay := testutil.NewTestProject("Essay", testutil.WithTargetDate(now.AddDate(0, 0, 3)))
+	essay.CreatedAt = now.AddDate(0, 0, -30)
+	require.NoError(t, projects.Create(ctx, essay))
+	node := testutil.NewTestNode(essay.ID, "Draft")
+	require.NoError(t, nodes.Create(ctx, node))
+	write := testutil.NewTestWorkItem(node.ID, "Write",
+		testutil.WithPlannedMin(600), testutil.WithSessionBounds(15, 60, 30))
+	require.NoError(t, workItems.Create(ctx, write))
+	outline := testutil.NewTestWorkItem(node.ID, "Outline",
+		testutil.WithPlannedMin(60), testutil.WithWorkItemStatus(domain.WorkItemDone))
+	finished := now.AddDate(0, 0, -2)
+	outline.CompletedAt = &finished
+	require.NoError(t, workItems.Create(ctx, outline))
+	stale := testutil.NewTestWorkItem(node.ID, "Research",
+		testutil.WithPlannedMin(30), testutil.WithWorkItemStatus(domain.WorkItemDone))
+	longAgo := now.AddDate(0, 0, -12)
+	stale.CompletedAt = &longAgo
+	require.NoError(t, workItems.Create(ctx, stale))
+
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(outline.ID, 45,
+		testutil.WithStartedAt(now.AddDate(0, 0, -3)))))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(stale.ID, 30,
+		testutil.WithStartedAt(now.AddDate(0, 0, -10)))))
+
+	// On track a week ago; behind now with the deadline three days out.
+	require.NoError(t, snapshots.Upsert(ctx, &domain.RiskSnapshot{
+		ProjectID:    essay.ID,
+		SnapshotDate: snapshotDay(now.AddDate(0, 0, -8)),
+		RiskLevel:    domain.RiskOnTrack,
+		CreatedAt:    now.AddDate(0, 0, -8),
+	}))
+
+	svc := NewWeeklyReviewService(
+		NewStatusService(projects, nodes, workItems, sessions, profiles, snapshots),
+		NewWeeklyPlanService(workItems, sessions, deps, profiles),
+		workItems, sessions,
+	)
+	review, err := svc.Review(ctx, app.WeeklyReviewRequest{Now: &now})
+	require.NoError(t, err)
+
+	assert.Equal(t, now.AddDate(0, 0, -7), review.From)
+	assert.Equal(t, 45, review.LoggedMin, "the session before the period is left out")
+	assert.Equal(t, 1, review.SessionCount)
+	require.Len(t, review.Projects, 1)
+	assert.Equal(t, 45, review.Projects[0].LoggedMin)
+	assert.Equal(t, 1, review.Projects[0].Sessions)
+
+	require.Len(t, review.Completed, 1)
+	assert.Equal(t, "Outline", review.Completed[0].Title)
+
+	require.Len(t, review.Worsened, 1)
+	assert.Equal(t, domain.RiskOnTrack, review.Worsened[0].RiskBefore)
+	assert.NotEqual(t, domain.RiskOnTrack, review.Worsened[0].RiskLevel)
+	assert.False(t, review.Worsened[0].Infeasible, "10h fits in three days at max-daily")
+
+	require.Len(t, review.NextWeek, 1)
+	next := review.NextWeek[0]
+	assert.Equal(t, write.ID, next.WorkItemID)
+	assert.Equal(t, "Essay", next.ProjectName)
+	assert.NotEmpty(t, next.Days)
+	assert.Positive(t, next.Minutes)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alexanderramin/kairos/internal/app"
+	"github.com/alexanderramin/kairos/internal/db"
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+	"github.com/alexanderramin/kairos/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedLargeDB fills database with projects × itemsPerProject schedulable
+// items, a recent session on every third item and a dependency chain on
+// every fifth, approximating a long-time user's database.
+func seedLargeDB(tb testing.TB, database *sql.DB, projects, itemsPerProject int) {
+	tb.Helper()
+	ctx := context.Background()
+	projRepo := repository.NewSQLiteProjectRepo(database)
+	nodeRepo := repository.NewSQLitePlanNodeRepo(database)
+	wiRepo := repository.NewSQLiteWorkItemRepo(database)
+	depRepo := repository.NewSQLiteDependencyRepo(database)
+	sessRepo := repository.NewSQLiteSessionRepo(database)
+
+	now := time.Now().UTC()
+	must := func(err error) {
+		if err != nil {
+			tb.Fatal(err)
+		}
+	}
+	for p := 0; p < projects; p++ {
+		proj := testutil.NewTestProject(fmt.Sprintf("Project %d", p),
+			testutil.WithTargetDate(now.AddDate(0, 0, 14+p*7)))
+		must(projRepo.Create(ctx, proj))
+		node := testutil.NewTestNode(proj.ID, "Backlog", testutil.WithNodeKind(domain.NodeModule))
+		must(nodeRepo.Create(ctx, node))
+
+		var prev *domain.WorkItem
+		for i := 0; i < itemsPerProject; i++ {
+			wi := testutil.NewTestWorkItem(node.ID, fmt.Sprintf("Item %d.%d", p, i),
+				testutil.WithPlannedMin(60+i%4*30),
+				testutil.WithSessionBounds(15, 90, 30))
+			must(wiRepo.Create(ctx, wi))
+			if i%3 == 0 {
+				must(sessRepo.Create(ctx, testutil.NewTestSession(wi.ID, 20,
+					testutil.WithStartedAt(now.Add(-time.Duration(i%6+1)*24*time.Hour)))))
+			}
+			if i%5 == 0 && prev != nil {
+				must(depRepo.Create(ctx, &domain.Dependency{
+					PredecessorWorkItemID: prev.ID, SuccessorWorkItemID: wi.ID,
+				}))
+			}
+			prev = wi
+		}
+	}
+}
+
+func newBenchWhatNow(database db.DBTX) app.WhatNowUseCase {
+	return NewWhatNowService(
+		repository.NewSQLiteWorkItemRepo(database),
+		repository.NewSQLiteSessionRepo(database),
+		repository.NewSQLiteDependencyRepo(database),
+		repository.NewSQLiteUserProfileRepo(database),
+	)
+}
+
+// countingDBTX counts the statements issued through it.
+type countingDBTX struct {
+	db.DBTX
+	queries int
+}
+
+func (c *countingDBTX) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	c.queries++
+	return c.DBTX.QueryContext(ctx, query, args...)
+}
+
+func (c *countingDBTX) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	c.queries++
+	return c.DBTX.QueryRowContext(ctx, query, args...)
+}
+
+// Recommend must load its inputs in a fixed number of batched statements;
+// a per-item lookup would make the count grow with the database.
+func TestWhatNow_Recommend_QueryCountIndependentOfSize(t *testing.T) {
+	count := func(projects, items int) int {
+		database := testutil.NewTestDB(t)
+		seedLargeDB(t, database, projects, items)
+		counter := &countingDBTX{DBTX: database}
+		_, err := newBenchWhatNow(counter).Recommend(context.Background(), app.NewWhatNowRequest(120))
+		require.NoError(t, err)
+		return counter.queries
+	}
+
+	small, large := count(1, 5), count(4, 60)
+	assert.Equal(t, small, large)
+	assert.LessOrEqual(t, large, 5)
+}
+
+// perSessionWorkItemRepo answers ListCandidateWorkItemsWithAggregates the
+// way Recommend used to load its inputs: the schedulable items, then the
+// recent sessions of each window, aggregated in Go.
+type perSessionWorkItemRepo struct {
+	repository.WorkItemRepo
+	sessions repository.SessionRepo
+}
+
+func (r *perSessionWorkItemRepo) ListCandidateWorkItemsWithAggregates(ctx context.Context, includeArchived bool, paceDays, spacingDays int) ([]repository.CandidateWithAggregates, error) {
+	candidates, err := r.ListSchedulable(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	paceSessions, err := r.sessions.ListRecent(ctx, paceDays)
+	if err != nil {
+		return nil, err
+	}
+	spacingSessions, err := r.sessions.ListRecent(ctx, spacingDays)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]repository.CandidateWithAggregates, len(candidates))
+	for i, c := range candidates {
+		out[i].SchedulableCandidate = c
+		for _, sess := range paceSessions {
+			if sess.WorkItemID == c.WorkItem.ID {
+				out[i].RecentMin += sess.Minutes
+			}
+		}
+		for _, sess := range spacingSessions {
+			if sess.WorkItemID == c.WorkItem.ID && (out[i].LastSessionAt == nil || sess.StartedAt.After(*out[i].LastSessionAt)) {
+				at := sess.StartedAt
+				out[i].LastSessionAt = &at
+			}
+		}
+	}
+	return out, nil
+}
+
+// The batched candidate query must not change a single byte of the
+// recommendation compared with aggregating the sessions one by one.
+func TestWhatNow_BatchedAggregates_MatchPerSessionPath(t *testing.T) {
+	database := testutil.NewTestDB(t)
+	seedLargeDB(t, database, 4, 60)
+	ctx := context.Background()
+
+	profiles := repository.NewSQLiteUserProfileRepo(database)
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.PaceWindowDays, profile.SpacingLookbackDays = 3, 5
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	workItems := repository.NewSQLiteWorkItemRepo(database)
+	sessions := repository.NewSQLiteSessionRepo(database)
+	deps := repository.NewSQLiteDependencyRepo(database)
+	batched := NewWhatNowService(workItems, sessions, deps, profiles)
+	perSession := NewWhatNowService(&perSessionWorkItemRepo{WorkItemRepo: workItems, sessions: sessions}, sessions, deps, profiles)
+
+	now := time.Now().UTC()
+	req := app.NewWhatNowRequest(240)
+	req.Now = &now
+	req.IncludeRanking = true
+
+	want, err := perSession.Recommend(ctx, req)
+	require.NoError(t, err)
+	got, err := batched.Recommend(ctx, req)
+	require.NoError(t, err)
+
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.Equal(t, string(wantJSON), string(gotJSON))
+}
+
+func BenchmarkWhatNow_Recommend_LargeDB(b *testing.B) {
+	for _, size := range []struct{ projects, items int }{{5, 100}, {20, 250}} {
+		b.Run(fmt.Sprintf("items=%d", size.projects*size.items), func(b *testing.B) {
+			database := testutil.NewTestDB(b)
+			seedLargeDB(b, database, size.projects, size.items)
+			svc := newBenchWhatNow(database)
+			ctx := context.Background()
+			req := app.NewWhatNowRequest(120)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := svc.Recommend(ctx, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/app"
@@ -57,38 +59,469 @@ func (s *whatNowService) Recommend(ctx context.Context, req app.WhatNowRequest)
 		})
 	}()
 
+	if req.Until != nil {
+		if req.AvailableMin, err = minutesUntil(req); err != nil {
+			return nil, err
+		}
+		fields["available_min"] = req.AvailableMin
+		fields["until"] = req.Until.UTC().Format(time.RFC3339)
+	}
+
 	maxSlices := req.MaxSlices
 	if maxSlices <= 0 {
 		maxSlices = 3
 	}
 	fields["max_slices"] = maxSlices
+	if req.MaxProjects > 0 {
+		fields["max_projects"] = req.MaxProjects
+	}
 
 	var rctx *RecommendationContext
 	rctx, err = s.loader.Load(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkActiveHours(rctx.Profile, req, rctx.Now); err != nil {
+		return nil, err
+	}
 
 	agg := ComputeAggregates(rctx)
 	mode := DetermineMode(agg)
 
+	var filterBlockers []app.ConstraintBlocker
+	var filterWarnings []string
+	if !req.ForceEarly {
+		var startBlockers []app.ConstraintBlocker
+		mode, startBlockers = applyNotStarted(rctx, agg, mode)
+		if len(startBlockers) > 0 {
+			fields["not_started_count"] = len(startBlockers)
+		}
+		filterBlockers = append(filterBlockers, startBlockers...)
+	}
+	snoozed := snoozedCriticalProjects(rctx, agg)
+	if len(snoozed) > 0 {
+		fields["snoozed_count"] = len(snoozed)
+		var snoozeBlockers []app.ConstraintBlocker
+		mode, snoozeBlockers, filterWarnings = applySnoozedCritical(rctx, agg, snoozed)
+		filterBlockers = append(filterBlockers, snoozeBlockers...)
+	}
+	if len(req.AvoidProjects) > 0 {
+		fields["avoid_count"] = len(req.AvoidProjects)
+		var avoidBlockers []app.ConstraintBlocker
+		var avoidWarnings []string
+		mode, avoidBlockers, avoidWarnings = applyAvoidedProjects(rctx, agg, req.AvoidProjects)
+		filterBlockers = append(filterBlockers, avoidBlockers...)
+		filterWarnings = append(filterWarnings, avoidWarnings...)
+	}
+	if req.Context != "" {
+		fields["context"] = domain.ContextTag(req.Context)
+		var contextBlockers []app.ConstraintBlocker
+		var contextWarnings []string
+		mode, contextBlockers, contextWarnings = applyContext(rctx, agg, mode, req.Context)
+		filterBlockers = append(filterBlockers, contextBlockers...)
+		filterWarnings = append(filterWarnings, contextWarnings...)
+	}
+
 	var unblocked []repository.SchedulableCandidate
 	var blockers []app.ConstraintBlocker
 	unblocked, blockers, err = s.resolver.Resolve(ctx, rctx.Candidates, rctx.Now)
 	if err != nil {
 		return nil, err
 	}
+	blockers = append(filterBlockers, blockers...)
 
-	scored := ScoreCandidates(unblocked, rctx.RecentSessions, agg, rctx.Weights, mode, rctx.Now)
+	scored := ScoreCandidates(unblocked, rctx.LastSessionAt, agg, rctx.Weights, mode, rctx.Now)
 	scheduler.CanonicalSort(scored)
 
-	slices, allocBlockers := scheduler.AllocateSlices(scored, req.AvailableMin, maxSlices, req.EnforceVariation)
+	if req.MinBlockMin > 0 {
+		fields["min_block"] = req.MinBlockMin
+		for i := range scored {
+			scored[i].Input.MinBlockMin = req.MinBlockMin
+		}
+	}
+
+	var strategyWarning string
+	if req.Strategy == app.WhatNowStrategyWarmup {
+		fields["strategy"] = req.Strategy
+		if !scheduler.WarmupFirst(scored, req.EnforceVariation) {
+			strategyWarning = fmt.Sprintf("No item with %dm or less left to warm up on; using priority order", scheduler.WarmupMaxMin)
+		}
+	}
+
+	var pinWarning string
+	if req.Continue {
+		pinWarning = pinContinueItem(scored, req.ContinueItemID, rctx.LastSessionAt)
+	}
+
+	projectBudget := projectBudgetMin(req, scored)
+	if projectBudget > 0 {
+		fields["project_budget_min"] = projectBudget
+	}
+
+	slices, allocBlockers := scheduler.AllocateSlices(scored, req.AvailableMin, maxSlices, req.MaxProjects, projectBudget, req.EnforceVariation)
 	blockers = append(blockers, allocBlockers...)
 
 	resp = AssembleResponse(rctx.Now, mode, req.AvailableMin, slices, blockers, agg)
+	if req.IncludeRanking {
+		resp.Ranking = buildRanking(scored, slices, blockers, maxSlices, req.MaxProjects, projectBudget, req.EnforceVariation)
+	}
+	resp.Warnings = append(resp.Warnings, filterWarnings...)
+	if strategyWarning != "" {
+		resp.Warnings = append(resp.Warnings, strategyWarning)
+	}
+	if pinWarning != "" {
+		resp.Warnings = append(resp.Warnings, pinWarning)
+	}
 	return resp, nil
 }
 
+// projectBudgetMin resolves the request's per-project budget: the explicit
+// cap, or with EvenProjectBudget the available time split evenly across the
+// projects that have an unblocked candidate (no more than MaxProjects).
+func projectBudgetMin(req app.WhatNowRequest, scored []scheduler.ScoredCandidate) int {
+	if !req.EvenProjectBudget {
+		return req.ProjectBudgetMin
+	}
+	projects := make(map[string]bool)
+	for _, c := range scored {
+		if !c.Blocked {
+			projects[c.Input.ProjectID] = true
+		}
+	}
+	n := len(projects)
+	if req.MaxProjects > 0 {
+		n = min(n, req.MaxProjects)
+	}
+	if n == 0 {
+		return 0
+	}
+	return req.AvailableMin / n
+}
+
+// buildRanking lists every scored candidate by final score, marking the ones
+// that got a slice and saying why each of the others did not.
+func buildRanking(
+	scored []scheduler.ScoredCandidate,
+	slices []app.WorkSlice,
+	blockers []app.ConstraintBlocker,
+	maxSlices int,
+	maxProjects int,
+	projectBudget int,
+	enforceVariation bool,
+) []app.RankedCandidate {
+	selected := make(map[string]bool, len(slices))
+	projectsUsed := make(map[string]bool, len(slices))
+	projectMin := make(map[string]int, len(slices))
+	allocated := 0
+	for _, sl := range slices {
+		selected[sl.WorkItemID] = true
+		projectsUsed[sl.ProjectID] = true
+		projectMin[sl.ProjectID] += sl.AllocatedMin
+		allocated += sl.AllocatedMin
+	}
+	blockedBy := make(map[string]app.ConstraintBlocker, len(blockers))
+	for _, b := range blockers {
+		if _, seen := blockedBy[b.EntityID]; !seen {
+			blockedBy[b.EntityID] = b
+		}
+	}
+
+	ranking := make([]app.RankedCandidate, 0, len(scored))
+	for _, c := range scored {
+		rc := app.RankedCandidate{
+			WorkItemID:  c.Input.WorkItemID,
+			ProjectID:   c.Input.ProjectID,
+			ProjectName: c.Input.ProjectName,
+			Title:       c.Input.Title,
+			RiskLevel:   c.Input.ProjectRisk,
+			Score:       c.Score,
+			Reasons:     c.Reasons,
+			Selected:    selected[c.Input.WorkItemID],
+		}
+		if !rc.Selected {
+			switch b, ok := blockedBy[c.Input.WorkItemID]; {
+			case ok:
+				rc.LostReason = fmt.Sprintf("%s: %s", b.Code, b.Message)
+			case c.Blocked && c.Blocker != nil:
+				rc.LostReason = fmt.Sprintf("%s: %s", c.Blocker.Code, c.Blocker.Message)
+			case maxProjects > 0 && !projectsUsed[c.Input.ProjectID] && len(projectsUsed) >= maxProjects:
+				rc.LostReason = fmt.Sprintf("Project limit reached (%d)", maxProjects)
+			case projectBudget > 0 && projectsUsed[c.Input.ProjectID] &&
+				projectMin[c.Input.ProjectID]+c.Input.MinSessionMin > projectBudget:
+				rc.LostReason = fmt.Sprintf("Project budget used (%dm of %dm)", projectMin[c.Input.ProjectID], projectBudget)
+			case enforceVariation && projectsUsed[c.Input.ProjectID]:
+				rc.LostReason = "Project already has a slice (variation)"
+			case len(slices) >= maxSlices:
+				rc.LostReason = fmt.Sprintf("Slice limit reached (%d)", maxSlices)
+			default:
+				rc.LostReason = fmt.Sprintf("No time left after %dm allocated to higher-ranked items", allocated)
+			}
+		}
+		ranking = append(ranking, rc)
+	}
+	sort.SliceStable(ranking, func(i, j int) bool { return ranking[i].Score > ranking[j].Score })
+	return ranking
+}
+
+// applyNotStarted drops candidates from projects whose start date is still
+// ahead of rctx.Now (unless the request sets ForceEarly), reporting one
+// NOT_STARTED blocker per project with its start date. When that removes
+// every candidate of a critical project, the plan mode is recomputed
+// without it.
+func applyNotStarted(rctx *RecommendationContext, agg ProjectAggregates, mode domain.PlanMode) (domain.PlanMode, []app.ConstraintBlocker) {
+	counts := make(map[string]int)
+	starts := make(map[string]time.Time)
+	kept := rctx.Candidates[:0:0]
+	for _, c := range rctx.Candidates {
+		if c.ProjectStartDate != nil && rctx.Now.Before(*c.ProjectStartDate) {
+			counts[c.ProjectID]++
+			starts[c.ProjectID] = *c.ProjectStartDate
+			continue
+		}
+		kept = append(kept, c)
+	}
+	if len(counts) == 0 {
+		return mode, nil
+	}
+	rctx.Candidates = kept
+
+	ids := make([]string, 0, len(counts))
+	for pid := range counts {
+		ids = append(ids, pid)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if !starts[ids[i]].Equal(starts[ids[j]]) {
+			return starts[ids[i]].Before(starts[ids[j]])
+		}
+		return agg.Names[ids[i]] < agg.Names[ids[j]]
+	})
+
+	blockers := make([]app.ConstraintBlocker, 0, len(ids))
+	for _, pid := range ids {
+		blockers = append(blockers, app.ConstraintBlocker{
+			EntityType: "project",
+			EntityID:   pid,
+			Code:       app.BlockerNotStarted,
+			Message: fmt.Sprintf("Project '%s' starts %s (%d items); use --force-early to work ahead",
+				agg.Names[pid], starts[pid].Format("2006-01-02"), counts[pid]),
+		})
+	}
+	return candidateMode(rctx, agg), blockers
+}
+
+// candidateMode is the plan mode for the candidates still in rctx: critical
+// when any of them belongs to a critical project.
+func candidateMode(rctx *RecommendationContext, agg ProjectAggregates) domain.PlanMode {
+	for _, c := range rctx.Candidates {
+		if agg.Risks[c.ProjectID].Level == domain.RiskCritical {
+			return domain.ModeCritical
+		}
+	}
+	return domain.ModeBalanced
+}
+
+// snoozedCriticalProjects returns when each critical project's snooze ends,
+// for the critical projects snoozed past rctx.Now. A snooze on a project that
+// is not critical has no effect.
+func snoozedCriticalProjects(rctx *RecommendationContext, agg ProjectAggregates) map[string]time.Time {
+	var snoozed map[string]time.Time
+	for _, c := range rctx.Candidates {
+		until := c.ProjectCriticalSnoozedUntil
+		if until == nil || !until.After(rctx.Now) || agg.Risks[c.ProjectID].Level != domain.RiskCritical {
+			continue
+		}
+		if snoozed == nil {
+			snoozed = make(map[string]time.Time)
+		}
+		snoozed[c.ProjectID] = *until
+	}
+	return snoozed
+}
+
+// applySnoozedCritical sets aside critical projects the user has snoozed
+// (what-now --snooze-critical), typically because they are waiting on
+// someone else. Their candidates are dropped with one CRITICAL_SNOOZED
+// blocker per project and the plan mode is recomputed without them, so the
+// rest is planned in balanced mode unless another project is critical. A
+// warning per project is shown on every request until the snooze expires.
+func applySnoozedCritical(rctx *RecommendationContext, agg ProjectAggregates, snoozed map[string]time.Time) (domain.PlanMode, []app.ConstraintBlocker, []string) {
+	counts := make(map[string]int)
+	kept := rctx.Candidates[:0:0]
+	for _, c := range rctx.Candidates {
+		if _, ok := snoozed[c.ProjectID]; ok {
+			counts[c.ProjectID]++
+			continue
+		}
+		kept = append(kept, c)
+	}
+	rctx.Candidates = kept
+	mode := candidateMode(rctx, agg)
+
+	ids := make([]string, 0, len(snoozed))
+	for pid := range snoozed {
+		ids = append(ids, pid)
+	}
+	sort.Slice(ids, func(i, j int) bool { return agg.Names[ids[i]] < agg.Names[ids[j]] })
+
+	var blockers []app.ConstraintBlocker
+	var warnings []string
+	for _, pid := range ids {
+		name := agg.Names[pid]
+		until := snoozed[pid].Local().Format("2006-01-02 15:04")
+		blockers = append(blockers, app.ConstraintBlocker{
+			EntityType: "project",
+			EntityID:   pid,
+			Code:       app.BlockerCriticalSnoozed,
+			Message:    fmt.Sprintf("Critical project '%s' snoozed until %s (%d items)", name, until, counts[pid]),
+		})
+		warnings = append(warnings, fmt.Sprintf("'%s' is critical but snoozed until %s; its deadline is not being worked", name, until))
+	}
+	return mode, blockers, warnings
+}
+
+// applyAvoidedProjects drops candidates from projects the user is avoiding
+// for this query, reporting one USER_EXCLUDED blocker per project. Risk is
+// still computed for them, but the plan mode is recomputed from the
+// candidates left, so avoiding the only critical project lifts critical
+// mode; a warning says so.
+func applyAvoidedProjects(rctx *RecommendationContext, agg ProjectAggregates, avoid []string) (domain.PlanMode, []app.ConstraintBlocker, []string) {
+	avoided := make(map[string]bool, len(avoid))
+	for _, id := range avoid {
+		avoided[id] = true
+	}
+
+	counts := make(map[string]int)
+	kept := rctx.Candidates[:0:0]
+	for _, c := range rctx.Candidates {
+		if avoided[c.ProjectID] {
+			counts[c.ProjectID]++
+			continue
+		}
+		kept = append(kept, c)
+	}
+	rctx.Candidates = kept
+	mode := candidateMode(rctx, agg)
+
+	var blockers []app.ConstraintBlocker
+	var warnings []string
+	for _, pid := range avoid {
+		n, ok := counts[pid]
+		if !ok {
+			continue
+		}
+		delete(counts, pid) // report each project once
+		name := agg.Names[pid]
+		blockers = append(blockers, app.ConstraintBlocker{
+			EntityType: "project",
+			EntityID:   pid,
+			Code:       app.BlockerUserExcluded,
+			Message:    fmt.Sprintf("Project '%s' avoided for this query (%d items)", name, n),
+		})
+		if mode != domain.ModeCritical && agg.Risks[pid].Level == domain.RiskCritical {
+			warnings = append(warnings, fmt.Sprintf("avoiding '%s' ignores a critical deadline for this query", name))
+		}
+	}
+	return mode, blockers, warnings
+}
+
+// applyContext keeps only candidates tagged with the requested context,
+// reporting each other item as a WRONG_CONTEXT blocker. When nothing is
+// tagged, all candidates stay and a warning says the filter was dropped.
+// Critical mode is lifted when no critical-project work is in context, since
+// none of it could be recommended here anyway.
+func applyContext(rctx *RecommendationContext, agg ProjectAggregates, mode domain.PlanMode, context string) (domain.PlanMode, []app.ConstraintBlocker, []string) {
+	tag := domain.ContextTag(context)
+	var kept, other []repository.SchedulableCandidate
+	for _, c := range rctx.Candidates {
+		if c.WorkItem.HasContext(tag) {
+			kept = append(kept, c)
+		} else {
+			other = append(other, c)
+		}
+	}
+	if len(kept) == 0 {
+		return mode, nil, []string{fmt.Sprintf("no open work is tagged @%s; showing every context", tag)}
+	}
+	rctx.Candidates = kept
+
+	blockers := make([]app.ConstraintBlocker, 0, len(other))
+	for _, c := range other {
+		blockers = append(blockers, app.ConstraintBlocker{
+			EntityType: "work_item",
+			EntityID:   c.WorkItem.ID,
+			Code:       app.BlockerWrongContext,
+			Message:    fmt.Sprintf("'%s' is not tagged @%s", c.WorkItem.Title, tag),
+		})
+	}
+
+	var warnings []string
+	if mode == domain.ModeCritical {
+		critical := false
+		for _, c := range kept {
+			if agg.Risks[c.ProjectID].Level == domain.RiskCritical {
+				critical = true
+				break
+			}
+		}
+		if !critical {
+			mode = domain.ModeBalanced
+			warnings = append(warnings, fmt.Sprintf("no critical work is tagged @%s; critical deadlines are ignored for this query", tag))
+		}
+	}
+	return mode, blockers, warnings
+}
+
+// pinContinueItem re-scores the item being continued without the spacing
+// penalty and moves it to the front of the sorted candidates. When itemID is
+// empty, the in-progress item with the most recent session is used. Returns a
+// warning when nothing could be pinned; critical-mode blocks are respected.
+func pinContinueItem(scored []scheduler.ScoredCandidate, itemID string, lastSessionAt map[string]time.Time) string {
+	if itemID == "" {
+		itemID = mostRecentInProgress(scored, lastSessionAt)
+		if itemID == "" {
+			return "--continue: no in-progress item to continue"
+		}
+	}
+
+	for i := range scored {
+		c := &scored[i]
+		if c.Input.WorkItemID != itemID {
+			continue
+		}
+		if c.Blocked {
+			return fmt.Sprintf("--continue: '%s' is outside critical scope; critical work comes first", c.Input.Title)
+		}
+		input := c.Input
+		input.Pinned = true
+		*c = scheduler.ScoreWorkItem(input)
+		scheduler.PinFirst(scored, itemID)
+		return ""
+	}
+	return "--continue: current item is not schedulable (done, blocked, or fully logged)"
+}
+
+// mostRecentInProgress returns the in-progress candidate with the latest
+// session, falling back to the first in-progress candidate in sort order.
+func mostRecentInProgress(scored []scheduler.ScoredCandidate, lastSessionAt map[string]time.Time) string {
+	best, fallback := "", ""
+	var bestAt time.Time
+	for _, c := range scored {
+		if c.Input.Status != domain.WorkItemInProgress {
+			continue
+		}
+		if fallback == "" {
+			fallback = c.Input.WorkItemID
+		}
+		if at, ok := lastSessionAt[c.Input.WorkItemID]; ok && (best == "" || at.After(bestAt)) {
+			best, bestAt = c.Input.WorkItemID, at
+		}
+	}
+	if best != "" {
+		return best
+	}
+	return fallback
+}
+
 // --- Internal types and helpers used by ComputeAggregates ---
 
 // projectAggregates holds per-project computed data (internal to the risk computation).
@@ -97,6 +530,7 @@ type projectAggregates struct {
 	names      map[string]string
 	planned    map[string]int
 	logged     map[string]int
+	remaining  map[string]int
 	recentMin  map[string]int
 	targetDate map[string]*time.Time
 	startDate  map[string]*time.Time
@@ -112,7 +546,7 @@ type projectIndex struct {
 func buildProjectIndex(
 	candidates []repository.SchedulableCandidate,
 	completedSummaries []repository.CompletedWorkSummary,
-	recentSessions []*domain.WorkSessionLog,
+	recentMin map[string]int,
 	now time.Time,
 ) (projectAggregates, projectIndex) {
 	agg := projectAggregates{
@@ -120,16 +554,17 @@ func buildProjectIndex(
 		names:      make(map[string]string),
 		planned:    make(map[string]int),
 		logged:     make(map[string]int),
+		remaining:  make(map[string]int),
 		recentMin:  make(map[string]int),
 		targetDate: make(map[string]*time.Time),
 		startDate:  make(map[string]*time.Time),
 	}
 
-	workItemToProject := make(map[string]string, len(candidates))
 	dueByNow := make(map[string]int)
 	for _, c := range candidates {
 		agg.planned[c.ProjectID] += c.WorkItem.PlannedMin
 		agg.logged[c.ProjectID] += c.WorkItem.LoggedMin
+		agg.remaining[c.ProjectID] += scheduler.RemainingMin(c.WorkItem.PlannedMin, c.WorkItem.LoggedMin, c.WorkItem.UnitsTotal, c.WorkItem.UnitsDone)
 		agg.names[c.ProjectID] = c.ProjectName
 		if c.ProjectTargetDate != nil {
 			agg.targetDate[c.ProjectID] = c.ProjectTargetDate
@@ -137,7 +572,9 @@ func buildProjectIndex(
 		if c.ProjectStartDate != nil {
 			agg.startDate[c.ProjectID] = c.ProjectStartDate
 		}
-		workItemToProject[c.WorkItem.ID] = c.ProjectID
+		if m := recentMin[c.WorkItem.ID]; m > 0 {
+			agg.recentMin[c.ProjectID] += m
+		}
 
 		effectiveDue := earliestDueDate(c.WorkItem.DueDate, c.NodeDueDate, c.ProjectTargetDate)
 		if effectiveDue != nil && !effectiveDue.After(now) {
@@ -150,17 +587,11 @@ func buildProjectIndex(
 		completedByProject[cs.ProjectID] = cs
 	}
 
-	for _, sess := range recentSessions {
-		if pid, ok := workItemToProject[sess.WorkItemID]; ok {
-			agg.recentMin[pid] += sess.Minutes
-		}
-	}
-
 	return agg, projectIndex{dueByNow: dueByNow, completedByProject: completedByProject}
 }
 
 // computeProjectRisks computes risk levels for each project using timeline math.
-func computeProjectRisks(agg *projectAggregates, idx projectIndex, now time.Time, bufferPct float64, baselineDailyMin int) {
+func computeProjectRisks(agg *projectAggregates, idx projectIndex, now time.Time, bufferPct float64, baselineDailyMin, maxDailyMin, paceDays int) {
 	for pid := range agg.planned {
 		cs := idx.completedByProject[pid]
 
@@ -185,18 +616,21 @@ func computeProjectRisks(agg *projectAggregates, idx projectIndex, now time.Time
 			dueBasedExpectedPct = float64(expectedDoneMin) / float64(allPlanned) * 100
 		}
 
-		recentDaily := float64(agg.recentMin[pid]) / 7.0
+		recentDaily := float64(agg.recentMin[pid]) / float64(paceDays)
 		effectiveDaily := math.Max(recentDaily, float64(baselineDailyMin))
+		remaining := agg.remaining[pid]
 		agg.risks[pid] = scheduler.ComputeRisk(scheduler.RiskInput{
 			Now:                 now,
 			TargetDate:          agg.targetDate[pid],
 			PlannedMin:          agg.planned[pid],
 			LoggedMin:           agg.logged[pid],
+			RemainingWorkMin:    &remaining,
 			BufferPct:           bufferPct,
 			RecentDailyMin:      effectiveDaily,
 			ProgressPct:         progressPct,
 			TimeElapsedPct:      timeElapsedPct,
 			DueBasedExpectedPct: dueBasedExpectedPct,
+			MaxDailyMin:         maxDailyMin,
 		})
 	}
 }
@@ -211,3 +645,40 @@ func earliestDueDate(dates ...*time.Time) *time.Time {
 	}
 	return earliest
 }
+
+// minutesUntil returns the whole minutes from req.Now (or the current
+// time) to req.Until, for requests sized by a target time instead of a
+// duration.
+func minutesUntil(req app.WhatNowRequest) (int, error) {
+	now := time.Now().UTC()
+	if req.Now != nil {
+		now = *req.Now
+	}
+	minutes := int(req.Until.Sub(now) / time.Minute)
+	if minutes < 1 {
+		return 0, &app.WhatNowError{
+			Code:    app.ErrInvalidAvailableMin,
+			Message: fmt.Sprintf("until %s is not in the future", req.Until.Local().Format("2006-01-02 15:04")),
+		}
+	}
+	return minutes, nil
+}
+
+// checkActiveHours fails with ErrOutsideActiveHours when the request or the
+// profile asks to respect active hours and now's local time of day is
+// outside them.
+func checkActiveHours(profile *domain.UserProfile, req app.WhatNowRequest, now time.Time) error {
+	if req.IgnoreActiveHours || (!req.RespectActiveHours && !profile.RespectActiveHours) {
+		return nil
+	}
+	now = now.Local()
+	if profile.InActiveHours(now) {
+		return nil
+	}
+	next := profile.NextActiveStart(now)
+	return &app.WhatNowError{
+		Code: app.ErrOutsideActiveHours,
+		Message: fmt.Sprintf("you're outside your active hours (%s-%s); the next window opens %s",
+			domain.FormatClock(profile.ActiveHoursStart), domain.FormatClock(profile.ActiveHoursEnd), next.Format("Mon 15:04")),
+	}
+}
@@ -491,3 +491,879 @@ func TestWhatNow_UserProfileWeightsAffectOrdering(t *testing.T) {
 	assert.NotEqual(t, firstProjectID1, firstProjectID2,
 		"changing scoring weights should change recommendation ordering")
 }
+
+func TestWhatNow_Continue_PinsInProgressItem(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	// Current item: in progress, worked an hour ago, later deadline.
+	projA := testutil.NewTestProject("Current", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projA))
+	nodeA := testutil.NewTestNode(projA.ID, "Node A")
+	require.NoError(t, nodes.Create(ctx, nodeA))
+	wiA := testutil.NewTestWorkItem(nodeA.ID, "Current Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithLoggedMin(30),
+		testutil.WithWorkItemStatus(domain.WorkItemInProgress),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiA))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(wiA.ID, 30,
+		testutil.WithStartedAt(now.Add(-time.Hour)))))
+
+	// Other item: earlier deadline, so canonical order puts it first.
+	projB := testutil.NewTestProject("Other", testutil.WithTargetDate(now.AddDate(0, 5, 0)))
+	require.NoError(t, projects.Create(ctx, projB))
+	nodeB := testutil.NewTestNode(projB.ID, "Node B")
+	require.NoError(t, nodes.Create(ctx, nodeB))
+	wiB := testutil.NewTestWorkItem(nodeB.ID, "Other Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithLoggedMin(30),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiB))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(wiB.ID, 30,
+		testutil.WithStartedAt(now.Add(-48*time.Hour)))))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(90)
+	req.Now = &now
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, wiB.ID, resp.Recommendations[0].WorkItemID, "without --continue the earlier deadline wins")
+
+	req.Continue = true
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Recommendations, 2, "remaining time is still filled normally")
+	assert.Equal(t, wiA.ID, resp.Recommendations[0].WorkItemID)
+	assert.Equal(t, wiB.ID, resp.Recommendations[1].WorkItemID)
+	assert.Empty(t, resp.Warnings)
+
+	var pinned bool
+	for _, r := range resp.Recommendations[0].Reasons {
+		if r.Code == contract.ReasonContinuePinned {
+			pinned = true
+		}
+		assert.NotEqual(t, contract.ReasonSpacingBlocked, r.Code)
+	}
+	assert.True(t, pinned)
+}
+
+func TestWhatNow_Continue_DoesNotOverrideCriticalScope(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projCrit := testutil.NewTestProject("Critical", testutil.WithTargetDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, projects.Create(ctx, projCrit))
+	nodeCrit := testutil.NewTestNode(projCrit.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeCrit))
+	wiCrit := testutil.NewTestWorkItem(nodeCrit.ID, "Critical Task",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiCrit))
+
+	projSafe := testutil.NewTestProject("Safe", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projSafe))
+	nodeSafe := testutil.NewTestNode(projSafe.ID, "Node S")
+	require.NoError(t, nodes.Create(ctx, nodeSafe))
+	wiSafe := testutil.NewTestWorkItem(nodeSafe.ID, "Safe Task",
+		testutil.WithPlannedMin(60),
+		testutil.WithLoggedMin(55),
+		testutil.WithWorkItemStatus(domain.WorkItemInProgress),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiSafe))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+	req.Continue = true
+	req.ContinueItemID = wiSafe.ID
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	require.NotEmpty(t, resp.Recommendations)
+	for _, rec := range resp.Recommendations {
+		assert.Equal(t, projCrit.ID, rec.ProjectID)
+	}
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "critical")
+}
+
+func TestWhatNow_AvoidProjects_ExcludesAndLiftsCriticalMode(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projCrit := testutil.NewTestProject("Critical", testutil.WithTargetDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, projects.Create(ctx, projCrit))
+	nodeCrit := testutil.NewTestNode(projCrit.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeCrit))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeCrit.ID, "Critical Task",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	projSafe := testutil.NewTestProject("Safe", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projSafe))
+	nodeSafe := testutil.NewTestNode(projSafe.ID, "Node S")
+	require.NoError(t, nodes.Create(ctx, nodeSafe))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeSafe.ID, "Safe Task",
+		testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+	req.AvoidProjects = []string{projCrit.ID}
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeBalanced, resp.Mode, "avoiding the only critical project lifts critical mode")
+	require.NotEmpty(t, resp.Recommendations)
+	for _, rec := range resp.Recommendations {
+		assert.Equal(t, projSafe.ID, rec.ProjectID)
+	}
+
+	var excluded []contract.ConstraintBlocker
+	for _, b := range resp.Blockers {
+		if b.Code == contract.BlockerUserExcluded {
+			excluded = append(excluded, b)
+		}
+	}
+	require.Len(t, excluded, 1)
+	assert.Equal(t, projCrit.ID, excluded[0].EntityID)
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "ignores a critical deadline")
+
+	// Avoiding an on-track project keeps critical mode and needs no warning.
+	req.AvoidProjects = []string{projSafe.ID}
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestWhatNow_Context_FiltersAcrossProjectsAndFallsBack(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projCrit := testutil.NewTestProject("Critical", testutil.WithTargetDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, projects.Create(ctx, projCrit))
+	nodeCrit := testutil.NewTestNode(projCrit.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeCrit))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeCrit.ID, "Lab Work",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+		testutil.WithWorkItemTags("office"),
+	)))
+
+	projSafe := testutil.NewTestProject("Safe", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projSafe))
+	nodeSafe := testutil.NewTestNode(projSafe.ID, "Node S")
+	require.NoError(t, nodes.Create(ctx, nodeSafe))
+	errand := testutil.NewTestWorkItem(nodeSafe.ID, "Errand",
+		testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(15, 60, 30),
+		testutil.WithWorkItemTags("home"),
+	)
+	require.NoError(t, workItems.Create(ctx, errand))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeSafe.ID, "Reading",
+		testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+	req.Context = "@home"
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeBalanced, resp.Mode, "no critical work at home lifts critical mode")
+	require.Len(t, resp.Recommendations, 1)
+	assert.Equal(t, errand.ID, resp.Recommendations[0].WorkItemID)
+
+	wrongContext := 0
+	for _, b := range resp.Blockers {
+		if b.Code == contract.BlockerWrongContext {
+			wrongContext++
+		}
+	}
+	assert.Equal(t, 2, wrongContext)
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "critical deadlines are ignored")
+
+	// Critical work in context keeps critical mode.
+	req.Context = "office"
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	assert.Empty(t, resp.Warnings)
+
+	// Nothing tagged: every item stays a candidate and a warning explains why.
+	req.Context = "gym"
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "no open work is tagged @gym")
+	for _, b := range resp.Blockers {
+		assert.NotEqual(t, contract.BlockerWrongContext, b.Code)
+	}
+}
+
+func TestWhatNow_WarmupStrategy_LeadsWithShortItem(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projDeep := testutil.NewTestProject("Thesis", testutil.WithTargetDate(now.AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, projDeep))
+	nodeDeep := testutil.NewTestNode(projDeep.ID, "Chapter")
+	require.NoError(t, nodes.Create(ctx, nodeDeep))
+	deep := testutil.NewTestWorkItem(nodeDeep.ID, "Write chapter",
+		testutil.WithPlannedMin(600),
+		testutil.WithSessionBounds(30, 90, 60),
+	)
+	require.NoError(t, workItems.Create(ctx, deep))
+
+	projChores := testutil.NewTestProject("Chores", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projChores))
+	nodeChores := testutil.NewTestNode(projChores.ID, "Admin")
+	require.NoError(t, nodes.Create(ctx, nodeChores))
+	short := testutil.NewTestWorkItem(nodeChores.ID, "Reply to email",
+		testutil.WithPlannedMin(20),
+		testutil.WithSessionBounds(10, 30, 20),
+	)
+	require.NoError(t, workItems.Create(ctx, short))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(120)
+	req.Now = &now
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, deep.ID, resp.Recommendations[0].WorkItemID, "priority order leads with the deep item")
+
+	req.Strategy = contract.WhatNowStrategyWarmup
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(resp.Recommendations), 2)
+	assert.Equal(t, short.ID, resp.Recommendations[0].WorkItemID)
+	assert.Equal(t, deep.ID, resp.Recommendations[1].WorkItemID)
+	var codes []contract.RecommendationReasonCode
+	for _, r := range resp.Recommendations[0].Reasons {
+		codes = append(codes, r.Code)
+	}
+	assert.Contains(t, codes, contract.ReasonWarmup)
+	assert.Empty(t, resp.Warnings)
+
+	req.Strategy = "fastest"
+	_, err = svc.Recommend(ctx, req)
+	var wnErr *contract.WhatNowError
+	require.ErrorAs(t, err, &wnErr)
+	assert.Equal(t, contract.ErrInvalidStrategy, wnErr.Code)
+}
+
+func TestWhatNow_ActiveHours_RefusesOutsideWindow(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	night := time.Date(2026, 10, 16, 2, 0, 0, 0, time.Local) // Friday
+	proj := testutil.NewTestProject("Thesis", testutil.WithTargetDate(night.AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Chapter")
+	require.NoError(t, nodes.Create(ctx, node))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Write", testutil.WithPlannedMin(120))))
+
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.ActiveHoursStart, profile.ActiveHoursEnd = 7*60, 22*60
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &night
+
+	_, err = svc.Recommend(ctx, req)
+	require.NoError(t, err, "the window only applies when asked for")
+
+	req.RespectActiveHours = true
+	_, err = svc.Recommend(ctx, req)
+	var wnErr *contract.WhatNowError
+	require.ErrorAs(t, err, &wnErr)
+	assert.Equal(t, contract.ErrOutsideActiveHours, wnErr.Code)
+	assert.Contains(t, wnErr.Message, "(07:00-22:00)")
+	assert.Contains(t, wnErr.Message, "opens Fri 07:00")
+
+	req.IgnoreActiveHours = true
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Recommendations)
+
+	morning := night.Add(8 * time.Hour)
+	req.Now, req.IgnoreActiveHours = &morning, false
+	_, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+
+	// The profile setting applies the window to every request.
+	profile.RespectActiveHours = true
+	require.NoError(t, profiles.Upsert(ctx, profile))
+	req.Now, req.RespectActiveHours = &night, false
+	_, err = svc.Recommend(ctx, req)
+	require.ErrorAs(t, err, &wnErr)
+	assert.Equal(t, contract.ErrOutsideActiveHours, wnErr.Code)
+}
+
+func TestWhatNow_IncludeRanking_ListsEveryCandidateWithLostReason(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projA := testutil.NewTestProject("Thesis", testutil.WithTargetDate(now.AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, projA))
+	nodeA := testutil.NewTestNode(projA.ID, "Chapter")
+	require.NoError(t, nodes.Create(ctx, nodeA))
+	for _, title := range []string{"Write chapter", "Outline chapter"} {
+		wi := testutil.NewTestWorkItem(nodeA.ID, title,
+			testutil.WithPlannedMin(300),
+			testutil.WithSessionBounds(30, 60, 60),
+		)
+		require.NoError(t, workItems.Create(ctx, wi))
+	}
+
+	projB := testutil.NewTestProject("Chores", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projB))
+	nodeB := testutil.NewTestNode(projB.ID, "Admin")
+	require.NoError(t, nodes.Create(ctx, nodeB))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeB.ID, "Taxes",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(30, 60, 60),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+	req.MaxSlices = 1
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Ranking, "ranking is opt-in")
+
+	req.IncludeRanking = true
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Ranking, 3)
+	require.Len(t, resp.Recommendations, 1)
+
+	selected := 0
+	var lost []string
+	for i, rc := range resp.Ranking {
+		if i > 0 {
+			assert.GreaterOrEqual(t, resp.Ranking[i-1].Score, rc.Score, "ranking is sorted by score")
+		}
+		if rc.Selected {
+			selected++
+			assert.Equal(t, resp.Recommendations[0].WorkItemID, rc.WorkItemID)
+			assert.Empty(t, rc.LostReason)
+			continue
+		}
+		assert.NotEmpty(t, rc.LostReason)
+		lost = append(lost, rc.LostReason)
+	}
+	assert.Equal(t, 1, selected)
+	assert.Contains(t, lost, "Slice limit reached (1)")
+}
+
+func TestWhatNow_MaxProjects_ConcentratesSlices(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	for i, name := range []string{"Thesis", "Chores", "Garden"} {
+		p := testutil.NewTestProject(name, testutil.WithTargetDate(now.AddDate(0, i+1, 0)))
+		require.NoError(t, projects.Create(ctx, p))
+		n := testutil.NewTestNode(p.ID, "Node")
+		require.NoError(t, nodes.Create(ctx, n))
+		for _, title := range []string{name + " one", name + " two"} {
+			require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(n.ID, title,
+				testutil.WithPlannedMin(300),
+				testutil.WithSessionBounds(30, 60, 30),
+			)))
+		}
+	}
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(120)
+	req.Now = &now
+	req.MaxSlices = 4
+	req.IncludeRanking = true
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	spread := make(map[string]bool)
+	for _, sl := range resp.Recommendations {
+		spread[sl.ProjectID] = true
+	}
+	assert.Len(t, spread, 3, "balanced mode spreads across every project by default")
+
+	req.MaxProjects = 1
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	for _, sl := range resp.Recommendations {
+		assert.Equal(t, resp.Recommendations[0].ProjectID, sl.ProjectID, "one project only")
+	}
+	assert.Len(t, resp.Recommendations, 2, "both of the chosen project's items get time")
+	lost := 0
+	for _, rc := range resp.Ranking {
+		if rc.ProjectID != resp.Recommendations[0].ProjectID {
+			assert.Equal(t, "Project limit reached (1)", rc.LostReason)
+			lost++
+		}
+	}
+	assert.Equal(t, 4, lost)
+}
+
+func TestWhatNow_EvenProjectBudget_SharesTime(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	for i, name := range []string{"Thesis", "Chores"} {
+		p := testutil.NewTestProject(name, testutil.WithTargetDate(now.AddDate(0, 3*(i+1), 0)))
+		require.NoError(t, projects.Create(ctx, p))
+		n := testutil.NewTestNode(p.ID, "Node")
+		require.NoError(t, nodes.Create(ctx, n))
+		for _, title := range []string{name + " one", name + " two"} {
+			require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(n.ID, title,
+				testutil.WithPlannedMin(300),
+				testutil.WithSessionBounds(20, 60, 60),
+			)))
+		}
+	}
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(120)
+	req.Now = &now
+	req.MaxSlices = 4
+	req.EnforceVariation = false
+	req.IncludeRanking = true
+
+	perProject := func(resp *contract.WhatNowResponse) map[string]int {
+		mins := make(map[string]int)
+		for _, sl := range resp.Recommendations {
+			mins[sl.ProjectID] += sl.AllocatedMin
+		}
+		return mins
+	}
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Len(t, perProject(resp), 1, "without a budget the top project takes the whole two hours")
+
+	req.EvenProjectBudget = true
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	mins := perProject(resp)
+	require.Len(t, mins, 2)
+	for _, m := range mins {
+		assert.Equal(t, 60, m, "120 minutes over two projects")
+	}
+	for _, rc := range resp.Ranking {
+		if !rc.Selected {
+			assert.Equal(t, "Project budget used (60m of 60m)", rc.LostReason)
+		}
+	}
+}
+
+func TestWhatNow_ManualPriority_PinsWithinCriticalScope(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projLate := testutil.NewTestProject("Later", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projLate))
+	nodeLate := testutil.NewTestNode(projLate.ID, "Node L")
+	require.NoError(t, nodes.Create(ctx, nodeLate))
+	wiLate := testutil.NewTestWorkItem(nodeLate.ID, "Later Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiLate))
+
+	projSoon := testutil.NewTestProject("Sooner", testutil.WithTargetDate(now.AddDate(0, 5, 0)))
+	require.NoError(t, projects.Create(ctx, projSoon))
+	nodeSoon := testutil.NewTestNode(projSoon.ID, "Node S")
+	require.NoError(t, nodes.Create(ctx, nodeSoon))
+	wiSoon := testutil.NewTestWorkItem(nodeSoon.ID, "Sooner Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiSoon))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, wiSoon.ID, resp.Recommendations[0].WorkItemID, "without a priority the earlier deadline wins")
+
+	wiLate.ManualPriority = domain.PriorityTop
+	require.NoError(t, workItems.Update(ctx, wiLate))
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, wiLate.ID, resp.Recommendations[0].WorkItemID)
+	var marked bool
+	for _, r := range resp.Recommendations[0].Reasons {
+		if r.Code == contract.ReasonManualPriority {
+			marked = true
+		}
+	}
+	assert.True(t, marked)
+
+	// A critical project elsewhere still excludes the pinned item.
+	projCrit := testutil.NewTestProject("Critical", testutil.WithTargetDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, projects.Create(ctx, projCrit))
+	nodeCrit := testutil.NewTestNode(projCrit.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeCrit))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeCrit.ID, "Critical Task",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	require.NotEmpty(t, resp.Recommendations)
+	for _, rec := range resp.Recommendations {
+		assert.Equal(t, projCrit.ID, rec.ProjectID)
+	}
+}
+
+func TestWhatNow_Importance_OutranksDueDateWithinRiskTier(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projChore := testutil.NewTestProject("Chores", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	projChore.Importance = 1
+	require.NoError(t, projects.Create(ctx, projChore))
+	nodeChore := testutil.NewTestNode(projChore.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeChore))
+	wiChore := testutil.NewTestWorkItem(nodeChore.ID, "Chore",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiChore))
+
+	projThesis := testutil.NewTestProject("Thesis")
+	projThesis.Importance = 5
+	require.NoError(t, projects.Create(ctx, projThesis))
+	nodeThesis := testutil.NewTestNode(projThesis.ID, "Node T")
+	require.NoError(t, nodes.Create(ctx, nodeThesis))
+	wiThesis := testutil.NewTestWorkItem(nodeThesis.ID, "Draft chapter",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, wiThesis))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, wiThesis.ID, resp.Recommendations[0].WorkItemID,
+		"an undated importance-5 item outranks a dated importance-1 item in the same tier")
+
+	// With the importance weight off, the due date decides again.
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.WeightImportance = 0
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, wiChore.ID, resp.Recommendations[0].WorkItemID)
+}
+
+func TestWhatNow_SnoozedCritical_FallsBackToBalancedUntilExpiry(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	projCrit := testutil.NewTestProject("Critical", testutil.WithTargetDate(now.AddDate(0, 0, 1)))
+	require.NoError(t, projects.Create(ctx, projCrit))
+	nodeCrit := testutil.NewTestNode(projCrit.ID, "Node C")
+	require.NoError(t, nodes.Create(ctx, nodeCrit))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeCrit.ID, "Critical Task",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	projSafe := testutil.NewTestProject("Safe", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projSafe))
+	nodeSafe := testutil.NewTestNode(projSafe.ID, "Node S")
+	require.NoError(t, nodes.Create(ctx, nodeSafe))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeSafe.ID, "Safe Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+
+	until := now.Add(6 * time.Hour)
+	projCrit.CriticalSnoozedUntil = &until
+	require.NoError(t, projects.Update(ctx, projCrit))
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeBalanced, resp.Mode)
+	require.NotEmpty(t, resp.Recommendations)
+	for _, rec := range resp.Recommendations {
+		assert.Equal(t, projSafe.ID, rec.ProjectID)
+	}
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "'Critical' is critical but snoozed until")
+	var snoozed bool
+	for _, b := range resp.Blockers {
+		if b.Code == contract.BlockerCriticalSnoozed && b.EntityID == projCrit.ID {
+			snoozed = true
+		}
+	}
+	assert.True(t, snoozed)
+
+	// Once the snooze expires, critical mode is back.
+	later := until.Add(time.Minute)
+	req.Now = &later
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestWhatNow_NotStartedProject_ExcludedUnlessForceEarly(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	start := now.AddDate(0, 0, 10)
+
+	// Starts in 10 days with a deadline right after: critical by the numbers,
+	// but nothing can be done about it yet.
+	projLater := testutil.NewTestProject("Next Term",
+		testutil.WithStartDate(start), testutil.WithTargetDate(now.AddDate(0, 0, 12)))
+	require.NoError(t, projects.Create(ctx, projLater))
+	nodeLater := testutil.NewTestNode(projLater.ID, "Week 1")
+	require.NoError(t, nodes.Create(ctx, nodeLater))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeLater.ID, "Pre-reading",
+		testutil.WithPlannedMin(1200),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	projNow := testutil.NewTestProject("Current", testutil.WithTargetDate(now.AddDate(0, 6, 0)))
+	require.NoError(t, projects.Create(ctx, projNow))
+	nodeNow := testutil.NewTestNode(projNow.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, nodeNow))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(nodeNow.ID, "Current Task",
+		testutil.WithPlannedMin(120),
+		testutil.WithSessionBounds(15, 60, 30),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeBalanced, resp.Mode)
+	require.NotEmpty(t, resp.Recommendations)
+	for _, rec := range resp.Recommendations {
+		assert.Equal(t, projNow.ID, rec.ProjectID)
+	}
+	var notStarted *contract.ConstraintBlocker
+	for i, b := range resp.Blockers {
+		if b.Code == contract.BlockerNotStarted {
+			notStarted = &resp.Blockers[i]
+		}
+	}
+	require.NotNil(t, notStarted)
+	assert.Equal(t, projLater.ID, notStarted.EntityID)
+	assert.Contains(t, notStarted.Message, "starts "+start.Format("2006-01-02"))
+
+	req.ForceEarly = true
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	require.NotEmpty(t, resp.Recommendations)
+	assert.Equal(t, projLater.ID, resp.Recommendations[0].ProjectID)
+	for _, b := range resp.Blockers {
+		assert.NotEqual(t, contract.BlockerNotStarted, b.Code)
+	}
+}
+
+func TestWhatNow_UntilSizesAvailableMinutes(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	proj := testutil.NewTestProject("Meeting Prep", testutil.WithTargetDate(now.AddDate(0, 1, 0)))
+	require.NoError(t, projects.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, node))
+	require.NoError(t, workItems.Create(ctx, testutil.NewTestWorkItem(node.ID, "Slides",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 120, 30),
+	)))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(60)
+	req.Now = &now
+	until := now.Add(95*time.Minute + 30*time.Second)
+	req.Until = &until
+
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 95, resp.RequestedMin, "until replaces available_min with whole minutes from now")
+
+	past := now.Add(-time.Minute)
+	req.Until = &past
+	_, err = svc.Recommend(ctx, req)
+	var wnErr *contract.WhatNowError
+	require.ErrorAs(t, err, &wnErr)
+	assert.Equal(t, contract.ErrInvalidAvailableMin, wnErr.Code)
+	assert.Contains(t, wnErr.Message, "is not in the future")
+}
+
+func TestWhatNow_RecentWindows_ShiftRiskAndOrdering(t *testing.T) {
+	projects, nodes, workItems, deps, sessions, profiles, _ := setupRepos(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	profile, err := profiles.Get(ctx)
+	require.NoError(t, err)
+	profile.BaselineDailyMin = 0
+	require.NoError(t, profiles.Upsert(ctx, profile))
+
+	// Sprint: 70m left in 5 days, last worked 10 days ago in a 280m burst.
+	sprint := testutil.NewTestProject("Sprint", testutil.WithTargetDate(now.AddDate(0, 0, 5)))
+	require.NoError(t, projects.Create(ctx, sprint))
+	sprintNode := testutil.NewTestNode(sprint.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, sprintNode))
+	sprintItem := testutil.NewTestWorkItem(sprintNode.ID, "Sprint Task",
+		testutil.WithPlannedMin(270),
+		testutil.WithLoggedMin(200),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, sprintItem))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(sprintItem.ID, 280,
+		testutil.WithStartedAt(now.AddDate(0, 0, -10)))))
+
+	// Steady: months of slack, worked two days ago.
+	steady := testutil.NewTestProject("Steady", testutil.WithTargetDate(now.AddDate(0, 3, 0)))
+	require.NoError(t, projects.Create(ctx, steady))
+	steadyNode := testutil.NewTestNode(steady.ID, "Node")
+	require.NoError(t, nodes.Create(ctx, steadyNode))
+	steadyItem := testutil.NewTestWorkItem(steadyNode.ID, "Steady Task",
+		testutil.WithPlannedMin(300),
+		testutil.WithSessionBounds(15, 60, 30),
+	)
+	require.NoError(t, workItems.Create(ctx, steadyItem))
+	require.NoError(t, sessions.Create(ctx, testutil.NewTestSession(steadyItem.ID, 60,
+		testutil.WithStartedAt(now.AddDate(0, 0, -2)))))
+
+	svc := NewWhatNowService(workItems, sessions, deps, profiles)
+	req := contract.NewWhatNowRequest(120)
+	req.Now = &now
+
+	sprintPace := func(resp *contract.WhatNowResponse) contract.RiskSummary {
+		for _, r := range resp.TopRiskProjects {
+			if r.ProjectID == sprint.ID {
+				return r
+			}
+		}
+		t.Fatalf("no risk summary for Sprint")
+		return contract.RiskSummary{}
+	}
+	recommendsSteady := func(resp *contract.WhatNowResponse) bool {
+		for _, rec := range resp.Recommendations {
+			if rec.ProjectID == steady.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// The default 7-day pace window misses the burst: Sprint shows no
+	// recent activity, turns critical and crowds Steady out.
+	resp, err := svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeCritical, resp.Mode)
+	assert.Equal(t, domain.RiskCritical, sprintPace(resp).RiskLevel)
+	assert.Zero(t, sprintPace(resp).RecentDailyMin)
+	assert.False(t, recommendsSteady(resp))
+
+	// A 14-day window averages it in (280m / 14 days = 20m a day), so
+	// Sprint is no longer critical and Steady gets time again.
+	profile.PaceWindowDays = 14
+	require.NoError(t, profiles.Upsert(ctx, profile))
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModeBalanced, resp.Mode)
+	assert.NotEqual(t, domain.RiskCritical, sprintPace(resp).RiskLevel)
+	assert.InDelta(t, 20.0, sprintPace(resp).RecentDailyMin, 0.01)
+	assert.True(t, recommendsSteady(resp))
+
+	// Spacing: an item last worked 10 days ago only earns the "not worked
+	// on recently" bonus once the look-back reaches that far.
+	spacingReason := func(resp *contract.WhatNowResponse) bool {
+		for _, rec := range resp.Recommendations {
+			if rec.WorkItemID != sprintItem.ID {
+				continue
+			}
+			for _, r := range rec.Reasons {
+				if r.Code == contract.ReasonSpacingOK {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	assert.False(t, spacingReason(resp), "default look-back misses the session")
+
+	profile.SpacingLookbackDays = 14
+	require.NoError(t, profiles.Upsert(ctx, profile))
+	resp, err = svc.Recommend(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, spacingReason(resp), "14-day look-back sees the session")
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+	"github.com/alexanderramin/kairos/internal/repository"
+)
+
+type workPresetService struct {
+	presets repository.WorkPresetRepo
+}
+
+func NewWorkPresetService(presets repository.WorkPresetRepo) WorkPresetService {
+	return &workPresetService{presets: presets}
+}
+
+func (s *workPresetService) Save(ctx context.Context, p *domain.WorkPreset) error {
+	p.Name = strings.ToLower(strings.TrimSpace(p.Name))
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	return s.presets.Upsert(ctx, p)
+}
+
+func (s *workPresetService) Get(ctx context.Context, name string) (*domain.WorkPreset, error) {
+	p, err := s.presets.GetByName(ctx, strings.ToLower(name))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("no work preset named %q (see work preset list)", name)
+	}
+	return p, err
+}
+
+func (s *workPresetService) List(ctx context.Context) ([]*domain.WorkPreset, error) {
+	return s.presets.List(ctx)
+}
+
+func (s *workPresetService) Delete(ctx context.Context, name string) error {
+	err := s.presets.Delete(ctx, strings.ToLower(name))
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("no work preset named %q", name)
+	}
+	return err
+}
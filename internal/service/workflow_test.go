@@ -18,12 +18,12 @@ func TestFullWorkflow_ProjectLifecycle(t *testing.T) {
 	ctx := context.Background()
 
 	// 2. Create all services
-	projectService := NewProjectService(projRepo)
+	projectService := NewProjectService(projRepo, uow)
 	nodeService := NewNodeService(nodeRepo, uow)
 	workItemService := NewWorkItemService(wiRepo, nodeRepo, uow)
 	sessionService := NewSessionService(sessRepo, uow)
 	whatNowService := NewWhatNowService(wiRepo, sessRepo, depRepo, profRepo)
-	statusService := NewStatusService(projRepo, wiRepo, sessRepo, profRepo)
+	statusService := NewStatusService(projRepo, nodeRepo, wiRepo, sessRepo, profRepo, nil)
 	replanService := NewReplanService(projRepo, wiRepo, sessRepo, profRepo, uow)
 
 	// 3. Create a project
@@ -143,7 +143,7 @@ func TestFullWorkflow_MultiProjectVariation(t *testing.T) {
 	projRepo, nodeRepo, wiRepo, depRepo, sessRepo, profRepo, uow := setupRepos(t)
 	ctx := context.Background()
 
-	projectService := NewProjectService(projRepo)
+	projectService := NewProjectService(projRepo, uow)
 	nodeService := NewNodeService(nodeRepo, uow)
 	workItemService := NewWorkItemService(wiRepo, nodeRepo, uow)
 	sessionService := NewSessionService(sessRepo, uow)
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/alexanderramin/kairos/internal/db"
@@ -15,21 +17,47 @@ type workItemService struct {
 	workItems repository.WorkItemRepo
 	nodes     repository.PlanNodeRepo
 	uow       db.UnitOfWork
+	observer  UseCaseObserver
 }
 
 func NewWorkItemService(
 	workItems repository.WorkItemRepo,
 	nodes repository.PlanNodeRepo,
 	uow db.UnitOfWork,
+	observers ...UseCaseObserver,
 ) WorkItemService {
 	return &workItemService{
 		workItems: workItems,
 		nodes:     nodes,
 		uow:       uow,
+		observer:  useCaseObserverOrNoop(observers),
 	}
 }
 
-func (s *workItemService) Create(ctx context.Context, w *domain.WorkItem) error {
+// observe reports a work item mutation with the fields it changed. before is
+// nil for creation; after is nil for deletion.
+func (s *workItemService) observe(ctx context.Context, name string, startedAt time.Time, id string, before, after *domain.WorkItem, err error) {
+	changes := workItemChanges(before, after)
+	if after == nil && before != nil {
+		changes = map[string]domain.FieldChange{"title": {From: before.Title}}
+	}
+	s.observer.ObserveUseCase(ctx, UseCaseEvent{
+		Name:       name,
+		StartedAt:  startedAt,
+		Duration:   time.Since(startedAt),
+		Success:    err == nil,
+		Err:        err,
+		Fields:     map[string]any{"work_item_id": id},
+		EntityType: "work_item",
+		EntityID:   id,
+		Changes:    changes,
+	})
+}
+
+func (s *workItemService) Create(ctx context.Context, w *domain.WorkItem) (err error) {
+	startedAt := time.Now().UTC()
+	defer func() { s.observe(ctx, "create-work-item", startedAt, w.ID, nil, w, err) }()
+
 	if w.ID == "" {
 		w.ID = uuid.New().String()
 	}
@@ -45,17 +73,34 @@ func (s *workItemService) Create(ctx context.Context, w *domain.WorkItem) error
 	if w.DurationSource == "" {
 		w.DurationSource = domain.SourceManual
 	}
+	w.Tags = domain.NormalizeTags(w.Tags)
 
 	return s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txProjects := repository.NewSQLiteProjectRepo(tx)
 		txNodes := repository.NewSQLitePlanNodeRepo(tx)
 		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
 		txSeqs := repository.NewSQLiteProjectSequenceRepo(tx)
 
+		node, err := txNodes.GetByID(ctx, w.NodeID)
+		if err != nil {
+			return fmt.Errorf("looking up node: %w", err)
+		}
+		project, err := txProjects.GetByID(ctx, node.ProjectID)
+		if err != nil {
+			return fmt.Errorf("looking up project: %w", err)
+		}
+		// Project defaults fill only what the caller left unset, before the
+		// built-in session defaults fill the rest.
+		project.WorkDefaults.ApplyTo(w)
+		if w.InitialPlannedMin == 0 {
+			w.InitialPlannedMin = w.PlannedMin
+		}
+		w.ApplySessionDefaults()
+		if err := w.ValidateSessionBounds(); err != nil {
+			return err
+		}
+
 		if w.Seq == 0 {
-			node, err := txNodes.GetByID(ctx, w.NodeID)
-			if err != nil {
-				return fmt.Errorf("looking up node for seq: %w", err)
-			}
 			seq, err := txSeqs.NextProjectSeq(ctx, node.ProjectID)
 			if err != nil {
 				return fmt.Errorf("assigning seq: %w", err)
@@ -83,37 +128,190 @@ func (s *workItemService) ListByProject(ctx context.Context, projectID string) (
 	return s.workItems.ListByProject(ctx, projectID)
 }
 
-func (s *workItemService) Update(ctx context.Context, w *domain.WorkItem) error {
+func (s *workItemService) Update(ctx context.Context, w *domain.WorkItem) (err error) {
+	startedAt := time.Now().UTC()
+	before, _ := s.workItems.GetByID(ctx, w.ID)
+	defer func() { s.observe(ctx, "update-work-item", startedAt, w.ID, before, w, err) }()
+
 	w.UpdatedAt = time.Now().UTC()
+	w.Tags = domain.NormalizeTags(w.Tags)
 	return s.workItems.Update(ctx, w)
 }
 
-func (s *workItemService) MarkDone(ctx context.Context, id string) error {
-	w, err := s.workItems.GetByID(ctx, id)
+func (s *workItemService) MarkDone(ctx context.Context, id string) (err error) {
+	startedAt := time.Now().UTC()
+	var before, w *domain.WorkItem
+	defer func() { s.observe(ctx, "mark-done", startedAt, id, before, w, err) }()
+
+	w, err = s.workItems.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	before = snapshotWorkItem(w)
 	if err := w.MarkDone(time.Now().UTC()); err != nil {
 		return err
 	}
 	return s.workItems.Update(ctx, w)
 }
 
-func (s *workItemService) MarkInProgress(ctx context.Context, id string) error {
-	w, err := s.workItems.GetByID(ctx, id)
+func (s *workItemService) MarkInProgress(ctx context.Context, id string) (err error) {
+	startedAt := time.Now().UTC()
+	var before, w *domain.WorkItem
+	defer func() { s.observe(ctx, "mark-in-progress", startedAt, id, before, w, err) }()
+
+	w, err = s.workItems.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	before = snapshotWorkItem(w)
 	if err := w.MarkInProgress(time.Now().UTC()); err != nil {
 		return err
 	}
 	return s.workItems.Update(ctx, w)
 }
 
-func (s *workItemService) Archive(ctx context.Context, id string) error {
+func (s *workItemService) Clone(ctx context.Context, id, nodeID, title string) (*domain.WorkItem, error) {
+	src, err := s.workItems.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if nodeID == "" {
+		nodeID = src.NodeID
+	}
+	if _, err := s.nodes.GetByID(ctx, nodeID); err != nil {
+		return nil, fmt.Errorf("target node: %w", err)
+	}
+
+	w := src.Clone(nodeID)
+	switch {
+	case title != "":
+		w.Title = title
+	case nodeID == src.NodeID:
+		siblings, err := s.workItems.ListByNode(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		titles := make([]string, len(siblings))
+		for i, sib := range siblings {
+			titles[i] = sib.Title
+		}
+		w.Title = domain.NextCloneTitle(src.Title, titles)
+	}
+	if err := s.Create(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (s *workItemService) BumpEstimate(ctx context.Context, id string, deltaMin int) (_ *domain.WorkItem, _ int, err error) {
+	startedAt := time.Now().UTC()
+	var before, w *domain.WorkItem
+	defer func() { s.observe(ctx, "bump-estimate", startedAt, id, before, w, err) }()
+
+	w, err = s.workItems.GetByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	before = snapshotWorkItem(w)
+	prev, err := w.BumpEstimate(deltaMin, time.Now().UTC())
+	if err != nil {
+		return nil, 0, err
+	}
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+		if err := txWorkItems.Update(ctx, w); err != nil {
+			return err
+		}
+		return txWorkItems.SetInitialPlannedMin(ctx, w.ID, w.InitialPlannedMin)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return w, prev, nil
+}
+
+func (s *workItemService) Move(ctx context.Context, id string, offset int) (bool, error) {
+	moved := false
+	err := s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		txWorkItems := repository.NewSQLiteWorkItemRepo(tx)
+		w, err := txWorkItems.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		siblings, err := txWorkItems.ListByNode(ctx, w.NodeID)
+		if err != nil {
+			return err
+		}
+		from := slices.IndexFunc(siblings, func(sib *domain.WorkItem) bool { return sib.ID == id })
+		to := from + offset
+		if from < 0 || offset == 0 || to < 0 || to >= len(siblings) {
+			return nil
+		}
+
+		item := siblings[from]
+		siblings = append(siblings[:from], siblings[from+1:]...)
+		siblings = append(siblings[:to], append([]*domain.WorkItem{item}, siblings[to:]...)...)
+		for i, sib := range siblings {
+			if sib.OrderIndex == i {
+				continue
+			}
+			if err := txWorkItems.SetOrderIndex(ctx, sib.ID, i); err != nil {
+				return err
+			}
+		}
+		moved = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return moved, nil
+}
+
+func (s *workItemService) Archive(ctx context.Context, id string) (err error) {
+	startedAt := time.Now().UTC()
+	before, _ := s.workItems.GetByID(ctx, id)
+	after := snapshotWorkItem(before)
+	if after != nil {
+		after.Status = domain.WorkItemArchived
+	}
+	defer func() { s.observe(ctx, "archive-work-item", startedAt, id, before, after, err) }()
+
 	return s.workItems.Archive(ctx, id)
 }
 
-func (s *workItemService) Delete(ctx context.Context, id string) error {
+func (s *workItemService) ArchiveDone(ctx context.Context, projectID string) (_ int, err error) {
+	startedAt := time.Now().UTC()
+	var archived int
+	defer func() {
+		s.observer.ObserveUseCase(ctx, UseCaseEvent{
+			Name:       "archive-done-items",
+			StartedAt:  startedAt,
+			Duration:   time.Since(startedAt),
+			Success:    err == nil,
+			Err:        err,
+			Fields:     map[string]any{"project_id": projectID, "archived": archived},
+			EntityType: "project",
+			EntityID:   projectID,
+			Changes:    map[string]domain.FieldChange{"archived_done_items": {To: strconv.Itoa(archived)}},
+		})
+	}()
+
+	err = s.uow.WithinTx(ctx, func(ctx context.Context, tx db.DBTX) error {
+		n, err := repository.NewSQLiteWorkItemRepo(tx).ArchiveDone(ctx, projectID)
+		archived = n
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return archived, nil
+}
+
+func (s *workItemService) Delete(ctx context.Context, id string) (err error) {
+	startedAt := time.Now().UTC()
+	before, _ := s.workItems.GetByID(ctx, id)
+	defer func() { s.observe(ctx, "delete-work-item", startedAt, id, before, nil, err) }()
+
 	return s.workItems.Delete(ctx, id)
 }
@@ -61,6 +61,47 @@ func TestWorkItemService_Create_DefaultStatus(t *testing.T) {
 	assert.Equal(t, domain.WorkItemTodo, fetched.Status)
 }
 
+func TestWorkItemService_Create_InitialPlannedMinIsImmutable(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	_, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	ctx := context.Background()
+
+	wi := testutil.NewTestWorkItem(nodeID, "Essay", testutil.WithPlannedMin(60))
+	require.NoError(t, svc.Create(ctx, wi))
+	assert.Equal(t, 60, wi.InitialPlannedMin)
+
+	wi.PlannedMin = 91
+	wi.InitialPlannedMin = 999 // ignored: the baseline is write-once
+	require.NoError(t, svc.Update(ctx, wi))
+
+	fetched, err := svc.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 91, fetched.PlannedMin)
+	assert.Equal(t, 60, fetched.InitialPlannedMin)
+}
+
+func TestWorkItemService_BumpEstimate(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	_, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	ctx := context.Background()
+
+	wi := testutil.NewTestWorkItem(nodeID, "Essay", testutil.WithPlannedMin(60))
+	require.NoError(t, svc.Create(ctx, wi))
+
+	updated, prev, err := svc.BumpEstimate(ctx, wi.ID, 30)
+	require.NoError(t, err)
+	assert.Equal(t, 60, prev)
+	assert.Equal(t, 90, updated.PlannedMin)
+
+	fetched, err := svc.GetByID(ctx, wi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 90, fetched.PlannedMin)
+	assert.Equal(t, 90, fetched.InitialPlannedMin, "bump moves the estimation baseline")
+
+	_, _, err = svc.BumpEstimate(ctx, "missing", 10)
+	require.Error(t, err)
+}
+
 func TestWorkItemService_GetByID(t *testing.T) {
 	svc, projRepo, nodeRepo := setupWorkItemService(t)
 	_, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
@@ -160,6 +201,30 @@ func TestWorkItemService_Archive(t *testing.T) {
 	assert.Equal(t, domain.WorkItemArchived, fetched.Status)
 }
 
+func TestWorkItemService_ArchiveDone(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	projID, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	ctx := context.Background()
+
+	done := testutil.NewTestWorkItem(nodeID, "Finished")
+	require.NoError(t, svc.Create(ctx, done))
+	require.NoError(t, svc.MarkDone(ctx, done.ID))
+	todo := testutil.NewTestWorkItem(nodeID, "Open")
+	require.NoError(t, svc.Create(ctx, todo))
+
+	n, err := svc.ArchiveDone(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	fetched, err := svc.GetByID(ctx, done.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemArchived, fetched.Status)
+
+	n, err = svc.ArchiveDone(ctx, projID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n, "already archived items are not counted again")
+}
+
 func TestWorkItemService_Delete(t *testing.T) {
 	svc, projRepo, nodeRepo := setupWorkItemService(t)
 	_, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
@@ -192,3 +257,126 @@ func TestWorkItemService_Create_SeqNotConsumedOnInsertFailure(t *testing.T) {
 	require.NoError(t, svc.Create(ctx, second))
 	assert.Equal(t, 2, second.Seq, "failed insert should not consume a sequence number")
 }
+
+func TestWorkItemService_Clone(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	projID, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	ctx := context.Background()
+
+	src := testutil.NewTestWorkItem(nodeID, "Read Ch1", testutil.WithPlannedMin(60),
+		testutil.WithSessionBounds(20, 45, 30), testutil.WithWorkItemTags("home"))
+	require.NoError(t, svc.Create(ctx, src))
+	require.NoError(t, svc.MarkDone(ctx, src.ID))
+
+	same, err := svc.Clone(ctx, src.ID, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Read Ch2", same.Title)
+	assert.Equal(t, nodeID, same.NodeID)
+	assert.NotEqual(t, src.ID, same.ID)
+	assert.Greater(t, same.Seq, src.Seq)
+	got, err := svc.GetByID(ctx, same.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WorkItemTodo, got.Status)
+	assert.Equal(t, 60, got.PlannedMin)
+	assert.Equal(t, 45, got.MaxSessionMin)
+	assert.Equal(t, []string{"home"}, got.Tags)
+
+	again, err := svc.Clone(ctx, src.ID, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Read Ch3", again.Title, "skips titles already in the node")
+
+	other := testutil.NewTestNode(projID, "Other")
+	require.NoError(t, nodeRepo.Create(ctx, other))
+	moved, err := svc.Clone(ctx, src.ID, other.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Read Ch1", moved.Title, "another node keeps the title")
+
+	named, err := svc.Clone(ctx, src.ID, "", "Read Ch9")
+	require.NoError(t, err)
+	assert.Equal(t, "Read Ch9", named.Title)
+
+	_, err = svc.Clone(ctx, src.ID, "missing", "")
+	assert.Error(t, err)
+}
+
+func TestWorkItemService_Move(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	projID, nodeID := setupWorkItemWithProject(t, projRepo, nodeRepo)
+	ctx := context.Background()
+
+	var items []*domain.WorkItem
+	for _, title := range []string{"A", "B", "C"} {
+		w := testutil.NewTestWorkItem(nodeID, title)
+		require.NoError(t, svc.Create(ctx, w))
+		items = append(items, w)
+	}
+	other := testutil.NewTestNode(projID, "Other")
+	require.NoError(t, nodeRepo.Create(ctx, other))
+	require.NoError(t, svc.Create(ctx, testutil.NewTestWorkItem(other.ID, "Elsewhere")))
+
+	order := func() []string {
+		t.Helper()
+		list, err := svc.ListByNode(ctx, nodeID)
+		require.NoError(t, err)
+		out := make([]string, len(list))
+		for i, w := range list {
+			out[i] = w.Title
+		}
+		return out
+	}
+
+	moved, err := svc.Move(ctx, items[2].ID, -1)
+	require.NoError(t, err)
+	assert.True(t, moved)
+	assert.Equal(t, []string{"A", "C", "B"}, order())
+
+	moved, err = svc.Move(ctx, items[0].ID, 2)
+	require.NoError(t, err)
+	assert.True(t, moved)
+	assert.Equal(t, []string{"C", "B", "A"}, order())
+
+	// Past either end of the node is a no-op, not a move to another node.
+	moved, err = svc.Move(ctx, items[0].ID, 1)
+	require.NoError(t, err)
+	assert.False(t, moved)
+	moved, err = svc.Move(ctx, items[2].ID, -1)
+	require.NoError(t, err)
+	assert.False(t, moved)
+	assert.Equal(t, []string{"C", "B", "A"}, order())
+	got, err := svc.GetByID(ctx, items[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, nodeID, got.NodeID)
+
+	_, err = svc.Move(ctx, "missing", 1)
+	assert.Error(t, err)
+}
+
+func TestWorkItemService_Create_AppliesProjectWorkDefaults(t *testing.T) {
+	svc, projRepo, nodeRepo := setupWorkItemService(t)
+	ctx := context.Background()
+
+	proj := testutil.NewTestProject("Defaults")
+	proj.WorkDefaults = domain.WorkDefaults{Type: "reading", PlannedMin: 45, MinSessionMin: 20, MaxSessionMin: 90, DefaultSessionMin: 45}
+	require.NoError(t, projRepo.Create(ctx, proj))
+	node := testutil.NewTestNode(proj.ID, "Node")
+	require.NoError(t, nodeRepo.Create(ctx, node))
+
+	plain := &domain.WorkItem{NodeID: node.ID, Title: "Chapter 1"}
+	require.NoError(t, svc.Create(ctx, plain))
+	got, err := svc.GetByID(ctx, plain.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "reading", got.Type)
+	assert.Equal(t, 45, got.PlannedMin)
+	assert.Equal(t, 45, got.InitialPlannedMin)
+	assert.Equal(t, []int{20, 90, 45}, []int{got.MinSessionMin, got.MaxSessionMin, got.DefaultSessionMin})
+
+	explicit := &domain.WorkItem{NodeID: node.ID, Title: "Problem set", Type: "exercise", PlannedMin: 120, MaxSessionMin: 30}
+	require.NoError(t, svc.Create(ctx, explicit))
+	got, err = svc.GetByID(ctx, explicit.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "exercise", got.Type, "explicit fields win over project defaults")
+	assert.Equal(t, 120, got.PlannedMin)
+	assert.Equal(t, 20, got.MinSessionMin)
+	assert.Equal(t, 30, got.MaxSessionMin)
+	assert.Equal(t, 30, got.DefaultSessionMin, "the clashing default session gives way to the explicit max")
+}
@@ -1,6 +1,11 @@
 package template
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alexanderramin/kairos/internal/domain"
+)
 
 // ValidateSchema checks a TemplateSchema for structural errors.
 // Returns a slice of errors (empty if valid).
@@ -23,6 +28,14 @@ func ValidateSchema(schema *TemplateSchema) []error {
 		errs = append(errs, fmt.Errorf("at least one work item is required"))
 	}
 
+	errs = append(errs, validateVariables(schema.Variables)...)
+	declared := map[string]int{}
+	for _, v := range schema.Variables {
+		if v.Key != "" {
+			declared[v.Key] = 1
+		}
+	}
+
 	// Check node configs.
 	nodeIDs := map[string]bool{}
 	for i, n := range schema.Nodes {
@@ -34,7 +47,16 @@ func ValidateSchema(schema *TemplateSchema) []error {
 		}
 		if n.Kind == "" {
 			errs = append(errs, fmt.Errorf("node[%d]: kind is required", i))
+		} else if !domain.ValidNodeKinds[n.Kind] {
+			errs = append(errs, fmt.Errorf("node[%d]: invalid kind %q", i, n.Kind))
+		}
+		exprs := []string{n.ID, n.Title}
+		if n.ParentID != nil {
+			exprs = append(exprs, *n.ParentID)
 		}
+		exprs = append(exprs, n.Order)
+		exprs = append(exprs, constraintExprs(n.Constraints)...)
+		errs = append(errs, validateExpressions(fmt.Sprintf("node[%d]", i), n.Repeat, declared, exprs)...)
 		if nodeIDs[n.ID] {
 			errs = append(errs, fmt.Errorf("node[%d]: duplicate id %q", i, n.ID))
 		}
@@ -55,6 +77,8 @@ func ValidateSchema(schema *TemplateSchema) []error {
 		if w.Type == "" {
 			errs = append(errs, fmt.Errorf("work_item[%d]: type is required", i))
 		}
+		exprs := append([]string{w.ID, w.NodeID, w.Title}, constraintExprs(w.Constraints)...)
+		errs = append(errs, validateExpressions(fmt.Sprintf("work_item[%d]", i), w.Repeat, declared, exprs)...)
 	}
 
 	// Check dependency configs reference known IDs.
@@ -76,3 +100,99 @@ func ValidateSchema(schema *TemplateSchema) []error {
 
 	return errs
 }
+
+// WorkItemTypeWarnings reports work item types outside domain.ValidWorkItemTypes.
+// These are warnings rather than errors: the engine accepts any type, and
+// several shipped templates use domain-specific ones (e.g. "workout").
+func WorkItemTypeWarnings(schema *TemplateSchema) []string {
+	var warnings []string
+	for i, w := range schema.WorkItems {
+		if w.Type != "" && !domain.ValidWorkItemTypes[w.Type] {
+			warnings = append(warnings, fmt.Sprintf("work_item[%d]: type %q is not a standard work item type", i, w.Type))
+		}
+	}
+	return warnings
+}
+
+// validateVariables checks variable declarations: keys must be present and
+// unique, bounds consistent, and defaults integers within bounds.
+func validateVariables(vars []VariableConfig) []error {
+	var errs []error
+	seen := map[string]bool{}
+	for i, v := range vars {
+		if v.Key == "" {
+			errs = append(errs, fmt.Errorf("variable[%d]: key is required", i))
+		} else if seen[v.Key] {
+			errs = append(errs, fmt.Errorf("variable[%d]: duplicate key %q", i, v.Key))
+		}
+		seen[v.Key] = true
+
+		if v.Type != "" && v.Type != "int" && v.Type != "string" {
+			errs = append(errs, fmt.Errorf("variable[%d]: invalid type %q (expected int or string)", i, v.Type))
+		}
+		if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+			errs = append(errs, fmt.Errorf("variable[%d]: min %d is greater than max %d", i, *v.Min, *v.Max))
+		}
+		if len(v.Default) == 0 || v.Type == "string" {
+			continue
+		}
+		var def int
+		if err := json.Unmarshal(v.Default, &def); err != nil {
+			errs = append(errs, fmt.Errorf("variable[%d]: default %s is not an integer", i, string(v.Default)))
+			continue
+		}
+		if v.Min != nil && def < *v.Min {
+			errs = append(errs, fmt.Errorf("variable[%d]: default %d below minimum %d", i, def, *v.Min))
+		}
+		if v.Max != nil && def > *v.Max {
+			errs = append(errs, fmt.Errorf("variable[%d]: default %d above maximum %d", i, def, *v.Max))
+		}
+	}
+	return errs
+}
+
+// validateExpressions parses an entity's repeat block and evaluates each of
+// its template strings with every declared and loop variable bound, so that
+// unknown variables and malformed {expr} blocks are caught before execution.
+func validateExpressions(label string, rawRepeat json.RawMessage, declared map[string]int, exprs []string) []error {
+	repeats, err := ParseRepeats(rawRepeat)
+	if err != nil {
+		return []error{fmt.Errorf("%s: invalid repeat: %w", label, err)}
+	}
+
+	var errs []error
+	vars := copyVars(declared)
+	for _, r := range repeats {
+		if r.Var == "" {
+			errs = append(errs, fmt.Errorf("%s: repeat var is required", label))
+		}
+		if r.To == nil && r.ToVar == "" {
+			errs = append(errs, fmt.Errorf("%s: repeat needs 'to' or 'to_var'", label))
+		}
+		if r.ToVar != "" {
+			if _, ok := vars[r.ToVar]; !ok {
+				errs = append(errs, fmt.Errorf("%s: repeat to_var %q is not a declared variable", label, r.ToVar))
+			}
+		}
+		if r.Var != "" {
+			vars[r.Var] = 1
+		}
+	}
+
+	for _, expr := range exprs {
+		if expr == "" {
+			continue
+		}
+		if _, err := ExpandTemplate(expr, vars); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %q: %w", label, expr, err))
+		}
+	}
+	return errs
+}
+
+func constraintExprs(c *ConstraintsConfig) []string {
+	if c == nil {
+		return nil
+	}
+	return []string{c.NotBeforeOffsetDays, c.NotAfterOffsetDays, c.DueDateOffsetDays}
+}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,53 @@ func TestValidateSchema_MissingNodeFields(t *testing.T) {
 	assert.Contains(t, errMsgs, "node[0]: title is required")
 	assert.Contains(t, errMsgs, "node[0]: kind is required")
 }
+
+func TestValidateSchema_NodeKindsVariablesAndExpressions(t *testing.T) {
+	lo, hi := 5, 2
+	schema := &TemplateSchema{
+		ID:     "test",
+		Name:   "Test",
+		Domain: "test",
+		Variables: []VariableConfig{
+			{Key: "weeks", Type: "int", Default: []byte(`"four"`)},
+			{Key: "weeks", Type: "int"},
+			{Key: "span", Type: "float", Min: &lo, Max: &hi},
+		},
+		Nodes: []NodeConfig{
+			{ID: "week_{i}", Title: "Week {i}", Kind: "chapter", Repeat: []byte(`{"var":"i","from":1,"to_var":"wekes"}`)},
+		},
+		WorkItems: []WorkItemConfig{
+			{ID: "wi_{i}", NodeID: "week_{i}", Title: "Read {j}", Type: "reading", Repeat: []byte(`{"var":"i","from":1,"to_var":"weeks"}`)},
+		},
+	}
+
+	errMsgs := []string{}
+	for _, e := range ValidateSchema(schema) {
+		errMsgs = append(errMsgs, e.Error())
+	}
+
+	assert.Contains(t, errMsgs, `node[0]: invalid kind "chapter"`)
+	assert.Contains(t, errMsgs, `node[0]: repeat to_var "wekes" is not a declared variable`)
+	assert.Contains(t, errMsgs, `variable[0]: default "four" is not an integer`)
+	assert.Contains(t, errMsgs, `variable[1]: duplicate key "weeks"`)
+	assert.Contains(t, errMsgs, `variable[2]: invalid type "float" (expected int or string)`)
+	assert.Contains(t, errMsgs, "variable[2]: min 5 is greater than max 2")
+
+	hasUndefined := false
+	for _, m := range errMsgs {
+		if strings.HasPrefix(m, `work_item[0]: "Read {j}"`) {
+			hasUndefined = true
+		}
+	}
+	assert.True(t, hasUndefined, "should flag unknown variable j in %v", errMsgs)
+}
+
+func TestWorkItemTypeWarnings(t *testing.T) {
+	schema := &TemplateSchema{
+		WorkItems: []WorkItemConfig{
+			{ID: "a", Type: "reading"},
+			{ID: "b", Type: "workout"},
+		},
+	}
+	assert.Equal(t, []string{`work_item[1]: type "workout" is not a standard work item type`}, WorkItemTypeWarnings(schema))
+}
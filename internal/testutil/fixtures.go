@@ -21,6 +21,12 @@ func WithTargetDate(d time.Time) ProjectOption {
 	}
 }
 
+func WithStartDate(d time.Time) ProjectOption {
+	return func(p *domain.Project) {
+		p.StartDate = d
+	}
+}
+
 func WithProjectStatus(s domain.ProjectStatus) ProjectOption {
 	return func(p *domain.Project) {
 		p.Status = s
@@ -177,6 +183,12 @@ func WithWorkItemType(t string) WorkItemOption {
 	}
 }
 
+func WithWorkItemTags(tags ...string) WorkItemOption {
+	return func(w *domain.WorkItem) {
+		w.Tags = tags
+	}
+}
+
 func NewTestWorkItem(nodeID, title string, opts ...WorkItemOption) *domain.WorkItem {
 	now := time.Now().UTC()
 	w := &domain.WorkItem{
@@ -218,6 +230,12 @@ func WithNote(n string) SessionOption {
 	}
 }
 
+func WithTags(tags ...string) SessionOption {
+	return func(s *domain.WorkSessionLog) {
+		s.Tags = tags
+	}
+}
+
 func WithStartedAt(t time.Time) SessionOption {
 	return func(s *domain.WorkSessionLog) {
 		s.StartedAt = t
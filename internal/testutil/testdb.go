@@ -9,7 +9,7 @@ import (
 
 // NewTestDB creates an in-memory SQLite database with all migrations applied.
 // The database is closed when the test completes.
-func NewTestDB(t *testing.T) *sql.DB {
+func NewTestDB(t testing.TB) *sql.DB {
 	t.Helper()
 	database, err := db.OpenDB(":memory:")
 	if err != nil {